@@ -0,0 +1,66 @@
+package currency
+
+import (
+	"errors"
+	"fmt"
+	"math"
+)
+
+// ErrRateUnavailable is returned when no exchange rate is known for a currency pair.
+var ErrRateUnavailable = errors.New("exchange rate unavailable")
+
+// RateProvider supplies exchange rates between currencies. Implementations must be safe for
+// concurrent use.
+type RateProvider interface {
+	// Rate returns the number of units of to per one unit of from (e.g., Rate("USD", "EUR")
+	// is 0.92 when one dollar buys 0.92 euros). It returns an error wrapping ErrRateUnavailable
+	// if the pair cannot be converted.
+	Rate(from, to string) (float64, error)
+}
+
+// StaticRates is a RateProvider backed by fixed rates against a base currency, for tests and
+// for callers that snapshot rates once per report. Cross rates are derived through the base.
+//
+// Example:
+//
+//	rates := currency.StaticRates{
+//	    Base:  "USD",
+//	    Rates: map[string]float64{"EUR": 0.92, "GBP": 0.79},
+//	}
+//	eurToGBP, err := rates.Rate("EUR", "GBP") // 0.79 / 0.92
+type StaticRates struct {
+	// Base is the currency the rates are quoted against.
+	Base string
+	// Rates maps a currency code to its units per one unit of Base.
+	Rates map[string]float64
+}
+
+// Rate returns the number of units of to per one unit of from. Converting a currency to itself
+// always returns 1. It returns an error wrapping ErrRateUnavailable if either currency has no
+// positive, finite rate against Base.
+func (r StaticRates) Rate(from, to string) (float64, error) {
+	if from == to {
+		return 1, nil
+	}
+	fromRate, ok := r.unitsPerBase(from)
+	if !ok {
+		return 0, fmt.Errorf("%w: %s -> %s", ErrRateUnavailable, from, to)
+	}
+	toRate, ok := r.unitsPerBase(to)
+	if !ok {
+		return 0, fmt.Errorf("%w: %s -> %s", ErrRateUnavailable, from, to)
+	}
+	return toRate / fromRate, nil
+}
+
+// unitsPerBase returns the units of code per one unit of Base.
+func (r StaticRates) unitsPerBase(code string) (float64, bool) {
+	if code == r.Base && code != "" {
+		return 1, true
+	}
+	rate, ok := r.Rates[code]
+	if !ok || rate <= 0 || math.IsNaN(rate) || math.IsInf(rate, 0) {
+		return 0, false
+	}
+	return rate, true
+}
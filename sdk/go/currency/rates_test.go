@@ -0,0 +1,54 @@
+package currency_test
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/rshade/finfocus-spec/sdk/go/currency"
+)
+
+func TestStaticRates_Rate(t *testing.T) {
+	t.Parallel()
+
+	rates := currency.StaticRates{
+		Base:  "USD",
+		Rates: map[string]float64{"EUR": 0.8, "GBP": 0.5, "JPY": 150, "BAD": -1},
+	}
+
+	tests := []struct {
+		name     string
+		from, to string
+		want     float64
+		wantErr  bool
+	}{
+		{name: "base to quoted", from: "USD", to: "EUR", want: 0.8},
+		{name: "quoted to base", from: "GBP", to: "USD", want: 2},
+		{name: "cross rate", from: "EUR", to: "GBP", want: 0.625},
+		{name: "same currency", from: "CHF", to: "CHF", want: 1},
+		{name: "unknown target", from: "USD", to: "CHF", wantErr: true},
+		{name: "unknown source", from: "CHF", to: "USD", wantErr: true},
+		{name: "non-positive rate", from: "USD", to: "BAD", wantErr: true},
+		{name: "empty code", from: "", to: "USD", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := rates.Rate(tt.from, tt.to)
+			if tt.wantErr {
+				if !errors.Is(err, currency.ErrRateUnavailable) {
+					t.Errorf("Rate(%q, %q) error = %v, want ErrRateUnavailable", tt.from, tt.to, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Rate(%q, %q) unexpected error: %v", tt.from, tt.to, err)
+			}
+			if math.Abs(got-tt.want) > 1e-9 {
+				t.Errorf("Rate(%q, %q) = %v, want %v", tt.from, tt.to, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,24 @@
+// Package focuscsv exports FOCUS cost data as flat CSV, the tabular form most FinOps tools
+// and spreadsheets import.
+//
+// # Basic Usage
+//
+//	if err := focuscsv.SerializeCSV(records, os.Stdout); err != nil {
+//		log.Fatal(err)
+//	}
+//
+// # Output Format
+//
+// The first row is a header of FOCUS column IDs (BilledCost, BillingAccountId, ...) in the
+// alphabetical order used by the FOCUS specification's column catalog, followed by one row
+// per FocusCostRecord:
+//   - Dates are RFC 3339 in UTC (e.g., 2025-01-01T00:00:00Z)
+//   - Enums use FOCUS values (e.g., "Usage", "Usage-Based", "AI and Machine Learning")
+//   - Tags and AllocatedTags are JSON objects
+//   - Extended columns become additional columns after the FOCUS columns
+//   - Unset optional values are empty cells
+//
+// Values containing commas, quotes, or newlines are quoted per RFC 4180.
+//
+// See README.md for the full column list.
+package focuscsv
@@ -0,0 +1,217 @@
+package focuscsv
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pbc "github.com/rshade/finfocus-spec/sdk/go/proto/finfocus/v1"
+)
+
+// ErrNilRecord is returned when the records slice contains a nil FocusCostRecord.
+var ErrNilRecord = errors.New("record cannot be nil")
+
+// extendedColumnsField is the proto field whose entries become additional columns.
+const extendedColumnsField protoreflect.Name = "extended_columns"
+
+// columnNameOverrides maps proto field names whose FOCUS column ID is not the
+// CamelCase form of the field name.
+//
+//nolint:gochecknoglobals // Intentional: read-only reference data
+var columnNameOverrides = map[protoreflect.Name]string{
+	"invoice_issuer": "InvoiceIssuerName",
+	"publisher":      "PublisherName",
+}
+
+// alwaysWrittenColumns are the mandatory cost columns, written even when zero. Other
+// numeric columns are nullable in FOCUS and left empty when zero.
+//
+//nolint:gochecknoglobals // Intentional: read-only reference data
+var alwaysWrittenColumns = map[string]bool{
+	"BilledCost":     true,
+	"ContractedCost": true,
+	"EffectiveCost":  true,
+	"ListCost":       true,
+}
+
+// enumValueOverrides maps enum value names whose FOCUS value is not the title-cased form
+// of the value name.
+//
+//nolint:gochecknoglobals // Intentional: read-only reference data
+var enumValueOverrides = map[protoreflect.Name]string{
+	"FOCUS_CHARGE_FREQUENCY_ONE_TIME":         "One-Time",
+	"FOCUS_CHARGE_FREQUENCY_USAGE_BASED":      "Usage-Based",
+	"FOCUS_SERVICE_CATEGORY_MACHINE_LEARNING": "AI and Machine Learning",
+	"FOCUS_SERVICE_CATEGORY_MANAGEMENT":       "Management and Governance",
+	"FOCUS_SERVICE_CATEGORY_NETWORK":          "Networking",
+}
+
+// column is one FOCUS column and the record field it is read from.
+type column struct {
+	name  string
+	field protoreflect.FieldDescriptor
+}
+
+// focusColumns is the FOCUS column set in header order.
+//
+//nolint:gochecknoglobals // Intentional: derived once from the FocusCostRecord descriptor
+var focusColumns = buildColumns()
+
+// buildColumns derives the FOCUS columns from the FocusCostRecord fields, sorted by column ID.
+func buildColumns() []column {
+	fields := (*pbc.FocusCostRecord)(nil).ProtoReflect().Descriptor().Fields()
+	columns := make([]column, 0, fields.Len())
+	for i := range fields.Len() {
+		fd := fields.Get(i)
+		if fd.Name() == extendedColumnsField {
+			continue
+		}
+		name, ok := columnNameOverrides[fd.Name()]
+		if !ok {
+			name = camelCase(string(fd.Name()))
+		}
+		columns = append(columns, column{name: name, field: fd})
+	}
+	slices.SortFunc(columns, func(a, b column) int { return strings.Compare(a.name, b.name) })
+	return columns
+}
+
+// Columns returns the FOCUS column IDs written by SerializeCSV, in header order, excluding
+// extended columns. The returned slice is a copy and may be modified.
+func Columns() []string {
+	names := make([]string, len(focusColumns))
+	for i, c := range focusColumns {
+		names[i] = c.name
+	}
+	return names
+}
+
+// SerializeCSV writes records to w as CSV with a header row of FOCUS column IDs.
+//
+// Columns are the FOCUS columns (see Columns) followed by the union of all records'
+// extended column keys, sorted. A record without a given extended column leaves it empty.
+// An empty records slice writes only the header.
+//
+// Returns ErrNilRecord (wrapped with the record index) if any record is nil, or the first
+// error from w.
+func SerializeCSV(records []*pbc.FocusCostRecord, w io.Writer) error {
+	extended := make(map[string]struct{})
+	for i, record := range records {
+		if record == nil {
+			return fmt.Errorf("records[%d]: %w", i, ErrNilRecord)
+		}
+		for key := range record.GetExtendedColumns() {
+			extended[key] = struct{}{}
+		}
+	}
+	extendedKeys := slices.Sorted(maps.Keys(extended))
+
+	cw := csv.NewWriter(w)
+	row := append(Columns(), extendedKeys...)
+	if err := cw.Write(row); err != nil {
+		return err
+	}
+
+	for i, record := range records {
+		msg := record.ProtoReflect()
+		for j, c := range focusColumns {
+			value, err := formatValue(msg, c)
+			if err != nil {
+				return fmt.Errorf("records[%d] %s: %w", i, c.name, err)
+			}
+			row[j] = value
+		}
+		for j, key := range extendedKeys {
+			row[len(focusColumns)+j] = record.GetExtendedColumns()[key]
+		}
+		if err := cw.Write(row); err != nil {
+			return err
+		}
+	}
+
+	cw.Flush()
+	return cw.Error()
+}
+
+// formatValue renders the field of column c as a CSV cell.
+func formatValue(msg protoreflect.Message, c column) (string, error) {
+	fd := c.field
+	if !msg.Has(fd) {
+		if alwaysWrittenColumns[c.name] {
+			return "0", nil
+		}
+		return "", nil
+	}
+
+	value := msg.Get(fd)
+	switch {
+	case fd.IsMap():
+		m := make(map[string]string, value.Map().Len())
+		value.Map().Range(func(k protoreflect.MapKey, v protoreflect.Value) bool {
+			m[k.String()] = v.String()
+			return true
+		})
+		data, err := json.Marshal(m)
+		return string(data), err
+	case fd.Kind() == protoreflect.DoubleKind:
+		return strconv.FormatFloat(value.Float(), 'f', -1, 64), nil
+	case fd.Kind() == protoreflect.EnumKind:
+		return enumValue(fd.Enum(), value.Enum()), nil
+	case fd.Kind() == protoreflect.MessageKind:
+		ts, ok := value.Message().Interface().(*timestamppb.Timestamp)
+		if !ok {
+			return "", fmt.Errorf("unsupported message type %s", fd.Message().FullName())
+		}
+		return ts.AsTime().UTC().Format(time.RFC3339), nil
+	default:
+		return value.String(), nil
+	}
+}
+
+// enumValue converts an enum value to its FOCUS form, e.g. FOCUS_CHARGE_CATEGORY_USAGE
+// becomes "Usage". Unknown values render as their number.
+func enumValue(ed protoreflect.EnumDescriptor, n protoreflect.EnumNumber) string {
+	ev := ed.Values().ByNumber(n)
+	if ev == nil {
+		return strconv.Itoa(int(n))
+	}
+	if s, ok := enumValueOverrides[ev.Name()]; ok {
+		return s
+	}
+
+	// Strip the type prefix shared with the UNSPECIFIED value (e.g., FOCUS_CHARGE_CATEGORY_)
+	name := string(ev.Name())
+	if unspecified := ed.Values().ByNumber(0); unspecified != nil {
+		name = strings.TrimPrefix(name, strings.TrimSuffix(string(unspecified.Name()), "UNSPECIFIED"))
+	}
+
+	words := strings.Split(strings.ToLower(name), "_")
+	for i, word := range words {
+		if word != "" {
+			words[i] = strings.ToUpper(word[:1]) + word[1:]
+		}
+	}
+	return strings.Join(words, " ")
+}
+
+// camelCase converts a snake_case field name to a FOCUS column ID (billing_account_id →
+// BillingAccountId).
+func camelCase(s string) string {
+	var b strings.Builder
+	for _, part := range strings.Split(s, "_") {
+		if part != "" {
+			b.WriteString(strings.ToUpper(part[:1]) + part[1:])
+		}
+	}
+	return b.String()
+}
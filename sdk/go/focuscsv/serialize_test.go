@@ -0,0 +1,175 @@
+package focuscsv_test
+
+import (
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/rshade/finfocus-spec/sdk/go/focuscsv"
+	pbc "github.com/rshade/finfocus-spec/sdk/go/proto/finfocus/v1"
+)
+
+func usageRecord() *pbc.FocusCostRecord {
+	return &pbc.FocusCostRecord{
+		BillingAccountId:   "123456789012",
+		BillingCurrency:    "USD",
+		BillingPeriodStart: &timestamppb.Timestamp{Seconds: 1735689600},
+		ChargePeriodStart:  &timestamppb.Timestamp{Seconds: 1735689600},
+		ChargePeriodEnd:    &timestamppb.Timestamp{Seconds: 1735693200, Nanos: 500},
+		ChargeCategory:     pbc.FocusChargeCategory_FOCUS_CHARGE_CATEGORY_USAGE,
+		ChargeFrequency:    pbc.FocusChargeFrequency_FOCUS_CHARGE_FREQUENCY_USAGE_BASED,
+		ChargeDescription:  "m5.large, Linux \"on-demand\"\nus-east-1",
+		ServiceCategory:    pbc.FocusServiceCategory_FOCUS_SERVICE_CATEGORY_MACHINE_LEARNING,
+		ServiceName:        "Amazon SageMaker",
+		BilledCost:         0.096,
+		EffectiveCost:      0.081,
+		PricingQuantity:    1,
+		InvoiceIssuer:      "AWS",
+		Tags:               map[string]string{"team": "ml", "env": "prod"},
+	}
+}
+
+// readCSV serializes records and parses the output back into rows keyed by column.
+func readCSV(t *testing.T, records ...*pbc.FocusCostRecord) ([]string, []map[string]string) {
+	t.Helper()
+	var buf bytes.Buffer
+	if err := focuscsv.SerializeCSV(records, &buf); err != nil {
+		t.Fatalf("SerializeCSV() failed: %v", err)
+	}
+
+	lines, err := csv.NewReader(&buf).ReadAll()
+	if err != nil {
+		t.Fatalf("Output is not valid CSV: %v", err)
+	}
+	if len(lines) != len(records)+1 {
+		t.Fatalf("Got %d rows, want header plus %d records", len(lines), len(records))
+	}
+
+	header := lines[0]
+	rows := make([]map[string]string, 0, len(records))
+	for _, line := range lines[1:] {
+		row := make(map[string]string, len(header))
+		for i, name := range header {
+			row[name] = line[i]
+		}
+		rows = append(rows, row)
+	}
+	return header, rows
+}
+
+func TestColumns(t *testing.T) {
+	columns := focuscsv.Columns()
+	if !slices.IsSorted(columns) {
+		t.Errorf("Columns() = %v, want FOCUS column IDs in alphabetical order", columns)
+	}
+	for _, want := range []string{
+		"BilledCost", "BillingAccountId", "ChargePeriodStart", "EffectiveCost",
+		"InvoiceIssuerName", "RegionId", "ServiceCategory", "SkuPriceId", "Tags",
+	} {
+		if !slices.Contains(columns, want) {
+			t.Errorf("Columns() is missing %s", want)
+		}
+	}
+	if slices.Contains(columns, "ExtendedColumns") {
+		t.Error("Columns() should not include ExtendedColumns")
+	}
+
+	columns[0] = "Modified"
+	if focuscsv.Columns()[0] == "Modified" {
+		t.Error("Columns() should return a copy")
+	}
+}
+
+func TestSerializeCSV_Values(t *testing.T) {
+	header, rows := readCSV(t, usageRecord())
+	if !slices.Equal(header, focuscsv.Columns()) {
+		t.Errorf("Header = %v, want Columns()", header)
+	}
+
+	tests := []struct {
+		column string
+		want   string
+	}{
+		{"BillingAccountId", "123456789012"},
+		{"BillingPeriodStart", "2025-01-01T00:00:00Z"},
+		{"ChargePeriodEnd", "2025-01-01T01:00:00Z"},
+		{"ChargeCategory", "Usage"},
+		{"ChargeFrequency", "Usage-Based"},
+		{"ChargeDescription", "m5.large, Linux \"on-demand\"\nus-east-1"},
+		{"ServiceCategory", "AI and Machine Learning"},
+		{"BilledCost", "0.096"},
+		{"PricingQuantity", "1"},
+		{"InvoiceIssuerName", "AWS"},
+		{"Tags", `{"env":"prod","team":"ml"}`},
+		// Mandatory costs are written even when zero
+		{"ListCost", "0"},
+		// Unset optional columns are empty
+		{"BillingPeriodEnd", ""},
+		{"ChargeClass", ""},
+		{"ListUnitPrice", ""},
+		{"RegionId", ""},
+		{"AllocatedTags", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.column, func(t *testing.T) {
+			if got := rows[0][tt.column]; got != tt.want {
+				t.Errorf("%s = %q, want %q", tt.column, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSerializeCSV_Quoting(t *testing.T) {
+	var buf bytes.Buffer
+	if err := focuscsv.SerializeCSV([]*pbc.FocusCostRecord{usageRecord()}, &buf); err != nil {
+		t.Fatalf("SerializeCSV() failed: %v", err)
+	}
+	if !strings.Contains(buf.String(), `"m5.large, Linux ""on-demand""`+"\nus-east-1\"") {
+		t.Errorf("ChargeDescription is not quoted per RFC 4180:\n%s", buf.String())
+	}
+}
+
+func TestSerializeCSV_ExtendedColumns(t *testing.T) {
+	first := usageRecord()
+	first.ExtendedColumns = map[string]string{"x_Project": "atlas"}
+	second := usageRecord()
+	second.ExtendedColumns = map[string]string{"x_CostCenter": "cc-42", "x_Project": "zephyr"}
+
+	header, rows := readCSV(t, first, second)
+	columns := focuscsv.Columns()
+	if want := append(columns, "x_CostCenter", "x_Project"); !slices.Equal(header, want) {
+		t.Errorf("Header = %v, want FOCUS columns followed by sorted extended columns", header[len(columns):])
+	}
+	if rows[0]["x_CostCenter"] != "" || rows[0]["x_Project"] != "atlas" {
+		t.Errorf("First row extended columns = %q, %q", rows[0]["x_CostCenter"], rows[0]["x_Project"])
+	}
+	if rows[1]["x_CostCenter"] != "cc-42" || rows[1]["x_Project"] != "zephyr" {
+		t.Errorf("Second row extended columns = %q, %q", rows[1]["x_CostCenter"], rows[1]["x_Project"])
+	}
+}
+
+func TestSerializeCSV_Empty(t *testing.T) {
+	header, rows := readCSV(t)
+	if !slices.Equal(header, focuscsv.Columns()) || len(rows) != 0 {
+		t.Errorf("SerializeCSV(nil) = %v with %d rows, want header only", header, len(rows))
+	}
+}
+
+func TestSerializeCSV_NilRecord(t *testing.T) {
+	var buf bytes.Buffer
+	err := focuscsv.SerializeCSV([]*pbc.FocusCostRecord{usageRecord(), nil}, &buf)
+	if !errors.Is(err, focuscsv.ErrNilRecord) {
+		t.Fatalf("SerializeCSV() error = %v, want ErrNilRecord", err)
+	}
+	if !strings.Contains(err.Error(), "records[1]") {
+		t.Errorf("Error %q should name the nil record index", err)
+	}
+	if buf.Len() != 0 {
+		t.Error("Expected no output when a record is nil")
+	}
+}
@@ -0,0 +1,114 @@
+// Package budget provides shared budget filtering and summary logic for the FinFocus SDK.
+//
+// This package exists to break circular dependencies between sdk/go/pluginsdk and
+// sdk/go/testing. Both packages need budget filtering and summary logic, but pluginsdk
+// imports testing for conformance test wrappers.
+//
+// # Usage
+//
+// This is an internal package. External consumers should use:
+//   - [github.com/rshade/finfocus-spec/sdk/go/pluginsdk.ApplyBudgetFilter]
+//   - [github.com/rshade/finfocus-spec/sdk/go/pluginsdk.CalculateBudgetSummary]
+package budget
+
+import (
+	"slices"
+	"strings"
+
+	pbc "github.com/rshade/finfocus-spec/sdk/go/proto/finfocus/v1"
+)
+
+// Filter returns the budgets matching every criterion set in filter, preserving order.
+// A nil filter returns budgets unchanged.
+//
+// Criteria within a repeated field are ORed; fields are ANDed:
+//   - providers: the budget's source contains one of the providers (e.g., "aws" matches "aws-budgets")
+//   - regions, resource_types: the budget's scope includes one of the values; a budget whose
+//     scope does not restrict that dimension applies everywhere and matches
+//   - tags: the budget's scope has every tag with the same value
+//   - periods: the budget's period is one of the periods
+//   - health_statuses: the budget's Health is one of the statuses
+func Filter(budgets []*pbc.Budget, filter *pbc.BudgetFilter) []*pbc.Budget {
+	if filter == nil {
+		return budgets
+	}
+
+	result := make([]*pbc.Budget, 0, len(budgets))
+	for _, b := range budgets {
+		if matches(b, filter) {
+			result = append(result, b)
+		}
+	}
+	return result
+}
+
+// matches checks if a budget matches all filter criteria.
+func matches(b *pbc.Budget, filter *pbc.BudgetFilter) bool {
+	if providers := filter.GetProviders(); len(providers) > 0 &&
+		!slices.ContainsFunc(providers, func(p string) bool { return strings.Contains(b.GetSource(), p) }) {
+		return false
+	}
+
+	scope := b.GetFilter()
+	if !scopeIncludesAny(scope.GetRegions(), filter.GetRegions()) ||
+		!scopeIncludesAny(scope.GetResourceTypes(), filter.GetResourceTypes()) {
+		return false
+	}
+	for key, value := range filter.GetTags() {
+		if got, ok := scope.GetTags()[key]; !ok || got != value {
+			return false
+		}
+	}
+
+	if periods := filter.GetPeriods(); len(periods) > 0 && !slices.Contains(periods, b.GetPeriod()) {
+		return false
+	}
+	if statuses := filter.GetHealthStatuses(); len(statuses) > 0 && !slices.Contains(statuses, Health(b)) {
+		return false
+	}
+	return true
+}
+
+// scopeIncludesAny reports whether a budget scoped to scope covers any of wanted. An empty
+// scope covers everything, and an empty wanted matches any scope.
+func scopeIncludesAny(scope, wanted []string) bool {
+	if len(wanted) == 0 || len(scope) == 0 {
+		return true
+	}
+	return slices.ContainsFunc(wanted, func(w string) bool { return slices.Contains(scope, w) })
+}
+
+// Health returns the budget's health status. Budgets without a status (e.g., fetched with
+// include_status=false) or with an unspecified health are considered OK.
+func Health(b *pbc.Budget) pbc.BudgetHealthStatus {
+	health := b.GetStatus().GetHealth()
+	if health == pbc.BudgetHealthStatus_BUDGET_HEALTH_STATUS_UNSPECIFIED {
+		return pbc.BudgetHealthStatus_BUDGET_HEALTH_STATUS_OK
+	}
+	return health
+}
+
+// Summarize builds a BudgetSummary counting budgets by Health. The counts always sum to
+// TotalBudgets.
+func Summarize(budgets []*pbc.Budget) *pbc.BudgetSummary {
+	summary := &pbc.BudgetSummary{
+		TotalBudgets: int32(len(budgets)), //nolint:gosec // length will not exceed int32 max
+	}
+	for _, b := range budgets {
+		switch Health(b) {
+		case pbc.BudgetHealthStatus_BUDGET_HEALTH_STATUS_WARNING:
+			summary.BudgetsWarning++
+		case pbc.BudgetHealthStatus_BUDGET_HEALTH_STATUS_CRITICAL:
+			summary.BudgetsCritical++
+		case pbc.BudgetHealthStatus_BUDGET_HEALTH_STATUS_EXCEEDED:
+			summary.BudgetsExceeded++
+		case pbc.BudgetHealthStatus_BUDGET_HEALTH_STATUS_OK,
+			pbc.BudgetHealthStatus_BUDGET_HEALTH_STATUS_UNSPECIFIED:
+			summary.BudgetsOk++
+		default:
+			// Unknown future statuses are counted as OK so the counts still sum to the total.
+			summary.BudgetsOk++
+		}
+	}
+	return summary
+}
@@ -0,0 +1,148 @@
+package budget_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/rshade/finfocus-spec/sdk/go/internal/budget"
+	pbc "github.com/rshade/finfocus-spec/sdk/go/proto/finfocus/v1"
+)
+
+func testBudgets() []*pbc.Budget {
+	return []*pbc.Budget{
+		{
+			Id:     "aws-prod",
+			Source: "aws-budgets",
+			Period: pbc.BudgetPeriod_BUDGET_PERIOD_MONTHLY,
+			Filter: &pbc.BudgetFilter{
+				Regions: []string{"us-east-1", "us-west-2"},
+				Tags:    map[string]string{"env": "prod"},
+			},
+			Status: &pbc.BudgetStatus{Health: pbc.BudgetHealthStatus_BUDGET_HEALTH_STATUS_WARNING},
+		},
+		{
+			Id:     "gcp-all",
+			Source: "gcp-billing",
+			Period: pbc.BudgetPeriod_BUDGET_PERIOD_QUARTERLY,
+			Status: &pbc.BudgetStatus{Health: pbc.BudgetHealthStatus_BUDGET_HEALTH_STATUS_EXCEEDED},
+		},
+		{
+			Id:     "aws-ec2",
+			Source: "aws-budgets",
+			Period: pbc.BudgetPeriod_BUDGET_PERIOD_MONTHLY,
+			Filter: &pbc.BudgetFilter{
+				Regions:       []string{"eu-west-1"},
+				ResourceTypes: []string{"aws:ec2:Instance"},
+			},
+		},
+	}
+}
+
+func ids(budgets []*pbc.Budget) []string {
+	result := make([]string, 0, len(budgets))
+	for _, b := range budgets {
+		result = append(result, b.GetId())
+	}
+	return result
+}
+
+func TestFilter(t *testing.T) {
+	tests := []struct {
+		name   string
+		filter *pbc.BudgetFilter
+		want   []string
+	}{
+		{"nil filter", nil, []string{"aws-prod", "gcp-all", "aws-ec2"}},
+		{"empty filter", &pbc.BudgetFilter{}, []string{"aws-prod", "gcp-all", "aws-ec2"}},
+		{
+			"provider matches source",
+			&pbc.BudgetFilter{Providers: []string{"aws"}},
+			[]string{"aws-prod", "aws-ec2"},
+		},
+		{
+			"providers are ORed",
+			&pbc.BudgetFilter{Providers: []string{"gcp", "kubecost"}},
+			[]string{"gcp-all"},
+		},
+		{
+			"region in scope or unscoped",
+			&pbc.BudgetFilter{Regions: []string{"us-west-2"}},
+			[]string{"aws-prod", "gcp-all"},
+		},
+		{
+			"resource type in scope or unscoped",
+			&pbc.BudgetFilter{ResourceTypes: []string{"aws:s3:Bucket"}},
+			[]string{"aws-prod", "gcp-all"},
+		},
+		{
+			"tags must match scope",
+			&pbc.BudgetFilter{Tags: map[string]string{"env": "prod"}},
+			[]string{"aws-prod"},
+		},
+		{
+			"period",
+			&pbc.BudgetFilter{Periods: []pbc.BudgetPeriod{pbc.BudgetPeriod_BUDGET_PERIOD_QUARTERLY}},
+			[]string{"gcp-all"},
+		},
+		{
+			"missing status counts as OK",
+			&pbc.BudgetFilter{
+				HealthStatuses: []pbc.BudgetHealthStatus{pbc.BudgetHealthStatus_BUDGET_HEALTH_STATUS_OK},
+			},
+			[]string{"aws-ec2"},
+		},
+		{
+			"fields are ANDed",
+			&pbc.BudgetFilter{
+				Providers: []string{"aws"},
+				Periods:   []pbc.BudgetPeriod{pbc.BudgetPeriod_BUDGET_PERIOD_MONTHLY},
+				HealthStatuses: []pbc.BudgetHealthStatus{
+					pbc.BudgetHealthStatus_BUDGET_HEALTH_STATUS_WARNING,
+					pbc.BudgetHealthStatus_BUDGET_HEALTH_STATUS_EXCEEDED,
+				},
+			},
+			[]string{"aws-prod"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ids(budget.Filter(testBudgets(), tt.filter))
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("Filter() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSummarize(t *testing.T) {
+	budgets := append(testBudgets(),
+		&pbc.Budget{Id: "unspecified", Status: &pbc.BudgetStatus{}},
+		&pbc.Budget{
+			Id:     "critical",
+			Status: &pbc.BudgetStatus{Health: pbc.BudgetHealthStatus_BUDGET_HEALTH_STATUS_CRITICAL},
+		},
+	)
+
+	summary := budget.Summarize(budgets)
+	if summary.GetTotalBudgets() != 5 {
+		t.Errorf("TotalBudgets = %d, want 5", summary.GetTotalBudgets())
+	}
+	if summary.GetBudgetsOk() != 2 {
+		t.Errorf("BudgetsOk = %d, want 2 (no status and unspecified)", summary.GetBudgetsOk())
+	}
+	if summary.GetBudgetsWarning() != 1 {
+		t.Errorf("BudgetsWarning = %d, want 1", summary.GetBudgetsWarning())
+	}
+	if summary.GetBudgetsCritical() != 1 {
+		t.Errorf("BudgetsCritical = %d, want 1", summary.GetBudgetsCritical())
+	}
+	if summary.GetBudgetsExceeded() != 1 {
+		t.Errorf("BudgetsExceeded = %d, want 1", summary.GetBudgetsExceeded())
+	}
+
+	empty := budget.Summarize(nil)
+	if empty.GetTotalBudgets() != 0 || empty.GetBudgetsOk() != 0 {
+		t.Errorf("Summarize(nil) = %v, want all zero", empty)
+	}
+}
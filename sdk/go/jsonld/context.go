@@ -1,6 +1,10 @@
 package jsonld
 
-import "net/url"
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+)
 
 // Context defines the JSON-LD context configuration for vocabulary mapping.
 //
@@ -39,6 +43,98 @@ func NewContext() *Context {
 	}
 }
 
+// NewContextFromJSON creates a context from an external JSON-LD @context document, for
+// organizations that maintain their own linked-data vocabulary.
+//
+// data is either a document with an "@context" member or a bare @context value. The value may
+// be an object of term definitions, a remote context URL, or an array of both. Term definitions
+// may be IRI strings, expanded term definitions (objects, e.g. {"@id": "...", "@type": "..."}),
+// or null; keywords such as "@vocab" are kept as-is.
+//
+// Precedence, from lowest to highest:
+//  1. The default schema, focus, and xsd prefixes of NewContext.
+//  2. Term definitions of the document, with later objects in an array overriding earlier ones.
+//     A document may therefore redefine "focus" or "schema".
+//  3. Mappings added afterwards with WithCustomMapping.
+//
+// Remote context URLs are kept in document order and emitted before the inline definitions.
+// Returns a *ValidationError if data is not valid JSON, the @context has an unsupported shape,
+// an expanded term definition has a non-string @id, or a remote URL is invalid (see Validate).
+func NewContextFromJSON(data []byte) (*Context, error) {
+	var doc interface{}
+	if err := json.Unmarshal(data, &doc); err != nil {
+		return nil, &ValidationError{
+			Field:      "@context",
+			Message:    fmt.Sprintf("invalid JSON: %v", err),
+			Suggestion: "provide a JSON-LD @context document",
+		}
+	}
+	if obj, ok := doc.(map[string]interface{}); ok {
+		if wrapped, hasContext := obj["@context"]; hasContext {
+			doc = wrapped
+		}
+	}
+
+	ctx := NewContext()
+	entries, isArray := doc.([]interface{})
+	if !isArray {
+		entries = []interface{}{doc}
+	}
+	for i, entry := range entries {
+		switch e := entry.(type) {
+		case string:
+			ctx.remoteContexts = append(ctx.remoteContexts, e)
+		case map[string]interface{}:
+			for term, def := range e {
+				if err := validateTermDefinition(term, def); err != nil {
+					return nil, err
+				}
+				ctx.customMappings[term] = def
+			}
+		default:
+			return nil, &ValidationError{
+				Field:      fmt.Sprintf("@context[%d]", i),
+				Message:    fmt.Sprintf("unsupported context entry of type %T", entry),
+				Suggestion: "use an object of term definitions or a remote context URL",
+			}
+		}
+	}
+
+	if err := ctx.Validate(); err != nil {
+		return nil, err
+	}
+	return ctx, nil
+}
+
+// validateTermDefinition checks the shape of a single @context term definition.
+func validateTermDefinition(term string, def interface{}) error {
+	switch d := def.(type) {
+	case nil, string:
+		return nil
+	case map[string]interface{}:
+		if id, hasID := d["@id"]; hasID {
+			if _, ok := id.(string); !ok && id != nil {
+				return &ValidationError{
+					Field:      term,
+					Message:    fmt.Sprintf("@id must be a string, got %T", id),
+					Suggestion: "set @id to an IRI or compact IRI",
+				}
+			}
+		}
+		return nil
+	default:
+		if len(term) > 0 && term[0] == '@' {
+			// Keywords such as @version (a number) or @protected (a boolean)
+			return nil
+		}
+		return &ValidationError{
+			Field:      term,
+			Message:    fmt.Sprintf("unsupported term definition of type %T", def),
+			Suggestion: "use an IRI string, an expanded term definition object, or null",
+		}
+	}
+}
+
 // WithCustomMapping adds a custom property mapping to the context.
 //
 // field is the protobuf field name, iri is the JSON-LD property IRI.
@@ -134,24 +230,45 @@ func isValidURL(s string) bool {
 // remote context URLs come first, followed by the inline context object.
 // When no remote contexts exist, returns just the inline context object.
 //
+// The inline object sets @vocab to the FOCUS namespace, so the plain column names used as
+// property keys expand to FOCUS IRIs under any JSON-LD processor. With Schema.org enabled it
+// also defines the "value" and "currency" terms of nested schema:MonetaryAmount objects.
+// Custom mappings are applied last and may override any of these definitions. The serializer
+// also adds type coercions for the fields of each document it emits.
+//
 // Returns:
 //   - []interface{} when remote contexts are configured (array form)
 //   - map[string]interface{} when no remote contexts (object form)
 func (c *Context) Build() interface{} {
+	return c.build(nil)
+}
+
+// build generates the @context value with terms added to the inline context object. The
+// serializer passes the type coercions of a document's fields; custom mappings take precedence
+// over terms of the same name.
+func (c *Context) build(terms map[string]interface{}) interface{} {
 	// Build the inline context object
 	inline := make(map[string]interface{})
 
 	// Add Schema.org vocabulary (if enabled)
 	if c.schemaOrg {
 		inline["schema"] = "https://schema.org/"
+		inline["value"] = "schema:value"
+		inline["currency"] = "schema:currency"
 	}
 
-	// Add FOCUS namespace
+	// Add FOCUS namespace, which is also the vocabulary for undefined terms
 	inline["focus"] = c.focusNamespace
+	inline["@vocab"] = c.focusNamespace
 
 	// Add XSD for type coercions
 	inline["xsd"] = "http://www.w3.org/2001/XMLSchema#"
 
+	// Add document-specific terms (type coercions)
+	for term, def := range terms {
+		inline[term] = def
+	}
+
 	// Add custom mappings
 	for field, mapping := range c.customMappings {
 		inline[field] = mapping
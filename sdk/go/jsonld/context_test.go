@@ -1,9 +1,13 @@
 package jsonld_test
 
 import (
+	"errors"
 	"testing"
 
+	"google.golang.org/protobuf/proto"
+
 	"github.com/rshade/finfocus-spec/sdk/go/jsonld"
+	pbc "github.com/rshade/finfocus-spec/sdk/go/proto/finfocus/v1"
 )
 
 func TestNewContext(t *testing.T) {
@@ -380,3 +384,126 @@ func TestContext_CopyOnWrite_RemoteContext(t *testing.T) {
 		t.Errorf("Expected first element to be remote URL, got %v", result2[0])
 	}
 }
+
+func TestNewContextFromJSON(t *testing.T) {
+	data := []byte(`{
+		"@context": [
+			"https://your-org.com/ontology/v1",
+			{
+				"org": "https://your-org.com/ontology#",
+				"billingAccountId": "org:accountIdentifier",
+				"billedCost": {"@id": "org:invoicedAmount", "@type": "xsd:decimal"},
+				"focus": "https://your-org.com/focus-mirror#"
+			},
+			{"billingAccountId": "org:payerAccount"}
+		]
+	}`)
+
+	ctx, err := jsonld.NewContextFromJSON(data)
+	if err != nil {
+		t.Fatalf("NewContextFromJSON() failed: %v", err)
+	}
+
+	result, ok := ctx.Build().([]interface{})
+	if !ok || len(result) != 2 {
+		t.Fatalf("Build() = %v, want [remote URL, inline object]", ctx.Build())
+	}
+	if result[0] != "https://your-org.com/ontology/v1" {
+		t.Errorf("Remote context = %v, want the document's URL", result[0])
+	}
+
+	inline, ok := result[1].(map[string]interface{})
+	if !ok {
+		t.Fatalf("Inline context = %T, want object", result[1])
+	}
+	tests := []struct {
+		term string
+		want interface{}
+	}{
+		{"schema", "https://schema.org/"},               // default kept
+		{"xsd", "http://www.w3.org/2001/XMLSchema#"},    // default kept
+		{"focus", "https://your-org.com/focus-mirror#"}, // document overrides default
+		{"billingAccountId", "org:payerAccount"},        // later object overrides earlier
+		{"org", "https://your-org.com/ontology#"},       // document term added
+	}
+	for _, tt := range tests {
+		if inline[tt.term] != tt.want {
+			t.Errorf("@context[%q] = %v, want %v", tt.term, inline[tt.term], tt.want)
+		}
+	}
+	def, isObject := inline["billedCost"].(map[string]interface{})
+	if !isObject || def["@id"] != "org:invoicedAmount" {
+		t.Errorf("@context[\"billedCost\"] = %v, want expanded term definition", inline["billedCost"])
+	}
+}
+
+func TestNewContextFromJSON_BareContextAndOverrides(t *testing.T) {
+	data := `{"billingAccountId": "org:accountIdentifier", "@vocab": "https://your-org.com/ontology#"}`
+	ctx, err := jsonld.NewContextFromJSON([]byte(data))
+	if err != nil {
+		t.Fatalf("NewContextFromJSON() failed: %v", err)
+	}
+
+	// WithCustomMapping takes precedence over the document.
+	result, ok := ctx.WithCustomMapping("billingAccountId", "org:override").Build().(map[string]interface{})
+	if !ok {
+		t.Fatal("Expected Build() to return map when no remote contexts")
+	}
+	if result["billingAccountId"] != "org:override" {
+		t.Errorf("billingAccountId = %v, want org:override", result["billingAccountId"])
+	}
+	if result["@vocab"] != "https://your-org.com/ontology#" {
+		t.Errorf("@vocab = %v, want the document's vocabulary", result["@vocab"])
+	}
+	if result["focus"] != "https://focus.finops.org/v1#" {
+		t.Errorf("focus = %v, want default namespace", result["focus"])
+	}
+}
+
+func TestNewContextFromJSON_Errors(t *testing.T) {
+	tests := []struct {
+		name string
+		data string
+	}{
+		{"invalid JSON", `{"@context": `},
+		{"numeric context", `{"@context": 42}`},
+		{"numeric term definition", `{"billingAccountId": 42}`},
+		{"non-string @id", `{"billingAccountId": {"@id": 42}}`},
+		{"invalid remote URL", `{"@context": ["ftp://example.com/context.jsonld"]}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, err := jsonld.NewContextFromJSON([]byte(tt.data))
+			if err == nil {
+				t.Fatalf("NewContextFromJSON() = %v, want error", ctx)
+			}
+			var validationErr *jsonld.ValidationError
+			if !errors.As(err, &validationErr) {
+				t.Errorf("NewContextFromJSON() error = %v, want *ValidationError", err)
+			}
+		})
+	}
+}
+
+func TestNewContextFromJSON_RoundTrip(t *testing.T) {
+	ctx, err := jsonld.NewContextFromJSON([]byte(`{
+		"@context": {"org": "https://your-org.com/ontology#", "serviceName": "org:serviceLabel"}
+	}`))
+	if err != nil {
+		t.Fatalf("NewContextFromJSON() failed: %v", err)
+	}
+
+	record := &pbc.FocusCostRecord{BillingAccountId: "123456789012", ServiceName: "Amazon EC2"}
+	data, err := jsonld.NewSerializer(jsonld.WithContext(ctx)).Serialize(record)
+	if err != nil {
+		t.Fatalf("Serialize() failed: %v", err)
+	}
+	restored, err := jsonld.Deserialize(data)
+	if err != nil {
+		t.Fatalf("Deserialize() failed: %v", err)
+	}
+	if !proto.Equal(record, restored) {
+		t.Errorf("Deserialize() = %v, want %v", restored, record)
+	}
+}
@@ -0,0 +1,401 @@
+package jsonld
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"slices"
+	"strings"
+	"time"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pbc "github.com/rshade/finfocus-spec/sdk/go/proto/finfocus/v1"
+)
+
+// timestampFullName is the protobuf message name of timestamp fields.
+const timestampFullName protoreflect.FullName = "google.protobuf.Timestamp"
+
+// Deserialize parses a JSON-LD document produced by Serialize back into a FocusCostRecord.
+//
+// Property keys are matched to FocusCostRecord fields as follows:
+//   - Bare terms naming a field (e.g., "billingAccountId") are read directly, as written by Serialize.
+//   - Compact IRIs (e.g., "focus:billingAccountId") and absolute IRIs are expanded against the
+//     prefixes declared in the document's @context, falling back to the standard schema, focus,
+//     and xsd prefixes, and matched to terms in the FOCUS namespace.
+//   - Other terms defined in @context are expanded and matched the same way.
+//
+// Values may be plain JSON values or JSON-LD value objects ({"@value": ...}, including
+// language-tagged text). Costs may be plain numbers or schema:MonetaryAmount objects, and enums
+// may be proto names ("FOCUS_CHARGE_CATEGORY_USAGE") or IRIs ("focus:FocusChargeCategoryUsage").
+// Expanded form written with WithExpanded is accepted as well: a single node object, optionally
+// wrapped in an array, whose property values are single-element arrays.
+//
+// The @id is ignored: generated SHA256 IDs carry no record data, and user-provided IDs duplicate
+// a field that is read on its own. Remote contexts are not fetched, and properties outside the
+// FOCUS vocabulary are ignored. Timestamps round-trip at the precision of the serializer's
+// DateFormat (seconds for the default RFC 3339).
+//
+// Returns an error if data is not a JSON object, @type is present but is not
+// focus:FocusCostRecord, or a property value has the wrong type for its field.
+func Deserialize(data []byte) (*pbc.FocusCostRecord, error) {
+	var parsed interface{}
+	if err := json.Unmarshal(data, &parsed); err != nil {
+		return nil, fmt.Errorf("parsing JSON-LD document: %w", err)
+	}
+	doc, ok := singleValue(parsed).(map[string]interface{})
+	if !ok {
+		return nil, &ValidationError{
+			Field:      "document",
+			Message:    "document must be a JSON object or an expanded document with one node",
+			Suggestion: "provide a JSON-LD document produced by Serialize",
+		}
+	}
+
+	resolver := newIRIResolver(doc["@context"])
+	if err := resolver.checkType(doc["@type"]); err != nil {
+		return nil, err
+	}
+
+	record := &pbc.FocusCostRecord{}
+	msg := record.ProtoReflect()
+	fields := msg.Descriptor().Fields()
+
+	// Sorted keys make the reported error deterministic.
+	for _, key := range slices.Sorted(maps.Keys(doc)) {
+		if strings.HasPrefix(key, "@") {
+			continue
+		}
+		fd := fields.ByJSONName(key)
+		if fd == nil {
+			name, ok := resolver.focusTerm(resolver.expandTerm(key))
+			if !ok {
+				continue
+			}
+			if fd = fields.ByJSONName(name); fd == nil {
+				continue
+			}
+		}
+		if err := resolver.setField(msg, fd, key, doc[key]); err != nil {
+			return nil, err
+		}
+	}
+
+	return record, nil
+}
+
+// DeserializeStream reads a stream of JSON-LD documents from r and sends each decoded
+// FocusCostRecord to out, closing out when it returns.
+//
+// The stream is either a JSON array of documents, as written by SerializeStream, or
+// newline-delimited JSON with one document per line. Each document is decoded as by Deserialize.
+// Memory use is bounded by the largest single document: records are read and decoded one at a
+// time, and each is sent on out before the next is read.
+//
+// Decoding stops at the first error, which is returned as a *StreamError whose Index is the
+// position of the failing document; records before it have already been sent. Empty input
+// yields no records and no error. Sends on out block, so the caller must keep receiving until
+// out is closed.
+//
+// Example:
+//
+//	records := make(chan *pbc.FocusCostRecord)
+//	errCh := make(chan error, 1)
+//	go func() { errCh <- jsonld.DeserializeStream(file, records) }()
+//	for record := range records {
+//	    process(record)
+//	}
+//	if err := <-errCh; err != nil {
+//	    log.Fatal(err)
+//	}
+func DeserializeStream(r io.Reader, out chan<- *pbc.FocusCostRecord) error {
+	defer close(out)
+
+	br := bufio.NewReader(r)
+	first, err := peekNonSpace(br)
+	if errors.Is(err, io.EOF) {
+		return nil
+	}
+	if err != nil {
+		return &StreamError{Index: 0, Message: "reading stream", Err: err}
+	}
+
+	dec := json.NewDecoder(br)
+	inArray := first == '['
+	if inArray {
+		if _, err = dec.Token(); err != nil {
+			return &StreamError{Index: 0, Message: "reading opening bracket", Err: err}
+		}
+	}
+
+	index := 0
+	for ; !inArray || dec.More(); index++ {
+		var raw json.RawMessage
+		if err = dec.Decode(&raw); err != nil {
+			if !inArray && errors.Is(err, io.EOF) {
+				return nil
+			}
+			return &StreamError{Index: index, Message: "parsing document", Err: err}
+		}
+		record, deserializeErr := Deserialize(raw)
+		if deserializeErr != nil {
+			return &StreamError{Index: index, Message: "decoding document", Err: deserializeErr}
+		}
+		out <- record
+	}
+
+	if _, err = dec.Token(); err != nil {
+		return &StreamError{Index: index, Message: "reading closing bracket", Err: err}
+	}
+	return nil
+}
+
+// peekNonSpace skips leading JSON whitespace and returns the next byte without consuming it.
+func peekNonSpace(br *bufio.Reader) (byte, error) {
+	for {
+		b, err := br.Peek(1)
+		if err != nil {
+			return 0, err
+		}
+		switch b[0] {
+		case ' ', '\t', '\n', '\r':
+			_, _ = br.ReadByte()
+		default:
+			return b[0], nil
+		}
+	}
+}
+
+// iriResolver expands terms and compact IRIs using the definitions in a document's @context.
+type iriResolver struct {
+	definitions map[string]string
+}
+
+// newIRIResolver collects the string term definitions of an @context value. Remote context
+// URLs are skipped; standard prefixes apply unless the context redefines them.
+func newIRIResolver(context interface{}) *iriResolver {
+	r := &iriResolver{definitions: maps.Clone(standardPrefixes)}
+
+	var collect func(interface{})
+	collect = func(v interface{}) {
+		switch c := v.(type) {
+		case []interface{}:
+			for _, item := range c {
+				collect(item)
+			}
+		case map[string]interface{}:
+			for term, def := range c {
+				switch d := def.(type) {
+				case string:
+					r.definitions[term] = d
+				case map[string]interface{}:
+					if id, ok := d["@id"].(string); ok {
+						r.definitions[term] = id
+					}
+				}
+			}
+		}
+	}
+	collect(context)
+
+	return r
+}
+
+// expandTerm returns the IRI a property key refers to: a term defined in the context is replaced
+// by its definition, and a compact IRI whose prefix is defined is expanded.
+func (r *iriResolver) expandTerm(key string) string {
+	if def, ok := r.definitions[key]; ok && !strings.Contains(key, ":") {
+		key = def
+	}
+	return r.expandIRI(key)
+}
+
+// expandIRI expands a compact IRI whose prefix is defined in the context.
+// Absolute IRIs and undefined prefixes are returned unchanged.
+func (r *iriResolver) expandIRI(iri string) string {
+	prefix, suffix, ok := strings.Cut(iri, ":")
+	if !ok || strings.HasPrefix(suffix, "//") {
+		return iri
+	}
+	if ns, defined := r.definitions[prefix]; defined {
+		return ns + suffix
+	}
+	return iri
+}
+
+// focusTerm returns the local name of an expanded IRI in the FOCUS namespace.
+func (r *iriResolver) focusTerm(iri string) (string, bool) {
+	ns := r.definitions["focus"]
+	if ns == "" || !strings.HasPrefix(iri, ns) || len(iri) == len(ns) {
+		return "", false
+	}
+	return iri[len(ns):], true
+}
+
+// checkType verifies that a present @type identifies a FocusCostRecord.
+func (r *iriResolver) checkType(value interface{}) error {
+	if value == nil {
+		return nil
+	}
+	types, ok := value.([]interface{})
+	if !ok {
+		types = []interface{}{value}
+	}
+	for _, t := range types {
+		if s, isString := t.(string); isString && r.expandIRI(s) == r.expandIRI(FocusCostRecordType) {
+			return nil
+		}
+	}
+	return &ValidationError{
+		Field:      "@type",
+		Message:    fmt.Sprintf("unexpected type %v", value),
+		Suggestion: "provide a document with @type " + FocusCostRecordType,
+	}
+}
+
+// setField decodes a JSON-LD property value into a FocusCostRecord field. Null values are skipped.
+func (r *iriResolver) setField(
+	msg protoreflect.Message,
+	fd protoreflect.FieldDescriptor,
+	key string,
+	raw interface{},
+) error {
+	raw = singleValue(raw)
+	if raw == nil {
+		return nil
+	}
+
+	switch {
+	case fd.IsMap():
+		return setStringMap(msg, fd, key, raw)
+	case fd.Kind() == protoreflect.StringKind:
+		s, ok := literalValue(raw).(string)
+		if !ok {
+			return fieldTypeError(key, "a string", raw)
+		}
+		msg.Set(fd, protoreflect.ValueOfString(s))
+	case fd.Kind() == protoreflect.DoubleKind:
+		f, ok := numericValue(raw)
+		if !ok {
+			return fieldTypeError(key, "a number or schema:MonetaryAmount", raw)
+		}
+		msg.Set(fd, protoreflect.ValueOfFloat64(f))
+	case fd.Kind() == protoreflect.EnumKind:
+		s, ok := literalValue(raw).(string)
+		if !ok {
+			return fieldTypeError(key, "an enum name or IRI", raw)
+		}
+		num, found := r.enumNumber(fd.Enum(), s)
+		if !found {
+			return &ValidationError{
+				Field:      key,
+				Message:    fmt.Sprintf("unknown %s value %q", fd.Enum().Name(), s),
+				Suggestion: "use a proto enum value name or its focus: IRI",
+			}
+		}
+		msg.Set(fd, protoreflect.ValueOfEnum(num))
+	case fd.Kind() == protoreflect.MessageKind && fd.Message().FullName() == timestampFullName:
+		s, ok := literalValue(raw).(string)
+		if !ok {
+			return fieldTypeError(key, "an ISO 8601 timestamp string", raw)
+		}
+		t, err := time.Parse(time.RFC3339Nano, s)
+		if err != nil {
+			return &ValidationError{
+				Field:      key,
+				Message:    fmt.Sprintf("invalid timestamp %q", s),
+				Suggestion: "use RFC 3339 format, e.g. 2025-01-01T00:00:00Z",
+			}
+		}
+		msg.Set(fd, protoreflect.ValueOfMessage(timestamppb.New(t).ProtoReflect()))
+	}
+	return nil
+}
+
+// setStringMap decodes a JSON object of string values into a map<string, string> field.
+func setStringMap(msg protoreflect.Message, fd protoreflect.FieldDescriptor, key string, raw interface{}) error {
+	obj, ok := literalValue(raw).(map[string]interface{})
+	if !ok {
+		return fieldTypeError(key, "an object of strings", raw)
+	}
+	m := msg.Mutable(fd).Map()
+	for k, v := range obj {
+		s, isString := v.(string)
+		if !isString {
+			return fieldTypeError(key+"."+k, "a string", v)
+		}
+		m.Set(protoreflect.ValueOfString(k).MapKey(), protoreflect.ValueOfString(s))
+	}
+	return nil
+}
+
+// enumNumber resolves an enum value given by proto name or by IRI (focus:FocusChargeCategoryUsage).
+func (r *iriResolver) enumNumber(ed protoreflect.EnumDescriptor, s string) (protoreflect.EnumNumber, bool) {
+	values := ed.Values()
+	if v := values.ByName(protoreflect.Name(s)); v != nil {
+		return v.Number(), true
+	}
+
+	name, ok := r.focusTerm(r.expandIRI(s))
+	if !ok {
+		return 0, false
+	}
+	for i := range values.Len() {
+		if toCamelCase(string(values.Get(i).Name())) == name {
+			return values.Get(i).Number(), true
+		}
+	}
+	return 0, false
+}
+
+// singleValue unwraps a single-element array, the shape of expanded property values.
+func singleValue(raw interface{}) interface{} {
+	if arr, ok := raw.([]interface{}); ok && len(arr) == 1 {
+		return arr[0]
+	}
+	return raw
+}
+
+// literalValue unwraps a JSON-LD value object ({"@value": ..., "@language": ...}) or
+// node reference ({"@id": ...}).
+func literalValue(raw interface{}) interface{} {
+	if obj, ok := raw.(map[string]interface{}); ok {
+		if v, hasValue := obj["@value"]; hasValue {
+			return v
+		}
+		if id, hasID := obj["@id"]; hasID && len(obj) == 1 {
+			return id
+		}
+	}
+	return raw
+}
+
+// numericValue returns the number held by a plain value, a value object, or a MonetaryAmount
+// in compacted or expanded form.
+func numericValue(raw interface{}) (float64, bool) {
+	raw = literalValue(raw)
+	if obj, ok := raw.(map[string]interface{}); ok {
+		raw = nil
+		for _, key := range []string{"value", "schema:value", standardPrefixes["schema"] + "value"} {
+			if v, hasValue := obj[key]; hasValue {
+				raw = literalValue(singleValue(v))
+				break
+			}
+		}
+	}
+	f, ok := raw.(float64)
+	return f, ok
+}
+
+// fieldTypeError reports a property value of the wrong JSON type.
+func fieldTypeError(field, expected string, got interface{}) error {
+	return &ValidationError{
+		Field:      field,
+		Message:    fmt.Sprintf("expected %s, got %T", expected, got),
+		Suggestion: "provide a JSON-LD document produced by Serialize",
+	}
+}
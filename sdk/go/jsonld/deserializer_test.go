@@ -0,0 +1,350 @@
+package jsonld_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/rshade/finfocus-spec/sdk/go/jsonld"
+	pbc "github.com/rshade/finfocus-spec/sdk/go/proto/finfocus/v1"
+)
+
+// fullyPopulatedRecord returns a FocusCostRecord with every field set to a non-zero value.
+func fullyPopulatedRecord() *pbc.FocusCostRecord {
+	return &pbc.FocusCostRecord{
+		ProviderName:       "AWS",
+		BillingAccountId:   "123456789012",
+		BillingAccountName: "Production Account",
+		SubAccountId:       "210987654321",
+		SubAccountName:     "Web Team",
+		BillingAccountType: "Management",
+		SubAccountType:     "Member",
+		BillingPeriodStart: &timestamppb.Timestamp{Seconds: 1735689600},
+		BillingPeriodEnd:   &timestamppb.Timestamp{Seconds: 1738368000},
+		BillingCurrency:    "USD",
+		ChargePeriodStart:  &timestamppb.Timestamp{Seconds: 1735689600},
+		ChargePeriodEnd:    &timestamppb.Timestamp{Seconds: 1735776000},
+		ChargeCategory:     pbc.FocusChargeCategory_FOCUS_CHARGE_CATEGORY_USAGE,
+		ChargeClass:        pbc.FocusChargeClass_FOCUS_CHARGE_CLASS_CORRECTION,
+		ChargeDescription:  "Compute usage (m5.large)",
+		ChargeFrequency:    pbc.FocusChargeFrequency_FOCUS_CHARGE_FREQUENCY_USAGE_BASED,
+		PricingCategory:    pbc.FocusPricingCategory_FOCUS_PRICING_CATEGORY_COMMITTED,
+		PricingQuantity:    24,
+		PricingUnit:        "Hours",
+		ListUnitPrice:      0.096,
+		PricingCurrency:    "EUR",
+
+		PricingCurrencyContractedUnitPrice: 0.081,
+		PricingCurrencyEffectiveCost:       1.944,
+		PricingCurrencyListUnitPrice:       0.088,
+
+		ServiceCategory:            pbc.FocusServiceCategory_FOCUS_SERVICE_CATEGORY_COMPUTE,
+		ServiceName:                "Amazon EC2",
+		ServiceSubcategory:         "Virtual Machines",
+		Publisher:                  "Amazon Web Services",
+		ResourceId:                 "i-1234567890abcdef0",
+		ResourceName:               "production-web-server",
+		ResourceType:               "Virtual Machine",
+		SkuId:                      "SKU-M5L",
+		SkuPriceId:                 "PRICE-M5L-USE1",
+		SkuMeter:                   "BoxUsage",
+		SkuPriceDetails:            `{"vCPU": 2}`,
+		RegionId:                   "us-east-1",
+		RegionName:                 "US East (N. Virginia)",
+		AvailabilityZone:           "us-east-1a",
+		BilledCost:                 2.112,
+		ListCost:                   2.304,
+		EffectiveCost:              2.112,
+		ContractedCost:             1.944,
+		ContractedUnitPrice:        0.081,
+		ConsumedQuantity:           24,
+		ConsumedUnit:               "Hours",
+		CommitmentDiscountCategory: pbc.FocusCommitmentDiscountCategory_FOCUS_COMMITMENT_DISCOUNT_CATEGORY_SPEND,
+		CommitmentDiscountId:       "sp-0123456789",
+		CommitmentDiscountName:     "Compute Savings Plan",
+		CommitmentDiscountQuantity: 1.944,
+		CommitmentDiscountStatus:   pbc.FocusCommitmentDiscountStatus_FOCUS_COMMITMENT_DISCOUNT_STATUS_USED,
+		CommitmentDiscountType:     "Savings Plan",
+		CommitmentDiscountUnit:     "USD",
+		CapacityReservationId:      "cr-0123456789",
+		CapacityReservationStatus:  pbc.FocusCapacityReservationStatus_FOCUS_CAPACITY_RESERVATION_STATUS_USED,
+		InvoiceId:                  "INV-2025-001",
+		InvoiceIssuer:              "Amazon Web Services, Inc.",
+		Tags:                       map[string]string{"environment": "production", "team": "web"},
+		ExtendedColumns:            map[string]string{"aws_lineitem_type": "SavingsPlanCoveredUsage"},
+		ServiceProviderName:        "Amazon Web Services",
+		HostProviderName:           "Amazon Web Services",
+		AllocatedMethodId:          "proportional-cpu",
+		AllocatedMethodDetails:     "Split by CPU hours",
+		AllocatedResourceId:        "pod-web-1",
+		AllocatedResourceName:      "web-frontend",
+		AllocatedTags:              map[string]string{"app": "frontend"},
+		ContractApplied:            "contract-2025",
+	}
+}
+
+func TestFullyPopulatedRecordSetsEveryField(t *testing.T) {
+	msg := fullyPopulatedRecord().ProtoReflect()
+	fields := msg.Descriptor().Fields()
+	for i := range fields.Len() {
+		if fd := fields.Get(i); !msg.Has(fd) {
+			t.Errorf("fullyPopulatedRecord() does not set %s; update it so round-trip tests cover the field", fd.Name())
+		}
+	}
+}
+
+func TestDeserialize_RoundTrip(t *testing.T) {
+	tests := []struct {
+		name string
+		opts []jsonld.SerializerOption
+	}{
+		{"default options", nil},
+		{"IRI enums", []jsonld.SerializerOption{jsonld.WithIRIEnums(true)}},
+		{"language-tagged text", []jsonld.SerializerOption{jsonld.WithLanguage("en-US")}},
+		{"user-provided @id", []jsonld.SerializerOption{jsonld.WithUserIDField("invoice_id")}},
+		{"pretty print", []jsonld.SerializerOption{jsonld.WithPrettyPrint(true)}},
+		{"remote context", []jsonld.SerializerOption{
+			jsonld.WithContext(jsonld.NewContext().WithRemoteContext("https://example.com/focus.jsonld")),
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			original := fullyPopulatedRecord()
+			data, err := jsonld.NewSerializer(tt.opts...).Serialize(original)
+			if err != nil {
+				t.Fatalf("Serialize() failed: %v", err)
+			}
+
+			restored, err := jsonld.Deserialize(data)
+			if err != nil {
+				t.Fatalf("Deserialize() failed: %v", err)
+			}
+			if !proto.Equal(original, restored) {
+				t.Errorf("Round trip is lossy:\noriginal: %v\nrestored: %v", original, restored)
+			}
+		})
+	}
+}
+
+func TestDeserialize_EmptyFieldsIncluded(t *testing.T) {
+	original := &pbc.FocusCostRecord{
+		BillingAccountId:  "123456789012",
+		ChargePeriodStart: &timestamppb.Timestamp{Seconds: 1735689600},
+		BilledCost:        42.5,
+	}
+	data, err := jsonld.NewSerializer(jsonld.WithOmitEmpty(false)).Serialize(original)
+	if err != nil {
+		t.Fatalf("Serialize() failed: %v", err)
+	}
+
+	restored, err := jsonld.Deserialize(data)
+	if err != nil {
+		t.Fatalf("Deserialize() failed: %v", err)
+	}
+	if !proto.Equal(original, restored) {
+		t.Errorf("Round trip is lossy:\noriginal: %v\nrestored: %v", original, restored)
+	}
+}
+
+func TestDeserialize_ResolvesCompactIRIs(t *testing.T) {
+	doc := `{
+		"@context": {"f": "https://focus.finops.org/v1#", "acct": "f:billingAccountId"},
+		"@type": "f:FocusCostRecord",
+		"@id": "urn:focus:cost:0123abcd",
+		"acct": "123456789012",
+		"f:serviceName": {"@value": "Amazon EC2", "@language": "en"},
+		"https://focus.finops.org/v1#regionId": "us-east-1",
+		"focus:chargeCategory": "focus:FocusChargeCategoryUsage",
+		"billedCost": {"@type": "schema:MonetaryAmount", "value": 12.5, "currency": "USD"},
+		"https://example.com/unrelated": "ignored"
+	}`
+
+	record, err := jsonld.Deserialize([]byte(doc))
+	if err != nil {
+		t.Fatalf("Deserialize() failed: %v", err)
+	}
+
+	want := &pbc.FocusCostRecord{
+		BillingAccountId: "123456789012",
+		ServiceName:      "Amazon EC2",
+		RegionId:         "us-east-1",
+		ChargeCategory:   pbc.FocusChargeCategory_FOCUS_CHARGE_CATEGORY_USAGE,
+		BilledCost:       12.5,
+	}
+	if !proto.Equal(want, record) {
+		t.Errorf("Deserialize() = %v, want %v", record, want)
+	}
+}
+
+func TestDeserialize_Errors(t *testing.T) {
+	tests := []struct {
+		name      string
+		doc       string
+		wantField string
+	}{
+		{"JSON array", `[]`, ""},
+		{"JSON null", `null`, "document"},
+		{"wrong @type", `{"@type": "focus:ContractCommitment"}`, "@type"},
+		{"string field with number", `{"billingAccountId": 123}`, "billingAccountId"},
+		{"cost with string", `{"billedCost": "12.5"}`, "billedCost"},
+		{"unknown enum value", `{"chargeCategory": "FOCUS_CHARGE_CATEGORY_BOGUS"}`, "chargeCategory"},
+		{"malformed timestamp", `{"chargePeriodStart": "yesterday"}`, "chargePeriodStart"},
+		{"tag with non-string value", `{"tags": {"env": 1}}`, "tags.env"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := jsonld.Deserialize([]byte(tt.doc))
+			if err == nil {
+				t.Fatal("Deserialize() expected error, got nil")
+			}
+			if tt.wantField == "" {
+				return
+			}
+			var validationErr *jsonld.ValidationError
+			if !errors.As(err, &validationErr) {
+				t.Fatalf("Deserialize() error = %v, want *ValidationError", err)
+			}
+			if validationErr.Field != tt.wantField {
+				t.Errorf("ValidationError.Field = %q, want %q", validationErr.Field, tt.wantField)
+			}
+		})
+	}
+}
+
+func TestDeserialize_IgnoresGeneratedID(t *testing.T) {
+	data, err := jsonld.NewSerializer().Serialize(fullyPopulatedRecord())
+	if err != nil {
+		t.Fatalf("Serialize() failed: %v", err)
+	}
+
+	var doc map[string]interface{}
+	if unmarshalErr := json.Unmarshal(data, &doc); unmarshalErr != nil {
+		t.Fatalf("Output is not valid JSON: %v", unmarshalErr)
+	}
+	doc["@id"] = "urn:focus:cost:not-a-real-hash"
+	tampered, _ := json.Marshal(doc)
+
+	record, err := jsonld.Deserialize(tampered)
+	if err != nil {
+		t.Fatalf("Deserialize() failed: %v", err)
+	}
+	if !proto.Equal(fullyPopulatedRecord(), record) {
+		t.Error("Deserialize() result should not depend on @id")
+	}
+}
+
+// collectStream runs DeserializeStream over input and returns the records it sent and its error.
+func collectStream(t *testing.T, input string) ([]*pbc.FocusCostRecord, error) {
+	t.Helper()
+	out := make(chan *pbc.FocusCostRecord)
+	errCh := make(chan error, 1)
+	go func() { errCh <- jsonld.DeserializeStream(strings.NewReader(input), out) }()
+
+	var records []*pbc.FocusCostRecord
+	for record := range out {
+		records = append(records, record)
+	}
+	return records, <-errCh
+}
+
+func TestDeserializeStream(t *testing.T) {
+	first := fullyPopulatedRecord()
+	second := fullyPopulatedRecord()
+	second.ResourceId = "i-0fedcba0987654321"
+	second.BilledCost = 4.224
+
+	serializer := jsonld.NewSerializer()
+	var array bytes.Buffer
+	records := []*pbc.FocusCostRecord{first, second}
+	if _, err := serializer.SerializeSlice(context.Background(), records, &array); err != nil {
+		t.Fatalf("SerializeSlice() failed: %v", err)
+	}
+	var expandedArray bytes.Buffer
+	expanded := jsonld.NewSerializer(jsonld.WithExpanded(), jsonld.WithPrettyPrint(true))
+	if _, err := expanded.SerializeSlice(context.Background(), records, &expandedArray); err != nil {
+		t.Fatalf("SerializeSlice() failed: %v", err)
+	}
+	var ndjson strings.Builder
+	for _, record := range records {
+		data, err := serializer.Serialize(record)
+		if err != nil {
+			t.Fatalf("Serialize() failed: %v", err)
+		}
+		ndjson.Write(data)
+		ndjson.WriteString("\n")
+	}
+
+	tests := []struct {
+		name  string
+		input string
+	}{
+		{"JSON array", array.String()},
+		{"expanded JSON array", expandedArray.String()},
+		{"newline-delimited", ndjson.String()},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := collectStream(t, tt.input)
+			if err != nil {
+				t.Fatalf("DeserializeStream() failed: %v", err)
+			}
+			if len(got) != 2 {
+				t.Fatalf("DeserializeStream() sent %d records, want 2", len(got))
+			}
+			if !proto.Equal(got[0], first) || !proto.Equal(got[1], second) {
+				t.Errorf("DeserializeStream() records differ from the originals")
+			}
+		})
+	}
+}
+
+func TestDeserializeStream_Empty(t *testing.T) {
+	for _, input := range []string{"", "  \n", "[]", "[\n]"} {
+		records, err := collectStream(t, input)
+		if err != nil {
+			t.Errorf("DeserializeStream(%q) error = %v, want nil", input, err)
+		}
+		if len(records) != 0 {
+			t.Errorf("DeserializeStream(%q) sent %d records, want 0", input, len(records))
+		}
+	}
+}
+
+func TestDeserializeStream_Errors(t *testing.T) {
+	tests := []struct {
+		name      string
+		input     string
+		wantSent  int
+		wantIndex int
+	}{
+		{"malformed array element", `[{"billingAccountId": "a"}, {"billingAccountId": }]`, 1, 1},
+		{"truncated array", `[{"billingAccountId": "a"}`, 1, 1},
+		{"invalid record", `[{"billingAccountId": "a"}, {"billedCost": "x"}]`, 1, 1},
+		{"malformed line", "{\"billingAccountId\": \"a\"}\n{\"billingAccountId\": \"b\"}\nnot json\n", 2, 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			records, err := collectStream(t, tt.input)
+			var streamErr *jsonld.StreamError
+			if !errors.As(err, &streamErr) {
+				t.Fatalf("DeserializeStream() error = %v, want *StreamError", err)
+			}
+			if streamErr.Index != tt.wantIndex {
+				t.Errorf("StreamError.Index = %d, want %d", streamErr.Index, tt.wantIndex)
+			}
+			if len(records) != tt.wantSent {
+				t.Errorf("DeserializeStream() sent %d records before the error, want %d", len(records), tt.wantSent)
+			}
+		})
+	}
+}
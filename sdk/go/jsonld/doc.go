@@ -1,4 +1,4 @@
-// Package jsonld provides JSON-LD 1.1 serialization for FOCUS cost data.
+// Package jsonld provides JSON-LD 1.1 serialization and deserialization for FOCUS cost data.
 //
 // This package transforms protobuf messages (FocusCostRecord, ContractCommitment) into
 // JSON-LD format with Schema.org vocabulary mappings and custom FOCUS namespace support.
@@ -25,6 +25,15 @@
 //	serializer := jsonld.NewSerializer()
 //	err := serializer.SerializeStream(recordChannel, writer)
 //
+// # N-Quads Export
+//
+//	err := serializer.SerializeNQuads(record, writer)
+//
+// # Deserialization
+//
+//	record, err := jsonld.Deserialize(output)
+//	err = jsonld.DeserializeStream(reader, recordChannel) // JSON array or newline-delimited
+//
 // # Performance
 //
 // This package is optimized for high-throughput serialization:
@@ -36,9 +45,12 @@
 //
 // Output conforms to JSON-LD 1.1 specification:
 //   - @context defines vocabulary mappings (Schema.org + FOCUS namespace)
-//   - @id provides unique identifiers (user-provided or SHA256 fallback)
+//   - @id provides unique identifiers (user-provided, WithIDTemplate, or SHA256 fallback)
 //   - @type declares record types
 //   - Property names use compact IRIs defined in context
 //
+// WithExpanded switches to expanded form, in which every property is an absolute IRI and the
+// @context is omitted; WithCompacted (the default) restores compacted form.
+//
 // See README.md for detailed examples and configuration options.
 package jsonld
@@ -0,0 +1,130 @@
+package jsonld
+
+import (
+	"strings"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// xsdDateTime is the datatype IRI of expanded timestamp values.
+const xsdDateTime = "http://www.w3.org/2001/XMLSchema#dateTime"
+
+// expandDocument converts a compacted document built by the serializer to a JSON-LD expanded
+// node object: property keys and @type become absolute IRIs, every property value is wrapped
+// in an array of value or node objects, null values are dropped, and the @context is removed.
+//
+// desc describes the serialized message and determines how enum and timestamp values expand:
+// IRI enums become node references ({"@id": ...}) and timestamps are typed as xsd:dateTime.
+// Map fields (tags, extended columns) have arbitrary keys and expand to @json literals.
+func expandDocument(
+	doc map[string]interface{},
+	desc protoreflect.MessageDescriptor,
+	iriEnums bool,
+) map[string]interface{} {
+	r := newIRIResolver(doc["@context"])
+	fields := desc.Fields()
+	node := make(map[string]interface{}, len(doc))
+
+	for key, value := range doc {
+		switch {
+		case key == "@context" || value == nil:
+			continue
+		case key == "@id":
+			node[key] = value
+		case key == "@type":
+			if t, ok := value.(string); ok {
+				node[key] = []interface{}{r.expandIRI(t)}
+			}
+		default:
+			iri, ok := r.vocabIRI(key)
+			if !ok {
+				continue
+			}
+			node[iri] = []interface{}{r.expandValue(fields.ByJSONName(key), value, iriEnums)}
+		}
+	}
+	return node
+}
+
+// vocabIRI returns the absolute IRI of a property key as a JSON-LD processor would: a defined
+// term or compact IRI is expanded, and any other key is appended to the context's @vocab.
+// It reports false for a key that does not expand to an IRI, which expansion drops.
+func (r *iriResolver) vocabIRI(key string) (string, bool) {
+	iri := r.expandTerm(key)
+	if strings.Contains(iri, ":") {
+		return iri, true
+	}
+	vocab, ok := r.definitions["@vocab"]
+	if !ok || vocab == "" {
+		return "", false
+	}
+	return vocab + iri, true
+}
+
+// typeCoercions returns the term definitions that type the values of doc's fields, so that a
+// JSON-LD processor expands the compacted document exactly as expandDocument does: timestamps
+// are typed xsd:dateTime, map fields are @json literals, and IRI enums are node references.
+// The definitions have no @id, so the terms keep expanding against @vocab.
+func typeCoercions(
+	doc map[string]interface{},
+	desc protoreflect.MessageDescriptor,
+	iriEnums bool,
+) map[string]interface{} {
+	fields := desc.Fields()
+	terms := make(map[string]interface{})
+	for key := range doc {
+		fd := fields.ByJSONName(key)
+		if fd == nil {
+			continue
+		}
+		switch {
+		case fd.IsMap():
+			terms[key] = map[string]interface{}{"@type": "@json"}
+		case fd.Kind() == protoreflect.MessageKind && fd.Message().FullName() == timestampFullName:
+			terms[key] = map[string]interface{}{"@type": "xsd:dateTime"}
+		case fd.Kind() == protoreflect.EnumKind && iriEnums:
+			terms[key] = map[string]interface{}{"@type": "@id"}
+		}
+	}
+	return terms
+}
+
+// expandValue converts a compacted property value to its expanded value or node object.
+// fd is the message field the property was serialized from, or nil for a custom property.
+func (r *iriResolver) expandValue(fd protoreflect.FieldDescriptor, value interface{}, iriEnums bool) interface{} {
+	obj, isObject := value.(map[string]interface{})
+	switch {
+	case isObject && obj["@value"] != nil:
+		// Already a value object (language-tagged text)
+		return obj
+	case isObject && obj["@type"] != nil:
+		// Nested typed node (schema:MonetaryAmount); the context defines its property terms
+		nested := make(map[string]interface{}, len(obj))
+		for k, v := range obj {
+			if k == "@type" {
+				if t, ok := v.(string); ok {
+					nested[k] = []interface{}{r.expandIRI(t)}
+				}
+				continue
+			}
+			if iri, ok := r.vocabIRI(k); ok {
+				nested[iri] = []interface{}{map[string]interface{}{"@value": v}}
+			}
+		}
+		return nested
+	case isObject:
+		return map[string]interface{}{"@value": obj, "@type": "@json"}
+	}
+
+	if fd != nil {
+		switch {
+		case fd.Kind() == protoreflect.EnumKind && iriEnums:
+			if s, ok := value.(string); ok {
+				return map[string]interface{}{"@id": r.expandIRI(s)}
+			}
+		case fd.Kind() == protoreflect.MessageKind && fd.Message().FullName() == timestampFullName:
+			return map[string]interface{}{"@value": value, "@type": xsdDateTime}
+		}
+	}
+	return map[string]interface{}{"@value": value}
+}
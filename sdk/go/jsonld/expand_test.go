@@ -0,0 +1,247 @@
+package jsonld_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/piprate/json-gold/ld"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/rshade/finfocus-spec/sdk/go/jsonld"
+	pbc "github.com/rshade/finfocus-spec/sdk/go/proto/finfocus/v1"
+)
+
+// decodeJSON decodes serializer output for comparison with JSON-LD processor results.
+func decodeJSON(t *testing.T, data []byte) interface{} {
+	t.Helper()
+	var v interface{}
+	if err := json.Unmarshal(data, &v); err != nil {
+		t.Fatalf("Output is not valid JSON: %v", err)
+	}
+	return v
+}
+
+// checkWithProcessor validates both output forms of one document against a JSON-LD 1.1
+// processor (json-gold):
+//   - expanding the compacted form yields exactly the expanded form;
+//   - the expanded form is already expanded, so expanding it again changes nothing;
+//   - compacting the expanded form with the compacted form's @context restores its properties
+//     and expands back to the expanded form.
+func checkWithProcessor(t *testing.T, compactedData, expandedData []byte) {
+	t.Helper()
+	proc := ld.NewJsonLdProcessor()
+	opts := ld.NewJsonLdOptions("")
+
+	compacted, ok := decodeJSON(t, compactedData).(map[string]interface{})
+	if !ok {
+		t.Fatalf("Compacted output is not a JSON object: %s", compactedData)
+	}
+	want := decodeJSON(t, expandedData)
+
+	got, err := proc.Expand(compacted, opts)
+	if err != nil {
+		t.Fatalf("Expand(compacted) failed: %v", err)
+	}
+	if len(got) != 1 || !reflect.DeepEqual(got[0], want) {
+		t.Errorf("Expanding the compacted form differs from WithExpanded:\ngot:  %v\nwant: %v", got, want)
+	}
+
+	reexpanded, err := proc.Expand(want, opts)
+	if err != nil {
+		t.Fatalf("Expand(expanded) failed: %v", err)
+	}
+	if len(reexpanded) != 1 || !reflect.DeepEqual(reexpanded[0], want) {
+		t.Errorf("WithExpanded output is not in expanded form:\ngot:  %v\nwant: %v", reexpanded, want)
+	}
+
+	roundTrip, err := proc.Compact(want, compacted["@context"], opts)
+	if err != nil {
+		t.Fatalf("Compact(expanded) failed: %v", err)
+	}
+	for key, value := range compacted {
+		if _, found := roundTrip[key]; !found && value != nil && !strings.HasPrefix(key, "@") {
+			t.Errorf("Compacting the expanded form lost property %q", key)
+		}
+	}
+	again, err := proc.Expand(roundTrip, opts)
+	if err != nil {
+		t.Fatalf("Expand(compacted round trip) failed: %v", err)
+	}
+	if len(again) != 1 || !reflect.DeepEqual(again[0], want) {
+		t.Errorf("Compacted round trip does not expand back to WithExpanded:\ngot:  %v\nwant: %v", again, want)
+	}
+}
+
+func serializeExpanded(t *testing.T, opts ...jsonld.SerializerOption) map[string]interface{} {
+	t.Helper()
+	data, err := jsonld.NewSerializer(append(opts, jsonld.WithExpanded())...).Serialize(fullyPopulatedRecord())
+	if err != nil {
+		t.Fatalf("Serialize() failed: %v", err)
+	}
+	var node map[string]interface{}
+	if err = json.Unmarshal(data, &node); err != nil {
+		t.Fatalf("Output is not a JSON object: %v", err)
+	}
+	return node
+}
+
+func TestSerializerOptions_WithExpanded(t *testing.T) {
+	tests := []struct {
+		name string
+		opts []jsonld.SerializerOption
+	}{
+		{"default options", nil},
+		{"IRI enums", []jsonld.SerializerOption{jsonld.WithIRIEnums(true)}},
+		{"language-tagged text", []jsonld.SerializerOption{jsonld.WithLanguage("en")}},
+		{"empty fields included", []jsonld.SerializerOption{jsonld.WithOmitEmpty(false)}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			record := fullyPopulatedRecord()
+			compacted, err := jsonld.NewSerializer(tt.opts...).Serialize(record)
+			if err != nil {
+				t.Fatalf("Serialize() failed: %v", err)
+			}
+			expanded, err := jsonld.NewSerializer(append(tt.opts, jsonld.WithExpanded())...).Serialize(record)
+			if err != nil {
+				t.Fatalf("Serialize() failed: %v", err)
+			}
+			checkWithProcessor(t, compacted, expanded)
+		})
+	}
+}
+
+func TestSerializerOptions_WithExpanded_Values(t *testing.T) {
+	node := serializeExpanded(t, jsonld.WithIRIEnums(true))
+	focus := jsonld.FocusNamespace
+
+	types, _ := node["@type"].([]interface{})
+	if len(types) != 1 || types[0] != focus+"FocusCostRecord" {
+		t.Errorf("@type = %v, want [%sFocusCostRecord]", node["@type"], focus)
+	}
+
+	tests := []struct {
+		property string
+		want     string
+	}{
+		{"billingAccountId", `[{"@value":"123456789012"}]`},
+		{"chargeCategory", `[{"@id":"` + focus + `FocusChargeCategoryUsage"}]`},
+		{"chargePeriodStart", `[{"@type":"http://www.w3.org/2001/XMLSchema#dateTime","@value":"2025-01-01T00:00:00Z"}]`},
+		{"billedCost", `[{"@type":["https://schema.org/MonetaryAmount"],` +
+			`"https://schema.org/currency":[{"@value":"USD"}],"https://schema.org/value":[{"@value":2.112}]}]`},
+		{"tags", `[{"@type":"@json","@value":{"environment":"production","team":"web"}}]`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.property, func(t *testing.T) {
+			got, err := json.Marshal(node[focus+tt.property])
+			if err != nil {
+				t.Fatalf("json.Marshal() failed: %v", err)
+			}
+			if string(got) != tt.want {
+				t.Errorf("%s = %s, want %s", tt.property, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSerializerOptions_WithCompacted(t *testing.T) {
+	record := fullyPopulatedRecord()
+	want, err := jsonld.NewSerializer().Serialize(record)
+	if err != nil {
+		t.Fatalf("Serialize() failed: %v", err)
+	}
+
+	got, err := jsonld.NewSerializer(jsonld.WithExpanded(), jsonld.WithCompacted()).Serialize(record)
+	if err != nil {
+		t.Fatalf("Serialize() failed: %v", err)
+	}
+	if !bytes.Equal(got, want) {
+		t.Errorf("WithCompacted() output differs from the default:\ngot:  %s\nwant: %s", got, want)
+	}
+}
+
+func TestSerializeCommitment_Expanded(t *testing.T) {
+	commitment := &pbc.ContractCommitment{
+		ContractCommitmentId:       "commit-001",
+		ContractId:                 "contract-001",
+		ContractCommitmentCategory: pbc.FocusContractCommitmentCategory_FOCUS_CONTRACT_COMMITMENT_CATEGORY_SPEND,
+		ContractCommitmentCost:     10000,
+		BillingCurrency:            "USD",
+		ContractPeriodStart:        &timestamppb.Timestamp{Seconds: 1735689600},
+	}
+
+	compacted, err := jsonld.NewSerializer().SerializeCommitment(commitment)
+	if err != nil {
+		t.Fatalf("SerializeCommitment() failed: %v", err)
+	}
+	expanded, err := jsonld.NewSerializer(jsonld.WithExpanded()).SerializeCommitment(commitment)
+	if err != nil {
+		t.Fatalf("SerializeCommitment() failed: %v", err)
+	}
+	checkWithProcessor(t, compacted, expanded)
+}
+
+func TestSerializeStream_Expanded(t *testing.T) {
+	records := []*pbc.FocusCostRecord{fullyPopulatedRecord(), fullyPopulatedRecord()}
+	records[1].ResourceId = "i-0fedcba0987654321"
+
+	var buf bytes.Buffer
+	serializer := jsonld.NewSerializer(jsonld.WithExpanded())
+	if _, err := serializer.SerializeSlice(context.Background(), records, &buf); err != nil {
+		t.Fatalf("SerializeSlice() failed: %v", err)
+	}
+
+	var nodes []json.RawMessage
+	if err := json.Unmarshal(buf.Bytes(), &nodes); err != nil {
+		t.Fatalf("Stream output is not an array of node objects: %v", err)
+	}
+	if len(nodes) != len(records) {
+		t.Fatalf("len(nodes) = %d, want %d", len(nodes), len(records))
+	}
+	for i, node := range nodes {
+		compacted, err := jsonld.NewSerializer().Serialize(records[i])
+		if err != nil {
+			t.Fatalf("Serialize() failed: %v", err)
+		}
+		checkWithProcessor(t, compacted, node)
+	}
+}
+
+func TestDeserialize_Expanded(t *testing.T) {
+	tests := []struct {
+		name string
+		opts []jsonld.SerializerOption
+	}{
+		{"default options", nil},
+		{"IRI enums", []jsonld.SerializerOption{jsonld.WithIRIEnums(true)}},
+		{"language-tagged text", []jsonld.SerializerOption{jsonld.WithLanguage("en-US")}},
+		{"pretty print", []jsonld.SerializerOption{jsonld.WithPrettyPrint(true)}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			original := fullyPopulatedRecord()
+			data, err := jsonld.NewSerializer(append(tt.opts, jsonld.WithExpanded())...).Serialize(original)
+			if err != nil {
+				t.Fatalf("Serialize() failed: %v", err)
+			}
+
+			for _, doc := range [][]byte{data, []byte("[" + string(data) + "]")} {
+				restored, deserializeErr := jsonld.Deserialize(doc)
+				if deserializeErr != nil {
+					t.Fatalf("Deserialize() failed: %v", deserializeErr)
+				}
+				if !proto.Equal(original, restored) {
+					t.Errorf("Round trip is lossy:\noriginal: %v\nrestored: %v", original, restored)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,162 @@
+package jsonld
+
+import (
+	"net/url"
+	"strings"
+	"time"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pbc "github.com/rshade/finfocus-spec/sdk/go/proto/finfocus/v1"
+)
+
+// templatePart is a literal segment of an @id template, or a placeholder when field is set.
+type templatePart struct {
+	literal string
+	field   protoreflect.FieldDescriptor
+}
+
+// TemplateIDGenerator builds FocusCostRecord @id values from a template with record field
+// placeholders, such as "urn:focus:{billingAccountId}:{chargePeriodStart}:{resourceId}".
+//
+// Placeholders name FocusCostRecord fields by their JSON-LD property name (camelCase) and may
+// reference string, enum, or timestamp fields. Values are substituted as follows:
+//   - strings are percent-encoded as IRI path segments (e.g., "/" becomes "%2F")
+//   - enums use their value name (e.g., FOCUS_CHARGE_CATEGORY_USAGE)
+//   - timestamps use RFC 3339 in UTC (e.g., 2025-01-01T00:00:00Z)
+//
+// When any referenced field is empty (empty string, UNSPECIFIED enum, or unset timestamp),
+// the record is identified by the fallback generator instead, so distinct records never
+// collapse onto the same partially filled identifier. Commitments always use the fallback.
+type TemplateIDGenerator struct {
+	parts    []templatePart
+	fallback IDGenerator
+}
+
+// NewTemplateIDGenerator creates an IDGenerator from an @id template, using fallback for
+// records with empty template fields and for commitments. A nil fallback uses NewIDGenerator.
+//
+// Returns a *ValidationError if the template has unbalanced braces, no placeholders, or a
+// placeholder that does not name a string, enum, or timestamp field of FocusCostRecord.
+func NewTemplateIDGenerator(template string, fallback IDGenerator) (*TemplateIDGenerator, error) {
+	parts, err := parseIDTemplate(template)
+	if err != nil {
+		return nil, err
+	}
+	if fallback == nil {
+		fallback = NewIDGenerator()
+	}
+	return &TemplateIDGenerator{parts: parts, fallback: fallback}, nil
+}
+
+// Generate renders the template for record, or returns the fallback identifier when record is
+// nil or a referenced field is empty.
+func (g *TemplateIDGenerator) Generate(record *pbc.FocusCostRecord) string {
+	if record == nil {
+		return g.fallback.Generate(record)
+	}
+
+	msg := record.ProtoReflect()
+	var b strings.Builder
+	for _, part := range g.parts {
+		if part.field == nil {
+			b.WriteString(part.literal)
+			continue
+		}
+		value := templateValue(msg, part.field)
+		if value == "" {
+			return g.fallback.Generate(record)
+		}
+		b.WriteString(value)
+	}
+	return b.String()
+}
+
+// GenerateCommitment delegates to the fallback generator.
+func (g *TemplateIDGenerator) GenerateCommitment(record *pbc.ContractCommitment) string {
+	return g.fallback.GenerateCommitment(record)
+}
+
+// templateValue formats a placeholder value, returning "" for empty fields.
+func templateValue(msg protoreflect.Message, fd protoreflect.FieldDescriptor) string {
+	if !msg.Has(fd) {
+		return ""
+	}
+	value := msg.Get(fd)
+	switch fd.Kind() {
+	case protoreflect.EnumKind:
+		if ev := fd.Enum().Values().ByNumber(value.Enum()); ev != nil {
+			return string(ev.Name())
+		}
+		return ""
+	case protoreflect.MessageKind:
+		ts, _ := value.Message().Interface().(*timestamppb.Timestamp)
+		return ts.AsTime().Format(time.RFC3339)
+	default:
+		return url.PathEscape(value.String())
+	}
+}
+
+// parseIDTemplate splits template into literal and placeholder parts.
+func parseIDTemplate(template string) ([]templatePart, error) {
+	fields := (*pbc.FocusCostRecord)(nil).ProtoReflect().Descriptor().Fields()
+	var parts []templatePart
+	placeholders := 0
+
+	for rest := template; rest != ""; {
+		open := strings.IndexAny(rest, "{}")
+		if open < 0 {
+			parts = append(parts, templatePart{literal: rest})
+			break
+		}
+		if rest[open] == '}' {
+			return nil, templateError(template, "unbalanced '}'")
+		}
+		if open > 0 {
+			parts = append(parts, templatePart{literal: rest[:open]})
+		}
+
+		end := strings.IndexAny(rest[open+1:], "{}")
+		if end < 0 || rest[open+1+end] == '{' {
+			return nil, templateError(template, "unterminated placeholder")
+		}
+		name := rest[open+1 : open+1+end]
+		fd := fields.ByJSONName(name)
+		if fd == nil || !isTemplateField(fd) {
+			return nil, templateError(template, "unsupported placeholder {"+name+"}")
+		}
+		parts = append(parts, templatePart{field: fd})
+		placeholders++
+		rest = rest[open+end+2:]
+	}
+
+	if placeholders == 0 {
+		return nil, templateError(template, "template has no field placeholders")
+	}
+	return parts, nil
+}
+
+// isTemplateField reports whether fd is a singular string, enum, or timestamp field.
+func isTemplateField(fd protoreflect.FieldDescriptor) bool {
+	if fd.IsList() || fd.IsMap() {
+		return false
+	}
+	switch fd.Kind() {
+	case protoreflect.StringKind, protoreflect.EnumKind:
+		return true
+	case protoreflect.MessageKind:
+		return fd.Message().FullName() == timestampFullName
+	default:
+		return false
+	}
+}
+
+// templateError reports an invalid @id template.
+func templateError(template, message string) error {
+	return &ValidationError{
+		Field:      "idTemplate",
+		Message:    message + " in " + template,
+		Suggestion: "use {fieldName} placeholders naming string, enum, or timestamp fields, e.g. {billingAccountId}",
+	}
+}
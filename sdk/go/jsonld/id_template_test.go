@@ -0,0 +1,157 @@
+package jsonld_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/rshade/finfocus-spec/sdk/go/jsonld"
+	pbc "github.com/rshade/finfocus-spec/sdk/go/proto/finfocus/v1"
+)
+
+const usageTemplate = "urn:focus:{billingAccountId}:{chargePeriodStart}:{resourceId}"
+
+func TestTemplateIDGenerator_Generate(t *testing.T) {
+	tests := []struct {
+		name     string
+		template string
+		record   *pbc.FocusCostRecord
+		want     string
+	}{
+		{
+			"string and timestamp fields",
+			usageTemplate,
+			&pbc.FocusCostRecord{
+				BillingAccountId:  "123456789012",
+				ChargePeriodStart: &timestamppb.Timestamp{Seconds: 1735689600},
+				ResourceId:        "i-1234567890abcdef0",
+			},
+			"urn:focus:123456789012:2025-01-01T00:00:00Z:i-1234567890abcdef0",
+		},
+		{
+			"enum field uses value name",
+			"urn:focus:{invoiceId}/{chargeCategory}",
+			&pbc.FocusCostRecord{
+				InvoiceId:      "INV-2025-01",
+				ChargeCategory: pbc.FocusChargeCategory_FOCUS_CHARGE_CATEGORY_USAGE,
+			},
+			"urn:focus:INV-2025-01/FOCUS_CHARGE_CATEGORY_USAGE",
+		},
+		{
+			"values are percent-encoded",
+			"https://example.com/costs/{resourceId}",
+			&pbc.FocusCostRecord{ResourceId: "vm/web 01"},
+			"https://example.com/costs/vm%2Fweb%2001",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gen, err := jsonld.NewTemplateIDGenerator(tt.template, nil)
+			if err != nil {
+				t.Fatalf("NewTemplateIDGenerator() failed: %v", err)
+			}
+			if got := gen.Generate(tt.record); got != tt.want {
+				t.Errorf("Generate() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTemplateIDGenerator_FallsBackOnEmptyFields(t *testing.T) {
+	fallback := jsonld.NewIDGenerator()
+	gen, err := jsonld.NewTemplateIDGenerator(usageTemplate, fallback)
+	if err != nil {
+		t.Fatalf("NewTemplateIDGenerator() failed: %v", err)
+	}
+
+	records := map[string]*pbc.FocusCostRecord{
+		"empty string field": {
+			BillingAccountId:  "123456789012",
+			ChargePeriodStart: &timestamppb.Timestamp{Seconds: 1735689600},
+		},
+		"unset timestamp": {BillingAccountId: "123456789012", ResourceId: "i-1234567890abcdef0"},
+		"nil record":      nil,
+	}
+	for name, record := range records {
+		t.Run(name, func(t *testing.T) {
+			if got, want := gen.Generate(record), fallback.Generate(record); got != want {
+				t.Errorf("Generate() = %q, want fallback %q", got, want)
+			}
+		})
+	}
+
+	commitment := &pbc.ContractCommitment{ContractCommitmentId: "commit-001"}
+	if got, want := gen.GenerateCommitment(commitment), fallback.GenerateCommitment(commitment); got != want {
+		t.Errorf("GenerateCommitment() = %q, want fallback %q", got, want)
+	}
+}
+
+func TestNewTemplateIDGenerator_InvalidTemplates(t *testing.T) {
+	templates := map[string]string{
+		"no placeholders":        "urn:focus:static",
+		"unknown field":          "urn:focus:{accountNumber}",
+		"proto field name":       "urn:focus:{billing_account_id}",
+		"numeric field":          "urn:focus:{billedCost}",
+		"map field":              "urn:focus:{tags}",
+		"unterminated":           "urn:focus:{billingAccountId",
+		"nested brace":           "urn:focus:{billing{AccountId}",
+		"unbalanced close brace": "urn:focus:billingAccountId}",
+	}
+
+	for name, template := range templates {
+		t.Run(name, func(t *testing.T) {
+			_, err := jsonld.NewTemplateIDGenerator(template, nil)
+			var validationErr *jsonld.ValidationError
+			if !errors.As(err, &validationErr) {
+				t.Fatalf("NewTemplateIDGenerator(%q) error = %v, want *ValidationError", template, err)
+			}
+			if validationErr.Field != "idTemplate" {
+				t.Errorf("Field = %q, want idTemplate", validationErr.Field)
+			}
+		})
+	}
+}
+
+func TestSerializerOptions_WithIDTemplate(t *testing.T) {
+	serializer := jsonld.NewSerializer(jsonld.WithIDTemplate(usageTemplate))
+	record := fullyPopulatedRecord()
+
+	want := "urn:focus:123456789012:2025-01-01T00:00:00Z:" + record.GetResourceId()
+	if got := recordID(t, serializer, record); got != want {
+		t.Errorf("@id = %q, want %q", got, want)
+	}
+	if recordID(t, serializer, fullyPopulatedRecord()) != want {
+		t.Error("Expected identical records to produce identical @id values")
+	}
+}
+
+func TestSerializerOptions_WithIDTemplate_Fallback(t *testing.T) {
+	record := fullyPopulatedRecord()
+	record.ResourceId = ""
+	serializer := jsonld.NewSerializer(
+		jsonld.WithIDTemplate(usageTemplate),
+		jsonld.WithUserIDField("invoice_id"),
+	)
+
+	// The fallback keeps the configured user ID field and prefix.
+	want := "urn:focus:cost:" + record.GetInvoiceId()
+	if got := recordID(t, serializer, record); got != want {
+		t.Errorf("@id = %q, want fallback %q", got, want)
+	}
+}
+
+func TestWithIDTemplate_PanicsOnInvalidTemplate(t *testing.T) {
+	defer func() {
+		r := recover()
+		if r == nil {
+			t.Fatal("Expected WithIDTemplate to panic on an invalid template")
+		}
+		if msg, ok := r.(string); !ok || !strings.Contains(msg, "invalid ID template") {
+			t.Errorf("Unexpected panic value: %v", r)
+		}
+	}()
+	jsonld.WithIDTemplate("urn:focus:{notAField}")
+}
@@ -0,0 +1,196 @@
+package jsonld
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"maps"
+	"math"
+	"slices"
+	"strconv"
+	"strings"
+
+	pbc "github.com/rshade/finfocus-spec/sdk/go/proto/finfocus/v1"
+)
+
+// RDF datatype and property IRIs used in N-Quads output.
+const (
+	rdfType   = "http://www.w3.org/1999/02/22-rdf-syntax-ns#type"
+	rdfJSON   = "http://www.w3.org/1999/02/22-rdf-syntax-ns#JSON"
+	xsdDouble = "http://www.w3.org/2001/XMLSchema#double"
+)
+
+// literalEscaper escapes the characters N-Quads does not allow unescaped in string literals.
+//
+//nolint:gochecknoglobals // stateless replacer shared by all N-Quads writes
+var literalEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`, "\r", `\r`)
+
+// SerializeNQuads writes a FocusCostRecord to w as RDF N-Quads in the default graph, for
+// loading into triple stores.
+//
+// The subject is the record's @id (user-provided or SHA256 fallback, as in Serialize) and each
+// populated field becomes one triple whose predicate is the field's IRI from the JSON-LD context
+// (e.g., <https://focus.finops.org/v1#billingAccountId>). Literals carry XSD datatypes: costs
+// and quantities are xsd:double, timestamps are xsd:dateTime, and tag maps are rdf:JSON.
+// Costs are schema:MonetaryAmount blank nodes (_:b0, _:b1, ...) with their own schema:value
+// and schema:currency triples. Enums are node IRIs when WithIRIEnums(true) is set.
+//
+// Triples are written in a deterministic order: rdf:type first, then predicates sorted by IRI.
+//
+// Example output:
+//
+//	<urn:focus:cost:a1b2...> <https://focus.finops.org/v1#billedCost> _:b0 .
+//	<urn:focus:cost:a1b2...> <https://focus.finops.org/v1#serviceName> "Amazon EC2" .
+//	_:b0 <https://schema.org/value> "1.255E2"^^<http://www.w3.org/2001/XMLSchema#double> .
+func (s *Serializer) SerializeNQuads(record *pbc.FocusCostRecord, w io.Writer) error {
+	if record == nil {
+		return &ValidationError{
+			Field:      "record",
+			Message:    "record cannot be nil",
+			Suggestion: "provide a valid FocusCostRecord",
+		}
+	}
+
+	doc, err := s.costRecordDocument(record)
+	if err != nil {
+		return err
+	}
+	node := expandDocument(doc, record.ProtoReflect().Descriptor(), s.options.UseIRIEnums)
+
+	var q nquadWriter
+	id, _ := node["@id"].(string)
+	if err = q.writeNode(iriTerm(id), node); err != nil {
+		return err
+	}
+
+	_, err = io.WriteString(w, q.buf.String())
+	return err
+}
+
+// nquadWriter accumulates the triples of one record, labeling nested nodes as blank nodes.
+type nquadWriter struct {
+	buf        strings.Builder
+	blankNodes int
+}
+
+// writeNode writes the triples of an expanded node object, followed by those of its nested nodes.
+func (q *nquadWriter) writeNode(subject string, node map[string]interface{}) error {
+	types, _ := node["@type"].([]interface{})
+	for _, t := range types {
+		if iri, ok := t.(string); ok {
+			q.triple(subject, iriTerm(rdfType), iriTerm(iri))
+		}
+	}
+
+	type pendingNode struct {
+		label string
+		node  map[string]interface{}
+	}
+	var nested []pendingNode
+
+	for _, key := range slices.Sorted(maps.Keys(node)) {
+		if strings.HasPrefix(key, "@") {
+			continue
+		}
+		values, _ := node[key].([]interface{})
+		for _, v := range values {
+			obj, ok := v.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			var object string
+			switch {
+			case len(obj) == 1 && obj["@id"] != nil:
+				id, _ := obj["@id"].(string)
+				object = iriTerm(id)
+			case obj["@value"] == nil:
+				object = fmt.Sprintf("_:b%d", q.blankNodes)
+				q.blankNodes++
+				nested = append(nested, pendingNode{label: object, node: obj})
+			default:
+				literal, err := literalTerm(obj)
+				if err != nil {
+					return fmt.Errorf("writing %s: %w", key, err)
+				}
+				object = literal
+			}
+			q.triple(subject, iriTerm(key), object)
+		}
+	}
+
+	for _, n := range nested {
+		if err := q.writeNode(n.label, n.node); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// triple appends one N-Quads statement in the default graph.
+func (q *nquadWriter) triple(subject, predicate, object string) {
+	q.buf.WriteString(subject)
+	q.buf.WriteByte(' ')
+	q.buf.WriteString(predicate)
+	q.buf.WriteByte(' ')
+	q.buf.WriteString(object)
+	q.buf.WriteString(" .\n")
+}
+
+// literalTerm renders an expanded value object as an N-Quads literal.
+func literalTerm(obj map[string]interface{}) (string, error) {
+	switch v := obj["@value"].(type) {
+	case string:
+		literal := `"` + literalEscaper.Replace(v) + `"`
+		if lang, ok := obj["@language"].(string); ok {
+			return literal + "@" + lang, nil
+		}
+		if datatype, ok := obj["@type"].(string); ok {
+			return literal + "^^" + iriTerm(datatype), nil
+		}
+		return literal, nil
+	case float64:
+		return `"` + canonicalDouble(v) + `"^^` + iriTerm(xsdDouble), nil
+	default:
+		// @json literal; json.Marshal sorts object keys, giving a canonical form
+		data, err := json.Marshal(v)
+		if err != nil {
+			return "", err
+		}
+		return `"` + literalEscaper.Replace(string(data)) + `"^^` + iriTerm(rdfJSON), nil
+	}
+}
+
+// canonicalDouble formats f in the xsd:double canonical form used by JSON-LD (e.g., "1.255E2").
+func canonicalDouble(f float64) string {
+	switch {
+	case math.IsNaN(f):
+		return "NaN"
+	case math.IsInf(f, 1):
+		return "INF"
+	case math.IsInf(f, -1):
+		return "-INF"
+	}
+	mantissa, exponent, _ := strings.Cut(strconv.FormatFloat(f, 'E', -1, 64), "E")
+	if !strings.Contains(mantissa, ".") {
+		mantissa += ".0"
+	}
+	exp, _ := strconv.Atoi(exponent)
+	return mantissa + "E" + strconv.Itoa(exp)
+}
+
+// iriTerm renders an IRI in angle brackets, percent-encoding characters N-Quads forbids in IRIs.
+func iriTerm(iri string) string {
+	var b strings.Builder
+	b.Grow(len(iri) + 2)
+	b.WriteByte('<')
+	for i := range len(iri) {
+		c := iri[i]
+		if c <= ' ' || strings.IndexByte(`<>"{}|^`+"`"+`\`, c) >= 0 {
+			fmt.Fprintf(&b, "%%%02X", c)
+			continue
+		}
+		b.WriteByte(c)
+	}
+	b.WriteByte('>')
+	return b.String()
+}
@@ -0,0 +1,144 @@
+package jsonld_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/rshade/finfocus-spec/sdk/go/jsonld"
+	pbc "github.com/rshade/finfocus-spec/sdk/go/proto/finfocus/v1"
+)
+
+const (
+	xsdNS = "http://www.w3.org/2001/XMLSchema#"
+	rdfNS = "http://www.w3.org/1999/02/22-rdf-syntax-ns#"
+)
+
+// serializeNQuads returns the N-Quads statements written for record, one per line.
+func serializeNQuads(t *testing.T, s *jsonld.Serializer, record *pbc.FocusCostRecord) []string {
+	t.Helper()
+	var buf bytes.Buffer
+	if err := s.SerializeNQuads(record, &buf); err != nil {
+		t.Fatalf("SerializeNQuads() failed: %v", err)
+	}
+	out := buf.String()
+	if !strings.HasSuffix(out, " .\n") {
+		t.Fatalf("Output does not end with a terminated statement: %q", out)
+	}
+	return strings.Split(strings.TrimSuffix(out, "\n"), "\n")
+}
+
+// recordID returns the @id that Serialize assigns to record.
+func recordID(t *testing.T, s *jsonld.Serializer, record *pbc.FocusCostRecord) string {
+	t.Helper()
+	data, err := s.Serialize(record)
+	if err != nil {
+		t.Fatalf("Serialize() failed: %v", err)
+	}
+	var doc map[string]interface{}
+	if err = json.Unmarshal(data, &doc); err != nil {
+		t.Fatalf("Output is not valid JSON: %v", err)
+	}
+	id, _ := doc["@id"].(string)
+	return id
+}
+
+func TestSerializeNQuads_OneTriplePerField(t *testing.T) {
+	s := jsonld.NewSerializer()
+	record := fullyPopulatedRecord()
+	subject := "<" + recordID(t, s, record) + "> "
+
+	populated := 0
+	record.ProtoReflect().Range(func(protoreflect.FieldDescriptor, protoreflect.Value) bool {
+		populated++
+		return true
+	})
+
+	subjectTriples := 0
+	for _, line := range serializeNQuads(t, s, record) {
+		if !strings.HasPrefix(line, subject) && !strings.HasPrefix(line, "_:b") {
+			t.Errorf("Unexpected subject in %q", line)
+		}
+		if strings.HasPrefix(line, subject) {
+			subjectTriples++
+		}
+	}
+
+	// One triple per populated field, plus rdf:type.
+	if want := populated + 1; subjectTriples != want {
+		t.Errorf("Record has %d triples, want %d", subjectTriples, want)
+	}
+}
+
+func TestSerializeNQuads_Literals(t *testing.T) {
+	s := jsonld.NewSerializer()
+	record := &pbc.FocusCostRecord{
+		BillingAccountId:  "123456789012",
+		ChargePeriodStart: &timestamppb.Timestamp{Seconds: 1735689600},
+		ChargeDescription: "Line one\nsaid \"hi\" \\ bye",
+		PricingQuantity:   24,
+		BilledCost:        125.5,
+		BillingCurrency:   "USD",
+		Tags:              map[string]string{"team": "web", "env": "prod"},
+	}
+	subject := "<" + recordID(t, s, record) + ">"
+	focus := jsonld.FocusNamespace
+
+	want := []string{
+		subject + " <" + rdfNS + "type> <" + focus + "FocusCostRecord> .",
+		subject + " <" + focus + `billedCost> _:b0 .`,
+		subject + " <" + focus + `billingAccountId> "123456789012" .`,
+		subject + " <" + focus + `billingCurrency> "USD" .`,
+		subject + " <" + focus + `chargeDescription> "Line one\nsaid \"hi\" \\ bye" .`,
+		subject + " <" + focus + `chargePeriodStart> "2025-01-01T00:00:00Z"^^<` + xsdNS + "dateTime> .",
+		subject + " <" + focus + `pricingQuantity> "2.4E1"^^<` + xsdNS + "double> .",
+		subject + " <" + focus + `tags> "{\"env\":\"prod\",\"team\":\"web\"}"^^<` + rdfNS + "JSON> .",
+		"_:b0 <" + rdfNS + "type> <https://schema.org/MonetaryAmount> .",
+		`_:b0 <https://schema.org/currency> "USD" .`,
+		`_:b0 <https://schema.org/value> "1.255E2"^^<` + xsdNS + "double> .",
+	}
+
+	got := serializeNQuads(t, s, record)
+	if strings.Join(got, "\n") != strings.Join(want, "\n") {
+		t.Errorf("SerializeNQuads() =\n%s\nwant\n%s", strings.Join(got, "\n"), strings.Join(want, "\n"))
+	}
+}
+
+func TestSerializeNQuads_Options(t *testing.T) {
+	record := &pbc.FocusCostRecord{
+		InvoiceId:      "INV 2025/001",
+		ChargeCategory: pbc.FocusChargeCategory_FOCUS_CHARGE_CATEGORY_USAGE,
+		ServiceName:    "Amazon EC2",
+	}
+	s := jsonld.NewSerializer(
+		jsonld.WithUserIDField("invoice_id"),
+		jsonld.WithIRIEnums(true),
+		jsonld.WithLanguage("en"),
+	)
+	focus := jsonld.FocusNamespace
+	subject := "<urn:focus:cost:INV%202025/001>"
+
+	want := map[string]bool{
+		subject + " <" + focus + "chargeCategory> <" + focus + "FocusChargeCategoryUsage> .": true,
+		subject + " <" + focus + `serviceName> "Amazon EC2"@en .`:                            true,
+	}
+	for _, line := range serializeNQuads(t, s, record) {
+		delete(want, line)
+	}
+	for line := range want {
+		t.Errorf("Missing statement %s", line)
+	}
+}
+
+func TestSerializeNQuads_NilRecord(t *testing.T) {
+	err := jsonld.NewSerializer().SerializeNQuads(nil, &bytes.Buffer{})
+	var validationErr *jsonld.ValidationError
+	if !errors.As(err, &validationErr) {
+		t.Fatalf("SerializeNQuads(nil) error = %v, want *ValidationError", err)
+	}
+}
@@ -3,9 +3,12 @@ package jsonld
 import (
 	"encoding/json"
 	"fmt"
+	"regexp"
+	"strings"
 	"time"
 	"unicode/utf8"
 
+	"google.golang.org/protobuf/reflect/protoreflect"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	pbc "github.com/rshade/finfocus-spec/sdk/go/proto/finfocus/v1"
@@ -34,10 +37,30 @@ type SerializerOptions struct {
 	DateFormat        string
 	UserIDField       string
 	IDPrefix          string
+	// IDTemplate builds FocusCostRecord @id values from record field placeholders
+	// (see TemplateIDGenerator). Empty means the configured IDGenerator is used directly.
+	IDTemplate string
 	// StreamLimits configures optional limits for streaming serialization.
 	// Zero values mean unlimited (no limit enforced).
 	StreamLimits StreamLimits
-}
+	// StreamBufferSize is the size in bytes of the write buffer used by SerializeStream.
+	// Values <= 0 use DefaultStreamBufferSize.
+	StreamBufferSize int
+	// Language is a BCP 47 language tag (e.g., "en", "de-CH") applied to human-readable
+	// text fields. Empty means text fields are rendered as plain strings.
+	Language string
+	// Expanded emits JSON-LD expanded form (absolute IRIs, no @context) instead of
+	// the default compacted form.
+	Expanded bool
+	// StrictValidation rejects FocusCostRecords that fail ValidateSerializable.
+	StrictValidation bool
+	// FieldTransformers rewrites property values, keyed by JSON-LD property name, before
+	// the document is emitted (see WithFieldTransformer).
+	FieldTransformers map[string]FieldTransformer
+}
+
+// FieldTransformer rewrites the value of one JSON-LD property. Returning nil omits the property.
+type FieldTransformer func(value interface{}) interface{}
 
 // DefaultSerializerOptions returns sensible defaults for serialization.
 func DefaultSerializerOptions() *SerializerOptions {
@@ -49,6 +72,7 @@ func DefaultSerializerOptions() *SerializerOptions {
 		DateFormat:        time.RFC3339,
 		UserIDField:       "",
 		IDPrefix:          "urn:focus:cost:",
+		StreamBufferSize:  DefaultStreamBufferSize,
 	}
 }
 
@@ -97,6 +121,15 @@ func NewSerializer(opts ...SerializerOption) *Serializer {
 		}
 	}
 
+	// Wrap the configured generator so records with empty template fields fall back to it
+	if s.options.IDTemplate != "" {
+		gen, err := NewTemplateIDGenerator(s.options.IDTemplate, s.idGenerator)
+		if err != nil {
+			panic(fmt.Sprintf("invalid ID template: %v", err))
+		}
+		s.idGenerator = gen
+	}
+
 	return s
 }
 
@@ -137,6 +170,28 @@ func WithUserIDField(field string) SerializerOption {
 	}
 }
 
+// WithIDTemplate builds FocusCostRecord @id values from a template of record field
+// placeholders, producing stable, human-meaningful identifiers that can be deduplicated and
+// referenced across exports:
+//
+//	jsonld.WithIDTemplate("urn:focus:{billingAccountId}:{chargePeriodStart}:{resourceId}")
+//	// "@id": "urn:focus:123456789012:2025-01-01T00:00:00Z:i-1234567890abcdef0"
+//
+// Records with an empty template field fall back to the configured generator (SHA256 composite
+// key by default, honoring WithUserIDField and WithIDPrefix). See TemplateIDGenerator for the
+// supported placeholders and value formatting. Commitment IDs are unaffected.
+//
+// Panics if the template is invalid (fail-fast behavior); use NewTemplateIDGenerator to
+// validate templates from configuration.
+func WithIDTemplate(template string) SerializerOption {
+	if _, err := parseIDTemplate(template); err != nil {
+		panic(fmt.Sprintf("invalid ID template: %v", err))
+	}
+	return func(s *Serializer) {
+		s.options.IDTemplate = template
+	}
+}
+
 // WithIDPrefix sets a custom prefix for generated IDs.
 // If the IDGenerator implements ConfigurableIDGenerator, the prefix is applied
 // directly. Otherwise, the prefix is stored in options but may not affect ID generation.
@@ -192,6 +247,148 @@ func WithStreamLimits(limits StreamLimits) SerializerOption {
 	}
 }
 
+// WithStreamBufferSize sets the size in bytes of the write buffer used by SerializeStream.
+// Larger buffers reduce the number of writes to the underlying io.Writer at the cost of
+// memory per stream; smaller buffers keep memory tight. Sizes <= 0 fall back to
+// DefaultStreamBufferSize.
+//
+// Example:
+//
+//	// Fewer syscalls when streaming to a file or network connection
+//	s := jsonld.NewSerializer(jsonld.WithStreamBufferSize(256 * 1024))
+func WithStreamBufferSize(bytes int) SerializerOption {
+	return func(s *Serializer) {
+		if bytes <= 0 {
+			bytes = DefaultStreamBufferSize
+		}
+		s.options.StreamBufferSize = bytes
+	}
+}
+
+// languageTagRegex matches well-formed BCP 47 language tags (e.g., "en", "en-US", "zh-Hant-TW").
+//
+//nolint:gochecknoglobals // precompiled regex for fail-fast option validation
+var languageTagRegex = regexp.MustCompile(`^[A-Za-z]{2,8}(-[A-Za-z0-9]{1,8})*$`)
+
+// languageTaggedFields are the human-readable text fields rendered as language-tagged
+// value objects when WithLanguage is set. Identifiers, codes, and units are never tagged.
+//
+//nolint:gochecknoglobals // Intentional package-level lookup set for text field detection
+var languageTaggedFields = map[string]bool{
+	"billingAccountName":     true,
+	"subAccountName":         true,
+	"chargeDescription":      true,
+	"serviceName":            true,
+	"resourceName":           true,
+	"regionName":             true,
+	"commitmentDiscountName": true,
+	"allocatedResourceName":  true,
+}
+
+// WithLanguage renders human-readable text fields (names and descriptions such as
+// chargeDescription and resourceName) as JSON-LD language-tagged value objects:
+//
+//	"chargeDescription": {"@value": "Compute usage", "@language": "en"}
+//
+// Identifier, code, numeric, and enum fields are unaffected.
+//
+// Panics if tag is not a well-formed BCP 47 language tag (fail-fast behavior).
+func WithLanguage(tag string) SerializerOption {
+	if !languageTagRegex.MatchString(tag) {
+		panic(fmt.Sprintf("invalid language tag %q; expected a BCP 47 tag such as \"en\" or \"en-US\"", tag))
+	}
+	return func(s *Serializer) {
+		s.options.Language = tag
+	}
+}
+
+// WithExpanded emits JSON-LD expanded form for interoperability with RDF tooling and triple
+// stores that do not process contexts. Each record is a node object in which every property
+// key and @type is an absolute IRI (e.g., "https://focus.finops.org/v1#billedCost"), every
+// property value is an array of value or node objects, and the @context is omitted.
+// SerializeStream output is then an expanded document: an array of node objects.
+//
+// Example:
+//
+//	{
+//	  "@id": "urn:focus:cost:...",
+//	  "@type": ["https://focus.finops.org/v1#FocusCostRecord"],
+//	  "https://focus.finops.org/v1#billingAccountId": [{"@value": "123456789012"}]
+//	}
+func WithExpanded() SerializerOption {
+	return func(s *Serializer) {
+		s.options.Expanded = true
+	}
+}
+
+// WithCompacted emits JSON-LD compacted form: short property terms with an @context
+// declaring the vocabulary prefixes. This is the default.
+func WithCompacted() SerializerOption {
+	return func(s *Serializer) {
+		s.options.Expanded = false
+	}
+}
+
+// WithStrictValidation runs ValidateSerializable on every FocusCostRecord before it is
+// serialized (Serialize, SerializeStream, SerializeNQuads), returning its error instead of
+// emitting a document that is missing FOCUS-required fields. ContractCommitments are not affected.
+func WithStrictValidation() SerializerOption {
+	return func(s *Serializer) {
+		s.options.StrictValidation = true
+	}
+}
+
+// WithFieldTransformer registers fn to rewrite the value of field, a JSON-LD property name
+// such as "regionId" or "billingAccountId", before the document is emitted. This supports
+// normalizing (uppercasing a region) or redacting (masking an account ID) values at export.
+//
+// fn receives the value in compacted form: a string for text, identifiers, timestamps, and
+// enums; a float64 for plain numbers; and a map[string]interface{} for language-tagged text,
+// MonetaryAmount costs, and tag maps. It is only called for properties present in the
+// document, and the property is omitted if fn returns nil. Transformers apply to
+// FocusCostRecords and ContractCommitments alike; registering a field twice replaces the
+// earlier transformer. Transformed documents may no longer round-trip through Deserialize.
+//
+// Example:
+//
+//	serializer := jsonld.NewSerializer(
+//		jsonld.WithFieldTransformer("billingAccountId", func(v interface{}) interface{} {
+//			id, _ := v.(string)
+//			return "****" + id[max(len(id)-4, 0):]
+//		}),
+//	)
+//
+// Panics if field is empty or a JSON-LD keyword (e.g., @id), or if fn is nil (fail-fast behavior).
+func WithFieldTransformer(field string, fn func(interface{}) interface{}) SerializerOption {
+	if field == "" || strings.HasPrefix(field, "@") {
+		panic(fmt.Sprintf("invalid transformer field %q; use a JSON-LD property name such as regionId", field))
+	}
+	if fn == nil {
+		panic(fmt.Sprintf("transformer for field %q cannot be nil", field))
+	}
+	return func(s *Serializer) {
+		if s.options.FieldTransformers == nil {
+			s.options.FieldTransformers = make(map[string]FieldTransformer)
+		}
+		s.options.FieldTransformers[field] = fn
+	}
+}
+
+// applyFieldTransformers rewrites the document properties that have a registered transformer.
+func (s *Serializer) applyFieldTransformers(doc map[string]interface{}) {
+	for field, fn := range s.options.FieldTransformers {
+		value, ok := doc[field]
+		if !ok {
+			continue
+		}
+		if transformed := fn(value); transformed != nil {
+			doc[field] = transformed
+		} else {
+			delete(doc, field)
+		}
+	}
+}
+
 // fieldWriter is a fail-fast field writer that stops all operations after the first error.
 // This prevents partial document corruption and ensures consistent error handling.
 type fieldWriter struct {
@@ -291,9 +488,6 @@ func (s *Serializer) SerializeCommitment(record *pbc.ContractCommitment) ([]byte
 	// Build the JSON-LD document
 	doc := make(map[string]interface{})
 
-	// Add @context
-	doc["@context"] = s.context.Build()
-
 	// Add @type with namespace prefix for proper RDF semantics
 	doc["@type"] = ContractCommitmentType
 
@@ -304,12 +498,13 @@ func (s *Serializer) SerializeCommitment(record *pbc.ContractCommitment) ([]byte
 	if err := s.serializeCommitmentFields(doc, record); err != nil {
 		return nil, err
 	}
+	s.applyFieldTransformers(doc)
 
-	// Marshal to JSON
-	if s.options.PrettyPrint {
-		return json.MarshalIndent(doc, "", "  ")
-	}
-	return json.Marshal(doc)
+	// Add @context, typing the fields present
+	desc := record.ProtoReflect().Descriptor()
+	doc["@context"] = s.context.build(typeCoercions(doc, desc, s.options.UseIRIEnums))
+
+	return s.marshal(doc, desc)
 }
 
 // serializeCommitmentFields adds all ContractCommitment fields to the document.
@@ -362,12 +557,25 @@ func (s *Serializer) Serialize(record *pbc.FocusCostRecord) ([]byte, error) {
 		}
 	}
 
+	doc, err := s.costRecordDocument(record)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.marshal(doc, record.ProtoReflect().Descriptor())
+}
+
+// costRecordDocument builds the compacted JSON-LD document for a non-nil FocusCostRecord.
+func (s *Serializer) costRecordDocument(record *pbc.FocusCostRecord) (map[string]interface{}, error) {
+	if s.options.StrictValidation {
+		if err := ValidateSerializable(record); err != nil {
+			return nil, err
+		}
+	}
+
 	// Build the JSON-LD document
 	doc := make(map[string]interface{})
 
-	// Add @context
-	doc["@context"] = s.context.Build()
-
 	// Add @type with namespace prefix for proper RDF semantics
 	doc["@type"] = FocusCostRecordType
 
@@ -378,12 +586,26 @@ func (s *Serializer) Serialize(record *pbc.FocusCostRecord) ([]byte, error) {
 	if err := s.serializeCostRecordFields(doc, record); err != nil {
 		return nil, err
 	}
+	s.applyFieldTransformers(doc)
+
+	// Add @context, typing the fields present
+	doc["@context"] = s.context.build(typeCoercions(doc, record.ProtoReflect().Descriptor(), s.options.UseIRIEnums))
+
+	return doc, nil
+}
+
+// marshal encodes a document built from a message described by desc, in compacted or
+// expanded form as configured.
+func (s *Serializer) marshal(doc map[string]interface{}, desc protoreflect.MessageDescriptor) ([]byte, error) {
+	var out interface{} = doc
+	if s.options.Expanded {
+		out = expandDocument(doc, desc, s.options.UseIRIEnums)
+	}
 
-	// Marshal to JSON
 	if s.options.PrettyPrint {
-		return json.MarshalIndent(doc, "", "  ")
+		return json.MarshalIndent(out, "", "  ")
 	}
-	return json.Marshal(doc)
+	return json.Marshal(out)
 }
 
 // serializeCostRecordFields adds all FocusCostRecord fields to the document.
@@ -517,6 +739,7 @@ func (s *Serializer) serializeCostRecordFields(doc map[string]interface{}, recor
 }
 
 // addStringField adds a string field if not empty.
+// Text fields are language-tagged when a language is configured.
 // Returns an error if the value contains invalid UTF-8.
 func (s *Serializer) addStringField(doc map[string]interface{}, name, value string) error {
 	if !s.options.OmitEmptyFields || value != "" {
@@ -524,6 +747,13 @@ func (s *Serializer) addStringField(doc map[string]interface{}, name, value stri
 		if err := s.validateUTF8(name, value); err != nil {
 			return err
 		}
+		if s.options.Language != "" && languageTaggedFields[name] {
+			doc[name] = map[string]interface{}{
+				"@value":    value,
+				"@language": s.options.Language,
+			}
+			return nil
+		}
 		doc[name] = value
 	}
 	return nil
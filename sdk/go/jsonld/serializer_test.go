@@ -368,6 +368,56 @@ func TestSerializeCommitment(t *testing.T) {
 	}
 }
 
+func TestSerializeCommitment_CustomMapping(t *testing.T) {
+	ctx := jsonld.NewContext().
+		WithCustomMapping("contractCommitmentCost", "https://example.com/ontology#commitmentAmount")
+	serializer := jsonld.NewSerializer(jsonld.WithContext(ctx))
+
+	commitment := &pbc.ContractCommitment{
+		ContractCommitmentId:       "commit-001",
+		ContractCommitmentCost:     10000.00,
+		ContractCommitmentQuantity: 8760,
+		ContractCommitmentUnit:     "Hours",
+		BillingCurrency:            "USD",
+	}
+
+	output, err := serializer.SerializeCommitment(commitment)
+	if err != nil {
+		t.Fatalf("SerializeCommitment() failed: %v", err)
+	}
+
+	var result map[string]interface{}
+	if unmarshalErr := json.Unmarshal(output, &result); unmarshalErr != nil {
+		t.Fatalf("Output is not valid JSON: %v", unmarshalErr)
+	}
+
+	if result["@type"] != "focus:ContractCommitment" {
+		t.Errorf("@type = %v, want focus:ContractCommitment", result["@type"])
+	}
+
+	context, ok := result["@context"].(map[string]interface{})
+	if !ok {
+		t.Fatalf("@context = %T, want object", result["@context"])
+	}
+	if context["contractCommitmentCost"] != "https://example.com/ontology#commitmentAmount" {
+		t.Errorf("@context.contractCommitmentCost = %v, want custom IRI", context["contractCommitmentCost"])
+	}
+
+	cost, ok := result["contractCommitmentCost"].(map[string]interface{})
+	if !ok {
+		t.Fatalf("contractCommitmentCost = %T, want MonetaryAmount object", result["contractCommitmentCost"])
+	}
+	if cost["value"] != 10000.0 || cost["currency"] != "USD" {
+		t.Errorf("contractCommitmentCost = %v, want 10000 USD", cost)
+	}
+	if result["contractCommitmentQuantity"] != 8760.0 {
+		t.Errorf("contractCommitmentQuantity = %v, want 8760", result["contractCommitmentQuantity"])
+	}
+	if result["contractCommitmentUnit"] != "Hours" {
+		t.Errorf("contractCommitmentUnit = %v, want Hours", result["contractCommitmentUnit"])
+	}
+}
+
 func TestConformance_CommitmentCostRecordLinking(t *testing.T) {
 	serializer := jsonld.NewSerializer()
 
@@ -751,3 +801,214 @@ func TestSerializeCommitment_WithUserIDField(t *testing.T) {
 		t.Errorf("@id should contain user-provided ID, got: %s", id)
 	}
 }
+
+func TestSerializerOptions_WithLanguage(t *testing.T) {
+	record := &pbc.FocusCostRecord{
+		BillingAccountId:  "123456789012",
+		ChargeDescription: "Compute usage",
+		ResourceName:      "web-server",
+		ResourceId:        "i-1234567890abcdef0",
+		BilledCost:        12.5,
+		PricingQuantity:   730,
+	}
+
+	t.Run("text fields are language-tagged", func(t *testing.T) {
+		output, err := jsonld.NewSerializer(jsonld.WithLanguage("en")).Serialize(record)
+		if err != nil {
+			t.Fatalf("Serialize() failed: %v", err)
+		}
+
+		var result map[string]interface{}
+		if unmarshalErr := json.Unmarshal(output, &result); unmarshalErr != nil {
+			t.Fatalf("Output is not valid JSON: %v", unmarshalErr)
+		}
+
+		for field, want := range map[string]string{"chargeDescription": "Compute usage", "resourceName": "web-server"} {
+			tagged, ok := result[field].(map[string]interface{})
+			if !ok {
+				t.Fatalf("%s = %v, want a language-tagged value object", field, result[field])
+			}
+			if tagged["@value"] != want || tagged["@language"] != "en" {
+				t.Errorf("%s = %v, want @value %q with @language \"en\"", field, tagged, want)
+			}
+		}
+
+		// Identifier and numeric fields are unaffected
+		if result["resourceId"] != "i-1234567890abcdef0" || result["billingAccountId"] != "123456789012" {
+			t.Errorf("ID fields should remain plain strings, got resourceId=%v billingAccountId=%v",
+				result["resourceId"], result["billingAccountId"])
+		}
+		if result["pricingQuantity"] != 730.0 {
+			t.Errorf("pricingQuantity = %v, want 730", result["pricingQuantity"])
+		}
+	})
+
+	t.Run("omitting the option keeps plain strings", func(t *testing.T) {
+		output, err := jsonld.NewSerializer().Serialize(record)
+		if err != nil {
+			t.Fatalf("Serialize() failed: %v", err)
+		}
+		var result map[string]interface{}
+		if unmarshalErr := json.Unmarshal(output, &result); unmarshalErr != nil {
+			t.Fatalf("Output is not valid JSON: %v", unmarshalErr)
+		}
+		if result["chargeDescription"] != "Compute usage" {
+			t.Errorf("chargeDescription = %v, want plain string", result["chargeDescription"])
+		}
+	})
+
+	t.Run("tagged output deserializes back", func(t *testing.T) {
+		output, err := jsonld.NewSerializer(jsonld.WithLanguage("de-CH")).Serialize(record)
+		if err != nil {
+			t.Fatalf("Serialize() failed: %v", err)
+		}
+
+		type languageString struct {
+			Value    string `json:"@value"`
+			Language string `json:"@language"`
+		}
+		var decoded struct {
+			ChargeDescription languageString `json:"chargeDescription"`
+			ResourceID        string         `json:"resourceId"`
+		}
+		if unmarshalErr := json.Unmarshal(output, &decoded); unmarshalErr != nil {
+			t.Fatalf("json.Unmarshal() failed: %v", unmarshalErr)
+		}
+		if decoded.ChargeDescription.Value != record.GetChargeDescription() ||
+			decoded.ChargeDescription.Language != "de-CH" {
+			t.Errorf("chargeDescription decoded as %+v", decoded.ChargeDescription)
+		}
+		if decoded.ResourceID != record.GetResourceId() {
+			t.Errorf("resourceId decoded as %q", decoded.ResourceID)
+		}
+	})
+}
+
+func TestSerializerOptions_WithLanguage_InvalidTag(t *testing.T) {
+	for _, tag := range []string{"", "e", "en_US", "en-"} {
+		t.Run(tag, func(t *testing.T) {
+			defer func() {
+				if r := recover(); r == nil {
+					t.Errorf("Expected panic for invalid language tag %q", tag)
+				}
+			}()
+			_ = jsonld.WithLanguage(tag)
+		})
+	}
+}
+
+func TestSerializerOptions_WithFieldTransformer(t *testing.T) {
+	upper := func(v interface{}) interface{} {
+		s, _ := v.(string)
+		return strings.ToUpper(s)
+	}
+	mask := func(v interface{}) interface{} {
+		id, _ := v.(string)
+		if len(id) <= 4 {
+			return "****"
+		}
+		return strings.Repeat("*", len(id)-4) + id[len(id)-4:]
+	}
+	omit := func(interface{}) interface{} { return nil }
+
+	serializer := jsonld.NewSerializer(
+		jsonld.WithFieldTransformer("regionId", upper),
+		jsonld.WithFieldTransformer("billingAccountId", mask),
+		jsonld.WithFieldTransformer("resourceName", omit),
+		jsonld.WithFieldTransformer("invoiceIssuer", upper), // not present in the record
+	)
+
+	record := &pbc.FocusCostRecord{
+		BillingAccountId: "123456789012",
+		RegionId:         "us-east-1",
+		ResourceName:     "web-server-01",
+		ServiceName:      "Amazon EC2",
+		BilledCost:       12.5,
+		BillingCurrency:  "USD",
+	}
+	output, err := serializer.Serialize(record)
+	if err != nil {
+		t.Fatalf("Serialize() failed: %v", err)
+	}
+
+	var result map[string]interface{}
+	if unmarshalErr := json.Unmarshal(output, &result); unmarshalErr != nil {
+		t.Fatalf("Output is not valid JSON: %v", unmarshalErr)
+	}
+
+	if result["regionId"] != "US-EAST-1" {
+		t.Errorf("regionId = %v, want US-EAST-1", result["regionId"])
+	}
+	if result["billingAccountId"] != "********9012" {
+		t.Errorf("billingAccountId = %v, want ********9012", result["billingAccountId"])
+	}
+	if _, ok := result["resourceName"]; ok {
+		t.Error("resourceName should be omitted when its transformer returns nil")
+	}
+	if _, ok := result["invoiceIssuer"]; ok {
+		t.Error("invoiceIssuer should not be added by a transformer when absent from the record")
+	}
+
+	// Untransformed fields are unchanged
+	if result["serviceName"] != "Amazon EC2" || result["billingCurrency"] != "USD" {
+		t.Errorf("Untransformed fields changed: serviceName=%v billingCurrency=%v",
+			result["serviceName"], result["billingCurrency"])
+	}
+	if cost, ok := result["billedCost"].(map[string]interface{}); !ok || cost["value"] != 12.5 {
+		t.Errorf("billedCost = %v, want unchanged MonetaryAmount", result["billedCost"])
+	}
+
+	// The @id is generated from the original record, not the transformed values
+	plain, err := jsonld.NewSerializer().Serialize(record)
+	if err != nil {
+		t.Fatalf("Serialize() failed: %v", err)
+	}
+	var plainResult map[string]interface{}
+	if unmarshalErr := json.Unmarshal(plain, &plainResult); unmarshalErr != nil {
+		t.Fatalf("Output is not valid JSON: %v", unmarshalErr)
+	}
+	if result["@id"] != plainResult["@id"] {
+		t.Errorf("@id = %v, want %v", result["@id"], plainResult["@id"])
+	}
+}
+
+func TestSerializerOptions_WithFieldTransformer_Commitment(t *testing.T) {
+	serializer := jsonld.NewSerializer(jsonld.WithFieldTransformer("contractId", func(interface{}) interface{} {
+		return "redacted"
+	}))
+
+	output, err := serializer.SerializeCommitment(&pbc.ContractCommitment{
+		ContractCommitmentId: "commit-001",
+		ContractId:           "contract-001",
+	})
+	if err != nil {
+		t.Fatalf("SerializeCommitment() failed: %v", err)
+	}
+	if !strings.Contains(string(output), `"contractId":"redacted"`) {
+		t.Errorf("Expected transformed contractId in output: %s", output)
+	}
+}
+
+func TestSerializerOptions_WithFieldTransformer_Invalid(t *testing.T) {
+	identity := func(v interface{}) interface{} { return v }
+	tests := []struct {
+		name  string
+		field string
+		fn    func(interface{}) interface{}
+	}{
+		{"empty field", "", identity},
+		{"keyword field", "@id", identity},
+		{"nil transformer", "regionId", nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer func() {
+				if r := recover(); r == nil {
+					t.Errorf("Expected panic for %s", tt.name)
+				}
+			}()
+			_ = jsonld.WithFieldTransformer(tt.field, tt.fn)
+		})
+	}
+}
@@ -1,6 +1,7 @@
 package jsonld
 
 import (
+	"bufio"
 	"bytes"
 	"context"
 	"encoding/json"
@@ -74,6 +75,10 @@ func (r *StreamResult) HasErrors() bool {
 	return len(r.Errors) > 0
 }
 
+// DefaultStreamBufferSize is the default write buffer size for SerializeStream (32KB).
+// Override it per serializer with WithStreamBufferSize.
+const DefaultStreamBufferSize = 32 * 1024
+
 // Buffer pool configuration constants.
 const (
 	// defaultBufferSize is the initial capacity for pooled buffers (4KB).
@@ -117,12 +122,15 @@ func putBuffer(buf *bytes.Buffer) {
 // SerializeStream writes multiple FocusCostRecords to a writer as a JSON-LD array.
 //
 // The output format is a JSON array: [record1, record2, ...]
-// Memory usage is bounded - only one record is held in memory at a time.
+// Memory usage is bounded - only one record is held in memory at a time, plus a write
+// buffer of SerializerOptions.StreamBufferSize bytes (see WithStreamBufferSize).
+// The buffer is flushed before SerializeStream returns on every path.
 //
 // Context Cancellation:
 //   - The context is checked on each iteration of the loop
 //   - When cancelled, returns ctx.Err() with partial results in StreamResult
-//   - RecordsWritten reflects records successfully written before cancellation
+//   - RecordsWritten reflects records successfully written before cancellation, counting
+//     only records that reached w; records lost from the write buffer are not counted
 //   - Cancellation latency: <1ms (checked every iteration via select)
 //   - On cancellation, the closing bracket is written to attempt valid JSON output
 //   - CRITICAL: If cancellation occurs during a write syscall, the output MUST be
@@ -141,7 +149,10 @@ func putBuffer(buf *bytes.Buffer) {
 //   - Serialization errors (invalid records) are collected in StreamResult.Errors
 //     and processing continues with the next record
 //   - Write errors are also collected in StreamResult.Errors, allowing partial
-//     output when the writer recovers (e.g., temporary network issues)
+//     output when the writer recovers (e.g., temporary network issues). Because
+//     output is buffered, a write error may surface on a later record than the one
+//     that was lost, and buffered records are discarded (and not counted in
+//     RecordsWritten) when the error is recorded
 //   - Returns immediately if the closing bracket cannot be written
 //   - Call StreamResult.HasErrors() to check if any errors occurred
 //
@@ -155,10 +166,25 @@ func (s *Serializer) SerializeStream(
 		Errors: make([]*StreamError, 0),
 	}
 
+	bufferSize := s.options.StreamBufferSize
+	if bufferSize <= 0 {
+		bufferSize = DefaultStreamBufferSize
+	}
+	tracker := &flushTracker{w: w}
+	bw := bufio.NewWriterSize(tracker, bufferSize)
+
 	// Write opening bracket
-	if _, err := w.Write([]byte("[\n")); err != nil {
+	if _, err := bw.Write([]byte("[\n")); err != nil {
 		return result, fmt.Errorf("failed to write opening bracket: %w", err)
 	}
+	tracker.queue(len("[\n"), false)
+
+	// closeStream writes the closing bracket and counts the records it flushes.
+	closeStream := func() error {
+		err := writeClosingBracket(bw)
+		result.RecordsWritten += tracker.settle()
+		return err
+	}
 
 	index := 0
 	first := true
@@ -169,27 +195,27 @@ func (s *Serializer) SerializeStream(
 		case <-ctx.Done():
 			// Context cancelled - set corruption flag if any records were written
 			// (cancellation during write may leave partial record in output)
-			if result.RecordsWritten > 0 {
+			if result.RecordsWritten+tracker.pendingCount() > 0 {
 				result.CorruptedOnCancel = true
 			}
 			// Write closing bracket and return
-			if _, err := w.Write([]byte("\n]")); err != nil {
+			if err := closeStream(); err != nil {
 				return result, fmt.Errorf("failed to write closing bracket on cancellation: %w", err)
 			}
 			return result, ctx.Err()
 		case record, ok := <-records:
 			if !ok {
 				// Channel closed, we're done
-				if _, err := w.Write([]byte("\n]")); err != nil {
+				if err := closeStream(); err != nil {
 					return result, fmt.Errorf("failed to write closing bracket: %w", err)
 				}
 				return result, nil
 			}
 
 			// Check MaxRecords limit before processing
-			if limits.MaxRecords > 0 && result.RecordsWritten >= limits.MaxRecords {
+			if limits.MaxRecords > 0 && result.RecordsWritten+tracker.pendingCount() >= limits.MaxRecords {
 				// Write closing bracket and return limit error
-				if _, err := w.Write([]byte("\n]")); err != nil {
+				if err := closeStream(); err != nil {
 					return result, fmt.Errorf("failed to write closing bracket: %w", err)
 				}
 				return result, ErrMaxRecordsExceeded
@@ -242,24 +268,84 @@ func (s *Serializer) SerializeStream(
 			}
 
 			// Write to output
-			if _, writeErr := w.Write(buf.Bytes()); writeErr != nil {
+			if _, writeErr := bw.Write(buf.Bytes()); writeErr != nil {
 				result.Errors = append(result.Errors, &StreamError{
 					Index:   index,
 					Message: "write failed",
 					Err:     writeErr,
 				})
+				// bufio.Writer errors are sticky; reset so later records can be written
+				// if the underlying writer recovers. Reset discards the buffered records,
+				// so only those that reached the writer are counted.
+				result.RecordsWritten += tracker.settle()
+				bw.Reset(tracker)
+				tracker.discard()
 				putBuffer(buf)
 				index++
 				continue
 			}
 
+			tracker.queue(buf.Len(), true)
+			result.RecordsWritten += tracker.settle()
 			putBuffer(buf)
-			result.RecordsWritten++
 			index++
 		}
 	}
 }
 
+// flushTracker wraps the writer under a stream's bufio.Writer to tell which buffered records
+// have reached it, so StreamResult.RecordsWritten counts only records that were written.
+type flushTracker struct {
+	w       io.Writer
+	written int   // bytes accepted by w
+	queued  int   // bytes passed to the buffer, including those already written
+	pending []int // end offsets of records that have not fully reached w, in order
+}
+
+// Write forwards p to the underlying writer, counting the bytes it accepts.
+func (t *flushTracker) Write(p []byte) (int, error) {
+	n, err := t.w.Write(p)
+	t.written += n
+	return n, err
+}
+
+// queue records that n more bytes were passed to the buffer; record marks them as one record.
+func (t *flushTracker) queue(n int, record bool) {
+	t.queued += n
+	if record {
+		t.pending = append(t.pending, t.queued)
+	}
+}
+
+// settle removes the records that have fully reached the writer and returns how many there were.
+func (t *flushTracker) settle() int {
+	done := 0
+	for done < len(t.pending) && t.pending[done] <= t.written {
+		done++
+	}
+	t.pending = t.pending[done:]
+	return done
+}
+
+// discard forgets the buffered bytes and records after the buffer has been reset.
+func (t *flushTracker) discard() {
+	t.pending = t.pending[:0]
+	t.queued = t.written
+}
+
+// pendingCount returns the number of buffered records that have not fully reached the writer.
+func (t *flushTracker) pendingCount() int {
+	return len(t.pending)
+}
+
+// writeClosingBracket writes the closing array bracket and flushes the stream buffer.
+func writeClosingBracket(bw *bufio.Writer) error {
+	if _, err := bw.Write([]byte("\n]")); err != nil {
+		return err
+	}
+	return bw.Flush()
+}
+
 // SerializeSlice serializes a slice of FocusCostRecords to JSON-LD array.
 //
 // This is a convenience method that creates a channel from the slice
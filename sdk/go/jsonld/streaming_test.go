@@ -761,3 +761,173 @@ func TestSerializeStream_MaxRecordSizeLimit(t *testing.T) {
 		t.Errorf("Expected ErrRecordTooLarge, got %v", result.Errors[0].Err)
 	}
 }
+
+// countingWriter records how many Write calls reach the underlying writer.
+type countingWriter struct {
+	bytes.Buffer
+	writes int
+}
+
+func (c *countingWriter) Write(p []byte) (int, error) {
+	c.writes++
+	return c.Buffer.Write(p)
+}
+
+func streamTestRecords(n int) []*pbc.FocusCostRecord {
+	records := make([]*pbc.FocusCostRecord, n)
+	for i := range n {
+		records[i] = &pbc.FocusCostRecord{
+			BillingAccountId: "123456789012",
+			ChargePeriodStart: &timestamppb.Timestamp{
+				Seconds: int64(1735689600 + i*86400),
+			},
+			ServiceName:     "Amazon EC2",
+			BilledCost:      float64(i),
+			BillingCurrency: "USD",
+		}
+	}
+	return records
+}
+
+func TestSerializeStream_SmallBufferLargeStream(t *testing.T) {
+	serializer := jsonld.NewSerializer(jsonld.WithStreamBufferSize(16))
+
+	var out countingWriter
+	result, err := serializer.SerializeSlice(context.Background(), streamTestRecords(2000), &out)
+	if err != nil {
+		t.Fatalf("SerializeSlice() failed: %v", err)
+	}
+	if result.RecordsWritten != 2000 || result.HasErrors() {
+		t.Fatalf("Expected 2000 records and no errors, got %d records, %d errors",
+			result.RecordsWritten, len(result.Errors))
+	}
+
+	var parsed []interface{}
+	if unmarshalErr := json.Unmarshal(out.Bytes(), &parsed); unmarshalErr != nil {
+		t.Fatalf("Output is not valid JSON array: %v", unmarshalErr)
+	}
+	if len(parsed) != 2000 {
+		t.Errorf("Expected 2000 records in output, got %d", len(parsed))
+	}
+	if out.writes < 2000 {
+		t.Errorf("Expected a small buffer to produce many writes, got %d", out.writes)
+	}
+}
+
+func TestSerializeStream_BufferFlushedAtEnd(t *testing.T) {
+	serializer := jsonld.NewSerializer(jsonld.WithStreamBufferSize(1 << 20))
+
+	var out countingWriter
+	result, err := serializer.SerializeSlice(context.Background(), streamTestRecords(10), &out)
+	if err != nil {
+		t.Fatalf("SerializeSlice() failed: %v", err)
+	}
+	if result.RecordsWritten != 10 {
+		t.Errorf("Expected 10 records written, got %d", result.RecordsWritten)
+	}
+	if out.writes != 1 {
+		t.Errorf("Expected a single flush to the underlying writer, got %d writes", out.writes)
+	}
+	if !json.Valid(out.Bytes()) {
+		t.Errorf("Output is not valid JSON after final flush: %q", out.String())
+	}
+}
+
+func TestSerializeStream_BufferFlushedOnCancel(t *testing.T) {
+	serializer := jsonld.NewSerializer(jsonld.WithStreamBufferSize(1 << 20))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	var out countingWriter
+	_, err := serializer.SerializeStream(ctx, make(chan *pbc.FocusCostRecord), &out)
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("Expected context.Canceled, got %v", err)
+	}
+	if out.String() != "[\n\n]" {
+		t.Errorf("Expected empty array flushed on cancellation, got %q", out.String())
+	}
+}
+
+// failingWriter fails its first failures writes, then writes to the embedded buffer.
+type failingWriter struct {
+	bytes.Buffer
+	failures int
+}
+
+func (f *failingWriter) Write(p []byte) (int, error) {
+	if f.failures > 0 {
+		f.failures--
+		return 0, errors.New("disk full")
+	}
+	return f.Buffer.Write(p)
+}
+
+func TestSerializeStream_WriteFailureNotCounted(t *testing.T) {
+	t.Run("buffered records lost on final flush", func(t *testing.T) {
+		serializer := jsonld.NewSerializer(jsonld.WithStreamBufferSize(1 << 20))
+
+		out := &failingWriter{failures: 1}
+		result, err := serializer.SerializeSlice(context.Background(), streamTestRecords(5), out)
+		if err == nil {
+			t.Fatal("Expected the failed final flush to be reported")
+		}
+		if result.RecordsWritten != 0 {
+			t.Errorf("Expected 0 records written, got %d", result.RecordsWritten)
+		}
+	})
+
+	t.Run("buffered records lost mid-stream", func(t *testing.T) {
+		records := streamTestRecords(6)
+		recordSize := 0
+		for _, r := range records {
+			data, err := jsonld.NewSerializer().Serialize(r)
+			if err != nil {
+				t.Fatalf("Serialize() failed: %v", err)
+			}
+			recordSize = max(recordSize, len(data))
+		}
+		// Room for about two records, so the first flush carries buffered records.
+		serializer := jsonld.NewSerializer(jsonld.WithStreamBufferSize(2*recordSize + 8))
+
+		out := &failingWriter{failures: 1}
+		result, err := serializer.SerializeSlice(context.Background(), records, out)
+		if err != nil {
+			t.Fatalf("SerializeSlice() failed: %v", err)
+		}
+		if len(result.Errors) != 1 {
+			t.Fatalf("Expected 1 write error, got %d", len(result.Errors))
+		}
+		// Every record that was counted must be in the output.
+		if got := bytes.Count(out.Bytes(), []byte(`"@type"`)); result.RecordsWritten > got {
+			t.Errorf("RecordsWritten = %d, but only %d records reached the writer", result.RecordsWritten, got)
+		}
+		if result.RecordsWritten >= len(records)-1 {
+			t.Errorf("Expected buffered records to be lost with the failed flush, got %d written",
+				result.RecordsWritten)
+		}
+	})
+}
+
+func TestWithStreamBufferSize_InvalidFallsBackToDefault(t *testing.T) {
+	for _, size := range []int{0, -1} {
+		serializer := jsonld.NewSerializer(jsonld.WithStreamBufferSize(size))
+
+		// Output well under DefaultStreamBufferSize arrives in a single flush when the
+		// default is in effect; a 0 or negative buffer would fail or write per record.
+		var out countingWriter
+		result, err := serializer.SerializeSlice(context.Background(), streamTestRecords(5), &out)
+		if err != nil {
+			t.Fatalf("size %d: SerializeSlice() failed: %v", size, err)
+		}
+		if result.RecordsWritten != 5 {
+			t.Errorf("size %d: expected 5 records written, got %d", size, result.RecordsWritten)
+		}
+		if out.Len() >= jsonld.DefaultStreamBufferSize {
+			t.Fatalf("size %d: test output too large (%d bytes) for default buffer", size, out.Len())
+		}
+		if out.writes != 1 {
+			t.Errorf("size %d: expected default buffer (1 write), got %d writes", size, out.writes)
+		}
+	}
+}
@@ -0,0 +1,95 @@
+package jsonld
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/rshade/finfocus-spec/sdk/go/currency"
+	pbc "github.com/rshade/finfocus-spec/sdk/go/proto/finfocus/v1"
+)
+
+// ValidateSerializable checks that a FocusCostRecord has the FOCUS-required fields populated
+// before it is serialized, so that incomplete records are rejected instead of producing
+// non-conformant JSON-LD.
+//
+// Required fields are billingAccountId, billingPeriodStart/End, billingCurrency,
+// chargePeriodStart/End, chargeCategory, chargeClass, chargeDescription, serviceCategory, and
+// serviceName. billingCurrency, and pricingCurrency when set, must be valid ISO 4217 codes
+// (see currency.IsValid); billedCost may be zero or negative (credits) but must be finite; and
+// chargePeriodEnd must not precede chargePeriodStart. The deprecated providerName is not required.
+//
+// Returns a *ValidationError naming the first failing field, or nil.
+func ValidateSerializable(record *pbc.FocusCostRecord) error {
+	if record == nil {
+		return &ValidationError{
+			Field:      "record",
+			Message:    "record cannot be nil",
+			Suggestion: "provide a valid FocusCostRecord",
+		}
+	}
+
+	required := []struct {
+		field   string
+		missing bool
+	}{
+		{"billingAccountId", record.GetBillingAccountId() == ""},
+		{"billingPeriodStart", record.GetBillingPeriodStart() == nil},
+		{"billingPeriodEnd", record.GetBillingPeriodEnd() == nil},
+		{"billingCurrency", record.GetBillingCurrency() == ""},
+		{"chargePeriodStart", record.GetChargePeriodStart() == nil},
+		{"chargePeriodEnd", record.GetChargePeriodEnd() == nil},
+		{"chargeCategory", record.GetChargeCategory() == pbc.FocusChargeCategory_FOCUS_CHARGE_CATEGORY_UNSPECIFIED},
+		{"chargeClass", record.GetChargeClass() == pbc.FocusChargeClass_FOCUS_CHARGE_CLASS_UNSPECIFIED},
+		{"chargeDescription", record.GetChargeDescription() == ""},
+		{"serviceCategory", record.GetServiceCategory() == pbc.FocusServiceCategory_FOCUS_SERVICE_CATEGORY_UNSPECIFIED},
+		{"serviceName", record.GetServiceName() == ""},
+	}
+	for _, r := range required {
+		if r.missing {
+			return &ValidationError{
+				Field:      r.field,
+				Message:    "required FOCUS field is not set",
+				Suggestion: "populate the field before serializing",
+			}
+		}
+	}
+
+	if err := validateCurrencyCode("billingCurrency", record.GetBillingCurrency()); err != nil {
+		return err
+	}
+	if code := record.GetPricingCurrency(); code != "" {
+		if err := validateCurrencyCode("pricingCurrency", code); err != nil {
+			return err
+		}
+	}
+
+	if cost := record.GetBilledCost(); math.IsNaN(cost) || math.IsInf(cost, 0) {
+		return &ValidationError{
+			Field:      "billedCost",
+			Message:    fmt.Sprintf("cost must be finite, got %v", cost),
+			Suggestion: "use a finite value; credits may be negative",
+		}
+	}
+
+	if record.GetChargePeriodEnd().AsTime().Before(record.GetChargePeriodStart().AsTime()) {
+		return &ValidationError{
+			Field:      "chargePeriodEnd",
+			Message:    "charge period end is before its start",
+			Suggestion: "set chargePeriodEnd at or after chargePeriodStart",
+		}
+	}
+
+	return nil
+}
+
+// validateCurrencyCode checks that code is a valid ISO 4217 currency code.
+func validateCurrencyCode(field, code string) error {
+	if currency.IsValid(code) {
+		return nil
+	}
+	return &ValidationError{
+		Field:      field,
+		Message:    fmt.Sprintf("invalid ISO 4217 currency code %q", code),
+		Suggestion: "use a three-letter ISO 4217 code such as USD or EUR",
+	}
+}
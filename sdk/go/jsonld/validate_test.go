@@ -0,0 +1,117 @@
+package jsonld_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"math"
+	"testing"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/rshade/finfocus-spec/sdk/go/jsonld"
+	pbc "github.com/rshade/finfocus-spec/sdk/go/proto/finfocus/v1"
+)
+
+func TestValidateSerializable(t *testing.T) {
+	tests := []struct {
+		name      string
+		modify    func(r *pbc.FocusCostRecord)
+		wantField string
+	}{
+		{"complete record", func(*pbc.FocusCostRecord) {}, ""},
+		{"zero billed cost", func(r *pbc.FocusCostRecord) { r.BilledCost = 0 }, ""},
+		{"negative billed cost", func(r *pbc.FocusCostRecord) { r.BilledCost = -5 }, ""},
+		{"no pricing currency", func(r *pbc.FocusCostRecord) { r.PricingCurrency = "" }, ""},
+		{"missing billing account", func(r *pbc.FocusCostRecord) { r.BillingAccountId = "" }, "billingAccountId"},
+		{"missing billing currency", func(r *pbc.FocusCostRecord) { r.BillingCurrency = "" }, "billingCurrency"},
+		{"missing charge period start", func(r *pbc.FocusCostRecord) { r.ChargePeriodStart = nil }, "chargePeriodStart"},
+		{"missing charge period end", func(r *pbc.FocusCostRecord) { r.ChargePeriodEnd = nil }, "chargePeriodEnd"},
+		{"missing service name", func(r *pbc.FocusCostRecord) { r.ServiceName = "" }, "serviceName"},
+		{
+			"unspecified charge category",
+			func(r *pbc.FocusCostRecord) {
+				r.ChargeCategory = pbc.FocusChargeCategory_FOCUS_CHARGE_CATEGORY_UNSPECIFIED
+			},
+			"chargeCategory",
+		},
+		{"invalid billing currency", func(r *pbc.FocusCostRecord) { r.BillingCurrency = "XYZ" }, "billingCurrency"},
+		{"invalid pricing currency", func(r *pbc.FocusCostRecord) { r.PricingCurrency = "usd" }, "pricingCurrency"},
+		{"NaN billed cost", func(r *pbc.FocusCostRecord) { r.BilledCost = math.NaN() }, "billedCost"},
+		{
+			"charge period end before start",
+			func(r *pbc.FocusCostRecord) { r.ChargePeriodEnd = &timestamppb.Timestamp{Seconds: 1735603200} },
+			"chargePeriodEnd",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			record := fullyPopulatedRecord()
+			tt.modify(record)
+
+			err := jsonld.ValidateSerializable(record)
+			if tt.wantField == "" {
+				if err != nil {
+					t.Errorf("ValidateSerializable() unexpected error: %v", err)
+				}
+				return
+			}
+			var validationErr *jsonld.ValidationError
+			if !errors.As(err, &validationErr) {
+				t.Fatalf("ValidateSerializable() error = %v, want *ValidationError", err)
+			}
+			if validationErr.Field != tt.wantField {
+				t.Errorf("ValidationError.Field = %q, want %q", validationErr.Field, tt.wantField)
+			}
+		})
+	}
+}
+
+func TestValidateSerializable_NilRecord(t *testing.T) {
+	if err := jsonld.ValidateSerializable(nil); err == nil {
+		t.Error("ValidateSerializable(nil) expected error, got nil")
+	}
+}
+
+func TestSerializerOptions_WithStrictValidation(t *testing.T) {
+	incomplete := &pbc.FocusCostRecord{
+		BillingAccountId: "123456789012",
+		BilledCost:       42.5,
+		BillingCurrency:  "USD",
+	}
+
+	// Without strict validation, incomplete records serialize as before.
+	if _, err := jsonld.NewSerializer().Serialize(incomplete); err != nil {
+		t.Fatalf("Serialize() without strict validation failed: %v", err)
+	}
+
+	strict := jsonld.NewSerializer(jsonld.WithStrictValidation())
+	if _, err := strict.Serialize(fullyPopulatedRecord()); err != nil {
+		t.Errorf("Serialize() of a complete record failed: %v", err)
+	}
+
+	output, err := strict.Serialize(incomplete)
+	var validationErr *jsonld.ValidationError
+	if !errors.As(err, &validationErr) {
+		t.Fatalf("Serialize() error = %v, want *ValidationError", err)
+	}
+	if output != nil {
+		t.Errorf("Serialize() emitted %s for an incomplete record", output)
+	}
+
+	if nquadsErr := strict.SerializeNQuads(incomplete, &bytes.Buffer{}); !errors.As(nquadsErr, &validationErr) {
+		t.Errorf("SerializeNQuads() error = %v, want *ValidationError", nquadsErr)
+	}
+
+	var buf bytes.Buffer
+	records := []*pbc.FocusCostRecord{fullyPopulatedRecord(), incomplete}
+	result, err := strict.SerializeSlice(context.Background(), records, &buf)
+	if err != nil {
+		t.Fatalf("SerializeSlice() failed: %v", err)
+	}
+	if result.RecordsWritten != 1 || len(result.Errors) != 1 || result.Errors[0].Index != 1 {
+		t.Errorf("SerializeSlice() wrote %d records with errors %v, want 1 record and an error at index 1",
+			result.RecordsWritten, result.Errors)
+	}
+}
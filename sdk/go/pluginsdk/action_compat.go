@@ -0,0 +1,118 @@
+package pluginsdk
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	pbc "github.com/rshade/finfocus-spec/sdk/go/proto/finfocus/v1"
+)
+
+// Sentinel errors for recommendation action/resource compatibility.
+var (
+	// ErrActionResourceNil is returned when no resource is supplied for action validation.
+	ErrActionResourceNil = errors.New("resource is required")
+
+	// ErrActionTypeUnspecified is returned when the action type is UNSPECIFIED.
+	ErrActionTypeUnspecified = errors.New("action_type must be specified")
+
+	// ErrActionTypeUnknown is returned when the action type is not a defined enum value.
+	ErrActionTypeUnknown = errors.New("action_type is not a known value")
+
+	// ErrActionNotApplicable is returned when the action does not make sense for the resource type.
+	ErrActionNotApplicable = errors.New("action_type is not applicable to resource type")
+)
+
+// Action/resource compatibility matrix.
+//
+// Resource types are reduced to a lowercase "kind": the last segment after splitting on ':'
+// and '/'. This handles short names ("ec2"), Pulumi tokens ("aws:ec2/instance:Instance" ->
+// "instance"), and ARM types ("Microsoft.Compute/virtualMachines" -> "virtualmachines").
+//
+//	Action           | Applicable resource kinds
+//	-----------------|-----------------------------------------------------------------
+//	RIGHTSIZE        | sizeableResourceKinds (instances, VMs, databases, caches, functions)
+//	SCHEDULE         | schedulableResourceKinds (start/stop capable compute and databases)
+//	ADJUST_REQUESTS  | kubernetesWorkloadKinds, or any resource with provider "kubernetes"
+//	all other types  | any resource (terminate, delete_unused, modify, migrate, ...)
+//
+// Kinds not listed are treated as not applicable for the restricted actions.
+
+// sizeableResourceKinds are resource kinds that have a selectable size, SKU, or capacity.
+//
+//nolint:gochecknoglobals // Lookup table for the action/resource compatibility matrix
+var sizeableResourceKinds = map[string]struct{}{
+	"ec2": {}, "instance": {}, "virtualmachine": {}, "virtualmachines": {}, "vm": {},
+	"rds": {}, "dbinstance": {}, "databaseinstance": {}, "database": {},
+	"elasticache": {}, "cachecluster": {}, "replicationgroup": {},
+	"lambda": {}, "function": {},
+	"nodegroup": {}, "nodepool": {}, "launchtemplate": {},
+	"ebs": {}, "volume": {}, "disk": {},
+}
+
+// schedulableResourceKinds are resource kinds that can be stopped and started on a schedule.
+//
+//nolint:gochecknoglobals // Lookup table for the action/resource compatibility matrix
+var schedulableResourceKinds = map[string]struct{}{
+	"ec2": {}, "instance": {}, "virtualmachine": {}, "virtualmachines": {}, "vm": {},
+	"rds": {}, "dbinstance": {}, "databaseinstance": {},
+	"nodegroup": {}, "nodepool": {},
+}
+
+// kubernetesWorkloadKinds are Kubernetes workload kinds that declare container resource requests.
+//
+//nolint:gochecknoglobals // Lookup table for the action/resource compatibility matrix
+var kubernetesWorkloadKinds = map[string]struct{}{
+	"deployment": {}, "statefulset": {}, "daemonset": {}, "replicaset": {},
+	"pod": {}, "job": {}, "cronjob": {},
+}
+
+// ValidateActionForResource checks that a recommendation action type is sensible for the
+// target resource, using the compatibility matrix documented above.
+//
+// Returns ErrActionTypeUnspecified or ErrActionTypeUnknown for invalid action types,
+// ErrActionResourceNil for a nil resource, and an error wrapping ErrActionNotApplicable
+// when the action does not apply to the resource type (e.g., RIGHTSIZE on an S3 bucket).
+func ValidateActionForResource(
+	action pbc.RecommendationActionType,
+	resource *pbc.ResourceRecommendationInfo,
+) error {
+	if action == pbc.RecommendationActionType_RECOMMENDATION_ACTION_TYPE_UNSPECIFIED {
+		return ErrActionTypeUnspecified
+	}
+	if _, ok := pbc.RecommendationActionType_name[int32(action)]; !ok {
+		return fmt.Errorf("%w: %d", ErrActionTypeUnknown, action)
+	}
+	if resource == nil {
+		return ErrActionResourceNil
+	}
+
+	kind := resourceKind(resource.GetResourceType())
+
+	var applicable bool
+	//nolint:exhaustive // Only restricted actions are listed; all others apply to any resource.
+	switch action {
+	case pbc.RecommendationActionType_RECOMMENDATION_ACTION_TYPE_RIGHTSIZE:
+		_, applicable = sizeableResourceKinds[kind]
+	case pbc.RecommendationActionType_RECOMMENDATION_ACTION_TYPE_SCHEDULE:
+		_, applicable = schedulableResourceKinds[kind]
+	case pbc.RecommendationActionType_RECOMMENDATION_ACTION_TYPE_ADJUST_REQUESTS:
+		_, applicable = kubernetesWorkloadKinds[kind]
+		applicable = applicable || strings.EqualFold(resource.GetProvider(), "kubernetes")
+	default:
+		applicable = true
+	}
+
+	if !applicable {
+		return fmt.Errorf("%w: %s on %q", ErrActionNotApplicable, action, resource.GetResourceType())
+	}
+	return nil
+}
+
+// resourceKind reduces a resource type to its lowercase final segment.
+func resourceKind(resourceType string) string {
+	if i := strings.LastIndexAny(resourceType, ":/"); i >= 0 {
+		resourceType = resourceType[i+1:]
+	}
+	return strings.ToLower(resourceType)
+}
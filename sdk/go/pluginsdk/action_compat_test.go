@@ -0,0 +1,103 @@
+package pluginsdk_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/rshade/finfocus-spec/sdk/go/pluginsdk"
+	pbc "github.com/rshade/finfocus-spec/sdk/go/proto/finfocus/v1"
+)
+
+func TestValidateActionForResource(t *testing.T) {
+	resource := func(provider, resourceType string) *pbc.ResourceRecommendationInfo {
+		return &pbc.ResourceRecommendationInfo{Id: "r-1", Provider: provider, ResourceType: resourceType}
+	}
+
+	tests := []struct {
+		name     string
+		action   pbc.RecommendationActionType
+		resource *pbc.ResourceRecommendationInfo
+		wantErr  error
+	}{
+		{
+			"rightsize on ec2 passes",
+			pbc.RecommendationActionType_RECOMMENDATION_ACTION_TYPE_RIGHTSIZE,
+			resource("aws", "ec2"), nil,
+		},
+		{
+			"rightsize on Pulumi instance token passes",
+			pbc.RecommendationActionType_RECOMMENDATION_ACTION_TYPE_RIGHTSIZE,
+			resource("aws", "aws:ec2/instance:Instance"), nil,
+		},
+		{
+			"rightsize on Azure VM passes",
+			pbc.RecommendationActionType_RECOMMENDATION_ACTION_TYPE_RIGHTSIZE,
+			resource("azure", "Microsoft.Compute/virtualMachines"), nil,
+		},
+		{
+			"terminate on s3 bucket passes",
+			pbc.RecommendationActionType_RECOMMENDATION_ACTION_TYPE_TERMINATE,
+			resource("aws", "aws:s3/bucket:Bucket"), nil,
+		},
+		{
+			"terminate on unknown resource passes",
+			pbc.RecommendationActionType_RECOMMENDATION_ACTION_TYPE_TERMINATE,
+			resource("custom", "widget"), nil,
+		},
+		{
+			"rightsize on s3 bucket fails",
+			pbc.RecommendationActionType_RECOMMENDATION_ACTION_TYPE_RIGHTSIZE,
+			resource("aws", "aws:s3/bucket:Bucket"), pluginsdk.ErrActionNotApplicable,
+		},
+		{
+			"rightsize on s3 short name fails",
+			pbc.RecommendationActionType_RECOMMENDATION_ACTION_TYPE_RIGHTSIZE,
+			resource("aws", "s3"), pluginsdk.ErrActionNotApplicable,
+		},
+		{
+			"schedule on lambda fails",
+			pbc.RecommendationActionType_RECOMMENDATION_ACTION_TYPE_SCHEDULE,
+			resource("aws", "lambda"), pluginsdk.ErrActionNotApplicable,
+		},
+		{
+			"adjust requests on deployment passes",
+			pbc.RecommendationActionType_RECOMMENDATION_ACTION_TYPE_ADJUST_REQUESTS,
+			resource("kubernetes", "kubernetes:apps/v1:Deployment"), nil,
+		},
+		{
+			"adjust requests on ec2 fails",
+			pbc.RecommendationActionType_RECOMMENDATION_ACTION_TYPE_ADJUST_REQUESTS,
+			resource("aws", "ec2"), pluginsdk.ErrActionNotApplicable,
+		},
+		{
+			"unspecified action fails",
+			pbc.RecommendationActionType_RECOMMENDATION_ACTION_TYPE_UNSPECIFIED,
+			resource("aws", "ec2"), pluginsdk.ErrActionTypeUnspecified,
+		},
+		{
+			"unknown action fails",
+			pbc.RecommendationActionType(999),
+			resource("aws", "ec2"), pluginsdk.ErrActionTypeUnknown,
+		},
+		{
+			"nil resource fails",
+			pbc.RecommendationActionType_RECOMMENDATION_ACTION_TYPE_TERMINATE,
+			nil, pluginsdk.ErrActionResourceNil,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := pluginsdk.ValidateActionForResource(tc.action, tc.resource)
+			if tc.wantErr == nil {
+				if err != nil {
+					t.Errorf("ValidateActionForResource() unexpected error: %v", err)
+				}
+				return
+			}
+			if !errors.Is(err, tc.wantErr) {
+				t.Errorf("ValidateActionForResource() error = %v, want %v", err, tc.wantErr)
+			}
+		})
+	}
+}
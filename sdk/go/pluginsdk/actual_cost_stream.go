@@ -0,0 +1,83 @@
+// Copyright 2024 The FinFocus Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pluginsdk
+
+import (
+	"context"
+
+	"google.golang.org/protobuf/proto"
+
+	pbc "github.com/rshade/finfocus-spec/sdk/go/proto/finfocus/v1"
+)
+
+// ActualCostSendFunc sends one result on a StreamActualCost stream. It returns an
+// error if the stream is closed or the client has gone away.
+type ActualCostSendFunc func(result *pbc.ActualCostResult) error
+
+// ActualCostStreamer is an optional interface that plugins can implement to stream
+// actual cost results natively, e.g. while reading them from a paginated billing API.
+// Plugins that do not implement this interface have StreamActualCost served by
+// StreamActualCostFromUnary, which adapts their GetActualCost handler. This includes
+// plugins embedding BasePlugin, so an overridden GetActualCost is always used.
+type ActualCostStreamer interface {
+	// StreamActualCost calls send for each actual cost result, in order, and returns
+	// when all results have been sent. Errors from send must be returned unchanged.
+	StreamActualCost(ctx context.Context, req *pbc.GetActualCostRequest, send ActualCostSendFunc) error
+}
+
+// StreamActualCostFromUnary serves a StreamActualCost request from the plugin's unary
+// GetActualCost handler. It calls GetActualCost, sends every result, and follows
+// next_page_token until the last page, so paginated plugins stream their full result
+// set. The request's page_size and any initial page_token are preserved.
+//
+// Pagination safety and context cancellation follow ActualCostIterator. Errors from
+// GetActualCost and send are returned unchanged; results sent before the error remain
+// valid.
+//
+// Plugins implementing ActualCostStreamer can call this as a fallback:
+//
+//	func (p *MyPlugin) StreamActualCost(
+//	    ctx context.Context,
+//	    req *pbc.GetActualCostRequest,
+//	    send pluginsdk.ActualCostSendFunc,
+//	) error {
+//	    if !p.supportsExport(req) {
+//	        return pluginsdk.StreamActualCostFromUnary(ctx, p, req, send)
+//	    }
+//	    // Stream from the billing export
+//	}
+func StreamActualCostFromUnary(
+	ctx context.Context,
+	plugin Plugin,
+	req *pbc.GetActualCostRequest,
+	send ActualCostSendFunc,
+) error {
+	fetch := func(ctx context.Context, pageToken string, _ int32) (*pbc.GetActualCostResponse, error) {
+		if pageToken == "" {
+			return plugin.GetActualCost(ctx, req)
+		}
+		page := proto.CloneOf(req)
+		page.PageToken = pageToken
+		return plugin.GetActualCost(ctx, page)
+	}
+
+	iter := NewActualCostIterator(ctx, fetch, req.GetPageSize())
+	for iter.Next() {
+		if err := send(iter.Record()); err != nil {
+			return err
+		}
+	}
+	return iter.Err()
+}
@@ -0,0 +1,246 @@
+// Copyright 2024 The FinFocus Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pluginsdk_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/bufconn"
+
+	"github.com/rshade/finfocus-spec/sdk/go/pluginsdk"
+	pbc "github.com/rshade/finfocus-spec/sdk/go/proto/finfocus/v1"
+)
+
+// pagedCostPlugin embeds BasePlugin and overrides GetActualCost with a paginated handler.
+type pagedCostPlugin struct {
+	*pluginsdk.BasePlugin
+
+	results []*pbc.ActualCostResult
+	calls   int
+}
+
+func newPagedCostPlugin(count int) *pagedCostPlugin {
+	results := make([]*pbc.ActualCostResult, count)
+	for i := range results {
+		results[i] = &pbc.ActualCostResult{Source: fmt.Sprintf("record-%d", i), Cost: float64(i)}
+	}
+	return &pagedCostPlugin{BasePlugin: pluginsdk.NewBasePlugin("paged-cost"), results: results}
+}
+
+func (p *pagedCostPlugin) GetActualCost(
+	_ context.Context,
+	req *pbc.GetActualCostRequest,
+) (*pbc.GetActualCostResponse, error) {
+	p.calls++
+	page, nextToken, total, err := pluginsdk.PaginateActualCosts(p.results, req.GetPageSize(), req.GetPageToken())
+	if err != nil {
+		return nil, err
+	}
+	return &pbc.GetActualCostResponse{Results: page, NextPageToken: nextToken, TotalCount: total}, nil
+}
+
+// streamingCostPlugin implements ActualCostStreamer natively.
+type streamingCostPlugin struct {
+	*pluginsdk.BasePlugin
+}
+
+func (p *streamingCostPlugin) StreamActualCost(
+	_ context.Context,
+	req *pbc.GetActualCostRequest,
+	send pluginsdk.ActualCostSendFunc,
+) error {
+	for _, source := range []string{"native-1", "native-2"} {
+		if err := send(&pbc.ActualCostResult{Source: source + ":" + req.GetResourceId()}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func sources(results []*pbc.ActualCostResult) []string {
+	out := make([]string, len(results))
+	for i, r := range results {
+		out[i] = r.GetSource()
+	}
+	return out
+}
+
+func TestStreamActualCostFromUnary_FollowsPages(t *testing.T) {
+	plugin := newPagedCostPlugin(7)
+	req := &pbc.GetActualCostRequest{ResourceId: "i-abc123", PageSize: 3}
+
+	var got []*pbc.ActualCostResult
+	err := pluginsdk.StreamActualCostFromUnary(context.Background(), plugin, req,
+		func(result *pbc.ActualCostResult) error {
+			got = append(got, result)
+			return nil
+		})
+	require.NoError(t, err)
+
+	assert.Equal(t, sources(plugin.results), sources(got))
+	assert.Equal(t, 3, plugin.calls, "expected one GetActualCost call per page")
+	assert.Empty(t, req.GetPageToken(), "caller's request must not be modified")
+}
+
+func TestStreamActualCostFromUnary_StartsAtPageToken(t *testing.T) {
+	plugin := newPagedCostPlugin(7)
+	req := &pbc.GetActualCostRequest{PageSize: 3, PageToken: pluginsdk.EncodePageToken(5)}
+
+	var got []*pbc.ActualCostResult
+	err := pluginsdk.StreamActualCostFromUnary(context.Background(), plugin, req,
+		func(result *pbc.ActualCostResult) error {
+			got = append(got, result)
+			return nil
+		})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"record-5", "record-6"}, sources(got))
+}
+
+func TestStreamActualCostFromUnary_Errors(t *testing.T) {
+	t.Run("send error stops the stream", func(t *testing.T) {
+		plugin := newPagedCostPlugin(7)
+		sendErr := errors.New("client went away")
+		sent := 0
+		err := pluginsdk.StreamActualCostFromUnary(context.Background(), plugin,
+			&pbc.GetActualCostRequest{PageSize: 3},
+			func(*pbc.ActualCostResult) error {
+				sent++
+				return sendErr
+			})
+		require.ErrorIs(t, err, sendErr)
+		assert.Equal(t, 1, sent)
+		assert.Equal(t, 1, plugin.calls)
+	})
+
+	t.Run("BasePlugin GetActualCost error is returned", func(t *testing.T) {
+		plugin := pluginsdk.NewBasePlugin("no-data")
+		err := pluginsdk.StreamActualCostFromUnary(context.Background(), plugin,
+			&pbc.GetActualCostRequest{ResourceId: "i-abc123"},
+			func(*pbc.ActualCostResult) error {
+				t.Fatal("send should not be called")
+				return nil
+			})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "i-abc123")
+	})
+}
+
+// startStreamServer serves plugin over an in-memory gRPC connection.
+func startStreamServer(t *testing.T, plugin pluginsdk.Plugin) pbc.CostSourceServiceClient {
+	t.Helper()
+	listener := bufconn.Listen(1024 * 1024)
+	server := grpc.NewServer()
+	pbc.RegisterCostSourceServiceServer(server, pluginsdk.NewServer(plugin))
+	go func() { _ = server.Serve(listener) }()
+	t.Cleanup(server.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return listener.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+	return pbc.NewCostSourceServiceClient(conn)
+}
+
+func TestServer_StreamActualCost(t *testing.T) {
+	tests := []struct {
+		name   string
+		plugin pluginsdk.Plugin
+		want   []string
+	}{
+		{
+			name:   "adapts overridden GetActualCost",
+			plugin: newPagedCostPlugin(5),
+			want:   []string{"record-0", "record-1", "record-2", "record-3", "record-4"},
+		},
+		{
+			name:   "uses ActualCostStreamer",
+			plugin: &streamingCostPlugin{BasePlugin: pluginsdk.NewBasePlugin("streaming")},
+			want:   []string{"native-1:i-abc123", "native-2:i-abc123"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := startStreamServer(t, tt.plugin)
+			stream, err := client.StreamActualCost(context.Background(),
+				&pbc.GetActualCostRequest{ResourceId: "i-abc123", PageSize: 2})
+			require.NoError(t, err)
+
+			var got []*pbc.ActualCostResult
+			for {
+				result, recvErr := stream.Recv()
+				if errors.Is(recvErr, io.EOF) {
+					break
+				}
+				require.NoError(t, recvErr)
+				got = append(got, result)
+			}
+			assert.Equal(t, tt.want, sources(got))
+		})
+	}
+}
+
+func TestClient_CollectActualCost(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	plugin := newPagedCostPlugin(5)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- pluginsdk.Serve(ctx, pluginsdk.ServeConfig{
+			Plugin:   plugin,
+			Listener: listener,
+			Web: pluginsdk.WebConfig{
+				Enabled:              true,
+				EnableHealthEndpoint: true,
+			},
+		})
+	}()
+
+	addr := listener.Addr().String()
+	waitForServer(t, addr)
+	client := pluginsdk.NewConnectClient("http://" + addr)
+
+	results, err := client.CollectActualCost(ctx, &pbc.GetActualCostRequest{ResourceId: "i-abc123", PageSize: 2})
+	require.NoError(t, err)
+	assert.Equal(t, sources(plugin.results), sources(results))
+
+	_, err = client.CollectActualCost(ctx, nil)
+	require.Error(t, err)
+
+	cancel()
+	select {
+	case <-errCh:
+	case <-time.After(time.Second):
+		t.Fatal("server did not shut down in time")
+	}
+}
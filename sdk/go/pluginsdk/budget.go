@@ -0,0 +1,40 @@
+package pluginsdk
+
+import (
+	"github.com/rshade/finfocus-spec/sdk/go/internal/budget"
+	pbc "github.com/rshade/finfocus-spec/sdk/go/proto/finfocus/v1"
+)
+
+// ApplyBudgetFilter returns the budgets matching every criterion set in filter, preserving
+// order. A nil filter returns budgets unchanged. Values within a repeated field are ORed and
+// fields are ANDed:
+//   - providers: the budget's source contains one of the providers (e.g., "aws" matches "aws-budgets")
+//   - regions, resource_types: the budget's scope (Budget.filter) includes one of the values;
+//     a budget whose scope does not restrict that dimension matches
+//   - tags: the budget's scope has every tag with the same value
+//   - periods: the budget's period is one of the periods
+//   - health_statuses: the budget's health is one of the statuses, where a budget without a
+//     status or with an unspecified health counts as OK
+//
+// Example:
+//
+//	func (p *MyPlugin) GetBudgets(
+//	    ctx context.Context,
+//	    req *pbc.GetBudgetsRequest,
+//	) (*pbc.GetBudgetsResponse, error) {
+//	    budgets := pluginsdk.ApplyBudgetFilter(p.fetchBudgets(ctx), req.GetFilter())
+//	    return &pbc.GetBudgetsResponse{
+//	        Budgets: budgets,
+//	        Summary: pluginsdk.CalculateBudgetSummary(budgets),
+//	    }, nil
+//	}
+func ApplyBudgetFilter(budgets []*pbc.Budget, filter *pbc.BudgetFilter) []*pbc.Budget {
+	return budget.Filter(budgets, filter)
+}
+
+// CalculateBudgetSummary counts budgets by health status. Budgets without a status or with
+// an unspecified health count as OK, so the OK, warning, critical, and exceeded counts always
+// sum to TotalBudgets.
+func CalculateBudgetSummary(budgets []*pbc.Budget) *pbc.BudgetSummary {
+	return budget.Summarize(budgets)
+}
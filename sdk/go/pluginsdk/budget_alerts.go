@@ -0,0 +1,109 @@
+package pluginsdk
+
+import (
+	"math"
+	"slices"
+
+	pbc "github.com/rshade/finfocus-spec/sdk/go/proto/finfocus/v1"
+)
+
+// BudgetAlertSeverity represents how urgently a budget alert needs attention.
+type BudgetAlertSeverity string
+
+const (
+	// BudgetAlertSeverityInfo indicates forecasted spend will cross a threshold below the limit.
+	BudgetAlertSeverityInfo BudgetAlertSeverity = "info"
+	// BudgetAlertSeverityWarning indicates actual spend crossed a threshold below the limit, or
+	// forecasted spend will reach the limit.
+	BudgetAlertSeverityWarning BudgetAlertSeverity = "warning"
+	// BudgetAlertSeverityCritical indicates actual spend reached the limit.
+	BudgetAlertSeverityCritical BudgetAlertSeverity = "critical"
+)
+
+// budgetLimitPercent is the threshold percentage at which a budget's limit is reached.
+const budgetLimitPercent = 100.0
+
+// BudgetAlert describes a budget threshold breached by actual or forecasted spend.
+type BudgetAlert struct {
+	// Threshold is the breached threshold as a percentage of the budget limit (e.g., 80).
+	Threshold float64
+	// Type is THRESHOLD_TYPE_ACTUAL for current spend or THRESHOLD_TYPE_FORECASTED for
+	// forecasted spend.
+	Type pbc.ThresholdType
+	// Percentage is the actual or forecasted utilization that breached Threshold.
+	Percentage float64
+	// Severity is how urgently the alert needs attention.
+	Severity BudgetAlertSeverity
+}
+
+// EvaluateBudgetAlerts returns the thresholds (percentages of the budget limit, e.g. 80 and
+// 100) that the budget's current and forecasted spend reach, ordered by threshold with actual
+// alerts first.
+//
+// Severity depends on what breached the threshold:
+//
+//	              below 100%   100% or more
+//	actual        warning      critical
+//	forecasted    info         warning
+//
+// A threshold already breached by actual spend gets no forecasted alert. Utilization is taken
+// from the budget status's spend and the budget amount's limit, falling back to the status's
+// percentage_used and percentage_forecasted when the limit is not positive. Forecasts are only
+// evaluated when set. Returns nil if the budget has no status; non-positive or non-finite
+// thresholds are ignored.
+//
+// Example:
+//
+//	for _, alert := range pluginsdk.EvaluateBudgetAlerts(budget, []float64{50, 80, 100}) {
+//	    notify(budget.GetName(), alert.Severity, alert.Threshold, alert.Percentage)
+//	}
+func EvaluateBudgetAlerts(budget *pbc.Budget, thresholds []float64) []BudgetAlert {
+	st := budget.GetStatus()
+	if st == nil {
+		return nil
+	}
+
+	sorted := slices.DeleteFunc(slices.Clone(thresholds), func(t float64) bool {
+		return t <= 0 || math.IsNaN(t) || math.IsInf(t, 0)
+	})
+	slices.Sort(sorted)
+	sorted = slices.Compact(sorted)
+
+	limit := budget.GetAmount().GetLimit()
+	used, forecasted := st.GetPercentageUsed(), st.GetPercentageForecasted()
+	if limit > 0 {
+		used = st.GetCurrentSpend() * budgetLimitPercent / limit
+		forecasted = st.GetForecastedSpend() * budgetLimitPercent / limit
+	}
+
+	var alerts []BudgetAlert
+	for _, threshold := range sorted {
+		if used >= threshold {
+			alerts = append(alerts, BudgetAlert{
+				Threshold:  threshold,
+				Type:       pbc.ThresholdType_THRESHOLD_TYPE_ACTUAL,
+				Percentage: used,
+				Severity:   budgetAlertSeverity(threshold, BudgetAlertSeverityWarning, BudgetAlertSeverityCritical),
+			})
+		}
+	}
+	for _, threshold := range sorted {
+		if used < threshold && forecasted >= threshold {
+			alerts = append(alerts, BudgetAlert{
+				Threshold:  threshold,
+				Type:       pbc.ThresholdType_THRESHOLD_TYPE_FORECASTED,
+				Percentage: forecasted,
+				Severity:   budgetAlertSeverity(threshold, BudgetAlertSeverityInfo, BudgetAlertSeverityWarning),
+			})
+		}
+	}
+	return alerts
+}
+
+// budgetAlertSeverity returns belowLimit for thresholds under 100% and atLimit otherwise.
+func budgetAlertSeverity(threshold float64, belowLimit, atLimit BudgetAlertSeverity) BudgetAlertSeverity {
+	if threshold >= budgetLimitPercent {
+		return atLimit
+	}
+	return belowLimit
+}
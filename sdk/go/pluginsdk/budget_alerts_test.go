@@ -0,0 +1,94 @@
+package pluginsdk_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/rshade/finfocus-spec/sdk/go/pluginsdk"
+	pbc "github.com/rshade/finfocus-spec/sdk/go/proto/finfocus/v1"
+)
+
+func alertBudget(limit, current, forecasted float64) *pbc.Budget {
+	return &pbc.Budget{
+		Id:     "monthly",
+		Amount: &pbc.BudgetAmount{Limit: limit, Currency: "USD"},
+		Status: &pbc.BudgetStatus{CurrentSpend: current, ForecastedSpend: forecasted, Currency: "USD"},
+	}
+}
+
+func TestEvaluateBudgetAlerts(t *testing.T) {
+	actual := pbc.ThresholdType_THRESHOLD_TYPE_ACTUAL
+	forecast := pbc.ThresholdType_THRESHOLD_TYPE_FORECASTED
+
+	tests := []struct {
+		name   string
+		budget *pbc.Budget
+		want   []pluginsdk.BudgetAlert
+	}{
+		{
+			name:   "under every threshold",
+			budget: alertBudget(1000, 100, 300),
+			want:   nil,
+		},
+		{
+			name:   "actual warning, forecast reaches limit",
+			budget: alertBudget(1000, 850, 1100),
+			want: []pluginsdk.BudgetAlert{
+				{Threshold: 80, Type: actual, Percentage: 85, Severity: pluginsdk.BudgetAlertSeverityWarning},
+				{Threshold: 100, Type: forecast, Percentage: 110, Severity: pluginsdk.BudgetAlertSeverityWarning},
+			},
+		},
+		{
+			name:   "forecast below limit is info",
+			budget: alertBudget(1000, 500, 900),
+			want: []pluginsdk.BudgetAlert{
+				{Threshold: 80, Type: forecast, Percentage: 90, Severity: pluginsdk.BudgetAlertSeverityInfo},
+			},
+		},
+		{
+			name:   "actual over limit is critical and suppresses forecasts",
+			budget: alertBudget(1000, 1200, 1500),
+			want: []pluginsdk.BudgetAlert{
+				{Threshold: 80, Type: actual, Percentage: 120, Severity: pluginsdk.BudgetAlertSeverityWarning},
+				{Threshold: 100, Type: actual, Percentage: 120, Severity: pluginsdk.BudgetAlertSeverityCritical},
+			},
+		},
+		{
+			name: "percentages used without a limit",
+			budget: &pbc.Budget{Status: &pbc.BudgetStatus{
+				PercentageUsed:       100,
+				PercentageForecasted: 130,
+			}},
+			want: []pluginsdk.BudgetAlert{
+				{Threshold: 80, Type: actual, Percentage: 100, Severity: pluginsdk.BudgetAlertSeverityWarning},
+				{Threshold: 100, Type: actual, Percentage: 100, Severity: pluginsdk.BudgetAlertSeverityCritical},
+			},
+		},
+		{
+			name:   "no status",
+			budget: &pbc.Budget{Amount: &pbc.BudgetAmount{Limit: 1000}},
+			want:   nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Unsorted, duplicated, and invalid thresholds are tolerated.
+			thresholds := []float64{100, 80, 0, -5, math.NaN(), 80}
+			assert.Equal(t, tt.want, pluginsdk.EvaluateBudgetAlerts(tt.budget, thresholds))
+		})
+	}
+}
+
+func TestEvaluateBudgetAlerts_NoThresholds(t *testing.T) {
+	budget := alertBudget(1000, 2000, 3000)
+	assert.Empty(t, pluginsdk.EvaluateBudgetAlerts(budget, nil))
+	assert.Nil(t, pluginsdk.EvaluateBudgetAlerts(nil, []float64{100}))
+
+	alerts := pluginsdk.EvaluateBudgetAlerts(budget, []float64{150})
+	require.Len(t, alerts, 1)
+	assert.Equal(t, pluginsdk.BudgetAlertSeverityCritical, alerts[0].Severity)
+}
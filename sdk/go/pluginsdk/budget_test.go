@@ -0,0 +1,49 @@
+package pluginsdk_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/rshade/finfocus-spec/sdk/go/pluginsdk"
+	pbc "github.com/rshade/finfocus-spec/sdk/go/proto/finfocus/v1"
+)
+
+func TestApplyBudgetFilterAndSummary(t *testing.T) {
+	budgets := []*pbc.Budget{
+		{
+			Id:     "aws-monthly",
+			Source: "aws-budgets",
+			Period: pbc.BudgetPeriod_BUDGET_PERIOD_MONTHLY,
+			Status: &pbc.BudgetStatus{Health: pbc.BudgetHealthStatus_BUDGET_HEALTH_STATUS_EXCEEDED},
+		},
+		{
+			Id:     "aws-annual",
+			Source: "aws-budgets",
+			Period: pbc.BudgetPeriod_BUDGET_PERIOD_ANNUALLY,
+		},
+		{
+			Id:     "kubecost-monthly",
+			Source: "kubecost",
+			Period: pbc.BudgetPeriod_BUDGET_PERIOD_MONTHLY,
+			Status: &pbc.BudgetStatus{Health: pbc.BudgetHealthStatus_BUDGET_HEALTH_STATUS_WARNING},
+		},
+	}
+
+	assert.Equal(t, budgets, pluginsdk.ApplyBudgetFilter(budgets, nil))
+
+	filtered := pluginsdk.ApplyBudgetFilter(budgets, &pbc.BudgetFilter{
+		Providers: []string{"aws"},
+		Periods:   []pbc.BudgetPeriod{pbc.BudgetPeriod_BUDGET_PERIOD_MONTHLY},
+	})
+	require.Len(t, filtered, 1)
+	assert.Equal(t, "aws-monthly", filtered[0].GetId())
+
+	summary := pluginsdk.CalculateBudgetSummary(budgets)
+	assert.Equal(t, int32(3), summary.GetTotalBudgets())
+	assert.Equal(t, int32(1), summary.GetBudgetsOk(), "a budget without status counts as OK")
+	assert.Equal(t, int32(1), summary.GetBudgetsWarning())
+	assert.Equal(t, int32(0), summary.GetBudgetsCritical())
+	assert.Equal(t, int32(1), summary.GetBudgetsExceeded())
+}
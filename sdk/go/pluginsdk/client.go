@@ -6,6 +6,7 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"slices"
 	"time"
 
 	"connectrpc.com/connect"
@@ -303,6 +304,29 @@ func (c *Client) Supports(ctx context.Context, resource *pbc.ResourceDescriptor)
 	return resp.Msg, nil
 }
 
+// SupportsBatch checks many resources, sending them in requests of at most
+// MaxSupportsBatchSize resources so a whole stack can be checked in one call.
+// Results are aligned with resources: results[i] is the answer for resources[i].
+func (c *Client) SupportsBatch(
+	ctx context.Context,
+	resources []*pbc.ResourceDescriptor,
+) ([]*pbc.SupportsBatchResult, error) {
+	results := make([]*pbc.SupportsBatchResult, 0, len(resources))
+	for chunk := range slices.Chunk(resources, MaxSupportsBatchSize) {
+		resp, err := c.inner.SupportsBatch(ctx, connect.NewRequest(&pbc.SupportsBatchRequest{
+			Resources: chunk,
+		}))
+		if err != nil {
+			return nil, wrapRPCError(ctx, "SupportsBatch", err)
+		}
+		if got := len(resp.Msg.GetResults()); got != len(chunk) {
+			return nil, fmt.Errorf("SupportsBatch RPC returned %d results for %d resources", got, len(chunk))
+		}
+		results = append(results, resp.Msg.GetResults()...)
+	}
+	return results, nil
+}
+
 // SupportsResourceType is a convenience method to check support by resource type string.
 func (c *Client) SupportsResourceType(ctx context.Context, resourceType string) (bool, error) {
 	resp, err := c.Supports(ctx, &pbc.ResourceDescriptor{
@@ -314,6 +338,15 @@ func (c *Client) SupportsResourceType(ctx context.Context, resourceType string)
 	return resp.GetSupported(), nil
 }
 
+// GetSupportedResources lists the provider and resource type pairs the plugin can price.
+func (c *Client) GetSupportedResources(ctx context.Context) ([]*pbc.SupportedResource, error) {
+	resp, err := c.inner.GetSupportedResources(ctx, connect.NewRequest(&pbc.GetSupportedResourcesRequest{}))
+	if err != nil {
+		return nil, wrapRPCError(ctx, "GetSupportedResources", err)
+	}
+	return resp.Msg.GetResources(), nil
+}
+
 // EstimateCost returns an estimated monthly cost for a resource.
 func (c *Client) EstimateCost(ctx context.Context, req *pbc.EstimateCostRequest) (*pbc.EstimateCostResponse, error) {
 	if req == nil {
@@ -338,6 +371,36 @@ func (c *Client) GetActualCost(ctx context.Context, req *pbc.GetActualCostReques
 	return resp.Msg, nil
 }
 
+// CollectActualCost calls the StreamActualCost RPC and collects the streamed results
+// into a slice, for callers that want the GetActualCost result shape. Results are
+// returned in stream order. If the stream fails part-way, the results received so far
+// are discarded and the error is returned.
+//
+// Callers processing large time ranges should consume the stream incrementally via the
+// underlying pbcconnect client instead.
+func (c *Client) CollectActualCost(
+	ctx context.Context,
+	req *pbc.GetActualCostRequest,
+) ([]*pbc.ActualCostResult, error) {
+	if req == nil {
+		return nil, errors.New("request cannot be nil")
+	}
+	stream, err := c.inner.StreamActualCost(ctx, connect.NewRequest(req))
+	if err != nil {
+		return nil, wrapRPCError(ctx, "StreamActualCost", err)
+	}
+	defer stream.Close()
+
+	var results []*pbc.ActualCostResult
+	for stream.Receive() {
+		results = append(results, stream.Msg())
+	}
+	if err = stream.Err(); err != nil {
+		return nil, wrapRPCError(ctx, "StreamActualCost", err)
+	}
+	return results, nil
+}
+
 // GetProjectedCost calculates projected cost information for a resource.
 func (c *Client) GetProjectedCost(
 	ctx context.Context,
@@ -353,6 +416,23 @@ func (c *Client) GetProjectedCost(
 	return resp.Msg, nil
 }
 
+// GetProjectedCostBatch calculates projected costs for many resources in one call.
+// Results are aligned with req.Resources; a resource that could not be projected has
+// its Error set instead of failing the batch (see pricing.FromProtoErrorDetail).
+func (c *Client) GetProjectedCostBatch(
+	ctx context.Context,
+	req *pbc.BatchProjectedCostRequest,
+) (*pbc.BatchProjectedCostResponse, error) {
+	if req == nil {
+		return nil, errors.New("request cannot be nil")
+	}
+	resp, err := c.inner.GetProjectedCostBatch(ctx, connect.NewRequest(req))
+	if err != nil {
+		return nil, wrapRPCError(ctx, "GetProjectedCostBatch", err)
+	}
+	return resp.Msg, nil
+}
+
 // GetPricingSpec returns detailed pricing specification for a resource type.
 func (c *Client) GetPricingSpec(
 	ctx context.Context,
@@ -52,6 +52,18 @@ func (h *ConnectHandler) Supports(
 	return connect.NewResponse(resp), nil
 }
 
+// GetSupportedResources implements pbcconnect.CostSourceServiceHandler.
+func (h *ConnectHandler) GetSupportedResources(
+	ctx context.Context,
+	req *connect.Request[pbc.GetSupportedResourcesRequest],
+) (*connect.Response[pbc.SupportedResourcesResponse], error) {
+	resp, err := h.server.GetSupportedResources(ctx, req.Msg)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(resp), nil
+}
+
 // GetActualCost implements pbcconnect.CostSourceServiceHandler.
 func (h *ConnectHandler) GetActualCost(
 	ctx context.Context,
@@ -76,6 +88,30 @@ func (h *ConnectHandler) GetProjectedCost(
 	return connect.NewResponse(resp), nil
 }
 
+// GetProjectedCostBatch implements pbcconnect.CostSourceServiceHandler.
+func (h *ConnectHandler) GetProjectedCostBatch(
+	ctx context.Context,
+	req *connect.Request[pbc.BatchProjectedCostRequest],
+) (*connect.Response[pbc.BatchProjectedCostResponse], error) {
+	resp, err := h.server.GetProjectedCostBatch(ctx, req.Msg)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(resp), nil
+}
+
+// SupportsBatch implements pbcconnect.CostSourceServiceHandler.
+func (h *ConnectHandler) SupportsBatch(
+	ctx context.Context,
+	req *connect.Request[pbc.SupportsBatchRequest],
+) (*connect.Response[pbc.SupportsBatchResponse], error) {
+	resp, err := h.server.SupportsBatch(ctx, req.Msg)
+	if err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(resp), nil
+}
+
 // GetPricingSpec implements pbcconnect.CostSourceServiceHandler.
 func (h *ConnectHandler) GetPricingSpec(
 	ctx context.Context,
@@ -147,3 +183,12 @@ func (h *ConnectHandler) GetPluginInfo(
 	}
 	return connect.NewResponse(resp), nil
 }
+
+// StreamActualCost implements pbcconnect.CostSourceServiceHandler.
+func (h *ConnectHandler) StreamActualCost(
+	ctx context.Context,
+	req *connect.Request[pbc.GetActualCostRequest],
+	stream *connect.ServerStream[pbc.ActualCostResult],
+) error {
+	return h.server.streamActualCost(ctx, req.Msg, stream.Send)
+}
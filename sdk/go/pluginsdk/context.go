@@ -50,3 +50,53 @@ func ContextRemainingTime(ctx context.Context) time.Duration {
 	}
 	return time.Until(deadline)
 }
+
+// CollectUntilCancelled repeatedly calls source and collects its results until the source
+// is exhausted or ctx is done. It is intended for long batch operations that should return
+// the work completed so far when a caller cancels or a deadline expires.
+//
+// The source function returns (value, ok, err):
+//   - ok == true: value is appended and collection continues
+//   - ok == false with err == nil: the source is exhausted and collection stops
+//   - err != nil: collection aborts and err is returned
+//
+// The context is checked before each call to source. On cancellation, the results collected
+// so far are returned together with ctx.Err(), so callers can use errors.Is with
+// context.Canceled or context.DeadlineExceeded. When the source fails, the results
+// collected before the failure are returned together with the source error.
+// A nil context returns ErrNilContext without calling source.
+//
+// Example:
+//
+//	results, err := pluginsdk.CollectUntilCancelled(ctx, func() (*pbc.CostResult, bool, error) {
+//	    if i >= len(resources) {
+//	        return nil, false, nil
+//	    }
+//	    r, err := estimate(resources[i])
+//	    i++
+//	    return r, err == nil, err
+//	})
+//	if errors.Is(err, context.DeadlineExceeded) {
+//	    // results holds the estimates completed before the deadline
+//	}
+func CollectUntilCancelled[T any](ctx context.Context, source func() (T, bool, error)) ([]T, error) {
+	if ctx == nil {
+		return nil, ErrNilContext
+	}
+
+	var results []T
+	for {
+		if err := ctx.Err(); err != nil {
+			return results, err
+		}
+
+		value, ok, err := source()
+		if err != nil {
+			return results, err
+		}
+		if !ok {
+			return results, nil
+		}
+		results = append(results, value)
+	}
+}
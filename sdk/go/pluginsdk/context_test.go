@@ -2,6 +2,7 @@ package pluginsdk_test
 
 import (
 	"context"
+	"errors"
 	"math"
 	"testing"
 	"time"
@@ -156,3 +157,99 @@ func TestContextRemainingTime_ShortDeadline(t *testing.T) {
 		t.Errorf("expected zero duration after deadline expiry, got %v", gotAfterExpiry)
 	}
 }
+
+// countingSource returns a source that yields 0..limit-1 and calls onYield after each value.
+func countingSource(limit int, onYield func(n int)) func() (int, bool, error) {
+	next := 0
+	return func() (int, bool, error) {
+		if next >= limit {
+			return 0, false, nil
+		}
+		n := next
+		next++
+		if onYield != nil {
+			onYield(n)
+		}
+		return n, true, nil
+	}
+}
+
+func TestCollectUntilCancelled_FullCollection(t *testing.T) {
+	results, err := pluginsdk.CollectUntilCancelled(context.Background(), countingSource(5, nil))
+	if err != nil {
+		t.Fatalf("expected nil error, got %v", err)
+	}
+	if len(results) != 5 {
+		t.Fatalf("expected 5 results, got %d", len(results))
+	}
+	for i, r := range results {
+		if r != i {
+			t.Errorf("results[%d] = %d, want %d", i, r, i)
+		}
+	}
+}
+
+func TestCollectUntilCancelled_PartialOnCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	results, err := pluginsdk.CollectUntilCancelled(ctx, countingSource(100, func(n int) {
+		if n == 2 {
+			cancel()
+		}
+	}))
+	if !errors.Is(err, context.Canceled) || !errors.Is(err, ctx.Err()) {
+		t.Fatalf("expected ctx.Err() (context.Canceled), got %v", err)
+	}
+	if len(results) != 3 {
+		t.Errorf("expected 3 partial results, got %d: %v", len(results), results)
+	}
+}
+
+func TestCollectUntilCancelled_SourceError(t *testing.T) {
+	errSource := errors.New("upstream failure")
+	calls := 0
+	source := func() (int, bool, error) {
+		calls++
+		if calls == 3 {
+			return 0, false, errSource
+		}
+		return calls, true, nil
+	}
+
+	results, err := pluginsdk.CollectUntilCancelled(context.Background(), source)
+	if !errors.Is(err, errSource) {
+		t.Fatalf("expected source error, got %v", err)
+	}
+	if len(results) != 2 {
+		t.Errorf("expected 2 results before the error, got %d", len(results))
+	}
+	if calls != 3 {
+		t.Errorf("expected source to stop after the error, got %d calls", calls)
+	}
+}
+
+func TestCollectUntilCancelled_AlreadyCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	called := false
+	results, err := pluginsdk.CollectUntilCancelled(ctx, func() (int, bool, error) {
+		called = true
+		return 1, true, nil
+	})
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected context.Canceled, got %v", err)
+	}
+	if called || len(results) != 0 {
+		t.Errorf("expected source not to be called, called=%v results=%v", called, results)
+	}
+}
+
+func TestCollectUntilCancelled_NilContext(t *testing.T) {
+	//nolint:staticcheck // SA1012: intentionally passing nil context to test validation.
+	_, err := pluginsdk.CollectUntilCancelled[int](nil, countingSource(1, nil))
+	if !errors.Is(err, pluginsdk.ErrNilContext) {
+		t.Fatalf("expected ErrNilContext, got %v", err)
+	}
+}
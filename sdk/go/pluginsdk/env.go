@@ -24,7 +24,7 @@ const (
 	EnvLogLevelFallback   = "LOG_LEVEL"
 
 	// EnvLogFormat is the environment variable for log output format.
-	// Supported values: json, text (plugin-specific validation).
+	// Supported values: json, console (or text); see NewPluginLoggerWithFormat.
 	// Fallback chain: FINFOCUS_LOG_FORMAT -> PULUMICOST_LOG_FORMAT.
 	EnvLogFormat         = "FINFOCUS_LOG_FORMAT"
 	EnvLogFormatFallback = "PULUMICOST_LOG_FORMAT"
@@ -6,32 +6,44 @@ import (
 	"errors"
 	"fmt"
 	"math"
+	"slices"
 	"sort"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/rs/zerolog/log"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 
 	"github.com/rshade/finfocus-spec/sdk/go/currency"
+	"github.com/rshade/finfocus-spec/sdk/go/pricing"
 	pbc "github.com/rshade/finfocus-spec/sdk/go/proto/finfocus/v1"
 )
 
 // HoursPerMonth is the standard number of hours used for monthly cost calculations.
-// This value (730) represents the average number of hours in a month (365.25 days / 12 months * 24 hours).
-const HoursPerMonth = 730.0
+// This value (730) represents the average number of hours in a month (365 days / 12 months * 24 hours).
+// It aliases pricing.HoursPerMonth; use pricing.ProjectMonthlyCost for other billing modes.
+const HoursPerMonth = pricing.HoursPerMonth
 
 // HoursPerDay is the number of hours in a day for time calculations.
 const HoursPerDay = 24
 
+// defaultProjectionPeriod is the projection period servers apply when none is requested.
+const defaultProjectionPeriod = "monthly"
+
 // ResourceMatcher helps plugins determine if they support a resource.
 //
-// Thread Safety: ResourceMatcher is NOT safe for concurrent use. All calls to
-// AddProvider and AddResourceType must complete before the plugin begins serving
-// gRPC requests. Typical usage is to configure the matcher during plugin
-// initialization, before calling Serve().
+// Thread Safety: ResourceMatcher is NOT safe for concurrent use. All calls to AddProvider,
+// AddResourceType, AddResourceTypePattern, AddRegion, and AddSKUPattern must complete before
+// the plugin begins serving gRPC requests. Typical usage is to configure the matcher during
+// plugin initialization, before calling Serve().
 type ResourceMatcher struct {
 	supportedProviders map[string]bool
 	supportedTypes     map[string]bool
+	typePatterns       []string
+	supportedRegions   map[string]bool
+	skuPatterns        []string
 }
 
 // NewResourceMatcher creates a ResourceMatcher with initialized empty maps for supported providers and supported resource types.
@@ -39,6 +51,7 @@ func NewResourceMatcher() *ResourceMatcher {
 	return &ResourceMatcher{
 		supportedProviders: make(map[string]bool),
 		supportedTypes:     make(map[string]bool),
+		supportedRegions:   make(map[string]bool),
 	}
 }
 
@@ -60,47 +73,282 @@ func (rm *ResourceMatcher) AddResourceType(resourceType string) {
 	rm.supportedTypes[resourceType] = true
 }
 
+// AddResourceTypePattern adds a supported resource type pattern, in which each "*" matches any
+// sequence of characters (e.g., "aws:ec2/*" for every EC2 resource type, or "aws:*:Instance").
+// A pattern without "*" is added as an exact resource type. Empty strings are ignored.
+//
+// Example:
+//
+//	matcher.AddResourceTypePattern("aws:ec2/*") // aws:ec2/instance:Instance, aws:ec2/volume:Volume, ...
+func (rm *ResourceMatcher) AddResourceTypePattern(pattern string) {
+	switch {
+	case pattern == "":
+		return
+	case !strings.Contains(pattern, "*"):
+		rm.AddResourceType(pattern)
+	case !slices.Contains(rm.typePatterns, pattern):
+		rm.typePatterns = append(rm.typePatterns, pattern)
+	}
+}
+
+// AddRegion adds a supported region (e.g., "us-east-1"). Once a region is added, resources in
+// any other region, or with no region, are not supported. Empty strings are ignored.
+func (rm *ResourceMatcher) AddRegion(region string) {
+	if region == "" {
+		return
+	}
+	rm.supportedRegions[region] = true
+}
+
+// AddSKUPattern adds a supported SKU pattern, in which each "*" matches any sequence of
+// characters (e.g., "t3.*" for every t3 instance size). A pattern without "*" matches the SKU
+// exactly. Once a pattern is added, resources whose SKU matches no pattern, or with no SKU,
+// are not supported. Empty strings are ignored.
+func (rm *ResourceMatcher) AddSKUPattern(pattern string) {
+	if pattern == "" || slices.Contains(rm.skuPatterns, pattern) {
+		return
+	}
+	rm.skuPatterns = append(rm.skuPatterns, pattern)
+}
+
 // Supports checks if a resource is supported by this plugin.
+//
+// A resource type is supported if it was added with AddResourceType or matches a pattern added
+// with AddResourceTypePattern; exact types are checked first. A matcher with no providers, no
+// resource types, no regions, or no SKU patterns accepts any value for that field.
 func (rm *ResourceMatcher) Supports(resource *pbc.ResourceDescriptor) bool {
-	if rm == nil || resource == nil {
-		return false
+	supported, _ := rm.SupportsWithReason(resource)
+	return supported
+}
+
+// SupportsWithReason is Supports, but also returns a reason suitable for
+// SupportsResponse.reason when the resource is not supported (empty when it is), naming the
+// provider, resource type, region, or SKU that was rejected.
+//
+// Example:
+//
+//	supported, reason := matcher.SupportsWithReason(req.GetResource())
+//	return &pbc.SupportsResponse{Supported: supported, Reason: reason}, nil
+func (rm *ResourceMatcher) SupportsWithReason(resource *pbc.ResourceDescriptor) (bool, string) {
+	if rm == nil {
+		return false, "no resource matcher is configured"
+	}
+	if resource == nil {
+		return false, "resource is required"
 	}
 
-	if len(rm.supportedProviders) > 0 {
-		if !rm.supportedProviders[resource.GetProvider()] {
-			return false
+	if len(rm.supportedProviders) > 0 && !rm.supportedProviders[resource.GetProvider()] {
+		return false, fmt.Sprintf("provider %q is not supported", resource.GetProvider())
+	}
+
+	if len(rm.supportedTypes) > 0 || len(rm.typePatterns) > 0 {
+		if !rm.supportsResourceType(resource.GetResourceType()) {
+			return false, fmt.Sprintf("resource type %q is not supported", resource.GetResourceType())
+		}
+	}
+
+	if len(rm.supportedRegions) > 0 && !rm.supportedRegions[resource.GetRegion()] {
+		return false, fmt.Sprintf("region %q is not supported", resource.GetRegion())
+	}
+
+	if len(rm.skuPatterns) > 0 && !slices.ContainsFunc(rm.skuPatterns, func(pattern string) bool {
+		return matchWildcard(pattern, resource.GetSku())
+	}) {
+		return false, fmt.Sprintf("SKU %q is not supported", resource.GetSku())
+	}
+
+	return true, ""
+}
+
+// isEmpty reports whether nothing has been added to the matcher.
+func (rm *ResourceMatcher) isEmpty() bool {
+	return rm == nil || (len(rm.supportedProviders) == 0 && len(rm.supportedTypes) == 0 &&
+		len(rm.typePatterns) == 0 && len(rm.supportedRegions) == 0 && len(rm.skuPatterns) == 0)
+}
+
+// supportsResourceType reports whether resourceType is an added type or matches an added pattern.
+func (rm *ResourceMatcher) supportsResourceType(resourceType string) bool {
+	if rm.supportedTypes[resourceType] {
+		return true
+	}
+	for _, pattern := range rm.typePatterns {
+		if matchWildcard(pattern, resourceType) {
+			return true
 		}
 	}
+	return false
+}
 
-	if len(rm.supportedTypes) > 0 {
-		if !rm.supportedTypes[resource.GetResourceType()] {
+// matchWildcard reports whether s matches pattern, where each "*" in pattern matches any
+// sequence of characters, including "/" and ":".
+func matchWildcard(pattern, s string) bool {
+	parts := strings.Split(pattern, "*")
+	if len(parts) == 1 {
+		return pattern == s
+	}
+	if !strings.HasPrefix(s, parts[0]) {
+		return false
+	}
+	s = s[len(parts[0]):]
+	for _, part := range parts[1 : len(parts)-1] {
+		i := strings.Index(s, part)
+		if i < 0 {
 			return false
 		}
+		s = s[i+len(part):]
 	}
+	return strings.HasSuffix(s, parts[len(parts)-1])
+}
 
-	return true
+// SupportedResources lists the provider and resource type pairs this matcher accepts,
+// sorted by provider, then resource type. It backs the default GetSupportedResources RPC.
+//
+// The provider of a resource type token (e.g., "aws" for "aws:ec2:Instance") comes from the
+// token itself; tokens of a provider that is not registered are omitted when providers are
+// restricted. Other resource types are listed once per registered provider, or with an empty
+// provider if none are registered. With no resource types or patterns registered, each provider
+// is listed with an empty resource type, meaning all of its types are accepted. Resource type
+// patterns cannot be enumerated and are not listed.
+func (rm *ResourceMatcher) SupportedResources() []*pbc.SupportedResource {
+	if rm == nil {
+		return nil
+	}
+
+	providers := make([]string, 0, len(rm.supportedProviders))
+	for provider := range rm.supportedProviders {
+		providers = append(providers, provider)
+	}
+
+	var resources []*pbc.SupportedResource
+	if len(rm.supportedTypes) == 0 && len(rm.typePatterns) == 0 {
+		for _, provider := range providers {
+			resources = append(resources, &pbc.SupportedResource{Provider: provider})
+		}
+	}
+	for resourceType := range rm.supportedTypes {
+		if provider, _, _, err := ParseResourceType(resourceType); err == nil {
+			if len(providers) == 0 || rm.supportedProviders[provider] {
+				resources = append(resources, &pbc.SupportedResource{Provider: provider, ResourceType: resourceType})
+			}
+			continue
+		}
+		if len(providers) == 0 {
+			resources = append(resources, &pbc.SupportedResource{ResourceType: resourceType})
+		}
+		for _, provider := range providers {
+			resources = append(resources, &pbc.SupportedResource{Provider: provider, ResourceType: resourceType})
+		}
+	}
+
+	sort.Slice(resources, func(i, j int) bool {
+		if resources[i].GetProvider() != resources[j].GetProvider() {
+			return resources[i].GetProvider() < resources[j].GetProvider()
+		}
+		return resources[i].GetResourceType() < resources[j].GetResourceType()
+	})
+	return resources
 }
 
+// ErrHoursPerMonthInvalid is returned by NewCostCalculatorWithHours for a non-positive or
+// non-finite hours-per-month value.
+var ErrHoursPerMonthInvalid = errors.New("hours per month must be a positive, finite number")
+
 // CostCalculator provides utilities for cost calculations.
-type CostCalculator struct{}
+//
+// The zero value uses HoursPerMonth (730); use NewCostCalculatorWithHours for providers that
+// bill by a different monthly-hour convention.
+type CostCalculator struct {
+	// hoursPerMonth is the configured hours per month; 0 means HoursPerMonth.
+	hoursPerMonth float64
+}
 
 // NewCostCalculator returns a new CostCalculator for performing cost conversions and creating cost responses.
 func NewCostCalculator() *CostCalculator {
 	return &CostCalculator{}
 }
 
-// HourlyToMonthly converts hourly cost to monthly cost (730 hours).
+// NewCostCalculatorWithHours returns a CostCalculator that converts between hourly and monthly
+// costs using hoursPerMonth instead of 730, e.g. 720 (30 days x 24 hours) or the hours in a
+// specific calendar month, to match a provider's billing.
+//
+// Returns an error wrapping ErrHoursPerMonthInvalid if hoursPerMonth is not positive and finite.
+//
+// Example:
+//
+//	calc, err := pluginsdk.NewCostCalculatorWithHours(720)
+//	monthly := calc.HourlyToMonthly(0.10) // 72.0
+func NewCostCalculatorWithHours(hoursPerMonth float64) (*CostCalculator, error) {
+	if hoursPerMonth <= 0 || math.IsNaN(hoursPerMonth) || math.IsInf(hoursPerMonth, 0) {
+		return nil, fmt.Errorf("%w: got %v", ErrHoursPerMonthInvalid, hoursPerMonth)
+	}
+	return &CostCalculator{hoursPerMonth: hoursPerMonth}, nil
+}
+
+// HoursPerMonth returns the hours per month used by the calculator's conversions.
+func (cc *CostCalculator) HoursPerMonth() float64 {
+	if cc.hoursPerMonth == 0 {
+		return HoursPerMonth
+	}
+	return cc.hoursPerMonth
+}
+
+// HourlyToMonthly converts hourly cost to monthly cost (HoursPerMonth hours, 730 by default).
 func (cc *CostCalculator) HourlyToMonthly(hourlyCost float64) float64 {
-	return hourlyCost * HoursPerMonth
+	return hourlyCost * cc.HoursPerMonth()
 }
 
-// MonthlyToHourly converts monthly cost to hourly cost (730 hours).
+// MonthlyToHourly converts monthly cost to hourly cost (HoursPerMonth hours, 730 by default).
 func (cc *CostCalculator) MonthlyToHourly(monthlyCost float64) float64 {
-	return monthlyCost / HoursPerMonth
+	return monthlyCost / cc.HoursPerMonth()
+}
+
+// AnnualFromMonthly converts a monthly cost to an annual cost (12 months).
+func (cc *CostCalculator) AnnualFromMonthly(monthlyCost float64) float64 {
+	return monthlyCost * pricing.MonthsPerYear
+}
+
+// MonthlyFromAnnual converts an annual cost to a monthly cost (12 months).
+func (cc *CostCalculator) MonthlyFromAnnual(annualCost float64) float64 {
+	return annualCost / pricing.MonthsPerYear
+}
+
+// ConvertRate converts a rate billed per one time-based billing mode's period into the rate
+// per another's, using the period factors of pricing.MonthlyPeriodFactor (730 hours, 365/12
+// days, and 12 months per year). Periods shorter than a month are rescaled to the calculator's
+// HoursPerMonth, so a day is always 24 hours: with 720 hours per month, a month has 30 days.
+//
+// Returns an error wrapping pricing.ErrMonthlyProjectionUndefined if either mode has no fixed
+// period (e.g., per_request or on_demand).
+//
+// Example:
+//
+//	daily, err := calc.ConvertRate(0.10, pricing.PerHour, pricing.PerDay) // 0.10 * 24
+func (cc *CostCalculator) ConvertRate(amount float64, from, to pricing.BillingMode) (float64, error) {
+	fromFactor, ok := cc.monthlyPeriodFactor(from)
+	if !ok {
+		return 0, fmt.Errorf("%w: %s", pricing.ErrMonthlyProjectionUndefined, from)
+	}
+	toFactor, ok := cc.monthlyPeriodFactor(to)
+	if !ok {
+		return 0, fmt.Errorf("%w: %s", pricing.ErrMonthlyProjectionUndefined, to)
+	}
+	return amount * fromFactor / toFactor, nil
+}
+
+// monthlyPeriodFactor returns pricing.MonthlyPeriodFactor for mode, rescaled to the
+// calculator's HoursPerMonth for periods shorter than a month (those with more than one
+// period per month).
+func (cc *CostCalculator) monthlyPeriodFactor(mode pricing.BillingMode) (float64, bool) {
+	factor, ok := pricing.MonthlyPeriodFactor(mode)
+	if ok && factor > 1 {
+		factor *= cc.HoursPerMonth() / pricing.HoursPerMonth
+	}
+	return factor, ok
 }
 
 // CreateProjectedCostResponse creates a standard projected cost response.
-// unitPrice is expected to be an hourly rate; CostPerMonth is derived using 730 hours.
+// unitPrice is expected to be an hourly rate; CostPerMonth is derived using HoursPerMonth
+// hours (730 by default).
 func (cc *CostCalculator) CreateProjectedCostResponse(
 	currency string,
 	unitPrice float64,
@@ -249,6 +497,49 @@ func NewActualCostResponse(opts ...ActualCostResponseOption) *pbc.GetActualCostR
 	return resp
 }
 
+// BuildActualCostResponse builds a GetActualCostResponse from a plugin's results and the error
+// returned by its upstream billing source, following the fallback hint semantics:
+//
+//   - Results present: the results are returned with FALLBACK_HINT_NONE. Data presence is
+//     authoritative, so this applies even if upstreamErr is a transient error.
+//   - No results and no error ("no billing data found"): an empty response with
+//     FALLBACK_HINT_RECOMMENDED.
+//   - No results and a transient error (a pricing.PluginError in the transient category, such
+//     as a rate limit or network timeout): an empty response with FALLBACK_HINT_RECOMMENDED, so
+//     the core can try other plugins.
+//   - Any other error (permanent or configuration PluginErrors and unclassified errors):
+//     upstreamErr is returned unchanged with a nil response, to be surfaced as a gRPC error.
+//     Permanent errors are returned even if results are present.
+//
+// Example:
+//
+//	results, err := fetchBillingData(ctx, req)
+//	return pluginsdk.BuildActualCostResponse(results, err)
+func BuildActualCostResponse(
+	results []*pbc.ActualCostResult,
+	upstreamErr error,
+) (*pbc.GetActualCostResponse, error) {
+	if upstreamErr != nil && !isTransientError(upstreamErr) {
+		return nil, upstreamErr
+	}
+
+	if len(results) > 0 {
+		return NewActualCostResponse(
+			WithResults(results),
+			WithFallbackHint(pbc.FallbackHint_FALLBACK_HINT_NONE),
+		), nil
+	}
+	return NewActualCostResponse(
+		WithFallbackHint(pbc.FallbackHint_FALLBACK_HINT_RECOMMENDED),
+	), nil
+}
+
+// isTransientError reports whether err is, or wraps, a pricing.PluginError in the transient category.
+func isTransientError(err error) bool {
+	var pluginErr *pricing.PluginError
+	return errors.As(err, &pluginErr) && pluginErr.Category == pricing.TransientError
+}
+
 // ValidateActualCostResponse validates a GetActualCostResponse for structural correctness.
 //
 // Validation Rules:
@@ -257,8 +548,8 @@ func NewActualCostResponse(opts ...ActualCostResponseOption) *pbc.GetActualCostR
 //   - All results have non-empty source identifiers
 //   - No nil results in the results slice
 //
-// Validation stops at the first error encountered. To find all validation errors
-// in a response, you would need to implement your own multi-error collection.
+// By default validation stops at the first error encountered. Pass WithAllErrors to check
+// every result and return a *pricing.ValidationErrors listing all failures instead.
 //
 // Semantic Consistency (NOT validated):
 // This function performs structural validation only. The following combinations
@@ -279,24 +570,57 @@ func NewActualCostResponse(opts ...ActualCostResponseOption) *pbc.GetActualCostR
 //	if err := pluginsdk.ValidateActualCostResponse(resp); err != nil {
 //	    return nil, status.Errorf(codes.Internal, "invalid response: %v", err)
 //	}
-func ValidateActualCostResponse(resp *pbc.GetActualCostResponse) error {
+func ValidateActualCostResponse(resp *pbc.GetActualCostResponse, opts ...ValidateOption) error {
+	cfg := validateConfig{}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
 	if resp == nil {
 		return errors.New("response cannot be nil")
 	}
 
+	var errs pricing.ValidationErrors
 	for i, result := range resp.GetResults() {
 		if result == nil {
-			return fmt.Errorf("results[%d] cannot be nil", i)
-		}
-		if result.GetCost() < 0 {
-			return fmt.Errorf("results[%d].cost cannot be negative: %f", i, result.GetCost())
+			errs.Add(fmt.Errorf("results[%d] cannot be nil", i))
+		} else {
+			if result.GetCost() < 0 {
+				errs.Add(fmt.Errorf("results[%d].cost cannot be negative: %f", i, result.GetCost()))
+			}
+			if result.GetSource() == "" {
+				errs.Add(fmt.Errorf("results[%d].source cannot be empty", i))
+			}
 		}
-		if result.GetSource() == "" {
-			return fmt.Errorf("results[%d].source cannot be empty", i)
+		if errs.HasErrors() && !cfg.allErrors {
+			return errs.Unwrap()[0]
 		}
 	}
 
-	return nil
+	return errs.Err()
+}
+
+// ValidateOption configures a response validation helper such as ValidateActualCostResponse.
+type ValidateOption func(*validateConfig)
+
+// validateConfig holds the settings applied by ValidateOption.
+type validateConfig struct {
+	allErrors bool
+}
+
+// WithAllErrors makes validation check the whole response and return a *pricing.ValidationErrors
+// listing every failure, one per line, instead of stopping at the first. Each failure can still
+// be matched with errors.Is and errors.As.
+//
+// Example:
+//
+//	if err := pluginsdk.ValidateActualCostResponse(resp, pluginsdk.WithAllErrors()); err != nil {
+//	    log.Printf("invalid response:\n%v", err)
+//	}
+func WithAllErrors() ValidateOption {
+	return func(cfg *validateConfig) {
+		cfg.allErrors = true
+	}
 }
 
 // =============================================================================
@@ -451,17 +775,55 @@ func NewResourceDescriptor(provider, resourceType string, opts ...ResourceDescri
 
 // NotSupportedError returns an error indicating the specified resource type and provider are not supported.
 // The formatted message includes the resource's ResourceType and Provider.
+//
+// The error unwraps to a permanent *pricing.PluginError with ErrorCodeInvalidResource, so
+// errors.As and the retry helpers classify it, and gRPC servers send it as InvalidArgument
+// with a pbc.ErrorDetail attached. Its Error string is the plain message.
 func NotSupportedError(resource *pbc.ResourceDescriptor) error {
-	return fmt.Errorf(
+	pluginErr := pricing.NewPermanentError(pricing.ErrorCodeInvalidResource, fmt.Sprintf(
 		"resource type %s from provider %s is not supported",
 		resource.GetResourceType(),
 		resource.GetProvider(),
-	)
+	))
+	pluginErr.Details["resource_type"] = resource.GetResourceType()
+	pluginErr.Details["provider"] = resource.GetProvider()
+	return &messagePluginError{pluginErr: pluginErr}
 }
 
 // NoDataError returns a standard error when no cost data is available.
+//
+// The error unwraps to a permanent *pricing.PluginError with ErrorCodeResourceNotFound, so
+// gRPC servers send it as NotFound with a pbc.ErrorDetail attached. Its Error string is the
+// plain message.
 func NoDataError(resourceID string) error {
-	return fmt.Errorf("no cost data available for resource %s", resourceID)
+	pluginErr := pricing.NewPermanentError(
+		pricing.ErrorCodeResourceNotFound,
+		"no cost data available for resource "+resourceID,
+	)
+	pluginErr.Details["resource_id"] = resourceID
+	return &messagePluginError{pluginErr: pluginErr}
+}
+
+// messagePluginError is a PluginError whose Error string is only its message, without the
+// category and code prefix, for helpers whose messages predate PluginError.
+type messagePluginError struct {
+	pluginErr *pricing.PluginError
+}
+
+// Error returns the PluginError's message.
+func (e *messagePluginError) Error() string {
+	return e.pluginErr.Message
+}
+
+// GRPCStatus returns the PluginError's gRPC status; it lets status.FromError and gRPC servers
+// recognize the error.
+func (e *messagePluginError) GRPCStatus() *status.Status {
+	return e.pluginErr.GetGRPCStatus()
+}
+
+// Unwrap returns the underlying PluginError.
+func (e *messagePluginError) Unwrap() error {
+	return e.pluginErr
 }
 
 // BasePlugin provides common functionality for plugin implementations.
@@ -496,6 +858,27 @@ func (bp *BasePlugin) Calculator() *CostCalculator {
 	return bp.calc
 }
 
+// Supports provides a default implementation that answers from the plugin's ResourceMatcher,
+// filling SupportsResponse.reason from ResourceMatcher.SupportsWithReason when the resource is
+// rejected. Override this method to add checks the matcher cannot express.
+//
+// A plugin whose matcher has nothing configured reports every resource as unsupported with
+// DefaultSupportsNotImplementedReason, as for plugins that do not implement Supports, rather
+// than claiming support for resources its cost methods would reject.
+func (bp *BasePlugin) Supports(
+	_ context.Context,
+	req *pbc.SupportsRequest,
+) (*pbc.SupportsResponse, error) {
+	if req == nil {
+		return nil, errors.New("SupportsRequest cannot be nil")
+	}
+	if bp.matcher.isEmpty() {
+		return &pbc.SupportsResponse{Supported: false, Reason: DefaultSupportsNotImplementedReason}, nil
+	}
+	supported, reason := bp.matcher.SupportsWithReason(req.GetResource())
+	return &pbc.SupportsResponse{Supported: supported, Reason: reason}, nil
+}
+
 // GetProjectedCost provides a default implementation that returns not supported.
 func (bp *BasePlugin) GetProjectedCost(
 	_ context.Context,
@@ -617,6 +1000,44 @@ func ValidateRecommendationImpact(impact *pbc.RecommendationImpact) error {
 	return nil
 }
 
+// savingsPercentageTolerance is the allowed difference, in percentage points, between
+// impact.savings_percentage and the percentage implied by estimated_savings / current_cost.
+const savingsPercentageTolerance = 1.0
+
+// maxSavingsPercentage is the upper bound of impact.savings_percentage.
+const maxSavingsPercentage = 100.0
+
+// ValidateRecommendationImpactStrict validates impact information fields like
+// ValidateRecommendationImpact, and additionally checks that:
+//   - savings_percentage is between 0 and 100
+//   - when a baseline current_cost is present and savings_percentage is set, savings_percentage
+//     is within 1 percentage point of estimated_savings / current_cost * 100
+//
+// Without a baseline (current_cost of 0), a percentage-only impact cannot be cross-checked
+// and passes. A savings_percentage of 0 is treated as unset.
+func ValidateRecommendationImpactStrict(impact *pbc.RecommendationImpact) error {
+	if err := ValidateRecommendationImpact(impact); err != nil {
+		return err
+	}
+
+	pct := impact.GetSavingsPercentage()
+	if !(pct >= 0 && pct <= maxSavingsPercentage) {
+		return fmt.Errorf("impact.savings_percentage must be between 0 and 100, got %v", pct)
+	}
+
+	baseline := impact.GetCurrentCost()
+	if baseline > 0 && pct > 0 {
+		implied := impact.GetEstimatedSavings() / baseline * maxSavingsPercentage
+		if math.Abs(implied-pct) > savingsPercentageTolerance {
+			return fmt.Errorf(
+				"impact.savings_percentage %.2f is inconsistent with estimated_savings %.2f of current_cost %.2f (%.2f%%)",
+				pct, impact.GetEstimatedSavings(), baseline, implied,
+			)
+		}
+	}
+	return nil
+}
+
 // ValidateConfidenceScore validates that a confidence score is within the valid range.
 // Confidence scores must be between 0.0 and 1.0 inclusive.
 func ValidateConfidenceScore(score *float64) error {
@@ -652,7 +1073,11 @@ func ValidateRecommendationSummary(summary *pbc.RecommendationSummary) error {
 //   - Core filters (1-7): provider, region, resource_type, category, action_type, sku, tags
 //   - P0 filters (8-10): priority, min_estimated_savings, source
 //   - P1 filters (11-13): account_id (sort_by/sort_order handled by SortRecommendations)
-//   - P2 filters (14-16): min_confidence_score, max_age_days, resource_id
+//   - P2 filters (14-17): min_confidence_score, max_age_days, resource_id, exclude_unknown_confidence
+//
+// min_estimated_savings drops recommendations without an impact or with lower estimated savings.
+// min_confidence_score drops recommendations with a lower confidence score; recommendations
+// without one pass unless exclude_unknown_confidence is set.
 func ApplyRecommendationFilter(
 	recommendations []*pbc.Recommendation,
 	filter *pbc.RecommendationFilter,
@@ -672,7 +1097,7 @@ func ApplyRecommendationFilter(
 
 // matchesFilter checks if a recommendation matches all filter criteria.
 //
-//nolint:gocognit,gocyclo,cyclop // filter matching logic for 16 fields requires complexity
+//nolint:gocognit,gocyclo,cyclop // filter matching logic for 17 fields requires complexity
 func matchesFilter(rec *pbc.Recommendation, filter *pbc.RecommendationFilter) bool {
 	// Core filters (1-7)
 	// Filter by provider
@@ -762,15 +1187,17 @@ func matchesFilter(rec *pbc.Recommendation, filter *pbc.RecommendationFilter) bo
 		}
 	}
 
-	// P2 filters (14-16)
-	// Filter by min_confidence_score (field 14)
+	// P2 filters (14-17)
+	// Filter by min_confidence_score (field 14); exclude_unknown_confidence (field 17) drops nil scores
 	if filter.GetMinConfidenceScore() > 0 {
 		if rec.ConfidenceScore != nil {
 			if *rec.ConfidenceScore < filter.GetMinConfidenceScore() { //nolint:protogetter // direct access needed to distinguish nil from 0
 				return false
 			}
+		} else if filter.GetExcludeUnknownConfidence() {
+			return false
 		}
-		// nil confidence passes filter (unknown != low confidence).
+		// By default nil confidence passes filter (unknown != low confidence).
 		// We explicitly check for nil to avoid GetConfidenceScore() defaulting to 0,
 		// which would incorrectly filter out recommendations with unknown confidence.
 	}
@@ -822,9 +1249,108 @@ func ExcludeRecommendationsByIDs(
 	return result
 }
 
+// LimitPerResource keeps at most maxPerResource recommendations for each resource,
+// choosing those with the highest estimated savings.
+//
+// Resources are identified by provider and resource ID; recommendations without resource
+// info share a single group. The result lists resources in order of first appearance, with
+// each resource's recommendations sorted by estimated savings descending (ties keep input
+// order). Nil recommendations are dropped, and maxPerResource <= 0 returns an empty slice.
+func LimitPerResource(recommendations []*pbc.Recommendation, maxPerResource int) []*pbc.Recommendation {
+	result := make([]*pbc.Recommendation, 0, len(recommendations))
+	if maxPerResource <= 0 {
+		return result
+	}
+
+	var order []string
+	groups := make(map[string][]*pbc.Recommendation)
+	for _, rec := range recommendations {
+		if rec == nil {
+			continue
+		}
+		key := rec.GetResource().GetProvider() + "/" + rec.GetResource().GetId()
+		if _, seen := groups[key]; !seen {
+			order = append(order, key)
+		}
+		groups[key] = append(groups[key], rec)
+	}
+
+	for _, key := range order {
+		group := groups[key]
+		sort.SliceStable(group, func(i, j int) bool {
+			return compareRecommendations(group[j], group[i],
+				pbc.RecommendationSortBy_RECOMMENDATION_SORT_BY_ESTIMATED_SAVINGS)
+		})
+		result = append(result, group[:min(len(group), maxPerResource)]...)
+	}
+	return result
+}
+
+// recommendationDescriptionSeparator joins the descriptions of merged duplicate recommendations.
+const recommendationDescriptionSeparator = "; "
+
+// DeduplicateRecommendations collapses recommendations for the same resource and action type,
+// as produced when several analyzers flag the same opportunity.
+//
+// Duplicates share provider, non-empty resource ID, and action type; recommendations without
+// a resource ID are never treated as duplicates. Of each set, the recommendation
+// with the highest estimated savings is kept (the first one on ties), and its description is
+// replaced by the distinct non-empty descriptions of the set, joined with "; " and starting
+// with its own. Kept recommendations stay in input order. Merged recommendations are copies;
+// the input is never modified. Nil recommendations are dropped.
+func DeduplicateRecommendations(recommendations []*pbc.Recommendation) []*pbc.Recommendation {
+	type duplicates struct {
+		kept         int // index into recommendations
+		descriptions []string
+	}
+
+	var groups []*duplicates
+	byKey := make(map[string]*duplicates)
+	for i, rec := range recommendations {
+		if rec == nil {
+			continue
+		}
+		resourceID := rec.GetResource().GetId()
+		if resourceID == "" {
+			groups = append(groups, &duplicates{kept: i})
+			continue
+		}
+		key := rec.GetResource().GetProvider() + "/" + resourceID + "/" + rec.GetActionType().String()
+		g, seen := byKey[key]
+		if !seen {
+			g = &duplicates{kept: i}
+			byKey[key] = g
+			groups = append(groups, g)
+		} else if rec.GetImpact().GetEstimatedSavings() > recommendations[g.kept].GetImpact().GetEstimatedSavings() {
+			g.kept = i
+		}
+		if d := rec.GetDescription(); d != "" && !slices.Contains(g.descriptions, d) {
+			g.descriptions = append(g.descriptions, d)
+		}
+	}
+	slices.SortFunc(groups, func(a, b *duplicates) int { return a.kept - b.kept })
+
+	result := make([]*pbc.Recommendation, 0, len(groups))
+	for _, g := range groups {
+		rec := recommendations[g.kept]
+		own := rec.GetDescription()
+		others := slices.DeleteFunc(g.descriptions, func(d string) bool { return d == own })
+		if len(others) > 0 {
+			if own != "" {
+				others = append([]string{own}, others...)
+			}
+			rec = proto.CloneOf(rec)
+			rec.Description = strings.Join(others, recommendationDescriptionSeparator)
+		}
+		result = append(result, rec)
+	}
+	return result
+}
+
 // SortRecommendations sorts recommendations based on the specified sort criteria.
 // If sort_by is UNSPECIFIED, recommendations are returned in their original order.
-// Default sort order is DESC for ESTIMATED_SAVINGS and PRIORITY, ASC for others.
+// Default sort order is DESC for ESTIMATED_SAVINGS, PRIORITY, and SCORE, ASC for others.
+// SCORE uses ScoreRecommendation; see SortRecommendationsByScore for custom weights.
 func SortRecommendations(
 	recommendations []*pbc.Recommendation,
 	sortBy pbc.RecommendationSortBy,
@@ -861,10 +1387,11 @@ func determineSortOrder(sortBy pbc.RecommendationSortBy, sortOrder pbc.SortOrder
 	if sortOrder == pbc.SortOrder_SORT_ORDER_DESC {
 		return false
 	}
-	// Default: DESC for savings/priority, ASC for created_at/confidence
+	// Default: DESC for savings/priority/score, ASC for created_at/confidence
 	switch sortBy {
 	case pbc.RecommendationSortBy_RECOMMENDATION_SORT_BY_ESTIMATED_SAVINGS,
-		pbc.RecommendationSortBy_RECOMMENDATION_SORT_BY_PRIORITY:
+		pbc.RecommendationSortBy_RECOMMENDATION_SORT_BY_PRIORITY,
+		pbc.RecommendationSortBy_RECOMMENDATION_SORT_BY_SCORE:
 		return false
 	case pbc.RecommendationSortBy_RECOMMENDATION_SORT_BY_CREATED_AT,
 		pbc.RecommendationSortBy_RECOMMENDATION_SORT_BY_CONFIDENCE,
@@ -915,6 +1442,9 @@ func compareRecommendations(rec1, rec2 *pbc.Recommendation, sortBy pbc.Recommend
 		}
 		return conf1 < conf2
 
+	case pbc.RecommendationSortBy_RECOMMENDATION_SORT_BY_SCORE:
+		return ScoreRecommendation(rec1) < ScoreRecommendation(rec2)
+
 	case pbc.RecommendationSortBy_RECOMMENDATION_SORT_BY_UNSPECIFIED:
 		return false
 
@@ -962,25 +1492,54 @@ const DefaultPageSize = 50
 // MaxPageSize is the maximum allowed page size.
 const MaxPageSize = 1000
 
-// PaginateRecommendations applies pagination to a slice of recommendations.
-// PaginateRecommendations returns the page of recommendations and the next page token (empty if last page).
-func PaginateRecommendations(
-	recommendations []*pbc.Recommendation,
-	pageSize int32,
-	pageToken string,
-) ([]*pbc.Recommendation, string, error) {
-	// Determine effective page size
-	effectivePageSize := int(pageSize)
-	if effectivePageSize <= 0 {
-		effectivePageSize = DefaultPageSize
+// ClampPageSize bounds a requested page size for paginated RPCs.
+// A zero or negative request falls back to defaultSize, and a request above maxSize
+// is clamped to maxSize. A maxSize of zero or less disables the upper bound.
+//
+// Example:
+//
+//	pluginsdk.ClampPageSize(0, 50, 1000)    // 50 (default)
+//	pluginsdk.ClampPageSize(5000, 50, 1000) // 1000 (clamped)
+//	pluginsdk.ClampPageSize(100, 50, 1000)  // 100
+func ClampPageSize(requested, defaultSize, maxSize int) int {
+	size := requested
+	if size <= 0 {
+		size = defaultSize
+	}
+	if maxSize > 0 && size > maxSize {
+		size = maxSize
 	}
-	if effectivePageSize > MaxPageSize {
+	return size
+}
+
+// resolvePageSize applies ClampPageSize with DefaultPageSize and MaxPageSize,
+// logging a warning when the requested size exceeds the maximum.
+func resolvePageSize(pageSize int) int {
+	size := ClampPageSize(pageSize, DefaultPageSize, MaxPageSize)
+	if pageSize > MaxPageSize {
 		log.Warn().
-			Int("requested_page_size", int(pageSize)).
+			Int("requested_page_size", pageSize).
 			Int("max_page_size", MaxPageSize).
 			Msg("page_size exceeded maximum; clamped to MaxPageSize")
-		effectivePageSize = MaxPageSize
 	}
+	return size
+}
+
+// Paginate applies offset-based pagination to any slice, for list-returning RPCs.
+// Paginate returns the page of items and the next page token (empty if last page).
+//
+// pageSize is clamped with ClampPageSize to DefaultPageSize and MaxPageSize, and page tokens
+// are offsets created by EncodePageToken. The page shares its backing array with items.
+//
+// Example:
+//
+//	page, next, err := pluginsdk.Paginate(budgets, int(req.GetPageSize()), req.GetPageToken())
+//	if err != nil {
+//	    return nil, status.Error(codes.InvalidArgument, err.Error())
+//	}
+func Paginate[T any](items []T, pageSize int, pageToken string) ([]T, string, error) {
+	// Determine effective page size
+	effectivePageSize := resolvePageSize(pageSize)
 
 	// Decode offset from page token
 	offset := 0
@@ -993,19 +1552,13 @@ func PaginateRecommendations(
 	}
 
 	// Handle out-of-bounds offset
-	total := len(recommendations)
+	total := len(items)
 	if offset >= total {
-		return []*pbc.Recommendation{}, "", nil
+		return []T{}, "", nil
 	}
 
 	// Calculate page boundaries
-	end := offset + effectivePageSize
-	if end > total {
-		end = total
-	}
-
-	// Extract page
-	page := recommendations[offset:end]
+	end := min(offset+effectivePageSize, total)
 
 	// Generate next page token
 	nextToken := ""
@@ -1013,7 +1566,17 @@ func PaginateRecommendations(
 		nextToken = EncodePageToken(end)
 	}
 
-	return page, nextToken, nil
+	return items[offset:end], nextToken, nil
+}
+
+// PaginateRecommendations applies pagination to a slice of recommendations.
+// PaginateRecommendations returns the page of recommendations and the next page token (empty if last page).
+func PaginateRecommendations(
+	recommendations []*pbc.Recommendation,
+	pageSize int32,
+	pageToken string,
+) ([]*pbc.Recommendation, string, error) {
+	return Paginate(recommendations, int(pageSize), pageToken)
 }
 
 // EncodePageToken creates an opaque page token from an offset.
@@ -1040,6 +1603,79 @@ func DecodePageToken(token string) (int, error) {
 	return offset, nil
 }
 
+// idCursorPrefix distinguishes ID cursors from offset page tokens.
+const idCursorPrefix = "id:"
+
+// EncodeIDCursor creates an opaque page token identifying the last recommendation returned,
+// for use with PaginateRecommendationsByID.
+func EncodeIDCursor(id string) string {
+	return base64.StdEncoding.EncodeToString([]byte(idCursorPrefix + id))
+}
+
+// DecodeIDCursor decodes an opaque page token created by EncodeIDCursor to the ID of the last
+// recommendation returned. An empty ID round-trips, so a page ending on a recommendation
+// without an ID still yields a cursor that resumes after it.
+func DecodeIDCursor(cursor string) (string, error) {
+	decoded, err := base64.StdEncoding.DecodeString(cursor)
+	if err != nil {
+		return "", errors.New("malformed page token")
+	}
+	id, ok := strings.CutPrefix(string(decoded), idCursorPrefix)
+	if !ok {
+		return "", errors.New("invalid page token value")
+	}
+	return id, nil
+}
+
+// PaginateRecommendationsByID applies cursor-based pagination to a slice of recommendations.
+// PaginateRecommendationsByID returns the page of recommendations and the next page token
+// (empty if last page).
+//
+// Unlike PaginateRecommendations, whose offset tokens skip or repeat items when the list
+// changes between requests, the token records the ID of the last recommendation returned and
+// the next page resumes after it. To make that position stable, recommendations are paged in
+// ascending ID order regardless of input order (the input slice is not modified). If the
+// last-seen recommendation has since been removed, paging resumes at the next ID after it, so
+// no remaining recommendation is skipped or repeated. Recommendation IDs must be unique.
+//
+// Example:
+//
+//	page, next, err := pluginsdk.PaginateRecommendationsByID(recs, req.GetPageSize(), req.GetPageToken())
+//	if err != nil {
+//	    return nil, status.Error(codes.InvalidArgument, err.Error())
+//	}
+func PaginateRecommendationsByID(
+	recommendations []*pbc.Recommendation,
+	pageSize int32,
+	pageToken string,
+) ([]*pbc.Recommendation, string, error) {
+	effectivePageSize := resolvePageSize(int(pageSize))
+
+	sorted := slices.Clone(recommendations)
+	slices.SortStableFunc(sorted, func(a, b *pbc.Recommendation) int {
+		return strings.Compare(a.GetId(), b.GetId())
+	})
+
+	start := 0
+	if pageToken != "" {
+		lastID, err := DecodeIDCursor(pageToken)
+		if err != nil {
+			return nil, "", fmt.Errorf("invalid page_token: %w", err)
+		}
+		// First recommendation with an ID after lastID, whether or not lastID is still present.
+		start = sort.Search(len(sorted), func(i int) bool { return sorted[i].GetId() > lastID })
+	}
+
+	end := min(start+effectivePageSize, len(sorted))
+	page := sorted[start:end]
+
+	nextToken := ""
+	if end < len(sorted) {
+		nextToken = EncodeIDCursor(sorted[end-1].GetId())
+	}
+	return page, nextToken, nil
+}
+
 // =============================================================================
 // GetActualCost Pagination Helpers
 // =============================================================================
@@ -1073,8 +1709,6 @@ func PaginateActualCosts(
 	pageSize int32,
 	pageToken string,
 ) ([]*pbc.ActualCostResult, string, int32, error) {
-	total := len(results)
-
 	// Normalize negative page sizes to 0 (proto contract: <=0 means use default)
 	if pageSize < 0 {
 		pageSize = 0
@@ -1083,83 +1717,79 @@ func PaginateActualCosts(
 	// Handle legacy hosts: if no pagination params are provided, return all results
 	// This maintains backward compatibility with hosts that don't use pagination
 	if pageSize == 0 && pageToken == "" {
-		// Clamp totalCount to int32 max to avoid overflow
-		totalCount := int32(total)
-		if total > math.MaxInt32 {
-			log.Warn().
-				Int("total", total).
-				Int32("clamped_to", math.MaxInt32).
-				Msg("total_count clamped to int32 max; actual count exceeds representable range")
-			totalCount = math.MaxInt32
-		}
-		return results, "", totalCount, nil
+		return results, "", clampTotalCount(len(results)), nil
 	}
 
-	// Determine effective page size
-	effectivePageSize := int(pageSize)
-	if effectivePageSize <= 0 {
-		effectivePageSize = DefaultPageSize
-	}
-	if effectivePageSize > MaxPageSize {
-		log.Warn().
-			Int("requested_page_size", int(pageSize)).
-			Int("max_page_size", MaxPageSize).
-			Msg("page_size exceeded maximum; clamped to MaxPageSize")
-		effectivePageSize = MaxPageSize
-	}
-
-	// Decode offset from page token
-	offset := 0
-	if pageToken != "" {
-		var err error
-		offset, err = DecodePageToken(pageToken)
-		if err != nil {
-			return nil, "", 0, fmt.Errorf("invalid page_token: %w", err)
-		}
+	page, nextToken, err := Paginate(results, int(pageSize), pageToken)
+	if err != nil {
+		return nil, "", 0, err
 	}
+	return page, nextToken, clampTotalCount(len(results)), nil
+}
 
-	// Clamp totalCount to int32 max to avoid overflow
-	totalCount := int32(total)
+// clampTotalCount converts a result count to an int32 total_count, clamping to the int32
+// maximum to avoid overflow.
+func clampTotalCount(total int) int32 {
 	if total > math.MaxInt32 {
 		log.Warn().
 			Int("total", total).
 			Int32("clamped_to", math.MaxInt32).
 			Msg("total_count clamped to int32 max; actual count exceeds representable range")
-		totalCount = math.MaxInt32
+		return math.MaxInt32
 	}
+	return int32(total)
+}
 
-	// Handle out-of-bounds offset
-	if offset >= total {
-		return []*pbc.ActualCostResult{}, "", totalCount, nil
-	}
+// =============================================================================
+// GetRecommendations Summary Calculation
+// =============================================================================
 
-	// Calculate page boundaries
-	end := offset + effectivePageSize
-	if end > total {
-		end = total
+// ProjectionPeriodFactor returns the multiplier that converts a monthly amount into the given
+// projection period: 1/DaysPerMonth for "daily", 1 for "monthly" or empty (the default), and 12
+// for "annual". It returns false for any other period.
+//
+// Example:
+//
+//	factor, ok := pluginsdk.ProjectionPeriodFactor(req.GetProjectionPeriod())
+//	if !ok {
+//	    return nil, status.Error(codes.InvalidArgument, "invalid projection_period")
+//	}
+//	impact.EstimatedSavings = monthlySavings * factor
+func ProjectionPeriodFactor(period string) (float64, bool) {
+	switch period {
+	case "daily":
+		return 1 / pricing.DaysPerMonth, true
+	case "", "monthly":
+		return 1, true
+	case "annual":
+		return pricing.MonthsPerYear, true
+	default:
+		return 0, false
 	}
+}
 
-	// Extract page
-	page := results[offset:end]
-
-	// Generate next page token
-	nextToken := ""
-	if end < total {
-		nextToken = EncodePageToken(end)
+// summaryProjectionPeriod returns period if it passes ValidateProjectionPeriod, and otherwise
+// logs it and returns "monthly", so invalid periods do not reach a RecommendationSummary.
+func summaryProjectionPeriod(period string) string {
+	if err := ValidateProjectionPeriod(period); err != nil {
+		log.Warn().
+			Str("projection_period", period).
+			Msg("invalid projection_period in recommendation summary; using monthly")
+		return defaultProjectionPeriod
 	}
-
-	return page, nextToken, totalCount, nil
+	return period
 }
 
-// =============================================================================
-// GetRecommendations Summary Calculation
-// =============================================================================
-
 // CalculateRecommendationSummary computes aggregated summary statistics for recommendations.
+//
+// projectionPeriod is recorded as is when it passes ValidateProjectionPeriod; any other value
+// is logged and replaced with "monthly" so invalid periods do not reach the summary.
 func CalculateRecommendationSummary(
 	recommendations []*pbc.Recommendation,
 	projectionPeriod string,
 ) *pbc.RecommendationSummary {
+	projectionPeriod = summaryProjectionPeriod(projectionPeriod)
+
 	summary := &pbc.RecommendationSummary{
 		TotalRecommendations: int32(len(recommendations)), //nolint:gosec // length will not exceed int32 max
 		ProjectionPeriod:     projectionPeriod,
@@ -1277,6 +1907,28 @@ func WithEstimateCost(currency string, costMonthly float64) EstimateCostResponse
 	}
 }
 
+// WithExplanation records the calculation steps used to derive cost_monthly
+// (e.g., rate x hours x count) in the explanation field so users can audit the estimate.
+// Steps are copied in order. An empty or nil slice leaves the field unset.
+//
+// Example:
+//
+//	resp := pluginsdk.NewEstimateCostResponse(
+//	    pluginsdk.WithEstimateCost("USD", 15.18),
+//	    pluginsdk.WithExplanation([]string{
+//	        "t3.micro on-demand rate: 0.0104 USD/hour",
+//	        "0.0104 USD/hour x 730 hours x 2 instances = 15.18 USD",
+//	    }),
+//	)
+func WithExplanation(steps []string) EstimateCostResponseOption {
+	return func(resp *pbc.EstimateCostResponse) {
+		if len(steps) == 0 {
+			return
+		}
+		resp.Explanation = append([]string(nil), steps...)
+	}
+}
+
 // NewEstimateCostResponse creates an EstimateCostResponse with functional options.
 //
 // Example:
@@ -1423,6 +2075,66 @@ func WithPredictionInterval(lower, upper, confidence float64) GetProjectedCostRe
 	}
 }
 
+// WithBillingMode sets the billing_mode field for GetProjectedCostResponse, reporting the
+// billing mode unit_price and rate_per_unit are quoted in.
+//
+// Example:
+//
+//	resp := pluginsdk.NewGetProjectedCostResponse(
+//	    pluginsdk.WithBillingMode(pricing.PerHour),
+//	)
+func WithBillingMode(mode pricing.BillingMode) GetProjectedCostResponseOption {
+	return func(resp *pbc.GetProjectedCostResponse) {
+		resp.BillingMode = mode.String()
+	}
+}
+
+// WithRatePerUnit sets the rate_per_unit field for GetProjectedCostResponse.
+func WithRatePerUnit(rate float64) GetProjectedCostResponseOption {
+	return func(resp *pbc.GetProjectedCostResponse) {
+		resp.RatePerUnit = rate
+	}
+}
+
+// WithUnit sets the unit field for GetProjectedCostResponse (e.g., "hour", "GB-month").
+func WithUnit(unit string) GetProjectedCostResponseOption {
+	return func(resp *pbc.GetProjectedCostResponse) {
+		resp.Unit = unit
+	}
+}
+
+// WithAssumptions sets the assumptions field for GetProjectedCostResponse, explaining how
+// cost_per_month was derived.
+//
+// Example:
+//
+//	resp := pluginsdk.NewGetProjectedCostResponse(
+//	    pluginsdk.WithAssumptions([]string{"730 hours per month", "Linux, shared tenancy"}),
+//	)
+func WithAssumptions(assumptions []string) GetProjectedCostResponseOption {
+	return func(resp *pbc.GetProjectedCostResponse) {
+		resp.Assumptions = assumptions
+	}
+}
+
+// WithPricingTiers sets the pricing_tiers field for GetProjectedCostResponse. Use it with
+// WithBillingMode(pricing.Tiered) for volume-based pricing.
+//
+// Example:
+//
+//	resp := pluginsdk.NewGetProjectedCostResponse(
+//	    pluginsdk.WithBillingMode(pricing.Tiered),
+//	    pluginsdk.WithPricingTiers([]*pbc.PricingTier{
+//	        {MinQuantity: 0, MaxQuantity: 50000, RatePerUnit: 0.023},
+//	        {MinQuantity: 50000, MaxQuantity: 0, RatePerUnit: 0.022},
+//	    }),
+//	)
+func WithPricingTiers(tiers []*pbc.PricingTier) GetProjectedCostResponseOption {
+	return func(resp *pbc.GetProjectedCostResponse) {
+		resp.PricingTiers = tiers
+	}
+}
+
 // NewGetProjectedCostResponse creates a GetProjectedCostResponse with functional options.
 //
 // Example:
@@ -1439,6 +2151,16 @@ func WithPredictionInterval(lower, upper, confidence float64) GetProjectedCostRe
 //	    pluginsdk.WithProjectedCostDetails(0.05, "USD", 36.50, "spot-instance"),
 //	    pluginsdk.WithPredictionInterval(30.0, 45.0, 0.95),  // 95% CI
 //	)
+//
+// Example with pricing transparency:
+//
+//	resp := pluginsdk.NewGetProjectedCostResponse(
+//	    pluginsdk.WithProjectedCostDetails(0.0104, "USD", 7.59, "on-demand"),
+//	    pluginsdk.WithBillingMode(pricing.PerHour),
+//	    pluginsdk.WithRatePerUnit(0.0104),
+//	    pluginsdk.WithUnit("hour"),
+//	    pluginsdk.WithAssumptions([]string{"730 hours per month"}),
+//	)
 func NewGetProjectedCostResponse(opts ...GetProjectedCostResponseOption) *pbc.GetProjectedCostResponse {
 	resp := &pbc.GetProjectedCostResponse{}
 	for _, opt := range opts {
@@ -3,14 +3,21 @@ package pluginsdk_test
 import (
 	"context"
 	"encoding/base64"
+	"errors"
+	"fmt"
 	"math"
+	"strconv"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/rshade/finfocus-spec/sdk/go/pluginsdk"
+	"github.com/rshade/finfocus-spec/sdk/go/pricing"
 	pbc "github.com/rshade/finfocus-spec/sdk/go/proto/finfocus/v1"
 )
 
@@ -62,6 +69,134 @@ func TestResourceMatcher(t *testing.T) {
 	}
 }
 
+func TestResourceMatcherPatterns(t *testing.T) {
+	matcher := pluginsdk.NewResourceMatcher()
+	matcher.AddResourceType("aws:s3/bucket:Bucket")
+	matcher.AddResourceTypePattern("aws:ec2/*")
+	matcher.AddResourceTypePattern("aws:*:Instance")
+	matcher.AddResourceTypePattern("gcp:compute/*:*Disk")
+	matcher.AddResourceTypePattern("")
+
+	testCases := []struct {
+		resourceType string
+		expected     bool
+	}{
+		{"aws:s3/bucket:Bucket", true},
+		{"aws:ec2/instance:Instance", true},
+		{"aws:ec2/volume:Volume", true},
+		{"aws:rds/instance:Instance", true},
+		{"gcp:compute/disk:Disk", true},
+		{"gcp:compute/regionDisk:RegionDisk", true},
+		{"gcp:compute/instance:Instance", false},
+		{"aws:ec2", false},
+		{"aws:s3/bucketPolicy:BucketPolicy", false},
+		{"azure:ec2/instance:Instance", false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.resourceType, func(t *testing.T) {
+			resource := &pbc.ResourceDescriptor{Provider: "aws", ResourceType: tc.resourceType}
+			if result := matcher.Supports(resource); result != tc.expected {
+				t.Errorf("Expected %v, got %v for resource type %s", tc.expected, result, tc.resourceType)
+			}
+		})
+	}
+}
+
+func TestResourceMatcherPatternWithoutWildcard(t *testing.T) {
+	matcher := pluginsdk.NewResourceMatcher()
+	matcher.AddProvider("aws")
+	matcher.AddResourceTypePattern("aws:ec2/instance:Instance")
+	matcher.AddResourceTypePattern("aws:lambda/*")
+
+	// The literal pattern is an exact type and is listed; the wildcard pattern is not.
+	resources := matcher.SupportedResources()
+	if len(resources) != 1 || resources[0].GetResourceType() != "aws:ec2/instance:Instance" {
+		t.Errorf("Expected only the exact type to be listed, got %v", resources)
+	}
+
+	patternOnly := pluginsdk.NewResourceMatcher()
+	patternOnly.AddProvider("aws")
+	patternOnly.AddResourceTypePattern("aws:lambda/*")
+	if resources := patternOnly.SupportedResources(); len(resources) != 0 {
+		t.Errorf("Expected patterns to restrict the all-types listing, got %v", resources)
+	}
+}
+
+func TestResourceMatcherRegionAndSKU(t *testing.T) {
+	matcher := pluginsdk.NewResourceMatcher()
+	matcher.AddProvider("aws")
+	matcher.AddRegion("us-east-1")
+	matcher.AddRegion("us-west-2")
+	matcher.AddSKUPattern("t3.*")
+	matcher.AddSKUPattern("m5.large")
+
+	testCases := []struct {
+		name     string
+		resource *pbc.ResourceDescriptor
+		reason   string
+	}{
+		{
+			name:     "supported region and SKU pattern",
+			resource: &pbc.ResourceDescriptor{Provider: "aws", Region: "us-east-1", Sku: "t3.micro"},
+		},
+		{
+			name:     "supported exact SKU",
+			resource: &pbc.ResourceDescriptor{Provider: "aws", Region: "us-west-2", Sku: "m5.large"},
+		},
+		{
+			name:     "unsupported region",
+			resource: &pbc.ResourceDescriptor{Provider: "aws", Region: "eu-west-1", Sku: "t3.micro"},
+			reason:   `region "eu-west-1" is not supported`,
+		},
+		{
+			name:     "missing region",
+			resource: &pbc.ResourceDescriptor{Provider: "aws", Sku: "t3.micro"},
+			reason:   `region "" is not supported`,
+		},
+		{
+			name:     "unsupported SKU",
+			resource: &pbc.ResourceDescriptor{Provider: "aws", Region: "us-east-1", Sku: "m5.xlarge"},
+			reason:   `SKU "m5.xlarge" is not supported`,
+		},
+		{
+			name:     "provider checked first",
+			resource: &pbc.ResourceDescriptor{Provider: "gcp", Region: "eu-west-1", Sku: "n2"},
+			reason:   `provider "gcp" is not supported`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			supported, reason := matcher.SupportsWithReason(tc.resource)
+			if supported != (tc.reason == "") || reason != tc.reason {
+				t.Errorf("Expected (%v, %q), got (%v, %q)", tc.reason == "", tc.reason, supported, reason)
+			}
+			if matcher.Supports(tc.resource) != supported {
+				t.Errorf("Supports disagrees with SupportsWithReason")
+			}
+		})
+	}
+}
+
+func TestResourceMatcherSupportsWithReasonInvalidInput(t *testing.T) {
+	var nilMatcher *pluginsdk.ResourceMatcher
+	if supported, reason := nilMatcher.SupportsWithReason(&pbc.ResourceDescriptor{}); supported || reason == "" {
+		t.Errorf("Expected nil matcher to reject with a reason, got (%v, %q)", supported, reason)
+	}
+
+	resourceType := pluginsdk.NewResourceMatcher()
+	resourceType.AddResourceType("aws:ec2:Instance")
+	supported, reason := resourceType.SupportsWithReason(&pbc.ResourceDescriptor{ResourceType: "aws:s3:Bucket"})
+	if supported || reason != `resource type "aws:s3:Bucket" is not supported` {
+		t.Errorf("Unexpected result (%v, %q)", supported, reason)
+	}
+
+	if supported, reason := resourceType.SupportsWithReason(nil); supported || reason != "resource is required" {
+		t.Errorf("Unexpected result for nil resource (%v, %q)", supported, reason)
+	}
+}
+
 func TestResourceMatcherNoFilters(t *testing.T) {
 	// Empty matcher should support everything
 	matcher := pluginsdk.NewResourceMatcher()
@@ -96,6 +231,96 @@ func TestCostCalculator(t *testing.T) {
 	}
 }
 
+func TestCostCalculatorWithHours(t *testing.T) {
+	calc, err := pluginsdk.NewCostCalculatorWithHours(720)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if got := calc.HoursPerMonth(); got != 720 {
+		t.Errorf("Expected 720 hours per month, got %f", got)
+	}
+	if monthly := calc.HourlyToMonthly(0.10); math.Abs(monthly-72.0) > 1e-9 {
+		t.Errorf("Expected monthly cost 72.0, got %f", monthly)
+	}
+	if hourly := calc.MonthlyToHourly(144.0); math.Abs(hourly-0.2) > 1e-9 {
+		t.Errorf("Expected hourly cost 0.2, got %f", hourly)
+	}
+	if resp := calc.CreateProjectedCostResponse("USD", 0.05, ""); math.Abs(resp.GetCostPerMonth()-36.0) > 1e-9 {
+		t.Errorf("Expected cost per month 36.0, got %f", resp.GetCostPerMonth())
+	}
+	if monthly, _ := calc.ConvertRate(1, pricing.PerSecond, pricing.PerMonth); math.Abs(monthly-720*3600) > 1e-6 {
+		t.Errorf("Expected per-second rate to use 720 hours, got %f", monthly)
+	}
+	if annual, _ := calc.ConvertRate(10, pricing.PerMonth, pricing.PerYear); annual != 120 {
+		t.Errorf("Expected annual cost 120, got %f", annual)
+	}
+	if daily, _ := calc.ConvertRate(1, pricing.PerHour, pricing.PerDay); math.Abs(daily-24) > 1e-9 {
+		t.Errorf("Expected a 24-hour day at 720 hours, got %f", daily)
+	}
+	if monthly, _ := calc.ConvertRate(1, pricing.PerDay, pricing.PerMonth); math.Abs(monthly-30) > 1e-9 {
+		t.Errorf("Expected a 30-day month at 720 hours, got %f", monthly)
+	}
+
+	if got := pluginsdk.NewCostCalculator().HoursPerMonth(); got != pluginsdk.HoursPerMonth {
+		t.Errorf("Expected default %f hours per month, got %f", pluginsdk.HoursPerMonth, got)
+	}
+	if got := (&pluginsdk.CostCalculator{}).HourlyToMonthly(0.10); got != 73.0 {
+		t.Errorf("Expected zero-value calculator to use 730 hours, got %f", got)
+	}
+
+	for _, hours := range []float64{0, -730, math.NaN(), math.Inf(1)} {
+		if _, err := pluginsdk.NewCostCalculatorWithHours(hours); !errors.Is(err, pluginsdk.ErrHoursPerMonthInvalid) {
+			t.Errorf("NewCostCalculatorWithHours(%v): expected ErrHoursPerMonthInvalid, got %v", hours, err)
+		}
+	}
+}
+
+func TestCostCalculatorConvertRate(t *testing.T) {
+	calc := pluginsdk.NewCostCalculator()
+
+	tests := []struct {
+		name     string
+		amount   float64
+		from, to pricing.BillingMode
+		expected float64
+	}{
+		{"hour to month", 0.10, pricing.PerHour, pricing.PerMonth, 73.0},
+		{"month to hour", 146.0, pricing.PerMonth, pricing.PerHour, 0.2},
+		{"month to year", 10.0, pricing.PerMonth, pricing.PerYear, 120.0},
+		{"year to month", 120.0, pricing.PerYear, pricing.PerMonth, 10.0},
+		{"hour to day", 1.0, pricing.PerHour, pricing.PerDay, 24.0},
+		{"second to minute", 1.0, pricing.PerSecond, pricing.PerMinute, 60.0},
+		{"same mode", 5.0, pricing.PerGBMonth, pricing.PerGBMonth, 5.0},
+	}
+	for _, tt := range tests {
+		got, err := calc.ConvertRate(tt.amount, tt.from, tt.to)
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", tt.name, err)
+			continue
+		}
+		if math.Abs(got-tt.expected) > 1e-9 {
+			t.Errorf("%s: expected %f, got %f", tt.name, tt.expected, got)
+		}
+	}
+
+	for _, mode := range []pricing.BillingMode{pricing.PerRequest, pricing.OnDemand} {
+		if _, err := calc.ConvertRate(1, mode, pricing.PerMonth); !errors.Is(err, pricing.ErrMonthlyProjectionUndefined) {
+			t.Errorf("from %s: expected ErrMonthlyProjectionUndefined, got %v", mode, err)
+		}
+		if _, err := calc.ConvertRate(1, pricing.PerMonth, mode); !errors.Is(err, pricing.ErrMonthlyProjectionUndefined) {
+			t.Errorf("to %s: expected ErrMonthlyProjectionUndefined, got %v", mode, err)
+		}
+	}
+
+	if annual := calc.AnnualFromMonthly(10); annual != 120 {
+		t.Errorf("Expected annual cost 120, got %f", annual)
+	}
+	if monthly := calc.MonthlyFromAnnual(120); monthly != 10 {
+		t.Errorf("Expected monthly cost 10, got %f", monthly)
+	}
+}
+
 func TestCostCalculatorResponses(t *testing.T) {
 	calc := pluginsdk.NewCostCalculator()
 
@@ -162,6 +387,44 @@ func TestErrorFunctions(t *testing.T) {
 	}
 }
 
+// TestErrorFunctionsPluginErrors tests that NotSupportedError and NoDataError carry
+// permanent PluginError codes and map to gRPC status codes.
+func TestErrorFunctionsPluginErrors(t *testing.T) {
+	resource := &pbc.ResourceDescriptor{Provider: "test", ResourceType: "test:resource:Type"}
+
+	tests := []struct {
+		name     string
+		err      error
+		code     pricing.ErrorCode
+		grpcCode codes.Code
+	}{
+		{"not supported", pluginsdk.NotSupportedError(resource), pricing.ErrorCodeInvalidResource, codes.InvalidArgument},
+		{"no data", pluginsdk.NoDataError("test-resource-id"), pricing.ErrorCodeResourceNotFound, codes.NotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var pluginErr *pricing.PluginError
+			if !errors.As(tt.err, &pluginErr) {
+				t.Fatalf("Expected *pricing.PluginError, got %T", tt.err)
+			}
+			if pluginErr.Code != tt.code || !pricing.IsPermanentError(tt.err) {
+				t.Errorf("Expected permanent %s, got %s %s", tt.code, pluginErr.Category, pluginErr.Code)
+			}
+			if pluginErr.Message != tt.err.Error() {
+				t.Errorf("Expected PluginError message %q, got %q", tt.err.Error(), pluginErr.Message)
+			}
+
+			st, ok := status.FromError(tt.err)
+			if !ok || st.Code() != tt.grpcCode {
+				t.Errorf("Expected gRPC code %s, got %s", tt.grpcCode, st.Code())
+			}
+			if detail, ok := pricing.ExtractErrorDetails(st.Err()); !ok || detail.Code != tt.code {
+				t.Errorf("Expected error detail with code %s, got %v", tt.code, detail)
+			}
+		})
+	}
+}
+
 func TestBasePlugin(t *testing.T) {
 	plugin := pluginsdk.NewBasePlugin("test-plugin")
 
@@ -232,6 +495,62 @@ func TestBasePlugin(t *testing.T) {
 	}
 }
 
+func TestBasePluginSupports(t *testing.T) {
+	plugin := pluginsdk.NewBasePlugin("test-plugin")
+	plugin.Matcher().AddProvider("aws")
+	plugin.Matcher().AddRegion("us-east-1")
+	ctx := context.Background()
+
+	resp, err := plugin.Supports(ctx, &pbc.SupportsRequest{
+		Resource: &pbc.ResourceDescriptor{Provider: "aws", ResourceType: "aws:ec2:Instance", Region: "us-east-1"},
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !resp.GetSupported() || resp.GetReason() != "" {
+		t.Errorf("Expected supported with no reason, got (%v, %q)", resp.GetSupported(), resp.GetReason())
+	}
+
+	resp, err = plugin.Supports(ctx, &pbc.SupportsRequest{
+		Resource: &pbc.ResourceDescriptor{Provider: "aws", ResourceType: "aws:ec2:Instance", Region: "eu-west-1"},
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if resp.GetSupported() || resp.GetReason() != `region "eu-west-1" is not supported` {
+		t.Errorf("Expected region rejection, got (%v, %q)", resp.GetSupported(), resp.GetReason())
+	}
+
+	if _, err = plugin.Supports(ctx, nil); err == nil {
+		t.Error("Expected error for nil request, got nil")
+	}
+}
+
+// emptyMatcherPlugin embeds BasePlugin without configuring its ResourceMatcher.
+type emptyMatcherPlugin struct {
+	*pluginsdk.BasePlugin
+}
+
+func TestBasePluginSupportsEmptyMatcher(t *testing.T) {
+	plugin := emptyMatcherPlugin{BasePlugin: pluginsdk.NewBasePlugin("empty-matcher")}
+	ctx := context.Background()
+	resource := &pbc.ResourceDescriptor{Provider: "aws", ResourceType: "aws:ec2:Instance", Region: "us-east-1"}
+
+	resp, err := plugin.Supports(ctx, &pbc.SupportsRequest{Resource: resource})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if resp.GetSupported() || resp.GetReason() != pluginsdk.DefaultSupportsNotImplementedReason {
+		t.Errorf("Expected (false, %q), got (%v, %q)",
+			pluginsdk.DefaultSupportsNotImplementedReason, resp.GetSupported(), resp.GetReason())
+	}
+
+	// Supports must agree with the cost methods, which reject the resource.
+	if _, err = plugin.GetProjectedCost(ctx, &pbc.GetProjectedCostRequest{Resource: resource}); err == nil {
+		t.Error("Expected GetProjectedCost to reject the resource, got nil error")
+	}
+}
+
 func TestBasePluginNilRequests(t *testing.T) {
 	plugin := pluginsdk.NewBasePlugin("test-plugin")
 	ctx := context.Background()
@@ -498,6 +817,72 @@ func TestValidateRecommendationImpact(t *testing.T) {
 	}
 }
 
+// TestValidateRecommendationImpactStrict tests the ValidateRecommendationImpactStrict function.
+func TestValidateRecommendationImpactStrict(t *testing.T) {
+	testCases := []struct {
+		name          string
+		impact        *pbc.RecommendationImpact
+		errorContains string
+	}{
+		{
+			name: "consistent impact",
+			impact: &pbc.RecommendationImpact{
+				Currency: "USD", EstimatedSavings: 70.08, CurrentCost: 140.16, SavingsPercentage: 50,
+			},
+		},
+		{
+			name: "rounded percentage within tolerance",
+			impact: &pbc.RecommendationImpact{
+				Currency: "USD", EstimatedSavings: 33.33, CurrentCost: 100, SavingsPercentage: 33,
+			},
+		},
+		{
+			name:   "percentage only without baseline",
+			impact: &pbc.RecommendationImpact{Currency: "USD", SavingsPercentage: 25},
+		},
+		{
+			name:   "savings without percentage",
+			impact: &pbc.RecommendationImpact{Currency: "USD", EstimatedSavings: 10, CurrentCost: 100},
+		},
+		{
+			name: "percentage above 100",
+			impact: &pbc.RecommendationImpact{
+				Currency: "USD", EstimatedSavings: 10, SavingsPercentage: 150,
+			},
+			errorContains: "must be between 0 and 100",
+		},
+		{
+			name:          "negative percentage",
+			impact:        &pbc.RecommendationImpact{Currency: "USD", SavingsPercentage: -5},
+			errorContains: "must be between 0 and 100",
+		},
+		{
+			name: "inconsistent savings and percentage",
+			impact: &pbc.RecommendationImpact{
+				Currency: "USD", EstimatedSavings: 10, CurrentCost: 100, SavingsPercentage: 50,
+			},
+			errorContains: "inconsistent",
+		},
+		{
+			name:          "base validation still applies",
+			impact:        &pbc.RecommendationImpact{Currency: "INVALID", SavingsPercentage: 10},
+			errorContains: "ISO 4217",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := pluginsdk.ValidateRecommendationImpactStrict(tc.impact)
+			if tc.errorContains == "" {
+				assert.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tc.errorContains)
+		})
+	}
+}
+
 // =============================================================================
 // Recommendation Filter Tests
 // =============================================================================
@@ -601,6 +986,80 @@ func TestApplyRecommendationFilter(t *testing.T) {
 	}
 }
 
+// TestApplyRecommendationFilter_Thresholds tests the savings floor and confidence threshold.
+func TestApplyRecommendationFilter_Thresholds(t *testing.T) {
+	confidence := func(c float64) *float64 { return &c }
+	recommendations := []*pbc.Recommendation{
+		{
+			Id:              "high-value",
+			Impact:          &pbc.RecommendationImpact{EstimatedSavings: 500, Currency: "USD"},
+			ConfidenceScore: confidence(0.9),
+		},
+		{
+			Id:              "low-value",
+			Impact:          &pbc.RecommendationImpact{EstimatedSavings: 5, Currency: "USD"},
+			ConfidenceScore: confidence(0.95),
+		},
+		{
+			Id:              "low-confidence",
+			Impact:          &pbc.RecommendationImpact{EstimatedSavings: 300, Currency: "USD"},
+			ConfidenceScore: confidence(0.3),
+		},
+		{
+			Id:     "unknown-confidence",
+			Impact: &pbc.RecommendationImpact{EstimatedSavings: 200, Currency: "USD"},
+		},
+		{Id: "no-impact", ConfidenceScore: confidence(0.99)},
+	}
+
+	testCases := []struct {
+		name        string
+		filter      *pbc.RecommendationFilter
+		expectedIDs []string
+	}{
+		{
+			name:        "savings floor drops low-value and missing impact",
+			filter:      &pbc.RecommendationFilter{MinEstimatedSavings: 100},
+			expectedIDs: []string{"high-value", "low-confidence", "unknown-confidence"},
+		},
+		{
+			name:        "unknown confidence passes by default",
+			filter:      &pbc.RecommendationFilter{MinConfidenceScore: 0.8},
+			expectedIDs: []string{"high-value", "low-value", "unknown-confidence", "no-impact"},
+		},
+		{
+			name:        "unknown confidence fails when excluded",
+			filter:      &pbc.RecommendationFilter{MinConfidenceScore: 0.8, ExcludeUnknownConfidence: true},
+			expectedIDs: []string{"high-value", "low-value", "no-impact"},
+		},
+		{
+			name:        "exclude flag alone has no effect",
+			filter:      &pbc.RecommendationFilter{ExcludeUnknownConfidence: true},
+			expectedIDs: []string{"high-value", "low-value", "low-confidence", "unknown-confidence", "no-impact"},
+		},
+		{
+			name: "both thresholds",
+			filter: &pbc.RecommendationFilter{
+				MinEstimatedSavings:      100,
+				MinConfidenceScore:       0.8,
+				ExcludeUnknownConfidence: true,
+			},
+			expectedIDs: []string{"high-value"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			result := pluginsdk.ApplyRecommendationFilter(recommendations, tc.filter)
+			ids := make([]string, len(result))
+			for i, rec := range result {
+				ids[i] = rec.GetId()
+			}
+			assert.Equal(t, tc.expectedIDs, ids)
+		})
+	}
+}
+
 // =============================================================================
 // Pagination Tests
 // =============================================================================
@@ -698,6 +1157,105 @@ func TestPaginateRecommendations_OffsetBeyondRange(t *testing.T) {
 	}
 }
 
+// TestPaginateRecommendations_MaxPageSizeClamping tests that an over-max page size is clamped.
+func TestPaginateRecommendations_MaxPageSizeClamping(t *testing.T) {
+	recs := make([]*pbc.Recommendation, pluginsdk.MaxPageSize+10)
+	for i := range recs {
+		recs[i] = &pbc.Recommendation{Id: strconv.Itoa(i)}
+	}
+	result, nextToken, err := pluginsdk.PaginateRecommendations(recs, 5000, "")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(result) != pluginsdk.MaxPageSize {
+		t.Errorf("expected %d results, got %d", pluginsdk.MaxPageSize, len(result))
+	}
+	if nextToken == "" {
+		t.Error("expected next token but got empty")
+	}
+}
+
+// TestPaginate_Generic tests that Paginate walks any slice type with shared page tokens.
+func TestPaginate_Generic(t *testing.T) {
+	items := []string{"a", "b", "c", "d", "e"}
+
+	var got []string
+	token := ""
+	pages := 0
+	for {
+		page, next, err := pluginsdk.Paginate(items, 2, token)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		got = append(got, page...)
+		pages++
+		if next == "" {
+			break
+		}
+		token = next
+	}
+	if pages != 3 {
+		t.Errorf("expected 3 pages, got %d", pages)
+	}
+	if strings.Join(got, "") != "abcde" {
+		t.Errorf("expected abcde, got %v", got)
+	}
+
+	budgets := []*pbc.Budget{{Id: "b1"}, {Id: "b2"}, {Id: "b3"}}
+	page, next, err := pluginsdk.Paginate(budgets, 0, pluginsdk.EncodePageToken(1))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(page) != 2 || page[0].GetId() != "b2" || next != "" {
+		t.Errorf("expected [b2 b3] with no next token, got %d items and token %q", len(page), next)
+	}
+}
+
+// TestPaginate_EdgeCases tests invalid tokens and out-of-range offsets for Paginate.
+func TestPaginate_EdgeCases(t *testing.T) {
+	if _, _, err := pluginsdk.Paginate([]int{1, 2}, 1, "not-a-token"); err == nil {
+		t.Error("expected error for invalid token")
+	}
+
+	page, next, err := pluginsdk.Paginate([]int{1, 2}, 1, pluginsdk.EncodePageToken(5))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if page == nil || len(page) != 0 || next != "" {
+		t.Errorf("expected empty non-nil page and no token, got %v and %q", page, next)
+	}
+
+	page, next, err = pluginsdk.Paginate[int](nil, 10, "")
+	if err != nil || len(page) != 0 || next != "" {
+		t.Errorf("expected empty page for nil input, got %v, %q, %v", page, next, err)
+	}
+}
+
+// TestClampPageSize tests bounding of requested page sizes.
+func TestClampPageSize(t *testing.T) {
+	tests := []struct {
+		name      string
+		requested int
+		expected  int
+	}{
+		{"zero yields default", 0, 50},
+		{"negative yields default", -5, 50},
+		{"valid value passes through", 100, 100},
+		{"max value passes through", 1000, 1000},
+		{"over-max value is clamped", 5000, 1000},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, pluginsdk.ClampPageSize(tt.requested, 50, 1000))
+		})
+	}
+
+	t.Run("non-positive max disables upper bound", func(t *testing.T) {
+		assert.Equal(t, 5000, pluginsdk.ClampPageSize(5000, 50, 0))
+	})
+}
+
 // TestEncodeDecodePageToken tests encoding and decoding of page tokens.
 func TestEncodeDecodePageToken(t *testing.T) {
 	testCases := []struct {
@@ -787,6 +1345,98 @@ func b64encode(s string) string {
 	return base64.StdEncoding.EncodeToString([]byte(s))
 }
 
+// TestIDCursor_RoundTrip verifies that ID cursors decode to the encoded ID.
+func TestIDCursor_RoundTrip(t *testing.T) {
+	t.Parallel()
+
+	for _, id := range []string{"rec-1", "arn:aws:ec2:us-east-1:123:instance/i-1", "id:nested", ""} {
+		got, err := pluginsdk.DecodeIDCursor(pluginsdk.EncodeIDCursor(id))
+		require.NoError(t, err)
+		assert.Equal(t, id, got)
+	}
+
+	for _, bad := range []string{"!!!", "", b64encode("25"), b64encode("ID:x"), pluginsdk.EncodePageToken(10)} {
+		_, err := pluginsdk.DecodeIDCursor(bad)
+		assert.Error(t, err, "cursor %q", bad)
+	}
+}
+
+// TestPaginateRecommendationsByID_Pages verifies pages follow ID order across the whole list.
+func TestPaginateRecommendationsByID_Pages(t *testing.T) {
+	recs := []*pbc.Recommendation{{Id: "e"}, {Id: "b"}, {Id: "d"}, {Id: "a"}, {Id: "c"}}
+
+	var seen []string
+	token := ""
+	for range len(recs) {
+		page, next, err := pluginsdk.PaginateRecommendationsByID(recs, 2, token)
+		require.NoError(t, err)
+		for _, rec := range page {
+			seen = append(seen, rec.GetId())
+		}
+		if next == "" {
+			break
+		}
+		token = next
+	}
+	assert.Equal(t, []string{"a", "b", "c", "d", "e"}, seen)
+	assert.Equal(t, "e", recs[0].GetId(), "input must not be modified")
+}
+
+// TestPaginateRecommendationsByID_EmptyID verifies a page ending on a recommendation without an
+// ID yields a cursor that resumes after it rather than restarting from the first page.
+func TestPaginateRecommendationsByID_EmptyID(t *testing.T) {
+	recs := []*pbc.Recommendation{{Id: "b"}, {Id: ""}, {Id: "a"}}
+
+	page, next, err := pluginsdk.PaginateRecommendationsByID(recs, 1, "")
+	require.NoError(t, err)
+	require.Len(t, page, 1)
+	assert.Empty(t, page[0].GetId())
+	require.NotEmpty(t, next)
+
+	page, next, err = pluginsdk.PaginateRecommendationsByID(recs, 1, next)
+	require.NoError(t, err)
+	require.Len(t, page, 1)
+	assert.Equal(t, "a", page[0].GetId())
+
+	page, next, err = pluginsdk.PaginateRecommendationsByID(recs, 1, next)
+	require.NoError(t, err)
+	require.Len(t, page, 1)
+	assert.Equal(t, "b", page[0].GetId())
+	assert.Empty(t, next)
+}
+
+// TestPaginateRecommendationsByID_ListMutation verifies no item is skipped or repeated when the
+// list changes between pages, including when the last-seen item is removed.
+func TestPaginateRecommendationsByID_ListMutation(t *testing.T) {
+	recs := []*pbc.Recommendation{{Id: "a"}, {Id: "b"}, {Id: "c"}, {Id: "d"}, {Id: "e"}}
+
+	page, next, err := pluginsdk.PaginateRecommendationsByID(recs, 2, "")
+	require.NoError(t, err)
+	require.Len(t, page, 2)
+	require.NotEmpty(t, next)
+
+	// "a" and the last-seen "b" are dismissed; "bb" is added after the cursor.
+	mutated := []*pbc.Recommendation{{Id: "c"}, {Id: "bb"}, {Id: "e"}, {Id: "d"}}
+	page, next, err = pluginsdk.PaginateRecommendationsByID(mutated, 2, next)
+	require.NoError(t, err)
+	assert.Equal(t, "bb", page[0].GetId())
+	assert.Equal(t, "c", page[1].GetId())
+
+	page, next, err = pluginsdk.PaginateRecommendationsByID(mutated, 2, next)
+	require.NoError(t, err)
+	require.Len(t, page, 2)
+	assert.Equal(t, "d", page[0].GetId())
+	assert.Equal(t, "e", page[1].GetId())
+	assert.Empty(t, next)
+}
+
+// TestPaginateRecommendationsByID_InvalidToken verifies malformed cursors are rejected.
+func TestPaginateRecommendationsByID_InvalidToken(t *testing.T) {
+	_, _, err := pluginsdk.PaginateRecommendationsByID(testRecommendations25(), 10, "not-base64!")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid page_token")
+}
+
 // =============================================================================
 // Summary Calculation Tests
 // =============================================================================
@@ -855,6 +1505,40 @@ func TestCalculateRecommendationSummaryEmpty(t *testing.T) {
 	}
 }
 
+// TestCalculateRecommendationSummaryInvalidPeriod tests that invalid periods are normalized to monthly.
+func TestCalculateRecommendationSummaryInvalidPeriod(t *testing.T) {
+	summary := pluginsdk.CalculateRecommendationSummary(nil, "fortnightly")
+	if summary.GetProjectionPeriod() != "monthly" {
+		t.Errorf("expected monthly, got %q", summary.GetProjectionPeriod())
+	}
+
+	summary = pluginsdk.CalculateRecommendationSummary(nil, "annual")
+	if summary.GetProjectionPeriod() != "annual" {
+		t.Errorf("expected annual, got %q", summary.GetProjectionPeriod())
+	}
+}
+
+// TestProjectionPeriodFactor tests conversion factors from monthly amounts to each period.
+func TestProjectionPeriodFactor(t *testing.T) {
+	tests := []struct {
+		period string
+		want   float64
+		ok     bool
+	}{
+		{"daily", 24.0 / 730, true},
+		{"monthly", 1, true},
+		{"", 1, true},
+		{"annual", 12, true},
+		{"weekly", 0, false},
+	}
+	for _, tt := range tests {
+		got, ok := pluginsdk.ProjectionPeriodFactor(tt.period)
+		if ok != tt.ok || math.Abs(got-tt.want) > 1e-9 {
+			t.Errorf("ProjectionPeriodFactor(%q) = (%v, %v), want (%v, %v)", tt.period, got, ok, tt.want, tt.ok)
+		}
+	}
+}
+
 // TestCalculateRecommendationSummaryMixedCurrency tests summary calculation with mixed currencies.
 func TestCalculateRecommendationSummaryMixedCurrency(t *testing.T) {
 	// Test that mixed currencies result in empty currency field
@@ -1063,6 +1747,64 @@ func TestNewActualCostResponseRequiredForUnsupportedType(t *testing.T) {
 	}
 }
 
+func TestBuildActualCostResponse(t *testing.T) {
+	results := []*pbc.ActualCostResult{{Cost: 12.5, Source: "aws-ce"}}
+	retryAfter := 30 * time.Second
+	rateLimited := pricing.NewTransientError(pricing.ErrorCodeRateLimited, "throttled", &retryAfter)
+
+	tests := []struct {
+		name        string
+		results     []*pbc.ActualCostResult
+		upstreamErr error
+		wantHint    pbc.FallbackHint
+		wantResults int
+	}{
+		{"results present", results, nil, pbc.FallbackHint_FALLBACK_HINT_NONE, 1},
+		{"results present override transient error", results, rateLimited, pbc.FallbackHint_FALLBACK_HINT_NONE, 1},
+		{"no data found", nil, nil, pbc.FallbackHint_FALLBACK_HINT_RECOMMENDED, 0},
+		{"transient error", nil, rateLimited, pbc.FallbackHint_FALLBACK_HINT_RECOMMENDED, 0},
+		{
+			"wrapped transient error",
+			[]*pbc.ActualCostResult{},
+			fmt.Errorf("query billing API: %w", rateLimited),
+			pbc.FallbackHint_FALLBACK_HINT_RECOMMENDED,
+			0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := pluginsdk.BuildActualCostResponse(tt.results, tt.upstreamErr)
+			require.NoError(t, err)
+			require.NotNil(t, resp)
+			assert.Equal(t, tt.wantHint, resp.GetFallbackHint())
+			assert.Len(t, resp.GetResults(), tt.wantResults)
+		})
+	}
+}
+
+func TestBuildActualCostResponsePropagatesErrors(t *testing.T) {
+	results := []*pbc.ActualCostResult{{Cost: 12.5, Source: "aws-ce"}}
+	tests := []struct {
+		name        string
+		results     []*pbc.ActualCostResult
+		upstreamErr error
+	}{
+		{"permanent error", nil, pricing.NewPermanentError(pricing.ErrorCodeInvalidResource, "bad resource")},
+		{"permanent error with results", results, pricing.NewPermanentError(pricing.ErrorCodeDataCorruption, "bad data")},
+		{"configuration error", nil, pricing.NewConfigurationError(pricing.ErrorCodeMissingAPIKey, "no key")},
+		{"unclassified error", nil, errors.New("unexpected failure")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := pluginsdk.BuildActualCostResponse(tt.results, tt.upstreamErr)
+			require.ErrorIs(t, err, tt.upstreamErr)
+			assert.Nil(t, resp, "errors must not produce a hinted response")
+		})
+	}
+}
+
 // TestGetActualCostReturnsErrorForAPIFailures tests that GetActualCost should return error for API failures, not hint.
 func TestGetActualCostReturnsErrorForAPIFailures(t *testing.T) {
 	// This test documents the expected behavior: errors should be returned
@@ -1359,6 +2101,35 @@ func TestValidateActualCostResponseStopsAtFirstError(t *testing.T) {
 	}
 }
 
+// TestValidateActualCostResponseWithAllErrors verifies that WithAllErrors reports
+// every invalid result instead of stopping at the first.
+func TestValidateActualCostResponseWithAllErrors(t *testing.T) {
+	resp := &pbc.GetActualCostResponse{
+		Results: []*pbc.ActualCostResult{
+			{Cost: 10.0, Source: "valid-source"},
+			{Cost: -5.0, Source: "test"},
+			nil,
+			{Cost: -10.0, Source: ""},
+		},
+	}
+
+	err := pluginsdk.ValidateActualCostResponse(resp, pluginsdk.WithAllErrors())
+	require.Error(t, err)
+
+	var errs *pricing.ValidationErrors
+	require.ErrorAs(t, err, &errs)
+	assert.Len(t, errs.Unwrap(), 4)
+	assert.Equal(t, []string{
+		"results[1].cost cannot be negative: -5.000000",
+		"results[2] cannot be nil",
+		"results[3].cost cannot be negative: -10.000000",
+		"results[3].source cannot be empty",
+	}, strings.Split(err.Error(), "\n"))
+
+	valid := &pbc.GetActualCostResponse{Results: []*pbc.ActualCostResult{{Cost: 1, Source: "aws"}}}
+	assert.NoError(t, pluginsdk.ValidateActualCostResponse(valid, pluginsdk.WithAllErrors()))
+}
+
 // =============================================================================
 // SortRecommendations Tests
 // =============================================================================
@@ -1439,6 +2210,159 @@ func TestSortRecommendations(t *testing.T) {
 	}
 }
 
+// TestLimitPerResource tests trimming recommendations to the top-N by savings per resource.
+func TestLimitPerResource(t *testing.T) {
+	rec := func(id, resourceID string, savings float64) *pbc.Recommendation {
+		return &pbc.Recommendation{
+			Id:       id,
+			Resource: &pbc.ResourceRecommendationInfo{Id: resourceID, Provider: "aws"},
+			Impact:   &pbc.RecommendationImpact{EstimatedSavings: savings, Currency: "USD"},
+		}
+	}
+	ids := func(recs []*pbc.Recommendation) []string {
+		out := make([]string, len(recs))
+		for i, r := range recs {
+			out[i] = r.GetId()
+		}
+		return out
+	}
+
+	input := []*pbc.Recommendation{
+		rec("a-10", "i-a", 10),
+		rec("b-5", "i-b", 5),
+		rec("a-50", "i-a", 50),
+		rec("a-30", "i-a", 30),
+		nil,
+		rec("b-20", "i-b", 20),
+	}
+
+	t.Run("trims to highest-savings N per resource", func(t *testing.T) {
+		got := pluginsdk.LimitPerResource(input, 2)
+		assert.Equal(t, []string{"a-50", "a-30", "b-20", "b-5"}, ids(got))
+	})
+
+	t.Run("resources under the limit are unchanged", func(t *testing.T) {
+		got := pluginsdk.LimitPerResource(input, 3)
+		assert.Equal(t, []string{"a-50", "a-30", "a-10", "b-20", "b-5"}, ids(got))
+	})
+
+	t.Run("ordering within a resource is by savings descending", func(t *testing.T) {
+		got := pluginsdk.LimitPerResource(input, 10)
+		require.Len(t, got, 5)
+		for i := 1; i < 3; i++ {
+			assert.GreaterOrEqual(t,
+				got[i-1].GetImpact().GetEstimatedSavings(), got[i].GetImpact().GetEstimatedSavings())
+		}
+	})
+
+	t.Run("zero limit returns empty", func(t *testing.T) {
+		got := pluginsdk.LimitPerResource(input, 0)
+		require.NotNil(t, got)
+		assert.Empty(t, got)
+	})
+
+	t.Run("same resource ID on different providers is kept separate", func(t *testing.T) {
+		gcp := &pbc.Recommendation{
+			Id:       "gcp-1",
+			Resource: &pbc.ResourceRecommendationInfo{Id: "i-a", Provider: "gcp"},
+			Impact:   &pbc.RecommendationImpact{EstimatedSavings: 1, Currency: "USD"},
+		}
+		got := pluginsdk.LimitPerResource([]*pbc.Recommendation{rec("a-10", "i-a", 10), gcp}, 1)
+		assert.Equal(t, []string{"a-10", "gcp-1"}, ids(got))
+	})
+}
+
+func TestDeduplicateRecommendations(t *testing.T) {
+	rightsize := pbc.RecommendationActionType_RECOMMENDATION_ACTION_TYPE_RIGHTSIZE
+	terminate := pbc.RecommendationActionType_RECOMMENDATION_ACTION_TYPE_TERMINATE
+	rec := func(
+		id, resourceID string,
+		action pbc.RecommendationActionType,
+		savings float64,
+		description string,
+	) *pbc.Recommendation {
+		return &pbc.Recommendation{
+			Id:          id,
+			ActionType:  action,
+			Description: description,
+			Resource:    &pbc.ResourceRecommendationInfo{Id: resourceID, Provider: "aws"},
+			Impact:      &pbc.RecommendationImpact{EstimatedSavings: savings, Currency: "USD"},
+		}
+	}
+	ids := func(recs []*pbc.Recommendation) []string {
+		out := make([]string, len(recs))
+		for i, r := range recs {
+			out[i] = r.GetId()
+		}
+		return out
+	}
+
+	t.Run("keeps highest savings in input order and merges descriptions", func(t *testing.T) {
+		input := []*pbc.Recommendation{
+			rec("a-low", "i-a", rightsize, 10, "Downsize to t3.small"),
+			rec("b", "i-b", rightsize, 5, "Downsize to t3.nano"),
+			nil,
+			rec("a-high", "i-a", rightsize, 50, "CPU below 5% for 14 days"),
+			rec("a-terminate", "i-a", terminate, 70, "Idle instance"),
+			rec("a-dup", "i-a", rightsize, 20, "Downsize to t3.small"),
+		}
+
+		got := pluginsdk.DeduplicateRecommendations(input)
+		assert.Equal(t, []string{"b", "a-high", "a-terminate"}, ids(got))
+		assert.Equal(t, "CPU below 5% for 14 days; Downsize to t3.small", got[1].GetDescription())
+		assert.Equal(t, "Downsize to t3.nano", got[0].GetDescription())
+		assert.Same(t, input[1], got[0], "unmerged recommendations are returned as is")
+
+		assert.Equal(t, "CPU below 5% for 14 days", input[3].GetDescription(), "input must not be modified")
+	})
+
+	t.Run("kept recommendation without description takes the others", func(t *testing.T) {
+		got := pluginsdk.DeduplicateRecommendations([]*pbc.Recommendation{
+			rec("first", "i-a", rightsize, 10, "Downsize"),
+			rec("second", "i-a", rightsize, 10, ""),
+			rec("best", "i-a", rightsize, 30, ""),
+		})
+		require.Len(t, got, 1)
+		assert.Equal(t, "best", got[0].GetId())
+		assert.Equal(t, "Downsize", got[0].GetDescription())
+	})
+
+	t.Run("ties keep the first", func(t *testing.T) {
+		got := pluginsdk.DeduplicateRecommendations([]*pbc.Recommendation{
+			rec("first", "i-a", rightsize, 10, ""),
+			rec("second", "i-a", rightsize, 10, ""),
+		})
+		assert.Equal(t, []string{"first"}, ids(got))
+	})
+
+	t.Run("same resource ID on different providers is kept separate", func(t *testing.T) {
+		gcp := rec("gcp", "i-a", rightsize, 10, "")
+		gcp.Resource.Provider = "gcp"
+		got := pluginsdk.DeduplicateRecommendations([]*pbc.Recommendation{rec("aws", "i-a", rightsize, 10, ""), gcp})
+		assert.Equal(t, []string{"aws", "gcp"}, ids(got))
+	})
+
+	t.Run("recommendations without resource ID are not merged", func(t *testing.T) {
+		noResource := &pbc.Recommendation{Id: "no-resource", ActionType: rightsize, Description: "Review"}
+		input := []*pbc.Recommendation{
+			rec("empty-1", "", rightsize, 10, "Downsize"),
+			rec("empty-2", "", rightsize, 20, "Downsize"),
+			noResource,
+			{Id: "no-resource-2", ActionType: rightsize},
+		}
+		got := pluginsdk.DeduplicateRecommendations(input)
+		assert.Equal(t, []string{"empty-1", "empty-2", "no-resource", "no-resource-2"}, ids(got))
+		assert.Same(t, noResource, got[2])
+		assert.Equal(t, "Downsize", got[0].GetDescription())
+	})
+
+	t.Run("empty input", func(t *testing.T) {
+		got := pluginsdk.DeduplicateRecommendations(nil)
+		require.NotNil(t, got)
+		assert.Empty(t, got)
+	})
+}
+
 // TestSortRecommendationsDoesNotModifyOriginal tests that sorting does not modify the original slice.
 func TestSortRecommendationsDoesNotModifyOriginal(t *testing.T) {
 	rec100 := &pbc.Recommendation{
@@ -2081,6 +3005,44 @@ func TestWithPredictionIntervalPanics(t *testing.T) {
 	})
 }
 
+// TestNewGetProjectedCostResponsePricingTransparency tests the billing mode, rate, unit,
+// assumptions, and pricing tier options.
+func TestNewGetProjectedCostResponsePricingTransparency(t *testing.T) {
+	t.Parallel()
+
+	tiers := []*pbc.PricingTier{
+		{MinQuantity: 0, MaxQuantity: 50000, RatePerUnit: 0.023},
+		{MinQuantity: 50000, RatePerUnit: 0.022},
+	}
+	resp := pluginsdk.NewGetProjectedCostResponse(
+		pluginsdk.WithProjectedCostDetails(0.023, "USD", 2.30, "standard storage"),
+		pluginsdk.WithBillingMode(pricing.Tiered),
+		pluginsdk.WithRatePerUnit(0.023),
+		pluginsdk.WithUnit("GB-month"),
+		pluginsdk.WithAssumptions([]string{"100 GB stored", "first pricing tier"}),
+		pluginsdk.WithPricingTiers(tiers),
+	)
+
+	if resp.GetBillingMode() != "tiered" {
+		t.Errorf("expected billing mode tiered, got %s", resp.GetBillingMode())
+	}
+	if resp.GetRatePerUnit() != 0.023 {
+		t.Errorf("expected rate per unit 0.023, got %f", resp.GetRatePerUnit())
+	}
+	if resp.GetUnit() != "GB-month" {
+		t.Errorf("expected unit GB-month, got %s", resp.GetUnit())
+	}
+	if len(resp.GetAssumptions()) != 2 || resp.GetAssumptions()[0] != "100 GB stored" {
+		t.Errorf("unexpected assumptions: %v", resp.GetAssumptions())
+	}
+	if len(resp.GetPricingTiers()) != 2 || resp.GetPricingTiers()[1].GetRatePerUnit() != 0.022 {
+		t.Errorf("unexpected pricing tiers: %v", resp.GetPricingTiers())
+	}
+	if resp.GetCostPerMonth() != 2.30 {
+		t.Errorf("expected cost per month 2.30, got %f", resp.GetCostPerMonth())
+	}
+}
+
 // TestWithPredictionIntervalCombinedWithOtherOptions tests combining prediction interval with other options.
 func TestWithPredictionIntervalCombinedWithOtherOptions(t *testing.T) {
 	t.Parallel()
@@ -2,6 +2,7 @@ package pluginsdk
 
 import (
 	"context"
+	"fmt"
 	"io"
 	"os"
 	"path/filepath"
@@ -12,6 +13,8 @@ import (
 	"github.com/rs/zerolog"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
 
 	"github.com/rshade/finfocus-spec/sdk/go/pricing"
 )
@@ -19,11 +22,20 @@ import (
 // contextKey is the type for context keys to avoid collisions.
 type contextKey string
 
-const traceIDKey contextKey = "finfocus-trace-id"
+const (
+	traceIDKey contextKey = "finfocus-trace-id"
+	spanIDKey  contextKey = "finfocus-span-id"
+)
 
 // TraceIDMetadataKey is the gRPC metadata header for trace ID propagation.
 const TraceIDMetadataKey = "x-finfocus-trace-id"
 
+// SpanIDMetadataKey is the gRPC metadata header for span ID propagation.
+const SpanIDMetadataKey = "x-finfocus-span-id"
+
+// RedactedValue is the placeholder RedactedAttributes logs in place of scalar attribute values.
+const RedactedValue = "[REDACTED]"
+
 // Log file configuration constants.
 const (
 	// LogFilePermissions is the default file permission mode for created log files (rw-r--r--).
@@ -47,6 +59,7 @@ const (
 // Standard field names for structured logging consistency across plugins.
 const (
 	FieldTraceID       = "trace_id"
+	FieldSpanID        = "span_id"
 	FieldComponent     = "component"
 	FieldOperation     = "operation"
 	FieldDurationMs    = "duration_ms"
@@ -59,6 +72,11 @@ const (
 	FieldCostMonthly   = "cost_monthly"
 	FieldAdapter       = "adapter"
 	FieldErrorCode     = "error_code"
+	FieldSuccess       = "success"
+
+	// Redacted request fields; see LogRedactedRequest.
+	FieldAttributes     = "attributes"
+	FieldAttributeCount = "attribute_count"
 
 	// GetRecommendations-specific fields.
 	FieldRecommendationCount = "recommendation_count"
@@ -75,6 +93,12 @@ const (
 	FieldBudgetsWarning  = "budgets_warning"
 	FieldBudgetsCritical = "budgets_critical"
 	FieldBudgetsExceeded = "budgets_exceeded"
+
+	// ResponseCache-specific fields.
+	FieldCacheHits     = "cache_hits"
+	FieldCacheMisses   = "cache_misses"
+	FieldCacheHitRatio = "cache_hit_ratio"
+	FieldCacheEntries  = "cache_entries"
 )
 
 //nolint:gochecknoglobals // Intentional singleton for log file handle reuse (process lifetime)
@@ -232,6 +256,16 @@ func newDefaultLogger() zerolog.Logger {
 		Logger()
 }
 
+// Log output formats accepted by NewPluginLoggerWithFormat and FINFOCUS_LOG_FORMAT.
+const (
+	// LogFormatJSON writes one JSON object per line (the default).
+	LogFormatJSON = "json"
+	// LogFormatConsole writes human-readable, uncolored lines for local development.
+	LogFormatConsole = "console"
+	// LogFormatText is an alias for LogFormatConsole.
+	LogFormatText = "text"
+)
+
 // NewPluginLogger creates a configured zerolog logger for plugins.
 //
 // Parameters:
@@ -241,26 +275,61 @@ func newDefaultLogger() zerolog.Logger {
 //   - w: Output writer (nil defaults to os.Stderr)
 //
 // Returns a logger with plugin_name and plugin_version fields pre-configured.
+// Output is JSON; use NewPluginLoggerWithFormat for human-readable output.
 func NewPluginLogger(pluginName, version string, level zerolog.Level, w io.Writer) zerolog.Logger {
+	return NewPluginLoggerWithFormat(pluginName, version, level, w, LogFormatJSON)
+}
+
+// NewPluginLoggerWithFormat creates a plugin logger like NewPluginLogger that writes in the
+// given format: LogFormatJSON (or empty) for JSON, or LogFormatConsole (or LogFormatText) for
+// human-readable lines. An unknown format falls back to JSON and logs a warning.
+//
+// Example:
+//
+//	logger := pluginsdk.NewPluginLoggerWithFormat(
+//	    "my-plugin", "v1.0.0", zerolog.InfoLevel, pluginsdk.NewLogWriter(), pluginsdk.GetLogFormat(),
+//	)
+func NewPluginLoggerWithFormat(
+	pluginName, version string,
+	level zerolog.Level,
+	w io.Writer,
+	format string,
+) zerolog.Logger {
 	if w == nil {
 		w = os.Stderr
 	}
 
-	return zerolog.New(w).
+	unknownFormat := false
+	switch format {
+	case "", LogFormatJSON:
+	case LogFormatConsole, LogFormatText:
+		w = zerolog.ConsoleWriter{Out: w, NoColor: true, TimeFormat: time.RFC3339}
+	default:
+		unknownFormat = true
+	}
+
+	logger := zerolog.New(w).
 		Level(level).
 		With().
 		Timestamp().
 		Str(FieldPluginName, pluginName).
 		Str(FieldPluginVersion, version).
 		Logger()
+	if unknownFormat {
+		logger.Warn().
+			Str("log_format", format).
+			Msg("unknown log format, using json")
+	}
+	return logger
 }
 
 // TracingUnaryServerInterceptor returns a gRPC server interceptor that extracts
-// trace_id from incoming request metadata, validates it, and adds it to the request context.
+// trace_id and span_id from incoming request metadata, validates them, and adds them to the
+// request context.
 //
-// The interceptor looks for the TraceIDMetadataKey header. If the trace_id is missing or
-// invalid, a new valid trace_id is generated. The validated or generated trace_id is
-// stored in the context for retrieval via TraceIDFromContext.
+// The interceptor looks for the TraceIDMetadataKey and SpanIDMetadataKey headers. If either
+// ID is missing or invalid, a new valid ID is generated. The validated or generated IDs are
+// stored in the context for retrieval via TraceIDFromContext and SpanIDFromContext.
 func TracingUnaryServerInterceptor() grpc.UnaryServerInterceptor {
 	return func(
 		ctx context.Context,
@@ -268,30 +337,79 @@ func TracingUnaryServerInterceptor() grpc.UnaryServerInterceptor {
 		_ *grpc.UnaryServerInfo,
 		handler grpc.UnaryHandler,
 	) (interface{}, error) {
-		var traceID string
+		var traceID, spanID string
 
 		if md, ok := metadata.FromIncomingContext(ctx); ok {
 			if values := md.Get(TraceIDMetadataKey); len(values) > 0 {
 				traceID = values[0]
 			}
-		}
-
-		// Validate the trace ID; generate a new one if invalid or missing
-		if traceID == "" || pricing.ValidateTraceID(traceID) != nil {
-			var err error
-			traceID, err = GenerateTraceID()
-			if err != nil {
-				// If generation fails, proceed with empty trace ID
-				// This maintains request flow even in extreme failure cases
-				traceID = ""
+			if values := md.Get(SpanIDMetadataKey); len(values) > 0 {
+				spanID = values[0]
 			}
 		}
 
-		ctx = ContextWithTraceID(ctx, traceID)
+		ctx = ContextWithTraceID(ctx, validOrGeneratedID(traceID, pricing.ValidateTraceID, GenerateTraceID))
+		ctx = ContextWithSpanID(ctx, validOrGeneratedID(spanID, pricing.ValidateSpanID, GenerateSpanID))
 		return handler(ctx, req)
 	}
 }
 
+// TracingUnaryClientInterceptor returns a gRPC client interceptor that propagates the trace_id
+// and span_id from the call context to the server in the TraceIDMetadataKey and
+// SpanIDMetadataKey headers. It is the client-side companion of TracingUnaryServerInterceptor,
+// so both ends of a call share a trace_id and span_id.
+//
+// The IDs are read via TraceIDFromContext and SpanIDFromContext. If either is missing or
+// invalid, a new valid ID is generated. Any values for these keys already in the outgoing
+// metadata are replaced.
+//
+// Usage:
+//
+//	conn, err := grpc.NewClient(addr,
+//	    grpc.WithTransportCredentials(insecure.NewCredentials()),
+//	    grpc.WithUnaryInterceptor(pluginsdk.TracingUnaryClientInterceptor()),
+//	)
+func TracingUnaryClientInterceptor() grpc.UnaryClientInterceptor {
+	return func(
+		ctx context.Context,
+		method string,
+		req, reply interface{},
+		cc *grpc.ClientConn,
+		invoker grpc.UnaryInvoker,
+		opts ...grpc.CallOption,
+	) error {
+		md, ok := metadata.FromOutgoingContext(ctx)
+		if ok {
+			md = md.Copy()
+		} else {
+			md = metadata.MD{}
+		}
+
+		// If generation fails, send the call without that ID rather than failing it
+		if traceID := validOrGeneratedID(TraceIDFromContext(ctx), pricing.ValidateTraceID, GenerateTraceID); traceID != "" {
+			md.Set(TraceIDMetadataKey, traceID)
+		}
+		if spanID := validOrGeneratedID(SpanIDFromContext(ctx), pricing.ValidateSpanID, GenerateSpanID); spanID != "" {
+			md.Set(SpanIDMetadataKey, spanID)
+		}
+
+		return invoker(metadata.NewOutgoingContext(ctx, md), method, req, reply, cc, opts...)
+	}
+}
+
+// validOrGeneratedID returns id if it is present and valid, or a newly generated ID otherwise.
+// If generation fails it returns "", so that requests still flow in extreme failure cases.
+func validOrGeneratedID(id string, validate func(string) error, generate func() (string, error)) string {
+	if id != "" && validate(id) == nil {
+		return id
+	}
+	generated, err := generate()
+	if err != nil {
+		return ""
+	}
+	return generated
+}
+
 // TraceIDFromContext extracts the trace ID from the given context.
 //
 // Returns empty string if no trace ID is present in the context.
@@ -312,6 +430,24 @@ func ContextWithTraceID(ctx context.Context, traceID string) context.Context {
 	return context.WithValue(ctx, traceIDKey, traceID)
 }
 
+// SpanIDFromContext extracts the span ID from the given context.
+//
+// Returns empty string if no span ID is present in the context.
+func SpanIDFromContext(ctx context.Context) string {
+	if spanID, ok := ctx.Value(spanIDKey).(string); ok {
+		return spanID
+	}
+	return ""
+}
+
+// ContextWithSpanID returns a new context with the span ID stored.
+//
+// Use it to give a sub-operation its own span ID from GenerateSpanID while keeping the
+// request's trace ID.
+func ContextWithSpanID(ctx context.Context, spanID string) context.Context {
+	return context.WithValue(ctx, spanIDKey, spanID)
+}
+
 // LogOperation returns a function that logs the operation duration when called.
 //
 // Usage:
@@ -330,3 +466,95 @@ func LogOperation(logger zerolog.Logger, operation string) func() {
 			Msg("operation completed")
 	}
 }
+
+// LogOperationWithResult returns a function that logs the operation duration and outcome when
+// called with the operation's error.
+//
+// Usage:
+//
+//	done := LogOperationWithResult(logger, "GetProjectedCost")
+//	resp, err := fetchPrice(ctx, req)
+//	done(err)
+//
+// A nil error logs "operation completed" at Info level; a non-nil error logs "operation failed"
+// at Error level with the error. Both include FieldOperation, FieldDurationMs, and FieldSuccess.
+// Failures also set FieldErrorCode to the PluginError code (e.g., "RATE_LIMITED") when err
+// carries a *pricing.PluginError, or else to the gRPC status code (e.g., "NotFound") when err is
+// a gRPC status error.
+func LogOperationWithResult(logger zerolog.Logger, operation string) func(err error) {
+	start := time.Now()
+	return func(err error) {
+		if err == nil {
+			logger.Info().
+				Str(FieldOperation, operation).
+				Int64(FieldDurationMs, time.Since(start).Milliseconds()).
+				Bool(FieldSuccess, true).
+				Msg("operation completed")
+			return
+		}
+
+		event := logger.Error().
+			Err(err).
+			Str(FieldOperation, operation).
+			Int64(FieldDurationMs, time.Since(start).Milliseconds()).
+			Bool(FieldSuccess, false)
+		if pluginErr, ok := pluginErrorOf(err); ok {
+			event = event.Str(FieldErrorCode, string(pluginErr.Code))
+		} else if st, ok := status.FromError(err); ok {
+			event = event.Str(FieldErrorCode, st.Code().String())
+		}
+		event.Msg("operation failed")
+	}
+}
+
+// RedactedAttributes returns a copy of attrs that keeps the attribute names but hides their
+// values, so resource attributes can be logged without exposing credentials or other secrets
+// they may carry. Scalar and null values become RedactedValue; lists and nested structs are
+// replaced by their size (e.g., "[REDACTED: 3 items]", "[REDACTED: 2 fields]"). Returns an empty
+// map for nil attrs.
+//
+// Example:
+//
+//	logger.Debug().
+//	    Interface(pluginsdk.FieldAttributes, pluginsdk.RedactedAttributes(req.GetAttributes())).
+//	    Msg("estimating cost")
+func RedactedAttributes(attrs *structpb.Struct) map[string]interface{} {
+	redacted := make(map[string]interface{}, len(attrs.GetFields()))
+	for key, value := range attrs.GetFields() {
+		switch kind := value.GetKind().(type) {
+		case *structpb.Value_ListValue:
+			redacted[key] = fmt.Sprintf("[REDACTED: %d items]", len(kind.ListValue.GetValues()))
+		case *structpb.Value_StructValue:
+			redacted[key] = fmt.Sprintf("[REDACTED: %d fields]", len(kind.StructValue.GetFields()))
+		default:
+			redacted[key] = RedactedValue
+		}
+	}
+	return redacted
+}
+
+// LogRedactedRequest logs an incoming request at Info level with the trace ID from ctx, the
+// operation, the resource type, and the request's attributes passed through RedactedAttributes
+// (FieldAttributes) together with their count (FieldAttributeCount). Use it instead of logging
+// attributes directly so attribute values never reach the logs.
+//
+// Example:
+//
+//	func (p *MyPlugin) EstimateCost(ctx context.Context, req *pbc.EstimateCostRequest) (...) {
+//	    pluginsdk.LogRedactedRequest(ctx, p.logger, "EstimateCost", req.GetResourceType(), req.GetAttributes())
+//	    ...
+//	}
+func LogRedactedRequest(
+	ctx context.Context,
+	logger zerolog.Logger,
+	operation, resourceType string,
+	attrs *structpb.Struct,
+) {
+	logger.Info().
+		Str(FieldTraceID, TraceIDFromContext(ctx)).
+		Str(FieldOperation, operation).
+		Str(FieldResourceType, resourceType).
+		Int(FieldAttributeCount, len(attrs.GetFields())).
+		Interface(FieldAttributes, RedactedAttributes(attrs)).
+		Msg("request received")
+}
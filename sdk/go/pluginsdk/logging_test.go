@@ -4,17 +4,23 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"os"
 	"path/filepath"
+	"strings"
 	"sync"
 	"testing"
 	"time"
 
 	"github.com/rs/zerolog"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
 
 	"github.com/rshade/finfocus-spec/sdk/go/pluginsdk"
+	"github.com/rshade/finfocus-spec/sdk/go/pricing"
 )
 
 // TestNewPluginLogger_DefaultStderr tests NewPluginLogger with default stderr.
@@ -96,6 +102,66 @@ func TestNewPluginLogger_NilWriter(t *testing.T) {
 	t.Log("Logger with nil writer did not panic")
 }
 
+// TestNewPluginLoggerWithFormat_Console tests human-readable console output.
+func TestNewPluginLoggerWithFormat_Console(t *testing.T) {
+	for _, format := range []string{pluginsdk.LogFormatConsole, pluginsdk.LogFormatText} {
+		t.Run(format, func(t *testing.T) {
+			var buf bytes.Buffer
+			logger := pluginsdk.NewPluginLoggerWithFormat("test-plugin", "v1.0.0", zerolog.InfoLevel, &buf, format)
+
+			logger.Info().Msg("test message")
+
+			output := buf.String()
+			if json.Valid(bytes.TrimSpace(buf.Bytes())) {
+				t.Errorf("Expected console output, got JSON: %s", output)
+			}
+			for _, want := range []string{"INF", "test message", "plugin_name=test-plugin", "plugin_version=v1.0.0"} {
+				if !bytes.Contains(buf.Bytes(), []byte(want)) {
+					t.Errorf("Expected %q in output, got: %s", want, output)
+				}
+			}
+		})
+	}
+}
+
+// TestNewPluginLoggerWithFormat_JSON tests that json and empty formats write JSON.
+func TestNewPluginLoggerWithFormat_JSON(t *testing.T) {
+	for _, format := range []string{pluginsdk.LogFormatJSON, ""} {
+		var buf bytes.Buffer
+		logger := pluginsdk.NewPluginLoggerWithFormat("test-plugin", "v1.0.0", zerolog.InfoLevel, &buf, format)
+
+		logger.Info().Msg("test message")
+
+		if !json.Valid(bytes.TrimSpace(buf.Bytes())) {
+			t.Errorf("format %q: expected JSON output, got: %s", format, buf.String())
+		}
+	}
+}
+
+// TestNewPluginLoggerWithFormat_Unknown tests that unknown formats fall back to JSON with a warning.
+func TestNewPluginLoggerWithFormat_Unknown(t *testing.T) {
+	var buf bytes.Buffer
+	logger := pluginsdk.NewPluginLoggerWithFormat("test-plugin", "v1.0.0", zerolog.InfoLevel, &buf, "yaml")
+
+	lines := bytes.Split(bytes.TrimSpace(buf.Bytes()), []byte("\n"))
+	if len(lines) != 1 {
+		t.Fatalf("Expected one warning line, got: %s", buf.String())
+	}
+	var entry map[string]any
+	if err := json.Unmarshal(lines[0], &entry); err != nil {
+		t.Fatalf("Expected JSON warning, got: %s", buf.String())
+	}
+	if entry["level"] != "warn" || entry["log_format"] != "yaml" {
+		t.Errorf("Expected warn entry naming the format, got: %v", entry)
+	}
+
+	buf.Reset()
+	logger.Info().Msg("test message")
+	if !json.Valid(bytes.TrimSpace(buf.Bytes())) {
+		t.Errorf("Expected JSON output after fallback, got: %s", buf.String())
+	}
+}
+
 // TestContextWithTraceID_TraceIDFromContext tests ContextWithTraceID/TraceIDFromContext.
 func TestContextWithTraceID_TraceIDFromContext(t *testing.T) {
 	ctx := context.Background()
@@ -266,6 +332,207 @@ func TestTracingUnaryServerInterceptor_MultipleTraceIDs(t *testing.T) {
 	}
 }
 
+// outgoingTraceIDs runs the client interceptor with ctx and returns the trace IDs in the
+// outgoing metadata seen by the invoker, along with that metadata.
+func outgoingTraceIDs(ctx context.Context, t *testing.T) ([]string, metadata.MD) {
+	t.Helper()
+	interceptor := pluginsdk.TracingUnaryClientInterceptor()
+
+	var sent metadata.MD
+	invoker := func(ctx context.Context, _ string, _, _ interface{}, _ *grpc.ClientConn, _ ...grpc.CallOption) error {
+		sent, _ = metadata.FromOutgoingContext(ctx)
+		return nil
+	}
+
+	if err := interceptor(ctx, "/finfocus.v1.CostSourceService/Name", nil, nil, nil, invoker); err != nil {
+		t.Fatalf("Interceptor failed: %v", err)
+	}
+	return sent.Get(pluginsdk.TraceIDMetadataKey), sent
+}
+
+// TestTracingUnaryClientInterceptor_PropagatesTraceID tests the context trace ID is sent as metadata.
+func TestTracingUnaryClientInterceptor_PropagatesTraceID(t *testing.T) {
+	traceID := "abcdef1234567890abcdef1234567890"
+	ctx := pluginsdk.ContextWithTraceID(context.Background(), traceID)
+
+	sent, _ := outgoingTraceIDs(ctx, t)
+	if len(sent) != 1 || sent[0] != traceID {
+		t.Errorf("Expected outgoing trace ID [%q], got %v", traceID, sent)
+	}
+}
+
+// TestTracingUnaryClientInterceptor_GeneratesTraceID tests missing or invalid trace IDs are replaced.
+func TestTracingUnaryClientInterceptor_GeneratesTraceID(t *testing.T) {
+	tests := []struct {
+		name string
+		ctx  context.Context
+	}{
+		{"missing", context.Background()},
+		{"invalid", pluginsdk.ContextWithTraceID(context.Background(), "not-a-trace-id")},
+		{"all zeros", pluginsdk.ContextWithTraceID(context.Background(), "00000000000000000000000000000000")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sent, _ := outgoingTraceIDs(tt.ctx, t)
+			if len(sent) != 1 {
+				t.Fatalf("Expected one outgoing trace ID, got %v", sent)
+			}
+			if err := pricing.ValidateTraceID(sent[0]); err != nil {
+				t.Errorf("Generated trace ID %q is invalid: %v", sent[0], err)
+			}
+		})
+	}
+}
+
+// TestTracingUnaryClientInterceptor_PreservesMetadata tests other outgoing metadata is kept
+// and a stale trace ID header is replaced.
+func TestTracingUnaryClientInterceptor_PreservesMetadata(t *testing.T) {
+	traceID := "abcdef1234567890abcdef1234567890"
+	ctx := metadata.AppendToOutgoingContext(context.Background(),
+		"authorization", "Bearer token",
+		pluginsdk.TraceIDMetadataKey, "1234567890abcdef1234567890abcdef",
+	)
+	ctx = pluginsdk.ContextWithTraceID(ctx, traceID)
+
+	sent, md := outgoingTraceIDs(ctx, t)
+	if len(sent) != 1 || sent[0] != traceID {
+		t.Errorf("Expected outgoing trace ID [%q], got %v", traceID, sent)
+	}
+	if auth := md.Get("authorization"); len(auth) != 1 || auth[0] != "Bearer token" {
+		t.Errorf("Expected authorization metadata to be preserved, got %v", auth)
+	}
+}
+
+// TestTracingInterceptors_EndToEnd tests client and server interceptors share trace and span IDs.
+func TestTracingInterceptors_EndToEnd(t *testing.T) {
+	client := pluginsdk.TracingUnaryClientInterceptor()
+	server := pluginsdk.TracingUnaryServerInterceptor()
+
+	var serverTraceID, serverSpanID string
+	handler := func(ctx context.Context, _ interface{}) (interface{}, error) {
+		serverTraceID = pluginsdk.TraceIDFromContext(ctx)
+		serverSpanID = pluginsdk.SpanIDFromContext(ctx)
+		return struct{}{}, nil
+	}
+	// The invoker stands in for the transport, delivering outgoing metadata as incoming metadata.
+	invoker := func(ctx context.Context, _ string, req, _ interface{}, _ *grpc.ClientConn, _ ...grpc.CallOption) error {
+		md, _ := metadata.FromOutgoingContext(ctx)
+		_, err := server(metadata.NewIncomingContext(context.Background(), md), req, &grpc.UnaryServerInfo{}, handler)
+		return err
+	}
+
+	traceID := "abcdef1234567890abcdef1234567890"
+	spanID := "00f067aa0ba902b7"
+	ctx := pluginsdk.ContextWithTraceID(context.Background(), traceID)
+	ctx = pluginsdk.ContextWithSpanID(ctx, spanID)
+	if err := client(ctx, "/finfocus.v1.CostSourceService/Name", nil, nil, nil, invoker); err != nil {
+		t.Fatalf("Call failed: %v", err)
+	}
+
+	if serverTraceID != traceID {
+		t.Errorf("Expected server trace ID %q, got %q", traceID, serverTraceID)
+	}
+	if serverSpanID != spanID {
+		t.Errorf("Expected server span ID %q, got %q", spanID, serverSpanID)
+	}
+}
+
+// TestGenerateSpanID tests GenerateSpanID produces unique, valid 16-character span IDs.
+func TestGenerateSpanID(t *testing.T) {
+	seen := make(map[string]bool)
+	for range 100 {
+		spanID, err := pluginsdk.GenerateSpanID()
+		if err != nil {
+			t.Fatalf("GenerateSpanID failed: %v", err)
+		}
+		if len(spanID) != 16 {
+			t.Errorf("Span ID should be 16 characters, got %d: %q", len(spanID), spanID)
+		}
+		if err := pricing.ValidateSpanID(spanID); err != nil {
+			t.Errorf("Generated span ID %q is invalid: %v", spanID, err)
+		}
+		if seen[spanID] {
+			t.Errorf("Duplicate span ID generated: %q", spanID)
+		}
+		seen[spanID] = true
+	}
+}
+
+// TestContextWithSpanID_SpanIDFromContext tests span ID context storage alongside the trace ID.
+func TestContextWithSpanID_SpanIDFromContext(t *testing.T) {
+	if got := pluginsdk.SpanIDFromContext(context.Background()); got != "" {
+		t.Errorf("Expected empty string for context without span ID, got %q", got)
+	}
+
+	traceID := "abcdef1234567890abcdef1234567890"
+	ctx := pluginsdk.ContextWithTraceID(context.Background(), traceID)
+	ctx = pluginsdk.ContextWithSpanID(ctx, "00f067aa0ba902b7")
+
+	if got := pluginsdk.SpanIDFromContext(ctx); got != "00f067aa0ba902b7" {
+		t.Errorf("Expected span ID %q, got %q", "00f067aa0ba902b7", got)
+	}
+	if got := pluginsdk.TraceIDFromContext(ctx); got != traceID {
+		t.Errorf("Span ID should not replace trace ID: expected %q, got %q", traceID, got)
+	}
+}
+
+// TestTracingUnaryServerInterceptor_SpanID tests span ID extraction, validation, and generation.
+func TestTracingUnaryServerInterceptor_SpanID(t *testing.T) {
+	tests := []struct {
+		name     string
+		spanID   string
+		wantSame bool
+	}{
+		{"valid", "00f067aa0ba902b7", true},
+		{"missing", "", false},
+		{"wrong length", "00f067aa", false},
+		{"all zeros", "0000000000000000", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			if tt.spanID != "" {
+				ctx = metadata.NewIncomingContext(ctx, metadata.Pairs(pluginsdk.SpanIDMetadataKey, tt.spanID))
+			}
+
+			var capturedSpanID string
+			handler := func(ctx context.Context, _ interface{}) (interface{}, error) {
+				capturedSpanID = pluginsdk.SpanIDFromContext(ctx)
+				return struct{}{}, nil
+			}
+			if _, err := pluginsdk.TracingUnaryServerInterceptor()(ctx, nil, &grpc.UnaryServerInfo{}, handler); err != nil {
+				t.Fatalf("Interceptor failed: %v", err)
+			}
+
+			if tt.wantSame && capturedSpanID != tt.spanID {
+				t.Errorf("Expected span ID %q, got %q", tt.spanID, capturedSpanID)
+			}
+			if !tt.wantSame {
+				if err := pricing.ValidateSpanID(capturedSpanID); err != nil || capturedSpanID == "" {
+					t.Errorf("Expected a generated valid span ID, got %q (%v)", capturedSpanID, err)
+				}
+			}
+		})
+	}
+}
+
+// TestTracingUnaryClientInterceptor_SpanID tests the context span ID is sent and a missing one generated.
+func TestTracingUnaryClientInterceptor_SpanID(t *testing.T) {
+	spanID := "00f067aa0ba902b7"
+	_, md := outgoingTraceIDs(pluginsdk.ContextWithSpanID(context.Background(), spanID), t)
+	if sent := md.Get(pluginsdk.SpanIDMetadataKey); len(sent) != 1 || sent[0] != spanID {
+		t.Errorf("Expected outgoing span ID [%q], got %v", spanID, sent)
+	}
+
+	_, md = outgoingTraceIDs(context.Background(), t)
+	sent := md.Get(pluginsdk.SpanIDMetadataKey)
+	if len(sent) != 1 || pricing.ValidateSpanID(sent[0]) != nil {
+		t.Errorf("Expected one generated valid span ID, got %v", sent)
+	}
+}
+
 // TestLogOperation_TimingAccuracy tests LogOperation timing accuracy.
 func TestLogOperation_TimingAccuracy(t *testing.T) {
 	var buf bytes.Buffer
@@ -312,7 +579,76 @@ func TestLogOperation_OutputFormat(t *testing.T) {
 	}
 }
 
-// TestFieldConstants_Values tests all 11 field constants have correct string values.
+// TestLogOperationWithResult tests the outcome fields logged by LogOperationWithResult.
+func TestLogOperationWithResult(t *testing.T) {
+	tests := []struct {
+		name      string
+		err       error
+		level     string
+		success   bool
+		errorCode string
+	}{
+		{name: "success", level: "info", success: true},
+		{
+			name:      "plugin error",
+			err:       pricing.NewTransientError(pricing.ErrorCodeRateLimited, "slow down", nil),
+			level:     "error",
+			errorCode: "RATE_LIMITED",
+		},
+		{
+			name:      "plugin error in grpc status",
+			err:       pricing.NewPermanentError(pricing.ErrorCodeInvalidResource, "bad sku").GetGRPCStatus().Err(),
+			level:     "error",
+			errorCode: "INVALID_RESOURCE",
+		},
+		{
+			name:      "grpc status",
+			err:       status.Error(codes.NotFound, "no such sku"),
+			level:     "error",
+			errorCode: "NotFound",
+		},
+		{name: "plain error", err: errors.New("boom"), level: "error"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			logger := pluginsdk.NewPluginLogger("test", "v1.0.0", zerolog.InfoLevel, &buf)
+
+			done := pluginsdk.LogOperationWithResult(logger, "GetProjectedCost")
+			done(tt.err)
+
+			var entry map[string]any
+			if err := json.Unmarshal(buf.Bytes(), &entry); err != nil {
+				t.Fatalf("Failed to parse log output %q: %v", buf.String(), err)
+			}
+			if entry["level"] != tt.level {
+				t.Errorf("Expected level %q, got %v", tt.level, entry["level"])
+			}
+			if entry[pluginsdk.FieldOperation] != "GetProjectedCost" {
+				t.Errorf("Expected operation field, got: %v", entry)
+			}
+			if _, ok := entry[pluginsdk.FieldDurationMs]; !ok {
+				t.Errorf("Expected duration_ms field, got: %v", entry)
+			}
+			if entry[pluginsdk.FieldSuccess] != tt.success {
+				t.Errorf("Expected success %v, got %v", tt.success, entry[pluginsdk.FieldSuccess])
+			}
+			code, hasCode := entry[pluginsdk.FieldErrorCode]
+			if tt.errorCode == "" && hasCode {
+				t.Errorf("Expected no error_code, got %v", code)
+			}
+			if tt.errorCode != "" && code != tt.errorCode {
+				t.Errorf("Expected error_code %q, got %v", tt.errorCode, code)
+			}
+			if tt.err != nil && entry["error"] != tt.err.Error() {
+				t.Errorf("Expected error %q, got %v", tt.err.Error(), entry["error"])
+			}
+		})
+	}
+}
+
+// TestFieldConstants_Values tests all 14 field constants have correct string values.
 func TestFieldConstants_Values(t *testing.T) {
 	tests := []struct {
 		constant string
@@ -329,6 +665,9 @@ func TestFieldConstants_Values(t *testing.T) {
 		{pluginsdk.FieldCostMonthly, "cost_monthly"},
 		{pluginsdk.FieldAdapter, "adapter"},
 		{pluginsdk.FieldErrorCode, "error_code"},
+		{pluginsdk.FieldSuccess, "success"},
+		{pluginsdk.FieldAttributes, "attributes"},
+		{pluginsdk.FieldAttributeCount, "attribute_count"},
 	}
 
 	for _, tt := range tests {
@@ -1039,3 +1378,90 @@ func BenchmarkGetLogFilePermissions_Configured(b *testing.B) {
 		pluginsdk.GetLogFilePermissions()
 	}
 }
+
+// TestRedactedAttributes tests that attribute names are kept and values are replaced.
+func TestRedactedAttributes(t *testing.T) {
+	attrs, err := structpb.NewStruct(map[string]interface{}{
+		"instanceType": "t3.micro",
+		"password":     "hunter2",
+		"count":        3,
+		"enabled":      true,
+		"nothing":      nil,
+		"zones":        []interface{}{"us-east-1a", "us-east-1b"},
+		"tags":         map[string]interface{}{"env": "prod", "team": "core", "owner": "ops"},
+	})
+	if err != nil {
+		t.Fatalf("Failed to build attributes: %v", err)
+	}
+
+	got := pluginsdk.RedactedAttributes(attrs)
+
+	want := map[string]interface{}{
+		"instanceType": pluginsdk.RedactedValue,
+		"password":     pluginsdk.RedactedValue,
+		"count":        pluginsdk.RedactedValue,
+		"enabled":      pluginsdk.RedactedValue,
+		"nothing":      pluginsdk.RedactedValue,
+		"zones":        "[REDACTED: 2 items]",
+		"tags":         "[REDACTED: 3 fields]",
+	}
+	if len(got) != len(want) {
+		t.Fatalf("Expected %d keys, got %d: %v", len(want), len(got), got)
+	}
+	for key, value := range want {
+		if got[key] != value {
+			t.Errorf("RedactedAttributes()[%q] = %v, want %v", key, got[key], value)
+		}
+	}
+
+	if nilAttrs := pluginsdk.RedactedAttributes(nil); nilAttrs == nil || len(nilAttrs) != 0 {
+		t.Errorf("RedactedAttributes(nil) = %v, want empty map", nilAttrs)
+	}
+}
+
+// TestLogRedactedRequest tests that request logs carry context fields but no attribute values.
+func TestLogRedactedRequest(t *testing.T) {
+	var buf bytes.Buffer
+	logger := pluginsdk.NewPluginLogger("test", "v1.0.0", zerolog.InfoLevel, &buf)
+	ctx := pluginsdk.ContextWithTraceID(context.Background(), "trace-abc123")
+	attrs, err := structpb.NewStruct(map[string]interface{}{
+		"instanceType": "t3.micro",
+		"secretKey":    "AKIAEXAMPLE",
+	})
+	if err != nil {
+		t.Fatalf("Failed to build attributes: %v", err)
+	}
+
+	pluginsdk.LogRedactedRequest(ctx, logger, "EstimateCost", "aws:ec2/instance:Instance", attrs)
+
+	output := buf.String()
+	for _, secret := range []string{"t3.micro", "AKIAEXAMPLE"} {
+		if strings.Contains(output, secret) {
+			t.Errorf("Log output leaked attribute value %q: %s", secret, output)
+		}
+	}
+
+	var entry map[string]any
+	if err := json.Unmarshal(buf.Bytes(), &entry); err != nil {
+		t.Fatalf("Failed to parse log output %q: %v", output, err)
+	}
+	if entry[pluginsdk.FieldTraceID] != "trace-abc123" {
+		t.Errorf("Expected trace_id trace-abc123, got %v", entry[pluginsdk.FieldTraceID])
+	}
+	if entry[pluginsdk.FieldOperation] != "EstimateCost" {
+		t.Errorf("Expected operation EstimateCost, got %v", entry[pluginsdk.FieldOperation])
+	}
+	if entry[pluginsdk.FieldResourceType] != "aws:ec2/instance:Instance" {
+		t.Errorf("Expected resource_type aws:ec2/instance:Instance, got %v", entry[pluginsdk.FieldResourceType])
+	}
+	if entry[pluginsdk.FieldAttributeCount] != float64(2) {
+		t.Errorf("Expected attribute_count 2, got %v", entry[pluginsdk.FieldAttributeCount])
+	}
+	logged, ok := entry[pluginsdk.FieldAttributes].(map[string]any)
+	if !ok {
+		t.Fatalf("Expected attributes object, got %v", entry[pluginsdk.FieldAttributes])
+	}
+	if logged["secretKey"] != pluginsdk.RedactedValue {
+		t.Errorf("Expected secretKey to be %q, got %v", pluginsdk.RedactedValue, logged["secretKey"])
+	}
+}
@@ -0,0 +1,274 @@
+package pluginsdk
+
+import (
+	"context"
+	"errors"
+	"maps"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+
+	"github.com/rshade/finfocus-spec/sdk/go/pricing"
+)
+
+// MaxLatencySamples is the number of most recent latencies DefaultMetricsRecorder keeps per
+// method for percentile calculation.
+const MaxLatencySamples = 10000
+
+// percentScale converts between ratios and percentages.
+const percentScale = 100.0
+
+// MetricsRecorder receives the latency and outcome of each plugin call. It is the in-process
+// counterpart of the Prometheus PluginMetrics, for plugins and tests that want to inspect
+// metrics directly. Implementations must be safe for concurrent use.
+type MetricsRecorder interface {
+	// RecordLatency records one call of method that took d and returned err (nil on success).
+	RecordLatency(method string, d time.Duration, err error)
+}
+
+// ErrorRecorder is an optional MetricsRecorder extension that receives the PluginError code
+// and category of failed calls, so operators can see, e.g., the proportion of RATE_LIMITED to
+// NETWORK_TIMEOUT failures per method. MetricsInterceptorWithRecorder calls it, in addition to
+// RecordLatency, for every error carrying a PluginError.
+type ErrorRecorder interface {
+	// RecordError records one call of method that failed with the given PluginError code and category.
+	RecordError(method string, code pricing.ErrorCode, category pricing.ErrorCategory)
+}
+
+// DefaultMetricsRecorder is an in-memory MetricsRecorder and ErrorRecorder that tracks, per
+// method, call latencies, the success rate, error counts by gRPC status code, and PluginError
+// counts by code and category.
+//
+// Counts cover every recorded call; percentiles cover the most recent MaxLatencySamples
+// latencies. DefaultMetricsRecorder is safe for concurrent use.
+type DefaultMetricsRecorder struct {
+	mu      sync.Mutex
+	methods map[string]*methodMetrics
+}
+
+// methodMetrics holds the metrics of a single method.
+type methodMetrics struct {
+	latencies  []time.Duration // ring buffer once full
+	next       int             // ring buffer write position once full
+	calls      int
+	errorCodes map[string]int
+
+	pluginErrorCodes      map[pricing.ErrorCode]int
+	pluginErrorCategories map[pricing.ErrorCategory]int
+}
+
+// newMethodMetrics creates empty metrics for a method.
+func newMethodMetrics() *methodMetrics {
+	return &methodMetrics{
+		errorCodes:            make(map[string]int),
+		pluginErrorCodes:      make(map[pricing.ErrorCode]int),
+		pluginErrorCategories: make(map[pricing.ErrorCategory]int),
+	}
+}
+
+// NewDefaultMetricsRecorder creates an empty DefaultMetricsRecorder.
+//
+// Example:
+//
+//	recorder := pluginsdk.NewDefaultMetricsRecorder()
+//	config := pluginsdk.ServeConfig{
+//	    UnaryInterceptors: []grpc.UnaryServerInterceptor{
+//	        pluginsdk.MetricsInterceptorWithRecorder(recorder),
+//	    },
+//	}
+//	// later
+//	p99 := recorder.Percentile("finfocus.v1.CostSourceService/GetProjectedCost", 99)
+func NewDefaultMetricsRecorder() *DefaultMetricsRecorder {
+	return &DefaultMetricsRecorder{methods: make(map[string]*methodMetrics)}
+}
+
+// RecordLatency records one call of method. A non-nil err is counted under its gRPC status
+// code name (e.g., "InvalidArgument"); errors without a status count as "Unknown".
+func (r *DefaultMetricsRecorder) RecordLatency(method string, d time.Duration, err error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	m := r.method(method)
+	m.calls++
+	if len(m.latencies) < MaxLatencySamples {
+		m.latencies = append(m.latencies, d)
+	} else {
+		m.latencies[m.next] = d
+		m.next = (m.next + 1) % MaxLatencySamples
+	}
+	if err != nil {
+		m.errorCodes[status.Code(err).String()]++
+	}
+}
+
+// RecordError records the PluginError code and category of one failed call of method. It does
+// not count a call; RecordLatency does.
+func (r *DefaultMetricsRecorder) RecordError(method string, code pricing.ErrorCode, category pricing.ErrorCategory) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	m := r.method(method)
+	m.pluginErrorCodes[code]++
+	m.pluginErrorCategories[category]++
+}
+
+// Percentile returns the pth percentile (0-100) of method's recorded latencies, linearly
+// interpolating between samples. p is clamped to [0, 100], so Percentile(method, 0) is the
+// fastest call and Percentile(method, 100) the slowest. Returns 0 if method has no calls.
+func (r *DefaultMetricsRecorder) Percentile(method string, p float64) time.Duration {
+	r.mu.Lock()
+	m, ok := r.methods[method]
+	var sorted []time.Duration
+	if ok {
+		sorted = slices.Clone(m.latencies)
+	}
+	r.mu.Unlock()
+
+	if len(sorted) == 0 {
+		return 0
+	}
+	slices.Sort(sorted)
+
+	p = min(max(p, 0), percentScale)
+	idx := p / percentScale * float64(len(sorted)-1)
+	lower := int(idx)
+	if lower == len(sorted)-1 {
+		return sorted[lower]
+	}
+	weight := idx - float64(lower)
+	return time.Duration(float64(sorted[lower])*(1-weight) + float64(sorted[lower+1])*weight)
+}
+
+// SuccessRate returns the percentage (0-100) of method's calls that returned no error.
+// Returns 0 if method has no calls.
+func (r *DefaultMetricsRecorder) SuccessRate(method string) float64 {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	m, ok := r.methods[method]
+	if !ok || m.calls == 0 {
+		return 0
+	}
+	failures := 0
+	for _, count := range m.errorCodes {
+		failures += count
+	}
+	return float64(m.calls-failures) / float64(m.calls) * percentScale
+}
+
+// ErrorCounts returns method's failed calls keyed by gRPC status code name. The map is a copy
+// and is empty if method has no failures.
+func (r *DefaultMetricsRecorder) ErrorCounts(method string) map[string]int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	counts := make(map[string]int)
+	if m, ok := r.methods[method]; ok {
+		maps.Copy(counts, m.errorCodes)
+	}
+	return counts
+}
+
+// PluginErrorCounts returns method's recorded PluginErrors keyed by ErrorCode. The map is a
+// copy and is empty if none were recorded.
+func (r *DefaultMetricsRecorder) PluginErrorCounts(method string) map[pricing.ErrorCode]int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	counts := make(map[pricing.ErrorCode]int)
+	if m, ok := r.methods[method]; ok {
+		maps.Copy(counts, m.pluginErrorCodes)
+	}
+	return counts
+}
+
+// ErrorCategoryCounts returns method's recorded PluginErrors keyed by ErrorCategory. The map is
+// a copy and is empty if none were recorded.
+func (r *DefaultMetricsRecorder) ErrorCategoryCounts(method string) map[pricing.ErrorCategory]int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	counts := make(map[pricing.ErrorCategory]int)
+	if m, ok := r.methods[method]; ok {
+		maps.Copy(counts, m.pluginErrorCategories)
+	}
+	return counts
+}
+
+// RequestCount returns the number of calls recorded for method.
+func (r *DefaultMetricsRecorder) RequestCount(method string) int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if m, ok := r.methods[method]; ok {
+		return m.calls
+	}
+	return 0
+}
+
+// Methods returns the names of all methods with recorded calls, sorted.
+func (r *DefaultMetricsRecorder) Methods() []string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return slices.Sorted(maps.Keys(r.methods))
+}
+
+// method returns the metrics for name, creating them if needed. Callers must hold r.mu.
+func (r *DefaultMetricsRecorder) method(name string) *methodMetrics {
+	m, ok := r.methods[name]
+	if !ok {
+		m = newMethodMetrics()
+		r.methods[name] = m
+	}
+	return m
+}
+
+// MetricsInterceptorWithRecorder returns a gRPC unary server interceptor that records the
+// latency and outcome of every call with rec. Methods are named by their full gRPC method
+// without the leading slash (e.g., "finfocus.v1.CostSourceService/GetProjectedCost").
+//
+// If rec also implements ErrorRecorder, errors carrying a PluginError are recorded with its code
+// and category. The PluginError is taken from the error chain, or from the pbc.ErrorDetail of a
+// gRPC status error (see pricing.ExtractErrorDetails); other errors have no such dimensions.
+//
+// Example:
+//
+//	recorder := pluginsdk.NewDefaultMetricsRecorder()
+//	config := pluginsdk.ServeConfig{
+//	    UnaryInterceptors: []grpc.UnaryServerInterceptor{
+//	        pluginsdk.MetricsInterceptorWithRecorder(recorder),
+//	    },
+//	}
+func MetricsInterceptorWithRecorder(rec MetricsRecorder) grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		start := time.Now()
+		resp, err := handler(ctx, req)
+		method := strings.TrimPrefix(info.FullMethod, "/")
+		rec.RecordLatency(method, time.Since(start), err)
+		if errRec, ok := rec.(ErrorRecorder); ok && err != nil {
+			if pluginErr, found := pluginErrorOf(err); found {
+				errRec.RecordError(method, pluginErr.Code, pluginErr.Category)
+			}
+		}
+		return resp, err
+	}
+}
+
+// pluginErrorOf returns the PluginError in err's chain or attached to its gRPC status.
+func pluginErrorOf(err error) (*pricing.PluginError, bool) {
+	var pluginErr *pricing.PluginError
+	if errors.As(err, &pluginErr) {
+		return pluginErr, true
+	}
+	return pricing.ExtractErrorDetails(err)
+}
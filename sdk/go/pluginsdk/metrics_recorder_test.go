@@ -0,0 +1,225 @@
+package pluginsdk_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/rshade/finfocus-spec/sdk/go/pluginsdk"
+	"github.com/rshade/finfocus-spec/sdk/go/pricing"
+)
+
+const recordedMethod = "finfocus.v1.CostSourceService/GetProjectedCost"
+
+func TestDefaultMetricsRecorder_Percentile(t *testing.T) {
+	recorder := pluginsdk.NewDefaultMetricsRecorder()
+	assert.Zero(t, recorder.Percentile(recordedMethod, 50), "no calls")
+
+	// Recorded out of order: 10ms, 20ms, ..., 100ms
+	for _, ms := range []int{70, 10, 100, 40, 20, 90, 30, 60, 50, 80} {
+		recorder.RecordLatency(recordedMethod, time.Duration(ms)*time.Millisecond, nil)
+	}
+
+	tests := []struct {
+		p    float64
+		want time.Duration
+	}{
+		{0, 10 * time.Millisecond},
+		{50, 55 * time.Millisecond}, // interpolated between 50ms and 60ms
+		{90, 91 * time.Millisecond},
+		{100, 100 * time.Millisecond},
+		{-5, 10 * time.Millisecond},   // clamped
+		{150, 100 * time.Millisecond}, // clamped
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, recorder.Percentile(recordedMethod, tt.p), "p%v", tt.p)
+	}
+}
+
+func TestDefaultMetricsRecorder_SuccessRateAndErrors(t *testing.T) {
+	recorder := pluginsdk.NewDefaultMetricsRecorder()
+	assert.Zero(t, recorder.SuccessRate(recordedMethod), "no calls")
+	assert.Empty(t, recorder.ErrorCounts(recordedMethod))
+
+	for range 6 {
+		recorder.RecordLatency(recordedMethod, time.Millisecond, nil)
+	}
+	recorder.RecordLatency(recordedMethod, time.Millisecond, status.Error(codes.InvalidArgument, "bad"))
+	recorder.RecordLatency(recordedMethod, time.Millisecond, status.Error(codes.InvalidArgument, "bad"))
+	recorder.RecordLatency(recordedMethod, time.Millisecond, status.Error(codes.Unavailable, "down"))
+	recorder.RecordLatency(recordedMethod, time.Millisecond, errors.New("plain"))
+
+	assert.Equal(t, 10, recorder.RequestCount(recordedMethod))
+	assert.InDelta(t, 60.0, recorder.SuccessRate(recordedMethod), 1e-9)
+
+	counts := recorder.ErrorCounts(recordedMethod)
+	assert.Equal(t, map[string]int{"InvalidArgument": 2, "Unavailable": 1, "Unknown": 1}, counts)
+
+	counts["InvalidArgument"] = 99
+	assert.Equal(t, 2, recorder.ErrorCounts(recordedMethod)["InvalidArgument"], "ErrorCounts returns a copy")
+}
+
+func TestDefaultMetricsRecorder_PerMethod(t *testing.T) {
+	recorder := pluginsdk.NewDefaultMetricsRecorder()
+	recorder.RecordLatency("b/Second", 2*time.Millisecond, status.Error(codes.Internal, "boom"))
+	recorder.RecordLatency("a/First", time.Millisecond, nil)
+
+	assert.Equal(t, []string{"a/First", "b/Second"}, recorder.Methods())
+	assert.InDelta(t, 100.0, recorder.SuccessRate("a/First"), 1e-9)
+	assert.Zero(t, recorder.SuccessRate("b/Second"))
+	assert.Zero(t, recorder.RequestCount("c/Unknown"))
+}
+
+func TestDefaultMetricsRecorder_SampleWindow(t *testing.T) {
+	recorder := pluginsdk.NewDefaultMetricsRecorder()
+	for range pluginsdk.MaxLatencySamples {
+		recorder.RecordLatency(recordedMethod, time.Second, nil)
+	}
+	for range pluginsdk.MaxLatencySamples {
+		recorder.RecordLatency(recordedMethod, time.Millisecond, nil)
+	}
+
+	assert.Equal(t, 2*pluginsdk.MaxLatencySamples, recorder.RequestCount(recordedMethod))
+	assert.Equal(t, time.Millisecond, recorder.Percentile(recordedMethod, 100),
+		"older samples should be replaced by newer ones")
+}
+
+func TestDefaultMetricsRecorder_Concurrent(t *testing.T) {
+	recorder := pluginsdk.NewDefaultMetricsRecorder()
+
+	const goroutines, calls = 8, 100
+	var wg sync.WaitGroup
+	for range goroutines {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range calls {
+				var err error
+				if i%4 == 0 {
+					err = status.Error(codes.Unavailable, "down")
+				}
+				recorder.RecordLatency(recordedMethod, time.Duration(i)*time.Microsecond, err)
+				recorder.Percentile(recordedMethod, 95)
+				recorder.SuccessRate(recordedMethod)
+			}
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, goroutines*calls, recorder.RequestCount(recordedMethod))
+	assert.InDelta(t, 75.0, recorder.SuccessRate(recordedMethod), 1e-9)
+	assert.Equal(t, goroutines*calls/4, recorder.ErrorCounts(recordedMethod)["Unavailable"])
+}
+
+func TestMetricsInterceptorWithRecorder(t *testing.T) {
+	recorder := pluginsdk.NewDefaultMetricsRecorder()
+	interceptor := pluginsdk.MetricsInterceptorWithRecorder(recorder)
+	info := &grpc.UnaryServerInfo{FullMethod: "/" + recordedMethod}
+
+	ok := func(_ context.Context, _ interface{}) (interface{}, error) {
+		time.Sleep(2 * time.Millisecond)
+		return "ok", nil
+	}
+	failing := func(_ context.Context, _ interface{}) (interface{}, error) {
+		return nil, status.Error(codes.NotFound, "missing")
+	}
+
+	resp, err := interceptor(context.Background(), nil, info, ok)
+	require.NoError(t, err)
+	assert.Equal(t, "ok", resp)
+
+	_, err = interceptor(context.Background(), nil, info, failing)
+	assert.Equal(t, codes.NotFound, status.Code(err), "handler error must be returned unchanged")
+
+	assert.Equal(t, []string{recordedMethod}, recorder.Methods())
+	assert.Equal(t, 2, recorder.RequestCount(recordedMethod))
+	assert.InDelta(t, 50.0, recorder.SuccessRate(recordedMethod), 1e-9)
+	assert.Equal(t, map[string]int{"NotFound": 1}, recorder.ErrorCounts(recordedMethod))
+	assert.GreaterOrEqual(t, recorder.Percentile(recordedMethod, 100), 2*time.Millisecond)
+}
+
+func TestDefaultMetricsRecorder_RecordError(t *testing.T) {
+	recorder := pluginsdk.NewDefaultMetricsRecorder()
+	assert.Empty(t, recorder.PluginErrorCounts(recordedMethod))
+	assert.Empty(t, recorder.ErrorCategoryCounts(recordedMethod))
+
+	recorder.RecordError(recordedMethod, pricing.ErrorCodeRateLimited, pricing.TransientError)
+	recorder.RecordError(recordedMethod, pricing.ErrorCodeRateLimited, pricing.TransientError)
+	recorder.RecordError(recordedMethod, pricing.ErrorCodeNetworkTimeout, pricing.TransientError)
+	recorder.RecordError(recordedMethod, pricing.ErrorCodeInvalidResource, pricing.PermanentError)
+
+	assert.Equal(t, map[pricing.ErrorCode]int{
+		pricing.ErrorCodeRateLimited:     2,
+		pricing.ErrorCodeNetworkTimeout:  1,
+		pricing.ErrorCodeInvalidResource: 1,
+	}, recorder.PluginErrorCounts(recordedMethod))
+	assert.Equal(t, map[pricing.ErrorCategory]int{
+		pricing.TransientError: 3,
+		pricing.PermanentError: 1,
+	}, recorder.ErrorCategoryCounts(recordedMethod))
+	assert.Zero(t, recorder.RequestCount(recordedMethod), "RecordError does not count calls")
+
+	counts := recorder.PluginErrorCounts(recordedMethod)
+	counts[pricing.ErrorCodeRateLimited] = 99
+	assert.Equal(t, 2, recorder.PluginErrorCounts(recordedMethod)[pricing.ErrorCodeRateLimited],
+		"PluginErrorCounts returns a copy")
+}
+
+func TestMetricsInterceptorWithRecorder_PluginErrors(t *testing.T) {
+	recorder := pluginsdk.NewDefaultMetricsRecorder()
+	interceptor := pluginsdk.MetricsInterceptorWithRecorder(recorder)
+	info := &grpc.UnaryServerInfo{FullMethod: "/" + recordedMethod}
+
+	handlerErrors := []error{
+		// returned directly
+		pricing.NewTransientError(pricing.ErrorCodeRateLimited, "slow down", nil),
+		// converted to a gRPC status carrying ErrorDetail
+		pricing.NewTransientError(pricing.ErrorCodeNetworkTimeout, "timed out", nil).GetGRPCStatus().Err(),
+		// no PluginError dimensions
+		status.Error(codes.Internal, "boom"),
+		errors.New("plain"),
+	}
+	for _, handlerErr := range handlerErrors {
+		failing := func(_ context.Context, _ interface{}) (interface{}, error) {
+			return nil, handlerErr
+		}
+		_, err := interceptor(context.Background(), nil, info, failing)
+		assert.Same(t, handlerErr, err, "handler error must be returned unchanged")
+	}
+
+	assert.Equal(t, len(handlerErrors), recorder.RequestCount(recordedMethod))
+	assert.Equal(t, map[pricing.ErrorCode]int{
+		pricing.ErrorCodeRateLimited:    1,
+		pricing.ErrorCodeNetworkTimeout: 1,
+	}, recorder.PluginErrorCounts(recordedMethod))
+	assert.Equal(t, map[pricing.ErrorCategory]int{pricing.TransientError: 2},
+		recorder.ErrorCategoryCounts(recordedMethod))
+}
+
+// latencyOnlyRecorder implements MetricsRecorder but not ErrorRecorder.
+type latencyOnlyRecorder struct {
+	calls int
+}
+
+func (r *latencyOnlyRecorder) RecordLatency(string, time.Duration, error) { r.calls++ }
+
+func TestMetricsInterceptorWithRecorder_LatencyOnlyRecorder(t *testing.T) {
+	recorder := &latencyOnlyRecorder{}
+	interceptor := pluginsdk.MetricsInterceptorWithRecorder(recorder)
+	info := &grpc.UnaryServerInfo{FullMethod: "/" + recordedMethod}
+
+	failing := func(_ context.Context, _ interface{}) (interface{}, error) {
+		return nil, pricing.NewTransientError(pricing.ErrorCodeRateLimited, "slow down", nil)
+	}
+	_, err := interceptor(context.Background(), nil, info, failing)
+	require.Error(t, err)
+	assert.Equal(t, 1, recorder.calls)
+}
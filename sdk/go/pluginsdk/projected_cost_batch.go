@@ -0,0 +1,92 @@
+// Copyright 2024 The FinFocus Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pluginsdk
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/status"
+
+	"github.com/rshade/finfocus-spec/sdk/go/pricing"
+	pbc "github.com/rshade/finfocus-spec/sdk/go/proto/finfocus/v1"
+)
+
+// MaxProjectedCostBatchSize is the maximum number of resources in a GetProjectedCostBatch request.
+const MaxProjectedCostBatchSize = 1000
+
+// BatchProjectedCostProvider is an optional interface that plugins can implement to project
+// many resources at once, e.g. with a single bulk pricing lookup. Plugins that do not
+// implement this interface have GetProjectedCostBatch served by
+// GetProjectedCostBatchFromSingle, which calls their GetProjectedCost handler per resource.
+// This includes plugins embedding BasePlugin, so an overridden GetProjectedCost is always used.
+type BatchProjectedCostProvider interface {
+	// GetProjectedCostBatch returns one result per requested resource, in request order.
+	GetProjectedCostBatch(ctx context.Context, req *pbc.BatchProjectedCostRequest) (
+		*pbc.BatchProjectedCostResponse, error)
+}
+
+// GetProjectedCostBatchFromSingle serves a GetProjectedCostBatch request by calling the
+// plugin's GetProjectedCost handler once per resource, in order. Each call receives the
+// batch's utilization_percentage and usage_profile.
+//
+// A resource whose GetProjectedCost call fails gets an ErrorDetail in its result instead of
+// failing the batch (see ProjectedCostErrorDetail). The batch fails only if ctx is done
+// before every resource has been projected, with the gRPC status for the context error.
+func GetProjectedCostBatchFromSingle(
+	ctx context.Context,
+	plugin Plugin,
+	req *pbc.BatchProjectedCostRequest,
+) (*pbc.BatchProjectedCostResponse, error) {
+	results := make([]*pbc.BatchProjectedCostResult, 0, len(req.GetResources()))
+	for _, resource := range req.GetResources() {
+		if err := ctx.Err(); err != nil {
+			return nil, status.FromContextError(err).Err()
+		}
+
+		resp, err := plugin.GetProjectedCost(ctx, &pbc.GetProjectedCostRequest{
+			Resource:              resource,
+			UtilizationPercentage: req.GetUtilizationPercentage(),
+			UsageProfile:          req.GetUsageProfile(),
+		})
+		if err == nil && resp == nil {
+			err = errors.New("GetProjectedCost returned no response")
+		}
+
+		result := &pbc.BatchProjectedCostResult{}
+		if err != nil {
+			result.Error = ProjectedCostErrorDetail(err)
+		} else {
+			result.Response = resp
+		}
+		results = append(results, result)
+	}
+	return &pbc.BatchProjectedCostResponse{Results: results}, nil
+}
+
+// ProjectedCostErrorDetail converts a per-resource GetProjectedCost error to the ErrorDetail
+// reported in a BatchProjectedCostResult.
+//
+// A pricing.PluginError keeps its code, category, and details. Any other error is classified
+// the way a client would classify it had it been returned from GetProjectedCost (see
+// pricing.FromGRPCStatus), so gRPC status errors map to the closest ErrorCode and plain
+// errors become TEMPORARY_FAILURE.
+func ProjectedCostErrorDetail(err error) *pbc.ErrorDetail {
+	var pluginErr *pricing.PluginError
+	if !errors.As(err, &pluginErr) {
+		pluginErr = pricing.FromGRPCStatus(status.Convert(err))
+	}
+	return pluginErr.ToProtoErrorDetail()
+}
@@ -0,0 +1,212 @@
+// Copyright 2024 The FinFocus Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pluginsdk_test
+
+import (
+	"context"
+	"errors"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/rshade/finfocus-spec/sdk/go/pluginsdk"
+	"github.com/rshade/finfocus-spec/sdk/go/pricing"
+	pbc "github.com/rshade/finfocus-spec/sdk/go/proto/finfocus/v1"
+)
+
+// ec2Plugin embeds BasePlugin and overrides GetProjectedCost for aws:ec2:Instance only.
+type ec2Plugin struct {
+	*pluginsdk.BasePlugin
+
+	requests []*pbc.GetProjectedCostRequest
+}
+
+func newEC2Plugin() *ec2Plugin {
+	return &ec2Plugin{BasePlugin: pluginsdk.NewBasePlugin("ec2")}
+}
+
+func (p *ec2Plugin) GetProjectedCost(
+	_ context.Context,
+	req *pbc.GetProjectedCostRequest,
+) (*pbc.GetProjectedCostResponse, error) {
+	p.requests = append(p.requests, req)
+	resource := req.GetResource()
+	switch {
+	case resource.GetResourceType() == "aws:ec2:Instance":
+		return &pbc.GetProjectedCostResponse{Currency: "USD", CostPerMonth: 70.08, BillingDetail: resource.GetSku()}, nil
+	case resource.GetRegion() == "mars-north-1":
+		return nil, pricing.NewPermanentError(pricing.ErrorCodeUnsupportedRegion, "region not supported")
+	default:
+		return nil, pluginsdk.NotSupportedError(resource)
+	}
+}
+
+// bulkPlugin implements BatchProjectedCostProvider natively.
+type bulkPlugin struct {
+	*pluginsdk.BasePlugin
+}
+
+func (p *bulkPlugin) GetProjectedCostBatch(
+	_ context.Context,
+	req *pbc.BatchProjectedCostRequest,
+) (*pbc.BatchProjectedCostResponse, error) {
+	results := make([]*pbc.BatchProjectedCostResult, len(req.GetResources()))
+	for i := range results {
+		results[i] = &pbc.BatchProjectedCostResult{
+			Response: &pbc.GetProjectedCostResponse{BillingDetail: "bulk"},
+		}
+	}
+	return &pbc.BatchProjectedCostResponse{Results: results}, nil
+}
+
+func batchRequest() *pbc.BatchProjectedCostRequest {
+	return &pbc.BatchProjectedCostRequest{
+		Resources: []*pbc.ResourceDescriptor{
+			{Provider: "aws", ResourceType: "aws:ec2:Instance", Sku: "t3.micro", Region: "us-east-1"},
+			{Provider: "aws", ResourceType: "aws:s3:Bucket", Region: "us-east-1"},
+			{Provider: "aws", ResourceType: "aws:rds:Instance", Region: "mars-north-1"},
+			{Provider: "aws", ResourceType: "aws:ec2:Instance", Sku: "m5.large", Region: "us-west-2"},
+		},
+		UtilizationPercentage: 0.8,
+		UsageProfile:          pbc.UsageProfile_USAGE_PROFILE_PROD,
+	}
+}
+
+func TestGetProjectedCostBatchFromSingle(t *testing.T) {
+	plugin := newEC2Plugin()
+	resp, err := pluginsdk.GetProjectedCostBatchFromSingle(context.Background(), plugin, batchRequest())
+	require.NoError(t, err)
+	require.Len(t, resp.GetResults(), 4)
+
+	// Successful resources keep request order
+	assert.Equal(t, "t3.micro", resp.GetResults()[0].GetResponse().GetBillingDetail())
+	assert.Nil(t, resp.GetResults()[0].GetError())
+	assert.Equal(t, "m5.large", resp.GetResults()[3].GetResponse().GetBillingDetail())
+
+	// NotSupportedError carries a permanent INVALID_RESOURCE PluginError
+	notSupported := resp.GetResults()[1]
+	assert.Nil(t, notSupported.GetResponse())
+	assert.Equal(t, pbc.ErrorCode_ERROR_CODE_INVALID_RESOURCE, notSupported.GetError().GetCode())
+	assert.Equal(t, pbc.ErrorCategory_ERROR_CATEGORY_PERMANENT, notSupported.GetError().GetCategory())
+	assert.Contains(t, notSupported.GetError().GetMessage(), "aws:s3:Bucket")
+
+	// A PluginError keeps its code and category
+	unsupportedRegion := resp.GetResults()[2].GetError()
+	assert.Equal(t, pbc.ErrorCode_ERROR_CODE_UNSUPPORTED_REGION, unsupportedRegion.GetCode())
+	assert.Equal(t, pbc.ErrorCategory_ERROR_CATEGORY_PERMANENT, unsupportedRegion.GetCategory())
+
+	// Batch-level settings are passed to every call
+	require.Len(t, plugin.requests, 4)
+	for _, req := range plugin.requests {
+		assert.InDelta(t, 0.8, req.GetUtilizationPercentage(), 1e-9)
+		assert.Equal(t, pbc.UsageProfile_USAGE_PROFILE_PROD, req.GetUsageProfile())
+	}
+}
+
+func TestGetProjectedCostBatchFromSingle_CanceledContext(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	plugin := newEC2Plugin()
+	_, err := pluginsdk.GetProjectedCostBatchFromSingle(ctx, plugin, batchRequest())
+	assert.Equal(t, codes.Canceled, status.Code(err))
+	assert.Empty(t, plugin.requests)
+}
+
+func TestProjectedCostErrorDetail(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want pbc.ErrorCode
+	}{
+		{"plugin error", pricing.NewPermanentError(pricing.ErrorCodeInvalidResource, "bad sku"),
+			pbc.ErrorCode_ERROR_CODE_INVALID_RESOURCE},
+		{"wrapped plugin error", errors.Join(errors.New("lookup"),
+			pricing.NewTransientError(pricing.ErrorCodeRateLimited, "slow down", nil)),
+			pbc.ErrorCode_ERROR_CODE_RATE_LIMITED},
+		{"grpc status", status.Error(codes.NotFound, "no such sku"), pbc.ErrorCode_ERROR_CODE_RESOURCE_NOT_FOUND},
+		{"plain error", errors.New("boom"), pbc.ErrorCode_ERROR_CODE_TEMPORARY_FAILURE},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, pluginsdk.ProjectedCostErrorDetail(tt.err).GetCode())
+		})
+	}
+}
+
+func TestServer_GetProjectedCostBatch(t *testing.T) {
+	t.Run("uses BatchProjectedCostProvider", func(t *testing.T) {
+		server := pluginsdk.NewServer(&bulkPlugin{BasePlugin: pluginsdk.NewBasePlugin("bulk")})
+		resp, err := server.GetProjectedCostBatch(context.Background(), batchRequest())
+		require.NoError(t, err)
+		require.Len(t, resp.GetResults(), 4)
+		assert.Equal(t, "bulk", resp.GetResults()[0].GetResponse().GetBillingDetail())
+	})
+
+	t.Run("rejects oversized batch", func(t *testing.T) {
+		plugin := newEC2Plugin()
+		req := &pbc.BatchProjectedCostRequest{
+			Resources: make([]*pbc.ResourceDescriptor, pluginsdk.MaxProjectedCostBatchSize+1),
+		}
+		_, err := pluginsdk.NewServer(plugin).GetProjectedCostBatch(context.Background(), req)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		assert.Empty(t, plugin.requests)
+	})
+}
+
+func TestClient_GetProjectedCostBatch(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- pluginsdk.Serve(ctx, pluginsdk.ServeConfig{
+			Plugin:   newEC2Plugin(),
+			Listener: listener,
+			Web: pluginsdk.WebConfig{
+				Enabled:              true,
+				EnableHealthEndpoint: true,
+			},
+		})
+	}()
+
+	addr := listener.Addr().String()
+	waitForServer(t, addr)
+	client := pluginsdk.NewConnectClient("http://" + addr)
+
+	resp, err := client.GetProjectedCostBatch(ctx, batchRequest())
+	require.NoError(t, err)
+	require.Len(t, resp.GetResults(), 4)
+	assert.InDelta(t, 70.08, resp.GetResults()[0].GetResponse().GetCostPerMonth(), 1e-9)
+	assert.NotNil(t, resp.GetResults()[1].GetError())
+
+	_, err = client.GetProjectedCostBatch(ctx, nil)
+	require.Error(t, err)
+
+	cancel()
+	select {
+	case <-errCh:
+	case <-time.After(time.Second):
+		t.Fatal("server did not shut down in time")
+	}
+}
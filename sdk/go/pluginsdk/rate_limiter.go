@@ -0,0 +1,99 @@
+package pluginsdk
+
+import (
+	"context"
+	"math"
+	"sync"
+	"time"
+)
+
+// RateLimiter is a token-bucket limiter for outbound cloud pricing API calls, keeping plugins
+// under provider quotas. The bucket holds up to burst tokens and refills at a steady rate;
+// each call consumes one token. Plugins using it should advertise
+// registry.PluginCapabilityRateLimiting.
+//
+// RateLimiter is safe for concurrent use.
+type RateLimiter struct {
+	mu     sync.Mutex
+	rate   float64 // tokens per second; <= 0 disables limiting
+	burst  float64
+	tokens float64
+	last   time.Time // time tokens was last refilled
+}
+
+// NewRateLimiter creates a limiter allowing ratePerSec calls per second on average, with
+// bursts of up to burst calls. The bucket starts full. A burst < 1 is treated as 1, and a
+// ratePerSec <= 0 (or NaN) disables limiting so every call is allowed immediately.
+//
+// Example:
+//
+//	limiter := pluginsdk.NewRateLimiter(10, 20) // 10 req/s, bursts of 20
+//	if err := limiter.Wait(ctx); err != nil {
+//	    return nil, err
+//	}
+//	prices, err := pricingAPI.GetProducts(ctx, input)
+func NewRateLimiter(ratePerSec float64, burst int) *RateLimiter {
+	if math.IsNaN(ratePerSec) {
+		ratePerSec = 0
+	}
+	b := float64(max(burst, 1))
+	return &RateLimiter{
+		rate:   ratePerSec,
+		burst:  b,
+		tokens: b,
+		last:   time.Now(),
+	}
+}
+
+// Allow consumes a token if one is available and reports whether it did. It never blocks.
+func (rl *RateLimiter) Allow() bool {
+	rl.mu.Lock()
+	defer rl.mu.Unlock()
+
+	_, ok := rl.take(time.Now())
+	return ok
+}
+
+// Wait blocks until a token is available and consumes it. It returns ctx.Err() without
+// consuming a token if ctx is cancelled or its deadline passes first.
+func (rl *RateLimiter) Wait(ctx context.Context) error {
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		rl.mu.Lock()
+		delay, ok := rl.take(time.Now())
+		rl.mu.Unlock()
+		if ok {
+			return nil
+		}
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+			// Another waiter may have taken the token; try again.
+		}
+	}
+}
+
+// take refills the bucket up to now and consumes one token if available. Otherwise it returns
+// how long until the next token is due. Callers must hold rl.mu.
+func (rl *RateLimiter) take(now time.Time) (time.Duration, bool) {
+	if rl.rate <= 0 {
+		return 0, true
+	}
+
+	if elapsed := now.Sub(rl.last); elapsed > 0 {
+		rl.tokens = math.Min(rl.burst, rl.tokens+elapsed.Seconds()*rl.rate)
+		rl.last = now
+	}
+	if rl.tokens >= 1 {
+		rl.tokens--
+		return 0, true
+	}
+	return time.Duration((1 - rl.tokens) / rl.rate * float64(time.Second)), false
+}
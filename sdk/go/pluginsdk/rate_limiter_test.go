@@ -0,0 +1,103 @@
+package pluginsdk_test
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/rshade/finfocus-spec/sdk/go/pluginsdk"
+)
+
+func TestRateLimiter_AllowBurst(t *testing.T) {
+	limiter := pluginsdk.NewRateLimiter(1, 3)
+
+	for i := range 3 {
+		assert.True(t, limiter.Allow(), "call %d should fit in the burst", i)
+	}
+	assert.False(t, limiter.Allow(), "bucket should be empty after the burst")
+}
+
+func TestRateLimiter_Refills(t *testing.T) {
+	limiter := pluginsdk.NewRateLimiter(100, 1) // one token every 10ms
+	require.True(t, limiter.Allow())
+	require.False(t, limiter.Allow())
+
+	time.Sleep(15 * time.Millisecond)
+	assert.True(t, limiter.Allow())
+}
+
+func TestRateLimiter_Wait(t *testing.T) {
+	limiter := pluginsdk.NewRateLimiter(50, 1) // one token every 20ms
+	ctx := context.Background()
+
+	start := time.Now()
+	for range 3 {
+		require.NoError(t, limiter.Wait(ctx))
+	}
+	// The first token is immediate; the next two each wait ~20ms.
+	assert.GreaterOrEqual(t, time.Since(start), 35*time.Millisecond)
+}
+
+func TestRateLimiter_WaitContextCancelled(t *testing.T) {
+	limiter := pluginsdk.NewRateLimiter(0.1, 1) // one token every 10s
+	require.True(t, limiter.Allow())
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	err := limiter.Wait(ctx)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Less(t, time.Since(start), time.Second, "Wait should return promptly on cancellation")
+
+	cancelled, cancelNow := context.WithCancel(context.Background())
+	cancelNow()
+	assert.ErrorIs(t, limiter.Wait(cancelled), context.Canceled)
+}
+
+func TestRateLimiter_Unlimited(t *testing.T) {
+	limiter := pluginsdk.NewRateLimiter(0, 1)
+	for range 100 {
+		require.True(t, limiter.Allow())
+	}
+	assert.NoError(t, limiter.Wait(context.Background()))
+}
+
+func TestRateLimiter_Concurrent(t *testing.T) {
+	const (
+		rate       = 200.0
+		burst      = 5
+		goroutines = 8
+		calls      = 5
+	)
+	limiter := pluginsdk.NewRateLimiter(rate, burst)
+	ctx := context.Background()
+
+	var allowed atomic.Int64
+	var wg sync.WaitGroup
+	start := time.Now()
+	for range goroutines {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for range calls {
+				if err := limiter.Wait(ctx); err == nil {
+					allowed.Add(1)
+				}
+				limiter.Allow()
+			}
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, int64(goroutines*calls), allowed.Load())
+	// Every Wait and any successful Allow consumed a token, so at least
+	// goroutines*calls - burst tokens had to be refilled at 200/s.
+	minElapsed := time.Duration(float64(goroutines*calls-burst) / rate * float64(time.Second))
+	assert.GreaterOrEqual(t, time.Since(start), minElapsed-10*time.Millisecond)
+}
@@ -0,0 +1,108 @@
+package pluginsdk
+
+import (
+	"math"
+	"sort"
+
+	pbc "github.com/rshade/finfocus-spec/sdk/go/proto/finfocus/v1"
+)
+
+const (
+	// unknownConfidenceScore is the neutral confidence assumed for recommendations without one.
+	unknownConfidenceScore = 0.5
+
+	// maxPriorityLevel is the level of RECOMMENDATION_PRIORITY_CRITICAL, used to scale priority to 0-1.
+	maxPriorityLevel = float64(pbc.RecommendationPriority_RECOMMENDATION_PRIORITY_CRITICAL)
+
+	// Default weights; see DefaultScoringWeights.
+	defaultSavingsWeight    = 1.0
+	defaultPriorityWeight   = 2.0
+	defaultConfidenceWeight = 2.0
+)
+
+// ScoringWeights sets how much each factor contributes to a recommendation's score:
+//
+//	score = Savings    * log10(1 + estimated_savings)
+//	      + Priority   * priority / 4
+//	      + Confidence * confidence_score
+//
+// Savings are log-scaled so each tenfold increase adds one point ($9 -> 1, $99 -> 2,
+// $999 -> 3) and large savings cannot drown out the other factors. Negative savings count as
+// zero. Priority runs from 0 (UNSPECIFIED) to 1 (CRITICAL). Confidence is clamped to 0-1, and
+// recommendations without one are assumed to be 0.5.
+type ScoringWeights struct {
+	// Savings weights the log-scaled estimated savings.
+	Savings float64
+	// Priority weights the priority level.
+	Priority float64
+	// Confidence weights the confidence score.
+	Confidence float64
+}
+
+// DefaultScoringWeights returns the weights used by ScoreRecommendation and
+// RECOMMENDATION_SORT_BY_SCORE: Savings 1, Priority 2, Confidence 2. With them a CRITICAL,
+// high-confidence $100 recommendation (score ~5.8) outranks a LOW-priority $1,000 one of unknown
+// confidence (~4.5).
+func DefaultScoringWeights() ScoringWeights {
+	return ScoringWeights{
+		Savings:    defaultSavingsWeight,
+		Priority:   defaultPriorityWeight,
+		Confidence: defaultConfidenceWeight,
+	}
+}
+
+// Score returns the recommendation's score under w; higher is better. See ScoringWeights for
+// the formula.
+func (w ScoringWeights) Score(rec *pbc.Recommendation) float64 {
+	savings := rec.GetImpact().GetEstimatedSavings()
+	if savings < 0 || math.IsNaN(savings) {
+		savings = 0
+	}
+
+	confidence := unknownConfidenceScore
+	//nolint:protogetter // direct access needed to distinguish nil from 0
+	if rec != nil && rec.ConfidenceScore != nil {
+		confidence = min(max(rec.GetConfidenceScore(), 0), 1)
+	}
+
+	priority := min(max(float64(rec.GetPriority()), 0), maxPriorityLevel) / maxPriorityLevel
+
+	return w.Savings*math.Log10(1+savings) + w.Priority*priority + w.Confidence*confidence
+}
+
+// ScoreRecommendation ranks a recommendation by combining its estimated savings, priority, and
+// confidence score with DefaultScoringWeights; higher is better. It is the ordering used by
+// RECOMMENDATION_SORT_BY_SCORE.
+//
+// Example:
+//
+//	score := pluginsdk.ScoreRecommendation(rec)
+func ScoreRecommendation(rec *pbc.Recommendation) float64 {
+	return DefaultScoringWeights().Score(rec)
+}
+
+// SortRecommendationsByScore sorts recommendations by their score under weights, highest first
+// unless sortOrder is SORT_ORDER_ASC. Ties keep their input order, and the input slice is not
+// modified. Use it instead of SortRecommendations with RECOMMENDATION_SORT_BY_SCORE to apply
+// custom weights.
+func SortRecommendationsByScore(
+	recommendations []*pbc.Recommendation,
+	weights ScoringWeights,
+	sortOrder pbc.SortOrder,
+) []*pbc.Recommendation {
+	sorted := make([]*pbc.Recommendation, len(recommendations))
+	copy(sorted, recommendations)
+
+	scores := make(map[*pbc.Recommendation]float64, len(sorted))
+	for _, rec := range sorted {
+		scores[rec] = weights.Score(rec)
+	}
+	ascending := sortOrder == pbc.SortOrder_SORT_ORDER_ASC
+	sort.SliceStable(sorted, func(i, j int) bool {
+		if ascending {
+			return scores[sorted[i]] < scores[sorted[j]]
+		}
+		return scores[sorted[j]] < scores[sorted[i]]
+	})
+	return sorted
+}
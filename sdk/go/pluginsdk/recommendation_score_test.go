@@ -0,0 +1,101 @@
+package pluginsdk_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/rshade/finfocus-spec/sdk/go/pluginsdk"
+	pbc "github.com/rshade/finfocus-spec/sdk/go/proto/finfocus/v1"
+)
+
+func scoredRecommendation(
+	id string,
+	savings float64,
+	priority pbc.RecommendationPriority,
+	confidence *float64,
+) *pbc.Recommendation {
+	return &pbc.Recommendation{
+		Id:              id,
+		Priority:        priority,
+		ConfidenceScore: confidence,
+		Impact:          &pbc.RecommendationImpact{EstimatedSavings: savings, Currency: "USD"},
+	}
+}
+
+func TestScoreRecommendation(t *testing.T) {
+	confidence := func(c float64) *float64 { return &c }
+	tests := []struct {
+		name string
+		rec  *pbc.Recommendation
+		want float64
+	}{
+		{
+			name: "all factors",
+			rec:  scoredRecommendation("a", 99, pbc.RecommendationPriority_RECOMMENDATION_PRIORITY_CRITICAL, confidence(0.9)),
+			want: 2 + 2 + 1.8,
+		},
+		{
+			name: "unknown confidence is neutral",
+			rec:  scoredRecommendation("b", 999, pbc.RecommendationPriority_RECOMMENDATION_PRIORITY_LOW, nil),
+			want: 3 + 0.5 + 1,
+		},
+		{
+			name: "negative savings and out-of-range confidence are clamped",
+			rec: scoredRecommendation("c", -50,
+				pbc.RecommendationPriority_RECOMMENDATION_PRIORITY_UNSPECIFIED, confidence(1.5)),
+			want: 2,
+		},
+		{
+			name: "nil recommendation",
+			rec:  nil,
+			want: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.InDelta(t, tt.want, pluginsdk.ScoreRecommendation(tt.rec), 1e-9)
+		})
+	}
+}
+
+func TestScoringWeights_Score(t *testing.T) {
+	confidence := 0.8
+	rec := scoredRecommendation("a", 9, pbc.RecommendationPriority_RECOMMENDATION_PRIORITY_MEDIUM, &confidence)
+
+	savingsOnly := pluginsdk.ScoringWeights{Savings: 1}
+	assert.InDelta(t, 1, savingsOnly.Score(rec), 1e-9)
+
+	custom := pluginsdk.ScoringWeights{Savings: 2, Priority: 4, Confidence: 10}
+	assert.InDelta(t, 2*math.Log10(10)+4*0.5+10*0.8, custom.Score(rec), 1e-9)
+}
+
+func TestSortRecommendations_ByScore(t *testing.T) {
+	high := 0.95
+	recs := []*pbc.Recommendation{
+		scoredRecommendation("big-low", 1000, pbc.RecommendationPriority_RECOMMENDATION_PRIORITY_LOW, nil),
+		scoredRecommendation("small-critical", 100, pbc.RecommendationPriority_RECOMMENDATION_PRIORITY_CRITICAL, &high),
+		scoredRecommendation("tiny", 1, pbc.RecommendationPriority_RECOMMENDATION_PRIORITY_LOW, nil),
+	}
+	ids := func(recs []*pbc.Recommendation) []string {
+		out := make([]string, len(recs))
+		for i, r := range recs {
+			out[i] = r.GetId()
+		}
+		return out
+	}
+
+	sortBy := pbc.RecommendationSortBy_RECOMMENDATION_SORT_BY_SCORE
+	assert.Equal(t, []string{"small-critical", "big-low", "tiny"},
+		ids(pluginsdk.SortRecommendations(recs, sortBy, pbc.SortOrder_SORT_ORDER_UNSPECIFIED)),
+		"score sorts descending by default")
+	assert.Equal(t, []string{"tiny", "big-low", "small-critical"},
+		ids(pluginsdk.SortRecommendations(recs, sortBy, pbc.SortOrder_SORT_ORDER_ASC)))
+
+	savingsFirst := pluginsdk.ScoringWeights{Savings: 1}
+	assert.Equal(t, []string{"big-low", "small-critical", "tiny"},
+		ids(pluginsdk.SortRecommendationsByScore(recs, savingsFirst, pbc.SortOrder_SORT_ORDER_UNSPECIFIED)))
+	assert.Equal(t, []string{"big-low", "small-critical", "tiny"}, ids(recs), "input must not be modified")
+}
@@ -0,0 +1,110 @@
+package pluginsdk
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	pbc "github.com/rshade/finfocus-spec/sdk/go/proto/finfocus/v1"
+)
+
+// ErrRecommendationNil is returned by RecommendationsToCSV when the slice contains a nil recommendation.
+var ErrRecommendationNil = errors.New("recommendation is required")
+
+// recommendationCSVHeader is the header row written by RecommendationsToCSV.
+//
+//nolint:gochecknoglobals // Intentional: read-only column list
+var recommendationCSVHeader = []string{
+	"id",
+	"category",
+	"action_type",
+	"provider",
+	"resource_type",
+	"resource_id",
+	"resource_name",
+	"region",
+	"estimated_savings",
+	"currency",
+	"projection_period",
+	"priority",
+	"confidence_score",
+	"description",
+}
+
+// RecommendationsToCSV writes recommendations to w as CSV, one row per recommendation after
+// a header row, for finance teams working in spreadsheets.
+//
+// Columns: id, category, action_type, provider, resource_type, resource_id, resource_name,
+// region, estimated_savings, currency, projection_period, priority, confidence_score,
+// description. Enums are written without their type prefix (e.g., "COST", "RIGHTSIZE",
+// "HIGH"), and UNSPECIFIED values are empty. A recommendation without an impact has empty
+// savings, currency, and projection period cells, and an unset confidence score is empty.
+// Values containing commas, quotes, or newlines are quoted per RFC 4180.
+//
+// An empty slice writes only the header. Returns ErrRecommendationNil (wrapped with the
+// index) if any recommendation is nil, before anything is written, or the first error from w.
+func RecommendationsToCSV(recs []*pbc.Recommendation, w io.Writer) error {
+	for i, rec := range recs {
+		if rec == nil {
+			return fmt.Errorf("recommendations[%d]: %w", i, ErrRecommendationNil)
+		}
+	}
+
+	cw := csv.NewWriter(w)
+	if err := cw.Write(recommendationCSVHeader); err != nil {
+		return err
+	}
+	for _, rec := range recs {
+		if err := cw.Write(recommendationCSVRow(rec)); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// recommendationCSVRow renders rec in recommendationCSVHeader order.
+func recommendationCSVRow(rec *pbc.Recommendation) []string {
+	resource := rec.GetResource()
+
+	var savings, currencyCode, period string
+	if impact := rec.GetImpact(); impact != nil {
+		savings = strconv.FormatFloat(impact.GetEstimatedSavings(), 'f', -1, 64)
+		currencyCode = impact.GetCurrency()
+		period = impact.GetProjectionPeriod()
+	}
+
+	var confidence string
+	if rec.ConfidenceScore != nil {
+		confidence = strconv.FormatFloat(rec.GetConfidenceScore(), 'f', -1, 64)
+	}
+
+	return []string{
+		rec.GetId(),
+		csvEnum(rec.GetCategory().String(), "RECOMMENDATION_CATEGORY_"),
+		csvEnum(rec.GetActionType().String(), "RECOMMENDATION_ACTION_TYPE_"),
+		resource.GetProvider(),
+		resource.GetResourceType(),
+		resource.GetId(),
+		resource.GetName(),
+		resource.GetRegion(),
+		savings,
+		currencyCode,
+		period,
+		csvEnum(rec.GetPriority().String(), "RECOMMENDATION_PRIORITY_"),
+		confidence,
+		rec.GetDescription(),
+	}
+}
+
+// csvEnum strips prefix from an enum value name, returning "" for the UNSPECIFIED value.
+func csvEnum(name, prefix string) string {
+	value := strings.TrimPrefix(name, prefix)
+	if value == "UNSPECIFIED" {
+		return ""
+	}
+	return value
+}
@@ -0,0 +1,122 @@
+package pluginsdk_test
+
+import (
+	"bytes"
+	"encoding/csv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/rshade/finfocus-spec/sdk/go/pluginsdk"
+	pbc "github.com/rshade/finfocus-spec/sdk/go/proto/finfocus/v1"
+)
+
+func csvRecommendation() *pbc.Recommendation {
+	return &pbc.Recommendation{
+		Id:         "rec-001",
+		Category:   pbc.RecommendationCategory_RECOMMENDATION_CATEGORY_COST,
+		ActionType: pbc.RecommendationActionType_RECOMMENDATION_ACTION_TYPE_RIGHTSIZE,
+		Resource: &pbc.ResourceRecommendationInfo{
+			Id:           "i-1234567890abcdef0",
+			Name:         "web-server",
+			Provider:     "aws",
+			ResourceType: "ec2",
+			Region:       "us-east-1",
+		},
+		Impact: &pbc.RecommendationImpact{
+			EstimatedSavings: 37.96,
+			Currency:         "USD",
+			ProjectionPeriod: "monthly",
+		},
+		Priority:        pbc.RecommendationPriority_RECOMMENDATION_PRIORITY_HIGH,
+		ConfidenceScore: proto.Float64(0.85),
+		Description:     `Downsize to t3.small, "low" CPU usage, 30 days`,
+	}
+}
+
+// recommendationRows writes recs as CSV and parses the output back into rows keyed by column.
+func recommendationRows(t *testing.T, recs ...*pbc.Recommendation) []map[string]string {
+	t.Helper()
+	var buf bytes.Buffer
+	require.NoError(t, pluginsdk.RecommendationsToCSV(recs, &buf))
+
+	lines, err := csv.NewReader(&buf).ReadAll()
+	require.NoError(t, err, "output must be valid CSV")
+	require.Len(t, lines, len(recs)+1, "expected a header plus one row per recommendation")
+
+	rows := make([]map[string]string, 0, len(recs))
+	for _, line := range lines[1:] {
+		row := make(map[string]string, len(lines[0]))
+		for i, column := range lines[0] {
+			row[column] = line[i]
+		}
+		rows = append(rows, row)
+	}
+	return rows
+}
+
+func TestRecommendationsToCSV(t *testing.T) {
+	second := csvRecommendation()
+	second.Id = "rec-002"
+	rows := recommendationRows(t, csvRecommendation(), second)
+
+	assert.Equal(t, map[string]string{
+		"id":                "rec-001",
+		"category":          "COST",
+		"action_type":       "RIGHTSIZE",
+		"provider":          "aws",
+		"resource_type":     "ec2",
+		"resource_id":       "i-1234567890abcdef0",
+		"resource_name":     "web-server",
+		"region":            "us-east-1",
+		"estimated_savings": "37.96",
+		"currency":          "USD",
+		"projection_period": "monthly",
+		"priority":          "HIGH",
+		"confidence_score":  "0.85",
+		"description":       `Downsize to t3.small, "low" CPU usage, 30 days`,
+	}, rows[0])
+	assert.Equal(t, "rec-002", rows[1]["id"])
+}
+
+func TestRecommendationsToCSV_Escaping(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, pluginsdk.RecommendationsToCSV([]*pbc.Recommendation{csvRecommendation()}, &buf))
+	assert.Contains(t, buf.String(), `"Downsize to t3.small, ""low"" CPU usage, 30 days"`)
+}
+
+func TestRecommendationsToCSV_Empty(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, pluginsdk.RecommendationsToCSV(nil, &buf))
+	assert.Equal(t,
+		"id,category,action_type,provider,resource_type,resource_id,resource_name,region,"+
+			"estimated_savings,currency,projection_period,priority,confidence_score,description\n",
+		buf.String())
+}
+
+func TestRecommendationsToCSV_MissingValues(t *testing.T) {
+	rec := csvRecommendation()
+	rec.Impact = nil
+	rec.ConfidenceScore = nil
+	rec.Priority = pbc.RecommendationPriority_RECOMMENDATION_PRIORITY_UNSPECIFIED
+	rec.Resource = nil
+
+	row := recommendationRows(t, rec)[0]
+	for _, column := range []string{
+		"estimated_savings", "currency", "projection_period", "confidence_score", "priority", "resource_id",
+	} {
+		assert.Empty(t, row[column], column)
+	}
+	assert.Equal(t, "rec-001", row["id"])
+}
+
+func TestRecommendationsToCSV_NilRecommendation(t *testing.T) {
+	var buf bytes.Buffer
+	err := pluginsdk.RecommendationsToCSV([]*pbc.Recommendation{csvRecommendation(), nil}, &buf)
+	require.ErrorIs(t, err, pluginsdk.ErrRecommendationNil)
+	assert.True(t, strings.Contains(err.Error(), "recommendations[1]"))
+	assert.Zero(t, buf.Len(), "nothing should be written")
+}
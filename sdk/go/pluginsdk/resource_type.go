@@ -0,0 +1,97 @@
+package pluginsdk
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Sentinel errors for resource type token parsing.
+var (
+	// ErrResourceTypeEmpty is returned when a resource type token is empty.
+	ErrResourceTypeEmpty = errors.New("resource type is required")
+
+	// ErrResourceTypeMalformed is returned when a resource type token is not of the form
+	// provider:module/type:Type or provider:module:Type.
+	ErrResourceTypeMalformed = errors.New("resource type must be of the form provider:module/type:Type")
+)
+
+// resourceTypeSegments is the number of colon-separated segments in a resource type token.
+const resourceTypeSegments = 3
+
+// resourceTypeProviderRegex matches Pulumi package names (e.g., "aws", "azure-native").
+//
+//nolint:gochecknoglobals // precompiled regex for resource type validation to avoid repeated compilation
+var resourceTypeProviderRegex = regexp.MustCompile(`^[a-z][a-z0-9-]*$`)
+
+// resourceTypeModuleRegex matches module paths (e.g., "ec2", "ec2/instance", "apps/v1").
+//
+//nolint:gochecknoglobals // precompiled regex for resource type validation to avoid repeated compilation
+var resourceTypeModuleRegex = regexp.MustCompile(`^[A-Za-z0-9_.-]+(/[A-Za-z0-9_.-]+)*$`)
+
+// resourceTypeNameRegex matches type names (e.g., "Instance", "BucketPolicy").
+//
+//nolint:gochecknoglobals // precompiled regex for resource type validation to avoid repeated compilation
+var resourceTypeNameRegex = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9]*$`)
+
+// ParseResourceType splits a Pulumi resource type token into its provider, module, and type name.
+//
+// Both the fully-qualified form ("aws:ec2/instance:Instance") and the loose form
+// ("aws:ec2:Instance") are accepted; in either case the module is the first segment of the
+// module path ("ec2"). Returns ErrResourceTypeEmpty for an empty token and an error wrapping
+// ErrResourceTypeMalformed for anything else that is not a three-part token.
+func ParseResourceType(s string) (string, string, string, error) {
+	provider, modulePath, typeName, err := splitResourceType(s)
+	if err != nil {
+		return "", "", "", err
+	}
+	module, _, _ := strings.Cut(modulePath, "/")
+	return provider, module, typeName, nil
+}
+
+// NormalizeResourceType converts a resource type token to the canonical
+// provider:module/type:Type form used by Pulumi.
+//
+// Loose tokens gain a module path element derived from the type name, and the type name is
+// capitalized: "aws:ec2:Instance" and "aws:ec2:instance" both become "aws:ec2/instance:Instance",
+// and "aws:s3:BucketPolicy" becomes "aws:s3/bucketPolicy:BucketPolicy". Tokens whose module
+// path is already qualified (e.g., "kubernetes:apps/v1:Deployment") are returned unchanged
+// apart from type name capitalization.
+func NormalizeResourceType(s string) (string, error) {
+	provider, modulePath, typeName, err := splitResourceType(s)
+	if err != nil {
+		return "", err
+	}
+
+	typeName = withFirstRune(typeName, unicode.ToUpper)
+	if !strings.Contains(modulePath, "/") {
+		modulePath += "/" + withFirstRune(typeName, unicode.ToLower)
+	}
+	return provider + ":" + modulePath + ":" + typeName, nil
+}
+
+// splitResourceType validates a token and returns its provider, full module path, and type name.
+func splitResourceType(s string) (string, string, string, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return "", "", "", ErrResourceTypeEmpty
+	}
+
+	parts := strings.Split(s, ":")
+	if len(parts) != resourceTypeSegments ||
+		!resourceTypeProviderRegex.MatchString(parts[0]) ||
+		!resourceTypeModuleRegex.MatchString(parts[1]) ||
+		!resourceTypeNameRegex.MatchString(parts[2]) {
+		return "", "", "", fmt.Errorf("%w: %q", ErrResourceTypeMalformed, s)
+	}
+	return parts[0], parts[1], parts[2], nil
+}
+
+// withFirstRune returns s with its first rune mapped by fn.
+func withFirstRune(s string, fn func(rune) rune) string {
+	r, size := utf8.DecodeRuneInString(s)
+	return string(fn(r)) + s[size:]
+}
@@ -0,0 +1,91 @@
+package pluginsdk_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/rshade/finfocus-spec/sdk/go/pluginsdk"
+)
+
+func TestParseResourceType(t *testing.T) {
+	tests := []struct {
+		name         string
+		token        string
+		wantProvider string
+		wantModule   string
+		wantType     string
+	}{
+		{"fully-qualified token", "aws:ec2/instance:Instance", "aws", "ec2", "Instance"},
+		{"loose token", "aws:ec2:Instance", "aws", "ec2", "Instance"},
+		{"hyphenated provider", "azure-native:compute/virtualMachine:VirtualMachine", "azure-native", "compute", "VirtualMachine"},
+		{"kubernetes versioned module", "kubernetes:apps/v1:Deployment", "kubernetes", "apps", "Deployment"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			provider, module, typeName, err := pluginsdk.ParseResourceType(tt.token)
+			if err != nil {
+				t.Fatalf("ParseResourceType(%q) unexpected error: %v", tt.token, err)
+			}
+			if provider != tt.wantProvider || module != tt.wantModule || typeName != tt.wantType {
+				t.Errorf("ParseResourceType(%q) = (%q, %q, %q), want (%q, %q, %q)",
+					tt.token, provider, module, typeName, tt.wantProvider, tt.wantModule, tt.wantType)
+			}
+		})
+	}
+}
+
+func TestNormalizeResourceType(t *testing.T) {
+	tests := []struct {
+		token string
+		want  string
+	}{
+		{"aws:ec2/instance:Instance", "aws:ec2/instance:Instance"},
+		{"aws:ec2:Instance", "aws:ec2/instance:Instance"},
+		{"aws:ec2:instance", "aws:ec2/instance:Instance"},
+		{"aws:s3:BucketPolicy", "aws:s3/bucketPolicy:BucketPolicy"},
+		{"kubernetes:apps/v1:Deployment", "kubernetes:apps/v1:Deployment"},
+		{"  gcp:compute:Instance  ", "gcp:compute/instance:Instance"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.token, func(t *testing.T) {
+			got, err := pluginsdk.NormalizeResourceType(tt.token)
+			if err != nil {
+				t.Fatalf("NormalizeResourceType(%q) unexpected error: %v", tt.token, err)
+			}
+			if got != tt.want {
+				t.Errorf("NormalizeResourceType(%q) = %q, want %q", tt.token, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestResourceTypeErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		token   string
+		wantErr error
+	}{
+		{"empty token", "", pluginsdk.ErrResourceTypeEmpty},
+		{"whitespace token", "   ", pluginsdk.ErrResourceTypeEmpty},
+		{"short name", "ec2", pluginsdk.ErrResourceTypeMalformed},
+		{"two segments", "aws:Instance", pluginsdk.ErrResourceTypeMalformed},
+		{"too many segments", "aws:ec2:instance:Instance", pluginsdk.ErrResourceTypeMalformed},
+		{"empty module", "aws::Instance", pluginsdk.ErrResourceTypeMalformed},
+		{"empty type", "aws:ec2/instance:", pluginsdk.ErrResourceTypeMalformed},
+		{"uppercase provider", "AWS:ec2:Instance", pluginsdk.ErrResourceTypeMalformed},
+		{"trailing slash in module", "aws:ec2/:Instance", pluginsdk.ErrResourceTypeMalformed},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, _, _, err := pluginsdk.ParseResourceType(tt.token); !errors.Is(err, tt.wantErr) {
+				t.Errorf("ParseResourceType(%q) error = %v, want %v", tt.token, err, tt.wantErr)
+			}
+			if _, err := pluginsdk.NormalizeResourceType(tt.token); !errors.Is(err, tt.wantErr) {
+				t.Errorf("NormalizeResourceType(%q) error = %v, want %v", tt.token, err, tt.wantErr)
+			}
+		})
+	}
+}
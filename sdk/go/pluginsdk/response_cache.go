@@ -0,0 +1,231 @@
+package pluginsdk
+
+import (
+	"container/list"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog"
+	"google.golang.org/protobuf/proto"
+
+	pbc "github.com/rshade/finfocus-spec/sdk/go/proto/finfocus/v1"
+)
+
+// ResponseCache caches projected cost responses for plugins backed by rate-limited pricing APIs.
+// Entries expire after a fixed TTL, and once the cache is full the least recently used entry is
+// evicted to make room. Plugins using it should advertise registry.PluginCapabilityCaching.
+//
+// Responses are copied on Set and Get, so callers may modify them freely. Get counts hits and
+// misses, reported by CacheStats and logged by LogStats.
+//
+// ResponseCache is safe for concurrent use.
+type ResponseCache struct {
+	mu         sync.Mutex
+	ttl        time.Duration
+	maxEntries int
+	order      *list.List // *cacheEntry values, most recently used first
+	entries    map[string]*list.Element
+	hits       uint64
+	misses     uint64
+}
+
+// cacheEntry is a cached response and the time it expires.
+type cacheEntry struct {
+	key       string
+	resp      *pbc.GetProjectedCostResponse
+	expiresAt time.Time
+}
+
+// NewResponseCache creates a cache whose entries expire after ttl and which holds at most
+// maxEntries responses. A ttl <= 0 disables expiry, and a maxEntries <= 0 disables the size
+// limit.
+//
+// Example:
+//
+//	cache := pluginsdk.NewResponseCache(15*time.Minute, 10000)
+//	key := pluginsdk.CacheKey(req.GetResource())
+//	if resp, ok := cache.Get(key); ok {
+//	    return resp, nil
+//	}
+//	resp, err := fetchFromPricingAPI(ctx, req)
+//	if err != nil {
+//	    return nil, err
+//	}
+//	cache.Set(key, resp)
+func NewResponseCache(ttl time.Duration, maxEntries int) *ResponseCache {
+	if maxEntries < 0 {
+		maxEntries = 0
+	}
+	return &ResponseCache{
+		ttl:        ttl,
+		maxEntries: maxEntries,
+		order:      list.New(),
+		entries:    make(map[string]*list.Element),
+	}
+}
+
+// Get returns a copy of the response cached under key. It returns false if there is no entry or
+// the entry has expired, in which case the expired entry is removed. Every call counts as a
+// hit or a miss in CacheStats.
+func (c *ResponseCache) Get(key string) (*pbc.GetProjectedCostResponse, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	elem, ok := c.entries[key]
+	if !ok {
+		c.misses++
+		return nil, false
+	}
+	entry, _ := elem.Value.(*cacheEntry)
+	if c.expired(entry, time.Now()) {
+		c.remove(elem)
+		c.misses++
+		return nil, false
+	}
+	c.order.MoveToFront(elem)
+	c.hits++
+	return proto.CloneOf(entry.resp), true
+}
+
+// Set caches a copy of resp under key, replacing any existing entry and restarting its TTL.
+// If the cache is full, expired entries are dropped first and then the least recently used
+// entry is evicted. A nil resp is not cached.
+func (c *ResponseCache) Set(key string, resp *pbc.GetProjectedCostResponse) {
+	if resp == nil {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := time.Now()
+	entry := &cacheEntry{key: key, resp: proto.CloneOf(resp)}
+	if c.ttl > 0 {
+		entry.expiresAt = now.Add(c.ttl)
+	}
+
+	if elem, ok := c.entries[key]; ok {
+		elem.Value = entry
+		c.order.MoveToFront(elem)
+		return
+	}
+
+	if c.maxEntries > 0 && c.order.Len() >= c.maxEntries {
+		c.removeExpired(now)
+		for c.order.Len() >= c.maxEntries {
+			c.remove(c.order.Back())
+		}
+	}
+	c.entries[key] = c.order.PushFront(entry)
+}
+
+// Len returns the number of cached entries, including expired entries not yet removed.
+func (c *ResponseCache) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.order.Len()
+}
+
+// CacheStats returns the number of Get hits and misses since the cache was created or stats
+// were last reset, and the hit ratio hits / (hits + misses). The ratio is 0 before any Get.
+func (c *ResponseCache) CacheStats() (uint64, uint64, float64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.hits, c.misses, hitRatio(c.hits, c.misses)
+}
+
+// ResetStats zeroes the hit and miss counters without touching cached entries.
+func (c *ResponseCache) ResetStats() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.hits, c.misses = 0, 0
+}
+
+// LogStats logs the current hit and miss counts, hit ratio, and entry count at Info level.
+func (c *ResponseCache) LogStats(logger zerolog.Logger) {
+	hits, misses, ratio := c.CacheStats()
+	logger.Info().
+		Uint64(FieldCacheHits, hits).
+		Uint64(FieldCacheMisses, misses).
+		Float64(FieldCacheHitRatio, ratio).
+		Int(FieldCacheEntries, c.Len()).
+		Msg("response cache stats")
+}
+
+// LogStatsPeriodically calls LogStats every interval until ctx is cancelled. It blocks, so
+// run it in its own goroutine. A non-positive interval returns immediately.
+//
+// Example:
+//
+//	go cache.LogStatsPeriodically(ctx, logger, time.Minute)
+func (c *ResponseCache) LogStatsPeriodically(ctx context.Context, logger zerolog.Logger, interval time.Duration) {
+	if interval <= 0 {
+		return
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			c.LogStats(logger)
+		}
+	}
+}
+
+// hitRatio returns hits / (hits + misses), or 0 when there were no lookups.
+func hitRatio(hits, misses uint64) float64 {
+	total := hits + misses
+	if total == 0 {
+		return 0
+	}
+	return float64(hits) / float64(total)
+}
+
+// expired reports whether entry has outlived the TTL at now.
+func (c *ResponseCache) expired(entry *cacheEntry, now time.Time) bool {
+	return c.ttl > 0 && !now.Before(entry.expiresAt)
+}
+
+// removeExpired drops every expired entry. Callers must hold c.mu.
+func (c *ResponseCache) removeExpired(now time.Time) {
+	for elem := c.order.Back(); elem != nil; {
+		prev := elem.Prev()
+		if entry, _ := elem.Value.(*cacheEntry); c.expired(entry, now) {
+			c.remove(elem)
+		}
+		elem = prev
+	}
+}
+
+// remove deletes elem from the cache. Callers must hold c.mu.
+func (c *ResponseCache) remove(elem *list.Element) {
+	entry, _ := c.order.Remove(elem).(*cacheEntry)
+	delete(c.entries, entry.key)
+}
+
+// CacheKey returns a stable cache key for a resource, derived from a SHA-256 hash of its
+// provider, resource type, SKU, and region. Tags, ID, and other fields are ignored, so
+// resources that differ only in those fields share a key. A nil resource hashes as if all
+// fields were empty.
+func CacheKey(resource *pbc.ResourceDescriptor) string {
+	h := sha256.New()
+	for _, field := range []string{
+		resource.GetProvider(),
+		resource.GetResourceType(),
+		resource.GetSku(),
+		resource.GetRegion(),
+	} {
+		// Length-prefixed so that ("ab", "c") and ("a", "bc") hash differently.
+		fmt.Fprintf(h, "%d:%s", len(field), field)
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
@@ -0,0 +1,271 @@
+package pluginsdk_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/rshade/finfocus-spec/sdk/go/pluginsdk"
+	pbc "github.com/rshade/finfocus-spec/sdk/go/proto/finfocus/v1"
+)
+
+func cachedResponse(cost float64) *pbc.GetProjectedCostResponse {
+	return &pbc.GetProjectedCostResponse{Currency: "USD", CostPerMonth: cost}
+}
+
+func TestResponseCache_GetSet(t *testing.T) {
+	cache := pluginsdk.NewResponseCache(time.Minute, 10)
+
+	_, ok := cache.Get("missing")
+	assert.False(t, ok)
+
+	resp := cachedResponse(70.08)
+	cache.Set("ec2", resp)
+	resp.CostPerMonth = 1 // the cache keeps its own copy
+
+	got, ok := cache.Get("ec2")
+	require.True(t, ok)
+	assert.InDelta(t, 70.08, got.GetCostPerMonth(), 1e-9)
+
+	got.CostPerMonth = 2 // and hands out copies
+	again, _ := cache.Get("ec2")
+	assert.InDelta(t, 70.08, again.GetCostPerMonth(), 1e-9)
+
+	cache.Set("ec2", cachedResponse(35.04))
+	got, _ = cache.Get("ec2")
+	assert.InDelta(t, 35.04, got.GetCostPerMonth(), 1e-9)
+	assert.Equal(t, 1, cache.Len())
+
+	cache.Set("nil", nil)
+	_, ok = cache.Get("nil")
+	assert.False(t, ok)
+}
+
+func TestResponseCache_TTL(t *testing.T) {
+	cache := pluginsdk.NewResponseCache(20*time.Millisecond, 0)
+	cache.Set("ec2", cachedResponse(70.08))
+
+	_, ok := cache.Get("ec2")
+	require.True(t, ok)
+
+	time.Sleep(30 * time.Millisecond)
+	_, ok = cache.Get("ec2")
+	assert.False(t, ok)
+	assert.Equal(t, 0, cache.Len(), "expired entry should be removed on Get")
+}
+
+func TestResponseCache_LRU(t *testing.T) {
+	cache := pluginsdk.NewResponseCache(0, 2)
+	cache.Set("a", cachedResponse(1))
+	cache.Set("b", cachedResponse(2))
+
+	_, ok := cache.Get("a") // a is now more recently used than b
+	require.True(t, ok)
+
+	cache.Set("c", cachedResponse(3))
+	assert.Equal(t, 2, cache.Len())
+
+	_, ok = cache.Get("b")
+	assert.False(t, ok, "least recently used entry should be evicted")
+	_, ok = cache.Get("a")
+	assert.True(t, ok)
+	_, ok = cache.Get("c")
+	assert.True(t, ok)
+}
+
+func TestResponseCache_EvictsExpiredBeforeLRU(t *testing.T) {
+	cache := pluginsdk.NewResponseCache(20*time.Millisecond, 2)
+	cache.Set("old", cachedResponse(1))
+	time.Sleep(30 * time.Millisecond)
+
+	cache.Set("a", cachedResponse(2))
+	cache.Set("b", cachedResponse(3))
+
+	_, ok := cache.Get("a")
+	assert.True(t, ok, "the expired entry should be evicted instead of a live one")
+	_, ok = cache.Get("b")
+	assert.True(t, ok)
+}
+
+func TestResponseCache_Concurrent(t *testing.T) {
+	cache := pluginsdk.NewResponseCache(time.Minute, 50)
+
+	var wg sync.WaitGroup
+	for i := range 8 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := range 100 {
+				key := fmt.Sprintf("k%d", (i*100+j)%75)
+				cache.Set(key, cachedResponse(float64(j)))
+				cache.Get(key)
+			}
+		}()
+	}
+	wg.Wait()
+
+	assert.LessOrEqual(t, cache.Len(), 50)
+}
+
+func TestCacheKey(t *testing.T) {
+	base := &pbc.ResourceDescriptor{
+		Provider:     "aws",
+		ResourceType: "aws:ec2:Instance",
+		Sku:          "t3.micro",
+		Region:       "us-east-1",
+	}
+	key := pluginsdk.CacheKey(base)
+	assert.Len(t, key, 64)
+
+	sameShape := &pbc.ResourceDescriptor{
+		Provider:     "aws",
+		ResourceType: "aws:ec2:Instance",
+		Sku:          "t3.micro",
+		Region:       "us-east-1",
+		Id:           "web-1",
+		Tags:         map[string]string{"env": "prod"},
+	}
+	assert.Equal(t, key, pluginsdk.CacheKey(sameShape), "ID and tags are not part of the key")
+
+	otherRegion := &pbc.ResourceDescriptor{
+		Provider:     "aws",
+		ResourceType: "aws:ec2:Instance",
+		Sku:          "t3.micro",
+		Region:       "us-west-2",
+	}
+	assert.NotEqual(t, key, pluginsdk.CacheKey(otherRegion))
+
+	assert.NotEqual(t,
+		pluginsdk.CacheKey(&pbc.ResourceDescriptor{Sku: "ab", Region: "c"}),
+		pluginsdk.CacheKey(&pbc.ResourceDescriptor{Sku: "a", Region: "bc"}),
+		"field boundaries must be part of the key")
+	assert.Equal(t, pluginsdk.CacheKey(&pbc.ResourceDescriptor{}), pluginsdk.CacheKey(nil))
+}
+
+func TestResponseCache_CacheStats(t *testing.T) {
+	cache := pluginsdk.NewResponseCache(time.Minute, 10)
+
+	hits, misses, ratio := cache.CacheStats()
+	assert.Zero(t, hits)
+	assert.Zero(t, misses)
+	assert.Zero(t, ratio, "ratio should be 0 before any lookups")
+
+	cache.Get("ec2") // miss
+	cache.Set("ec2", cachedResponse(70.08))
+	cache.Get("ec2") // hit
+	cache.Get("ec2") // hit
+	cache.Get("s3")  // miss
+	cache.Get("ec2") // hit
+
+	hits, misses, ratio = cache.CacheStats()
+	assert.Equal(t, uint64(3), hits)
+	assert.Equal(t, uint64(2), misses)
+	assert.InDelta(t, 0.6, ratio, 1e-9)
+
+	cache.ResetStats()
+	hits, misses, ratio = cache.CacheStats()
+	assert.Zero(t, hits)
+	assert.Zero(t, misses)
+	assert.Zero(t, ratio)
+	assert.Equal(t, 1, cache.Len(), "reset should keep cached entries")
+}
+
+func TestResponseCache_CacheStatsExpiredIsMiss(t *testing.T) {
+	cache := pluginsdk.NewResponseCache(10*time.Millisecond, 0)
+	cache.Set("ec2", cachedResponse(70.08))
+	time.Sleep(20 * time.Millisecond)
+
+	cache.Get("ec2")
+	hits, misses, _ := cache.CacheStats()
+	assert.Zero(t, hits)
+	assert.Equal(t, uint64(1), misses)
+}
+
+func TestResponseCache_CacheStatsConcurrent(t *testing.T) {
+	cache := pluginsdk.NewResponseCache(time.Minute, 0)
+	cache.Set("hit", cachedResponse(1))
+
+	const goroutines, lookups = 8, 200
+	var wg sync.WaitGroup
+	for range goroutines {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for range lookups {
+				cache.Get("hit")
+				cache.Get("miss")
+				cache.CacheStats()
+			}
+		}()
+	}
+	wg.Wait()
+
+	hits, misses, ratio := cache.CacheStats()
+	assert.Equal(t, uint64(goroutines*lookups), hits)
+	assert.Equal(t, uint64(goroutines*lookups), misses)
+	assert.InDelta(t, 0.5, ratio, 1e-9)
+}
+
+func TestResponseCache_LogStats(t *testing.T) {
+	cache := pluginsdk.NewResponseCache(time.Minute, 0)
+	cache.Set("ec2", cachedResponse(70.08))
+	cache.Get("ec2")
+	cache.Get("s3")
+
+	var buf bytes.Buffer
+	cache.LogStats(zerolog.New(&buf))
+
+	var entry map[string]any
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &entry))
+	assert.InDelta(t, 1, entry[pluginsdk.FieldCacheHits], 0)
+	assert.InDelta(t, 1, entry[pluginsdk.FieldCacheMisses], 0)
+	assert.InDelta(t, 0.5, entry[pluginsdk.FieldCacheHitRatio], 1e-9)
+	assert.InDelta(t, 1, entry[pluginsdk.FieldCacheEntries], 0)
+}
+
+// syncBuffer is a bytes.Buffer safe for a concurrent writer and reader.
+type syncBuffer struct {
+	mu  sync.Mutex
+	buf bytes.Buffer
+}
+
+func (b *syncBuffer) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.Write(p)
+}
+
+func (b *syncBuffer) Lines() int {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return bytes.Count(b.buf.Bytes(), []byte("\n"))
+}
+
+func TestResponseCache_LogStatsPeriodically(t *testing.T) {
+	cache := pluginsdk.NewResponseCache(time.Minute, 0)
+	var buf syncBuffer
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		cache.LogStatsPeriodically(ctx, zerolog.New(&buf), 5*time.Millisecond)
+		close(done)
+	}()
+
+	require.Eventually(t, func() bool { return buf.Lines() >= 2 }, time.Second, 5*time.Millisecond)
+
+	cancel()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("LogStatsPeriodically did not return after cancel")
+	}
+}
@@ -0,0 +1,66 @@
+package pluginsdk
+
+import (
+	"time"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/rshade/finfocus-spec/sdk/go/pricing"
+)
+
+// TransientErrorWithRetry builds a transient *pricing.PluginError and returns it as a gRPC
+// status error that tells clients when to retry.
+//
+// The status code follows PluginError.GetGRPCStatus (e.g., RATE_LIMITED maps to
+// ResourceExhausted) and carries the pbc.ErrorDetail attached by GetGRPCStatus plus a standard
+// google.rpc.RetryInfo detail with retryAfter as the retry delay. A retryAfter <= 0 omits the
+// RetryInfo detail and the PluginError's RetryAfter.
+//
+// The returned error unwraps to the *pricing.PluginError, so pricing.IsTransientError and
+// errors.As recognize it on the plugin side, while gRPC sends the status unchanged.
+//
+// Example:
+//
+//	if resp.StatusCode == http.StatusTooManyRequests {
+//	    return nil, pluginsdk.TransientErrorWithRetry(
+//	        pricing.ErrorCodeRateLimited, "pricing API rate limit exceeded", 30*time.Second)
+//	}
+func TransientErrorWithRetry(code pricing.ErrorCode, message string, retryAfter time.Duration) error {
+	var hint *time.Duration
+	if retryAfter > 0 {
+		hint = &retryAfter
+	}
+	pluginErr := pricing.NewTransientError(code, message, hint)
+
+	st := pluginErr.GetGRPCStatus()
+	if hint != nil {
+		if withRetry, err := st.WithDetails(&errdetails.RetryInfo{RetryDelay: durationpb.New(retryAfter)}); err == nil {
+			st = withRetry
+		}
+	}
+	return &statusPluginError{pluginErr: pluginErr, st: st}
+}
+
+// statusPluginError is a gRPC status error that also exposes the PluginError it was built from.
+type statusPluginError struct {
+	pluginErr *pricing.PluginError
+	st        *status.Status
+}
+
+// Error returns the gRPC status error message.
+func (e *statusPluginError) Error() string {
+	return e.st.Err().Error()
+}
+
+// GRPCStatus returns the status sent to clients; it lets status.FromError and gRPC servers
+// recognize the error.
+func (e *statusPluginError) GRPCStatus() *status.Status {
+	return e.st
+}
+
+// Unwrap returns the underlying PluginError.
+func (e *statusPluginError) Unwrap() error {
+	return e.pluginErr
+}
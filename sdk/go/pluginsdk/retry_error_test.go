@@ -0,0 +1,86 @@
+package pluginsdk_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/rshade/finfocus-spec/sdk/go/pluginsdk"
+	"github.com/rshade/finfocus-spec/sdk/go/pricing"
+)
+
+// retryInfo returns the RetryInfo detail attached to st, or nil.
+func retryInfo(st *status.Status) *errdetails.RetryInfo {
+	for _, d := range st.Details() {
+		if info, ok := d.(*errdetails.RetryInfo); ok {
+			return info
+		}
+	}
+	return nil
+}
+
+func TestTransientErrorWithRetry(t *testing.T) {
+	tests := []struct {
+		name     string
+		code     pricing.ErrorCode
+		wantCode codes.Code
+	}{
+		{"rate limited", pricing.ErrorCodeRateLimited, codes.ResourceExhausted},
+		{"service unavailable", pricing.ErrorCodeServiceUnavailable, codes.Unavailable},
+		{"network timeout", pricing.ErrorCodeNetworkTimeout, codes.Unavailable},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := pluginsdk.TransientErrorWithRetry(tt.code, "upstream busy", 30*time.Second)
+			require.Error(t, err)
+
+			st, ok := status.FromError(err)
+			require.True(t, ok, "error should be a gRPC status error")
+			assert.Equal(t, tt.wantCode, st.Code())
+			assert.Contains(t, st.Message(), "upstream busy")
+
+			info := retryInfo(st)
+			require.NotNil(t, info, "status should carry a RetryInfo detail")
+			assert.Equal(t, 30*time.Second, info.GetRetryDelay().AsDuration())
+
+			assert.True(t, pricing.IsTransientError(err))
+			var pluginErr *pricing.PluginError
+			require.True(t, errors.As(err, &pluginErr))
+			assert.Equal(t, tt.code, pluginErr.Code)
+			require.NotNil(t, pluginErr.GetRetryAfter())
+			assert.Equal(t, 30*time.Second, *pluginErr.GetRetryAfter())
+		})
+	}
+}
+
+func TestTransientErrorWithRetry_ExtractErrorDetails(t *testing.T) {
+	err := pluginsdk.TransientErrorWithRetry(pricing.ErrorCodeRateLimited, "slow down", 5*time.Second)
+
+	// Simulate the client side, which receives only the status.
+	st, _ := status.FromError(err)
+	pluginErr, ok := pricing.ExtractErrorDetails(st.Err())
+	require.True(t, ok)
+	assert.Equal(t, pricing.ErrorCodeRateLimited, pluginErr.Code)
+	assert.Equal(t, pricing.TransientError, pluginErr.Category)
+}
+
+func TestTransientErrorWithRetry_NoDelay(t *testing.T) {
+	err := pluginsdk.TransientErrorWithRetry(pricing.ErrorCodeTemporaryFailure, "try again", 0)
+
+	st, ok := status.FromError(err)
+	require.True(t, ok)
+	assert.Equal(t, codes.Unavailable, st.Code())
+	assert.Nil(t, retryInfo(st), "no RetryInfo should be attached without a delay")
+	assert.True(t, pricing.IsTransientError(err))
+
+	var pluginErr *pricing.PluginError
+	require.True(t, errors.As(err, &pluginErr))
+	assert.Nil(t, pluginErr.GetRetryAfter())
+}
@@ -211,6 +211,23 @@ type PluginInfoProvider interface {
 		*pbc.GetPluginInfoResponse, error)
 }
 
+// SupportedResourcesProvider is an optional interface that plugins can implement
+// to list the resources they can price via GetSupportedResources RPC. Plugins that
+// do not implement this interface but expose a ResourceMatcher through a
+// Matcher() method (as BasePlugin does) return the matcher's SupportedResources;
+// other plugins return Unimplemented.
+type SupportedResourcesProvider interface {
+	// GetSupportedResources lists the provider and resource type pairs the plugin supports.
+	GetSupportedResources(ctx context.Context, req *pbc.GetSupportedResourcesRequest) (
+		*pbc.SupportedResourcesResponse, error)
+}
+
+// matcherProvider is implemented by plugins that expose a ResourceMatcher, such as
+// plugins embedding BasePlugin.
+type matcherProvider interface {
+	Matcher() *ResourceMatcher
+}
+
 // RegistryLookup defines the interface for looking up plugins by provider and region.
 // This is used to validate incoming Supports requests against registered plugins.
 type RegistryLookup interface {
@@ -464,11 +481,53 @@ func (s *Server) GetProjectedCost(
 	return s.plugin.GetProjectedCost(ctx, req)
 }
 
+// GetProjectedCostBatch implements the gRPC GetProjectedCostBatch method.
+// It rejects requests with more than MaxProjectedCostBatchSize resources, then delegates
+// to the plugin's BatchProjectedCostProvider implementation if available, otherwise it
+// projects each resource via GetProjectedCostBatchFromSingle.
+func (s *Server) GetProjectedCostBatch(
+	ctx context.Context,
+	req *pbc.BatchProjectedCostRequest,
+) (*pbc.BatchProjectedCostResponse, error) {
+	if count := len(req.GetResources()); count > MaxProjectedCostBatchSize {
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			"batch contains %d resources, maximum is %d",
+			count,
+			MaxProjectedCostBatchSize,
+		)
+	}
+
+	if provider, ok := s.plugin.(BatchProjectedCostProvider); ok {
+		return provider.GetProjectedCostBatch(ctx, req)
+	}
+	return GetProjectedCostBatchFromSingle(ctx, s.plugin, req)
+}
+
 // GetActualCost implements the gRPC GetActualCost method.
 func (s *Server) GetActualCost(ctx context.Context, req *pbc.GetActualCostRequest) (*pbc.GetActualCostResponse, error) {
 	return s.plugin.GetActualCost(ctx, req)
 }
 
+// StreamActualCost implements the gRPC StreamActualCost method.
+// It delegates to the plugin's ActualCostStreamer implementation if available,
+// otherwise it adapts the plugin's GetActualCost handler via StreamActualCostFromUnary.
+func (s *Server) StreamActualCost(
+	req *pbc.GetActualCostRequest,
+	stream grpc.ServerStreamingServer[pbc.ActualCostResult],
+) error {
+	return s.streamActualCost(stream.Context(), req, stream.Send)
+}
+
+// streamActualCost is the transport-independent implementation shared by the gRPC
+// and connect StreamActualCost handlers.
+func (s *Server) streamActualCost(ctx context.Context, req *pbc.GetActualCostRequest, send ActualCostSendFunc) error {
+	if streamer, ok := s.plugin.(ActualCostStreamer); ok {
+		return streamer.StreamActualCost(ctx, req, send)
+	}
+	return StreamActualCostFromUnary(ctx, s.plugin, req, send)
+}
+
 // GetPricingSpec implements the gRPC GetPricingSpec method.
 func (s *Server) GetPricingSpec(
 	ctx context.Context,
@@ -485,6 +544,23 @@ func (s *Server) EstimateCost(
 	return s.plugin.EstimateCost(ctx, req)
 }
 
+// GetSupportedResources implements the gRPC GetSupportedResources method.
+// It delegates to the plugin's SupportedResourcesProvider implementation if available,
+// otherwise derives the list from the plugin's ResourceMatcher. Returns Unimplemented
+// if the plugin has neither.
+func (s *Server) GetSupportedResources(
+	ctx context.Context,
+	req *pbc.GetSupportedResourcesRequest,
+) (*pbc.SupportedResourcesResponse, error) {
+	if provider, ok := s.plugin.(SupportedResourcesProvider); ok {
+		return provider.GetSupportedResources(ctx, req)
+	}
+	if mp, ok := s.plugin.(matcherProvider); ok && mp.Matcher() != nil {
+		return &pbc.SupportedResourcesResponse{Resources: mp.Matcher().SupportedResources()}, nil
+	}
+	return nil, status.Error(codes.Unimplemented, "plugin does not list supported resources")
+}
+
 // Supports implements the gRPC Supports method.
 // It performs two-step validation: first checks registry for plugin by provider/region,
 // then delegates to the plugin's Supports method if implemented.
@@ -570,6 +646,30 @@ func (s *Server) Supports(ctx context.Context, req *pbc.SupportsRequest) (*pbc.S
 	return resp, nil
 }
 
+// SupportsBatch implements the gRPC SupportsBatch method.
+// It rejects requests with more than MaxSupportsBatchSize resources, then delegates to the
+// plugin's BatchSupportsProvider implementation if available, otherwise it checks each resource
+// with the server's Supports handling (registry lookup, SupportsProvider, and defaults) via
+// SupportsBatchFromSingle.
+func (s *Server) SupportsBatch(
+	ctx context.Context,
+	req *pbc.SupportsBatchRequest,
+) (*pbc.SupportsBatchResponse, error) {
+	if count := len(req.GetResources()); count > MaxSupportsBatchSize {
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			"batch contains %d resources, maximum is %d",
+			count,
+			MaxSupportsBatchSize,
+		)
+	}
+
+	if provider, ok := s.plugin.(BatchSupportsProvider); ok {
+		return provider.SupportsBatch(ctx, req)
+	}
+	return SupportsBatchFromSingle(ctx, s, req)
+}
+
 // GetRecommendations implements the gRPC GetRecommendations method.
 // GetRecommendations handles GetRecommendations RPC requests.
 // If the plugin implements RecommendationsProvider, delegates to it.
@@ -0,0 +1,157 @@
+package pluginsdk
+
+import (
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/rshade/finfocus-spec/sdk/go/currency"
+	pbc "github.com/rshade/finfocus-spec/sdk/go/proto/finfocus/v1"
+)
+
+// Sentinel errors for multi-currency summary conversion.
+var (
+	// ErrSummaryNil is returned when no summary is supplied for conversion.
+	ErrSummaryNil = errors.New("summary is required")
+
+	// ErrSummaryCurrencyInvalid is returned when the summary has no currency (e.g., mixed-currency
+	// recommendations) or a currency that is not a valid ISO 4217 code.
+	ErrSummaryCurrencyInvalid = errors.New("summary.currency must be a valid ISO 4217 currency code")
+
+	// ErrTargetCurrencyInvalid is returned when a target currency is not a valid ISO 4217 code.
+	ErrTargetCurrencyInvalid = errors.New("target currency is not a valid ISO 4217 currency code")
+)
+
+// SummaryInCurrencies converts a summary's total estimated savings into each target currency,
+// using rates to convert from the summary currency (rates may be nil if every target is the
+// summary currency). A target equal to the summary currency is returned unchanged.
+//
+// Returns a map keyed by target currency. An empty targets list returns an empty map.
+// Errors wrap ErrSummaryNil, ErrSummaryCurrencyInvalid, ErrTargetCurrencyInvalid, or
+// currency.ErrRateUnavailable when rates has no positive, finite rate for a target.
+//
+// Example:
+//
+//	rates := currency.StaticRates{Base: "USD", Rates: map[string]float64{"EUR": 0.92}}
+//	totals, err := pluginsdk.SummaryInCurrencies(summary, []string{"EUR"}, rates)
+func SummaryInCurrencies(
+	summary *pbc.RecommendationSummary,
+	targets []string,
+	rates currency.RateProvider,
+) (map[string]float64, error) {
+	if summary == nil {
+		return nil, ErrSummaryNil
+	}
+	source := summary.GetCurrency()
+	if !currency.IsValid(source) {
+		return nil, fmt.Errorf("%w: %q", ErrSummaryCurrencyInvalid, source)
+	}
+
+	total := summary.GetTotalEstimatedSavings()
+	converted := make(map[string]float64, len(targets))
+	for _, target := range targets {
+		if !currency.IsValid(target) {
+			return nil, fmt.Errorf("%w: %q", ErrTargetCurrencyInvalid, target)
+		}
+		rate, err := conversionRate(source, target, rates)
+		if err != nil {
+			return nil, err
+		}
+		converted[target] = total * rate
+	}
+	return converted, nil
+}
+
+// CalculateRecommendationSummaryInCurrency builds a RecommendationSummary like
+// CalculateRecommendationSummary, but converts each recommendation's estimated savings into
+// target before summing, so recommendations in mixed currencies produce a single total in
+// target instead of a summary with no currency.
+//
+// Savings already in target are used as is; others are converted with rates (which may be
+// nil if every recommendation is in target). Recommendations whose savings cannot be converted
+// (no currency, or no rate as in SummaryInCurrencies) are skipped: they still count toward the
+// recommendation counts but add no savings. The number skipped is returned.
+//
+// As in CalculateRecommendationSummary, a projectionPeriod that fails ValidateProjectionPeriod
+// is logged and replaced with "monthly". Returns an error wrapping ErrTargetCurrencyInvalid if
+// target is not a valid ISO 4217 code.
+//
+// Example:
+//
+//	rates := currency.StaticRates{Base: "USD", Rates: map[string]float64{"EUR": 0.92}}
+//	summary, skipped, err := pluginsdk.CalculateRecommendationSummaryInCurrency(recs, "USD", rates, "monthly")
+func CalculateRecommendationSummaryInCurrency(
+	recommendations []*pbc.Recommendation,
+	target string,
+	rates currency.RateProvider,
+	projectionPeriod string,
+) (*pbc.RecommendationSummary, int, error) {
+	if !currency.IsValid(target) {
+		return nil, 0, fmt.Errorf("%w: %q", ErrTargetCurrencyInvalid, target)
+	}
+	projectionPeriod = summaryProjectionPeriod(projectionPeriod)
+
+	summary := &pbc.RecommendationSummary{
+		TotalRecommendations: int32(len(recommendations)), //nolint:gosec // length will not exceed int32 max
+		Currency:             target,
+		ProjectionPeriod:     projectionPeriod,
+		CountByCategory:      make(map[string]int32),
+		SavingsByCategory:    make(map[string]float64),
+		CountByActionType:    make(map[string]int32),
+		SavingsByActionType:  make(map[string]float64),
+	}
+
+	// Look up each source currency once; a missing entry means not yet looked up.
+	rateFor := make(map[string]float64)
+	skipped := 0
+	for _, rec := range recommendations {
+		catName := rec.GetCategory().String()
+		actionName := rec.GetActionType().String()
+		summary.CountByCategory[catName]++
+		summary.CountByActionType[actionName]++
+
+		impact := rec.GetImpact()
+		if impact == nil {
+			continue
+		}
+		source := impact.GetCurrency()
+		rate, seen := rateFor[source]
+		if !seen {
+			rate, _ = conversionRate(source, target, rates) // 0 when unavailable
+			rateFor[source] = rate
+		}
+		if rate == 0 {
+			skipped++
+			continue
+		}
+
+		savings := impact.GetEstimatedSavings() * rate
+		summary.TotalEstimatedSavings += savings
+		summary.SavingsByCategory[catName] += savings
+		summary.SavingsByActionType[actionName] += savings
+	}
+	return summary, skipped, nil
+}
+
+// conversionRate returns the rate converting source into target. It returns an error wrapping
+// currency.ErrRateUnavailable if source is empty, rates is nil or fails, or the rate is not a
+// positive, finite number.
+func conversionRate(source, target string, rates currency.RateProvider) (float64, error) {
+	if source == target {
+		return 1, nil
+	}
+	if source == "" || rates == nil {
+		return 0, fmt.Errorf("%w: %q -> %s", currency.ErrRateUnavailable, source, target)
+	}
+	rate, err := rates.Rate(source, target)
+	if err != nil {
+		if errors.Is(err, currency.ErrRateUnavailable) {
+			return 0, err
+		}
+		return 0, fmt.Errorf("%w: %s -> %s: %w", currency.ErrRateUnavailable, source, target, err)
+	}
+	if rate <= 0 || math.IsNaN(rate) || math.IsInf(rate, 0) {
+		return 0, fmt.Errorf("%w: %s -> %s = %v", currency.ErrRateUnavailable, source, target, rate)
+	}
+	return rate, nil
+}
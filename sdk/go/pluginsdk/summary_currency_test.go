@@ -0,0 +1,172 @@
+package pluginsdk_test
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/rshade/finfocus-spec/sdk/go/currency"
+	"github.com/rshade/finfocus-spec/sdk/go/pluginsdk"
+	pbc "github.com/rshade/finfocus-spec/sdk/go/proto/finfocus/v1"
+)
+
+// fixedRate is a RateProvider that returns the same rate for every pair.
+type fixedRate float64
+
+func (r fixedRate) Rate(_, _ string) (float64, error) { return float64(r), nil }
+
+// failingRates is a RateProvider whose lookups fail, as a remote provider's might.
+type failingRates struct{}
+
+func (failingRates) Rate(_, _ string) (float64, error) { return 0, errors.New("rate service down") }
+
+func TestSummaryInCurrencies(t *testing.T) {
+	summary := &pbc.RecommendationSummary{TotalEstimatedSavings: 200, Currency: "USD"}
+	rates := currency.StaticRates{Base: "USD", Rates: map[string]float64{"EUR": 0.9, "GBP": 0.8}}
+
+	got, err := pluginsdk.SummaryInCurrencies(summary, []string{"EUR", "GBP", "USD"}, rates)
+	if err != nil {
+		t.Fatalf("SummaryInCurrencies() unexpected error: %v", err)
+	}
+
+	want := map[string]float64{"EUR": 180, "GBP": 160, "USD": 200}
+	if len(got) != len(want) {
+		t.Fatalf("SummaryInCurrencies() returned %d currencies, want %d", len(got), len(want))
+	}
+	for code, amount := range want {
+		if math.Abs(got[code]-amount) > 1e-9 {
+			t.Errorf("SummaryInCurrencies()[%s] = %v, want %v", code, got[code], amount)
+		}
+	}
+}
+
+func TestSummaryInCurrenciesEmptyTargets(t *testing.T) {
+	summary := &pbc.RecommendationSummary{TotalEstimatedSavings: 200, Currency: "USD"}
+
+	got, err := pluginsdk.SummaryInCurrencies(summary, nil, nil)
+	if err != nil {
+		t.Fatalf("SummaryInCurrencies() unexpected error: %v", err)
+	}
+	if got == nil || len(got) != 0 {
+		t.Errorf("SummaryInCurrencies() = %v, want empty map", got)
+	}
+}
+
+func TestSummaryInCurrenciesErrors(t *testing.T) {
+	usd := &pbc.RecommendationSummary{TotalEstimatedSavings: 200, Currency: "USD"}
+
+	usdRates := func(rates map[string]float64) currency.StaticRates {
+		return currency.StaticRates{Base: "USD", Rates: rates}
+	}
+
+	tests := []struct {
+		name    string
+		summary *pbc.RecommendationSummary
+		targets []string
+		rates   currency.RateProvider
+		wantErr error
+	}{
+		{"missing rate", usd, []string{"EUR", "JPY"}, usdRates(map[string]float64{"EUR": 0.9}), currency.ErrRateUnavailable},
+		{"nil rates", usd, []string{"EUR"}, nil, currency.ErrRateUnavailable},
+		{"invalid target currency", usd, []string{"XYZ"}, usdRates(nil), pluginsdk.ErrTargetCurrencyInvalid},
+		{"non-positive rate", usd, []string{"EUR"}, fixedRate(0), currency.ErrRateUnavailable},
+		{"provider failure", usd, []string{"EUR"}, failingRates{}, currency.ErrRateUnavailable},
+		{"nil summary", nil, []string{"EUR"}, nil, pluginsdk.ErrSummaryNil},
+		{
+			"mixed-currency summary",
+			&pbc.RecommendationSummary{TotalEstimatedSavings: 200},
+			[]string{"EUR"}, usdRates(map[string]float64{"EUR": 0.9}),
+			pluginsdk.ErrSummaryCurrencyInvalid,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := pluginsdk.SummaryInCurrencies(tt.summary, tt.targets, tt.rates)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("SummaryInCurrencies() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestCalculateRecommendationSummaryInCurrency(t *testing.T) {
+	cost := pbc.RecommendationCategory_RECOMMENDATION_CATEGORY_COST
+	rightsize := pbc.RecommendationActionType_RECOMMENDATION_ACTION_TYPE_RIGHTSIZE
+	terminate := pbc.RecommendationActionType_RECOMMENDATION_ACTION_TYPE_TERMINATE
+	rec := func(action pbc.RecommendationActionType, savings float64, code string) *pbc.Recommendation {
+		return &pbc.Recommendation{
+			Category:   cost,
+			ActionType: action,
+			Impact:     &pbc.RecommendationImpact{EstimatedSavings: savings, Currency: code},
+		}
+	}
+	recs := []*pbc.Recommendation{
+		rec(rightsize, 100, "USD"),
+		rec(rightsize, 92, "EUR"),               // 100 USD
+		rec(terminate, 50, "GBP"),               // 62.5 USD
+		rec(terminate, 1000, "JPY"),             // no rate: skipped
+		rec(terminate, 10, ""),                  // no currency: skipped
+		{Category: cost, ActionType: terminate}, // no impact: counted, no savings
+	}
+	rates := currency.StaticRates{Base: "USD", Rates: map[string]float64{"EUR": 0.92, "GBP": 0.8}}
+
+	summary, skipped, err := pluginsdk.CalculateRecommendationSummaryInCurrency(recs, "USD", rates, "monthly")
+	if err != nil {
+		t.Fatalf("CalculateRecommendationSummaryInCurrency() unexpected error: %v", err)
+	}
+	if skipped != 2 {
+		t.Errorf("skipped = %d, want 2", skipped)
+	}
+	if summary.GetCurrency() != "USD" || summary.GetProjectionPeriod() != "monthly" {
+		t.Errorf("currency, period = %q, %q, want USD, monthly", summary.GetCurrency(), summary.GetProjectionPeriod())
+	}
+	if summary.GetTotalRecommendations() != 6 {
+		t.Errorf("TotalRecommendations = %d, want 6", summary.GetTotalRecommendations())
+	}
+	if got := summary.GetCountByActionType()[terminate.String()]; got != 4 {
+		t.Errorf("CountByActionType[TERMINATE] = %d, want 4", got)
+	}
+	if math.Abs(summary.GetTotalEstimatedSavings()-262.5) > 1e-9 {
+		t.Errorf("TotalEstimatedSavings = %v, want 262.5", summary.GetTotalEstimatedSavings())
+	}
+	if got := summary.GetSavingsByActionType()[rightsize.String()]; math.Abs(got-200) > 1e-9 {
+		t.Errorf("SavingsByActionType[RIGHTSIZE] = %v, want 200", got)
+	}
+	if got := summary.GetSavingsByCategory()[cost.String()]; math.Abs(got-262.5) > 1e-9 {
+		t.Errorf("SavingsByCategory[COST] = %v, want 262.5", got)
+	}
+}
+
+func TestCalculateRecommendationSummaryInCurrencyNilRates(t *testing.T) {
+	recs := []*pbc.Recommendation{
+		{Impact: &pbc.RecommendationImpact{EstimatedSavings: 40, Currency: "EUR"}},
+		{Impact: &pbc.RecommendationImpact{EstimatedSavings: 60, Currency: "USD"}},
+	}
+
+	summary, skipped, err := pluginsdk.CalculateRecommendationSummaryInCurrency(recs, "EUR", nil, "")
+	if err != nil {
+		t.Fatalf("CalculateRecommendationSummaryInCurrency() unexpected error: %v", err)
+	}
+	if skipped != 1 || summary.GetTotalEstimatedSavings() != 40 {
+		t.Errorf("skipped, total = %d, %v, want 1, 40", skipped, summary.GetTotalEstimatedSavings())
+	}
+}
+
+func TestCalculateRecommendationSummaryInCurrencyInvalidTarget(t *testing.T) {
+	_, _, err := pluginsdk.CalculateRecommendationSummaryInCurrency(nil, "usd", nil, "")
+	if !errors.Is(err, pluginsdk.ErrTargetCurrencyInvalid) {
+		t.Errorf("error = %v, want ErrTargetCurrencyInvalid", err)
+	}
+}
+
+func TestCalculateRecommendationSummaryInCurrencyInvalidPeriod(t *testing.T) {
+	summary, _, err := pluginsdk.CalculateRecommendationSummaryInCurrency(nil, "USD", nil, "weekly")
+	if err != nil {
+		t.Fatalf("CalculateRecommendationSummaryInCurrency() error = %v", err)
+	}
+	want := pluginsdk.CalculateRecommendationSummary(nil, "weekly").GetProjectionPeriod()
+	if got := summary.GetProjectionPeriod(); got != "monthly" || got != want {
+		t.Errorf("ProjectionPeriod = %q, want %q as in CalculateRecommendationSummary", got, want)
+	}
+}
@@ -0,0 +1,160 @@
+package pluginsdk_test
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/rshade/finfocus-spec/sdk/go/pluginsdk"
+	pbc "github.com/rshade/finfocus-spec/sdk/go/proto/finfocus/v1"
+)
+
+// pairs flattens supported resources to "provider|resource_type" strings.
+func pairs(resources []*pbc.SupportedResource) []string {
+	out := make([]string, len(resources))
+	for i, r := range resources {
+		out[i] = r.GetProvider() + "|" + r.GetResourceType()
+	}
+	return out
+}
+
+func TestResourceMatcher_SupportedResources(t *testing.T) {
+	tests := []struct {
+		name      string
+		providers []string
+		types     []string
+		want      []string
+	}{
+		{
+			name:      "tokens carry their provider",
+			providers: []string{"aws", "gcp"},
+			types:     []string{"gcp:compute:Instance", "aws:s3:Bucket", "aws:ec2:Instance"},
+			want:      []string{"aws|aws:ec2:Instance", "aws|aws:s3:Bucket", "gcp|gcp:compute:Instance"},
+		},
+		{
+			name:      "tokens of unregistered providers are omitted",
+			providers: []string{"aws"},
+			types:     []string{"aws:ec2:Instance", "azure:compute:VirtualMachine"},
+			want:      []string{"aws|aws:ec2:Instance"},
+		},
+		{
+			name:      "plain types are listed per provider",
+			providers: []string{"gcp", "aws"},
+			types:     []string{"compute"},
+			want:      []string{"aws|compute", "gcp|compute"},
+		},
+		{
+			name:  "types without providers",
+			types: []string{"ec2", "aws:ec2:Instance"},
+			want:  []string{"|ec2", "aws|aws:ec2:Instance"},
+		},
+		{
+			name:      "providers without types",
+			providers: []string{"azure", "aws"},
+			want:      []string{"aws|", "azure|"},
+		},
+		{
+			name: "empty matcher",
+			want: []string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			matcher := pluginsdk.NewResourceMatcher()
+			for _, provider := range tt.providers {
+				matcher.AddProvider(provider)
+			}
+			for _, resourceType := range tt.types {
+				matcher.AddResourceType(resourceType)
+			}
+			assert.Equal(t, tt.want, pairs(matcher.SupportedResources()))
+		})
+	}
+}
+
+// listingPlugin implements SupportedResourcesProvider with example SKUs.
+type listingPlugin struct {
+	*pluginsdk.BasePlugin
+}
+
+func (p *listingPlugin) GetSupportedResources(
+	_ context.Context,
+	_ *pbc.GetSupportedResourcesRequest,
+) (*pbc.SupportedResourcesResponse, error) {
+	return &pbc.SupportedResourcesResponse{Resources: []*pbc.SupportedResource{
+		{Provider: "aws", ResourceType: "aws:ec2:Instance", ExampleSkus: []string{"t3.micro", "m5.large"}},
+	}}, nil
+}
+
+func TestServer_GetSupportedResources(t *testing.T) {
+	t.Run("derives from BasePlugin matcher", func(t *testing.T) {
+		base := pluginsdk.NewBasePlugin("matcher")
+		base.Matcher().AddProvider("aws")
+		base.Matcher().AddResourceType("aws:ec2:Instance")
+
+		resp, err := pluginsdk.NewServer(base).GetSupportedResources(
+			context.Background(), &pbc.GetSupportedResourcesRequest{})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"aws|aws:ec2:Instance"}, pairs(resp.GetResources()))
+	})
+
+	t.Run("uses SupportedResourcesProvider", func(t *testing.T) {
+		plugin := &listingPlugin{BasePlugin: pluginsdk.NewBasePlugin("listing")}
+		resp, err := pluginsdk.NewServer(plugin).GetSupportedResources(
+			context.Background(), &pbc.GetSupportedResourcesRequest{})
+		require.NoError(t, err)
+		require.Len(t, resp.GetResources(), 1)
+		assert.Equal(t, []string{"t3.micro", "m5.large"}, resp.GetResources()[0].GetExampleSkus())
+	})
+
+	t.Run("unimplemented without matcher", func(t *testing.T) {
+		_, err := pluginsdk.NewServer(&clientTestPlugin{name: "plain"}).GetSupportedResources(
+			context.Background(), &pbc.GetSupportedResourcesRequest{})
+		assert.Equal(t, codes.Unimplemented, status.Code(err))
+	})
+}
+
+func TestClient_GetSupportedResources(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	plugin := pluginsdk.NewBasePlugin("client-supported")
+	plugin.Matcher().AddResourceType("aws:s3:Bucket")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- pluginsdk.Serve(ctx, pluginsdk.ServeConfig{
+			Plugin:   plugin,
+			Listener: listener,
+			Web: pluginsdk.WebConfig{
+				Enabled:              true,
+				EnableHealthEndpoint: true,
+			},
+		})
+	}()
+
+	addr := listener.Addr().String()
+	waitForServer(t, addr)
+	client := pluginsdk.NewConnectClient("http://" + addr)
+
+	resources, err := client.GetSupportedResources(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"aws|aws:s3:Bucket"}, pairs(resources))
+
+	cancel()
+	select {
+	case <-errCh:
+	case <-time.After(time.Second):
+		t.Fatal("server did not shut down in time")
+	}
+}
@@ -0,0 +1,68 @@
+// Copyright 2024 The FinFocus Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pluginsdk
+
+import (
+	"context"
+
+	"google.golang.org/grpc/status"
+
+	pbc "github.com/rshade/finfocus-spec/sdk/go/proto/finfocus/v1"
+)
+
+// MaxSupportsBatchSize is the maximum number of resources in a SupportsBatch request.
+const MaxSupportsBatchSize = 500
+
+// BatchSupportsProvider is an optional interface that plugins can implement to check many
+// resources at once. Plugins that do not implement this interface have SupportsBatch served by
+// SupportsBatchFromSingle, which applies the server's Supports handling to each resource. This
+// includes plugins embedding BasePlugin, so an overridden Supports is always used.
+type BatchSupportsProvider interface {
+	// SupportsBatch returns one result per requested resource, in request order.
+	SupportsBatch(ctx context.Context, req *pbc.SupportsBatchRequest) (*pbc.SupportsBatchResponse, error)
+}
+
+// SupportsBatchFromSingle serves a SupportsBatch request by calling provider's Supports
+// handler once per resource, in order.
+//
+// A resource whose Supports call fails is reported as not supported, with the error's gRPC
+// status message as the reason, instead of failing the batch. The batch fails only if ctx is
+// done before every resource has been checked, with the gRPC status for the context error.
+func SupportsBatchFromSingle(
+	ctx context.Context,
+	provider SupportsProvider,
+	req *pbc.SupportsBatchRequest,
+) (*pbc.SupportsBatchResponse, error) {
+	results := make([]*pbc.SupportsBatchResult, 0, len(req.GetResources()))
+	for _, resource := range req.GetResources() {
+		if err := ctx.Err(); err != nil {
+			return nil, status.FromContextError(err).Err()
+		}
+
+		resp, err := provider.Supports(ctx, &pbc.SupportsRequest{Resource: resource})
+		result := &pbc.SupportsBatchResult{}
+		switch {
+		case err != nil:
+			result.Reason = status.Convert(err).Message()
+		case resp == nil:
+			result.Reason = "Supports returned no response"
+		default:
+			result.Supported = resp.GetSupported()
+			result.Reason = resp.GetReason()
+		}
+		results = append(results, result)
+	}
+	return &pbc.SupportsBatchResponse{Results: results}, nil
+}
@@ -0,0 +1,219 @@
+// Copyright 2024 The FinFocus Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pluginsdk_test
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/rshade/finfocus-spec/sdk/go/pluginsdk"
+	pbc "github.com/rshade/finfocus-spec/sdk/go/proto/finfocus/v1"
+)
+
+// ec2SupportsPlugin embeds BasePlugin and overrides Supports to accept aws:ec2:Instance only.
+type ec2SupportsPlugin struct {
+	*pluginsdk.BasePlugin
+
+	calls int
+}
+
+func newEC2SupportsPlugin() *ec2SupportsPlugin {
+	return &ec2SupportsPlugin{BasePlugin: pluginsdk.NewBasePlugin("ec2")}
+}
+
+func (p *ec2SupportsPlugin) Supports(
+	_ context.Context,
+	req *pbc.SupportsRequest,
+) (*pbc.SupportsResponse, error) {
+	p.calls++
+	resource := req.GetResource()
+	switch {
+	case resource.GetRegion() == "mars-north-1":
+		return nil, status.Error(codes.Unavailable, "pricing catalog unavailable")
+	case resource.GetResourceType() == "aws:ec2:Instance":
+		return &pbc.SupportsResponse{Supported: true}, nil
+	default:
+		return &pbc.SupportsResponse{Reason: "only EC2 instances are priced"}, nil
+	}
+}
+
+// bulkSupportsPlugin implements BatchSupportsProvider natively.
+type bulkSupportsPlugin struct {
+	*pluginsdk.BasePlugin
+}
+
+func (p *bulkSupportsPlugin) SupportsBatch(
+	_ context.Context,
+	req *pbc.SupportsBatchRequest,
+) (*pbc.SupportsBatchResponse, error) {
+	results := make([]*pbc.SupportsBatchResult, len(req.GetResources()))
+	for i := range results {
+		results[i] = &pbc.SupportsBatchResult{Supported: true, Reason: "bulk"}
+	}
+	return &pbc.SupportsBatchResponse{Results: results}, nil
+}
+
+// awsRegistry routes every AWS resource to the ec2 plugin.
+type awsRegistry struct{}
+
+func (awsRegistry) FindPlugin(provider, _ string) string {
+	if provider == "aws" {
+		return "ec2"
+	}
+	return ""
+}
+
+func supportsBatchResources() []*pbc.ResourceDescriptor {
+	return []*pbc.ResourceDescriptor{
+		{Provider: "aws", ResourceType: "aws:ec2:Instance", Sku: "t3.micro", Region: "us-east-1"},
+		{Provider: "aws", ResourceType: "aws:s3:Bucket", Region: "us-east-1"},
+		{Provider: "aws", ResourceType: "aws:rds:Instance", Region: "mars-north-1"},
+	}
+}
+
+func TestSupportsBatchFromSingle(t *testing.T) {
+	plugin := newEC2SupportsPlugin()
+	resp, err := pluginsdk.SupportsBatchFromSingle(context.Background(), plugin,
+		&pbc.SupportsBatchRequest{Resources: supportsBatchResources()})
+	require.NoError(t, err)
+	require.Len(t, resp.GetResults(), 3)
+
+	assert.True(t, resp.GetResults()[0].GetSupported())
+	assert.Empty(t, resp.GetResults()[0].GetReason())
+
+	assert.False(t, resp.GetResults()[1].GetSupported())
+	assert.Equal(t, "only EC2 instances are priced", resp.GetResults()[1].GetReason())
+
+	// A failed check is reported as unsupported with the error message
+	assert.False(t, resp.GetResults()[2].GetSupported())
+	assert.Equal(t, "pricing catalog unavailable", resp.GetResults()[2].GetReason())
+}
+
+func TestSupportsBatchFromSingle_CanceledContext(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	plugin := newEC2SupportsPlugin()
+	_, err := pluginsdk.SupportsBatchFromSingle(ctx, plugin,
+		&pbc.SupportsBatchRequest{Resources: supportsBatchResources()})
+	assert.Equal(t, codes.Canceled, status.Code(err))
+	assert.Zero(t, plugin.calls)
+}
+
+func TestServer_SupportsBatch(t *testing.T) {
+	t.Run("uses overridden Supports", func(t *testing.T) {
+		plugin := newEC2SupportsPlugin()
+		resp, err := pluginsdk.NewServerWithRegistry(plugin, awsRegistry{}).SupportsBatch(context.Background(),
+			&pbc.SupportsBatchRequest{Resources: supportsBatchResources()[:2]})
+		require.NoError(t, err)
+		require.Len(t, resp.GetResults(), 2)
+		assert.True(t, resp.GetResults()[0].GetSupported())
+		assert.False(t, resp.GetResults()[1].GetSupported())
+		assert.Equal(t, 2, plugin.calls)
+	})
+
+	t.Run("reports per-resource validation failures", func(t *testing.T) {
+		plugin := newEC2SupportsPlugin()
+		resp, err := pluginsdk.NewServerWithRegistry(plugin, awsRegistry{}).SupportsBatch(context.Background(),
+			&pbc.SupportsBatchRequest{Resources: []*pbc.ResourceDescriptor{
+				nil,
+				{Provider: "gcp", ResourceType: "compute_engine", Region: "us-central1"},
+			}})
+		require.NoError(t, err)
+		require.Len(t, resp.GetResults(), 2)
+		assert.False(t, resp.GetResults()[0].GetSupported())
+		assert.Equal(t, "resource descriptor is required", resp.GetResults()[0].GetReason())
+		assert.False(t, resp.GetResults()[1].GetSupported())
+		assert.Contains(t, resp.GetResults()[1].GetReason(), "no plugin registered")
+		assert.Zero(t, plugin.calls)
+	})
+
+	t.Run("uses BatchSupportsProvider", func(t *testing.T) {
+		server := pluginsdk.NewServer(&bulkSupportsPlugin{BasePlugin: pluginsdk.NewBasePlugin("bulk")})
+		resp, err := server.SupportsBatch(context.Background(),
+			&pbc.SupportsBatchRequest{Resources: supportsBatchResources()})
+		require.NoError(t, err)
+		require.Len(t, resp.GetResults(), 3)
+		assert.Equal(t, "bulk", resp.GetResults()[2].GetReason())
+	})
+
+	t.Run("rejects oversized batch", func(t *testing.T) {
+		plugin := newEC2SupportsPlugin()
+		req := &pbc.SupportsBatchRequest{
+			Resources: make([]*pbc.ResourceDescriptor, pluginsdk.MaxSupportsBatchSize+1),
+		}
+		_, err := pluginsdk.NewServer(plugin).SupportsBatch(context.Background(), req)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		assert.Zero(t, plugin.calls)
+	})
+}
+
+func TestClient_SupportsBatch(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- pluginsdk.Serve(ctx, pluginsdk.ServeConfig{
+			Plugin:   newEC2SupportsPlugin(),
+			Registry: awsRegistry{},
+			Listener: listener,
+			Web: pluginsdk.WebConfig{
+				Enabled:              true,
+				EnableHealthEndpoint: true,
+			},
+		})
+	}()
+
+	addr := listener.Addr().String()
+	waitForServer(t, addr)
+	client := pluginsdk.NewConnectClient("http://" + addr)
+
+	// More resources than fit in one request are split into several
+	resources := make([]*pbc.ResourceDescriptor, pluginsdk.MaxSupportsBatchSize+1)
+	for i := range resources {
+		resources[i] = &pbc.ResourceDescriptor{Provider: "aws", ResourceType: "aws:s3:Bucket", Region: "us-east-1"}
+	}
+	resources[pluginsdk.MaxSupportsBatchSize] = &pbc.ResourceDescriptor{
+		Provider: "aws", ResourceType: "aws:ec2:Instance", Region: "us-east-1",
+	}
+
+	results, err := client.SupportsBatch(ctx, resources)
+	require.NoError(t, err)
+	require.Len(t, results, len(resources))
+	assert.False(t, results[0].GetSupported())
+	assert.True(t, results[pluginsdk.MaxSupportsBatchSize].GetSupported())
+
+	results, err = client.SupportsBatch(ctx, nil)
+	require.NoError(t, err)
+	assert.Empty(t, results)
+
+	cancel()
+	select {
+	case <-errCh:
+	case <-time.After(time.Second):
+		t.Fatal("server did not shut down in time")
+	}
+}
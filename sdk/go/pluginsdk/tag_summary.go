@@ -0,0 +1,50 @@
+package pluginsdk
+
+import (
+	pbc "github.com/rshade/finfocus-spec/sdk/go/proto/finfocus/v1"
+)
+
+// UntaggedBucket is the SummarizeByTag key for costs whose resource lacks the tag.
+const UntaggedBucket = "untagged"
+
+// SummarizeByTag totals actual costs by the value of a cost-allocation tag (e.g., "cost-center"),
+// for chargeback reports.
+//
+// tagsFor returns the tags of the resource a result describes; a nil tagsFor uses the tags of the
+// result's FOCUS record. Results whose tags lack tagKey, or have an empty value for it, are
+// totalled under UntaggedBucket. Nil results are skipped.
+//
+// The results must share a currency (see ValidateUniformCurrency); otherwise the returned error
+// wraps ErrMixedCurrencies. An empty input returns an empty map.
+//
+// Example:
+//
+//	byCostCenter, err := pluginsdk.SummarizeByTag(results, "cost-center", nil)
+//	// map[string]float64{"platform": 1250.40, "data": 310.00, "untagged": 42.17}
+func SummarizeByTag(
+	results []*pbc.ActualCostResult,
+	tagKey string,
+	tagsFor func(*pbc.ActualCostResult) map[string]string,
+) (map[string]float64, error) {
+	if _, err := ValidateUniformCurrency(results); err != nil {
+		return nil, err
+	}
+	if tagsFor == nil {
+		tagsFor = func(result *pbc.ActualCostResult) map[string]string {
+			return result.GetFocusRecord().GetTags()
+		}
+	}
+
+	totals := make(map[string]float64)
+	for _, result := range results {
+		if result == nil {
+			continue
+		}
+		bucket := tagsFor(result)[tagKey]
+		if bucket == "" {
+			bucket = UntaggedBucket
+		}
+		totals[bucket] += result.GetCost()
+	}
+	return totals, nil
+}
@@ -0,0 +1,82 @@
+package pluginsdk_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/rshade/finfocus-spec/sdk/go/pluginsdk"
+	pbc "github.com/rshade/finfocus-spec/sdk/go/proto/finfocus/v1"
+)
+
+// taggedCost returns a USD cost result whose FOCUS record carries tags.
+func taggedCost(cost float64, tags map[string]string) *pbc.ActualCostResult {
+	return &pbc.ActualCostResult{
+		Cost:        cost,
+		FocusRecord: &pbc.FocusCostRecord{BillingCurrency: "USD", Tags: tags},
+	}
+}
+
+func TestSummarizeByTag(t *testing.T) {
+	results := []*pbc.ActualCostResult{
+		taggedCost(100, map[string]string{"cost-center": "platform", "env": "prod"}),
+		taggedCost(50, map[string]string{"cost-center": "data"}),
+		taggedCost(25.5, map[string]string{"cost-center": "platform"}),
+		nil,
+	}
+
+	totals, err := pluginsdk.SummarizeByTag(results, "cost-center", nil)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]float64{"platform": 125.5, "data": 50}, totals)
+}
+
+func TestSummarizeByTag_Untagged(t *testing.T) {
+	results := []*pbc.ActualCostResult{
+		taggedCost(100, map[string]string{"cost-center": "platform"}),
+		taggedCost(20, map[string]string{"env": "prod"}),
+		taggedCost(5, map[string]string{"cost-center": ""}),
+		taggedCost(2, nil),
+		{Cost: 1}, // no FOCUS record
+	}
+
+	totals, err := pluginsdk.SummarizeByTag(results, "cost-center", nil)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]float64{"platform": 100, pluginsdk.UntaggedBucket: 28}, totals)
+}
+
+func TestSummarizeByTag_CustomTags(t *testing.T) {
+	// Tags looked up from an inventory keyed by source instead of the FOCUS record
+	inventory := map[string]map[string]string{
+		"aws-cur": {"team": "payments"},
+	}
+	tagsFor := func(result *pbc.ActualCostResult) map[string]string {
+		return inventory[result.GetSource()]
+	}
+	results := []*pbc.ActualCostResult{
+		{Source: "aws-cur", Cost: 10},
+		{Source: "aws-cur", Cost: 15},
+		{Source: "gcp-billing", Cost: 7},
+	}
+
+	totals, err := pluginsdk.SummarizeByTag(results, "team", tagsFor)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]float64{"payments": 25, pluginsdk.UntaggedBucket: 7}, totals)
+}
+
+func TestSummarizeByTag_MixedCurrencies(t *testing.T) {
+	eur := taggedCost(40, map[string]string{"cost-center": "data"})
+	eur.FocusRecord.BillingCurrency = "EUR"
+
+	_, err := pluginsdk.SummarizeByTag(
+		[]*pbc.ActualCostResult{taggedCost(100, map[string]string{"cost-center": "platform"}), eur},
+		"cost-center", nil)
+	require.ErrorIs(t, err, pluginsdk.ErrMixedCurrencies)
+}
+
+func TestSummarizeByTag_Empty(t *testing.T) {
+	totals, err := pluginsdk.SummarizeByTag(nil, "cost-center", nil)
+	require.NoError(t, err)
+	assert.NotNil(t, totals)
+	assert.Empty(t, totals)
+}
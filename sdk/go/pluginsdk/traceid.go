@@ -31,3 +31,21 @@ func GenerateTraceID() (string, error) {
 
 	return traceID, nil
 }
+
+// GenerateSpanID generates a new valid span ID using cryptographically secure random bytes.
+// The generated ID is a 16-character lowercase hexadecimal string that conforms to
+// OpenTelemetry span ID format requirements (not all zeros), as checked by
+// pricing.ValidateSpanID.
+func GenerateSpanID() (string, error) {
+	const spanIDByteLength = 8 // 8 bytes = 16 hex characters
+	bytes := make([]byte, spanIDByteLength)
+	for {
+		if _, err := rand.Read(bytes); err != nil {
+			return "", fmt.Errorf("failed to generate random span ID: %w", err)
+		}
+		// An all-zero span ID is invalid; regenerate in that (astronomically unlikely) case
+		if spanID := hex.EncodeToString(bytes); spanID != "0000000000000000" {
+			return spanID, nil
+		}
+	}
+}
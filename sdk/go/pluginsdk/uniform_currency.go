@@ -0,0 +1,38 @@
+package pluginsdk
+
+import (
+	"errors"
+	"fmt"
+
+	pbc "github.com/rshade/finfocus-spec/sdk/go/proto/finfocus/v1"
+)
+
+// ErrMixedCurrencies is returned when a batch of cost results is billed in more than one currency.
+var ErrMixedCurrencies = errors.New("cost results have mixed currencies")
+
+// ValidateUniformCurrency checks that every result in a batch is billed in the same currency,
+// so that the costs can be safely summed.
+//
+// A result's currency is the billing_currency of its FOCUS record. Results without a FOCUS
+// record or with an empty billing_currency carry no currency and are skipped, as are nil
+// results; they neither establish nor conflict with the batch currency.
+//
+// Returns the shared currency, or "" when the batch is empty or no result carries a currency.
+// If a result's currency differs from the first currency seen, the returned error wraps
+// ErrMixedCurrencies and names the index of that result.
+func ValidateUniformCurrency(results []*pbc.ActualCostResult) (string, error) {
+	var batchCurrency string
+	for i, result := range results {
+		code := result.GetFocusRecord().GetBillingCurrency()
+		switch {
+		case code == "":
+			continue
+		case batchCurrency == "":
+			batchCurrency = code
+		case code != batchCurrency:
+			return "", fmt.Errorf("%w: results[%d] has currency %q, expected %q",
+				ErrMixedCurrencies, i, code, batchCurrency)
+		}
+	}
+	return batchCurrency, nil
+}
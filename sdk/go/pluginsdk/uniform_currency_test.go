@@ -0,0 +1,77 @@
+package pluginsdk_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/rshade/finfocus-spec/sdk/go/pluginsdk"
+	pbc "github.com/rshade/finfocus-spec/sdk/go/proto/finfocus/v1"
+)
+
+func resultInCurrency(code string) *pbc.ActualCostResult {
+	return &pbc.ActualCostResult{
+		Cost:        10,
+		FocusRecord: &pbc.FocusCostRecord{BillingCurrency: code},
+	}
+}
+
+func TestValidateUniformCurrency(t *testing.T) {
+	tests := []struct {
+		name    string
+		results []*pbc.ActualCostResult
+		want    string
+	}{
+		{
+			name:    "uniform USD batch",
+			results: []*pbc.ActualCostResult{resultInCurrency("USD"), resultInCurrency("USD"), resultInCurrency("USD")},
+			want:    "USD",
+		},
+		{
+			name:    "empty batch",
+			results: nil,
+			want:    "",
+		},
+		{
+			name: "results without currency are skipped",
+			results: []*pbc.ActualCostResult{
+				resultInCurrency(""), resultInCurrency("EUR"), {Cost: 5}, nil, resultInCurrency("EUR"),
+			},
+			want: "EUR",
+		},
+		{
+			name:    "no result carries a currency",
+			results: []*pbc.ActualCostResult{resultInCurrency(""), {Cost: 5}},
+			want:    "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := pluginsdk.ValidateUniformCurrency(tt.results)
+			if err != nil {
+				t.Fatalf("ValidateUniformCurrency() unexpected error: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("ValidateUniformCurrency() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidateUniformCurrencyMixed(t *testing.T) {
+	results := []*pbc.ActualCostResult{
+		resultInCurrency("USD"), resultInCurrency("USD"), resultInCurrency("EUR"), resultInCurrency("GBP"),
+	}
+
+	got, err := pluginsdk.ValidateUniformCurrency(results)
+	if !errors.Is(err, pluginsdk.ErrMixedCurrencies) {
+		t.Fatalf("ValidateUniformCurrency() error = %v, want ErrMixedCurrencies", err)
+	}
+	if !strings.Contains(err.Error(), "results[2]") || !strings.Contains(err.Error(), "EUR") {
+		t.Errorf("ValidateUniformCurrency() error = %q, want it to name results[2] and EUR", err)
+	}
+	if got != "" {
+		t.Errorf("ValidateUniformCurrency() = %q, want empty currency on error", got)
+	}
+}
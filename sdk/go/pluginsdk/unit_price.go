@@ -0,0 +1,80 @@
+package pluginsdk
+
+import (
+	"errors"
+	"fmt"
+	"math"
+)
+
+var (
+	// ErrNoPriceUsage is returned when no entries are given to WeightedAverageUnitPrice.
+	ErrNoPriceUsage = errors.New("no price/usage entries")
+
+	// ErrInvalidPriceUsage is returned when an entry has a negative or non-finite price or usage.
+	ErrInvalidPriceUsage = errors.New("unit price and usage must be finite values >= 0")
+
+	// ErrMismatchedUnits is returned when entries are priced in different units.
+	ErrMismatchedUnits = errors.New("price/usage entries have mismatched units")
+
+	// ErrZeroTotalUsage is returned when the entries' usage sums to zero, leaving the
+	// weighted average undefined.
+	ErrZeroTotalUsage = errors.New("total usage is zero")
+)
+
+// PriceUsage pairs a resource's unit price with the quantity it consumed.
+type PriceUsage struct {
+	// UnitPrice is the price per Unit (e.g., 0.096 for $/hour).
+	UnitPrice float64
+	// Usage is the quantity consumed, in Unit (e.g., 720 hours).
+	Usage float64
+	// Unit is the pricing unit shared by UnitPrice and Usage (e.g., "Hours").
+	Unit string
+}
+
+// WeightedAverageUnitPrice returns the usage-weighted average unit price of a fleet:
+// sum(UnitPrice * Usage) / sum(Usage).
+//
+// Unlike a simple mean, resources that consume more carry more weight, so the result times the
+// total usage equals the fleet's total cost.
+//
+// All entries must share the same Unit; otherwise the returned error wraps ErrMismatchedUnits
+// and names the first mismatched entry. Errors also wrap ErrNoPriceUsage for an empty slice,
+// ErrInvalidPriceUsage for a negative or non-finite price or usage, and ErrZeroTotalUsage when
+// usage sums to zero.
+//
+// Example:
+//
+//	// 100 hours at $0.10 and 300 hours at $0.20: (10 + 60) / 400 = $0.175/hour
+//	avg, err := pluginsdk.WeightedAverageUnitPrice([]pluginsdk.PriceUsage{
+//	    {UnitPrice: 0.10, Usage: 100, Unit: "Hours"},
+//	    {UnitPrice: 0.20, Usage: 300, Unit: "Hours"},
+//	})
+func WeightedAverageUnitPrice(entries []PriceUsage) (float64, error) {
+	if len(entries) == 0 {
+		return 0, ErrNoPriceUsage
+	}
+
+	var totalCost, totalUsage float64
+	for i, e := range entries {
+		if !isNonNegativeFinite(e.UnitPrice) || !isNonNegativeFinite(e.Usage) {
+			return 0, fmt.Errorf("%w: entries[%d] has price %v and usage %v",
+				ErrInvalidPriceUsage, i, e.UnitPrice, e.Usage)
+		}
+		if e.Unit != entries[0].Unit {
+			return 0, fmt.Errorf("%w: entries[%d] has unit %q, expected %q",
+				ErrMismatchedUnits, i, e.Unit, entries[0].Unit)
+		}
+		totalCost += e.UnitPrice * e.Usage
+		totalUsage += e.Usage
+	}
+
+	if totalUsage == 0 {
+		return 0, ErrZeroTotalUsage
+	}
+	return totalCost / totalUsage, nil
+}
+
+// isNonNegativeFinite reports whether v is a finite value >= 0.
+func isNonNegativeFinite(v float64) bool {
+	return v >= 0 && !math.IsInf(v, 1)
+}
@@ -0,0 +1,96 @@
+package pluginsdk_test
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/rshade/finfocus-spec/sdk/go/pluginsdk"
+)
+
+func TestWeightedAverageUnitPrice(t *testing.T) {
+	tests := []struct {
+		name    string
+		entries []pluginsdk.PriceUsage
+		want    float64
+	}{
+		{
+			name: "weighted average differs from simple mean",
+			entries: []pluginsdk.PriceUsage{
+				{UnitPrice: 0.10, Usage: 100, Unit: "Hours"},
+				{UnitPrice: 0.20, Usage: 300, Unit: "Hours"},
+			},
+			want: 0.175, // simple mean would be 0.15
+		},
+		{
+			name:    "single entry returns its price",
+			entries: []pluginsdk.PriceUsage{{UnitPrice: 0.096, Usage: 720, Unit: "Hours"}},
+			want:    0.096,
+		},
+		{
+			name: "idle resource carries no weight",
+			entries: []pluginsdk.PriceUsage{
+				{UnitPrice: 0.05, Usage: 50, Unit: "GB-Mo"},
+				{UnitPrice: 0.50, Usage: 0, Unit: "GB-Mo"},
+			},
+			want: 0.05,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := pluginsdk.WeightedAverageUnitPrice(tt.entries)
+			if err != nil {
+				t.Fatalf("WeightedAverageUnitPrice() unexpected error: %v", err)
+			}
+			if math.Abs(got-tt.want) > 1e-9 {
+				t.Errorf("WeightedAverageUnitPrice() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWeightedAverageUnitPriceErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		entries []pluginsdk.PriceUsage
+		wantErr error
+	}{
+		{"no entries", nil, pluginsdk.ErrNoPriceUsage},
+		{
+			name: "mismatched units",
+			entries: []pluginsdk.PriceUsage{
+				{UnitPrice: 0.10, Usage: 100, Unit: "Hours"},
+				{UnitPrice: 0.02, Usage: 500, Unit: "GB-Mo"},
+			},
+			wantErr: pluginsdk.ErrMismatchedUnits,
+		},
+		{
+			name: "zero total usage",
+			entries: []pluginsdk.PriceUsage{
+				{UnitPrice: 0.10, Usage: 0, Unit: "Hours"},
+				{UnitPrice: 0.20, Usage: 0, Unit: "Hours"},
+			},
+			wantErr: pluginsdk.ErrZeroTotalUsage,
+		},
+		{
+			name:    "negative usage",
+			entries: []pluginsdk.PriceUsage{{UnitPrice: 0.10, Usage: -1, Unit: "Hours"}},
+			wantErr: pluginsdk.ErrInvalidPriceUsage,
+		},
+		{
+			name:    "NaN price",
+			entries: []pluginsdk.PriceUsage{{UnitPrice: math.NaN(), Usage: 1, Unit: "Hours"}},
+			wantErr: pluginsdk.ErrInvalidPriceUsage,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := pluginsdk.WeightedAverageUnitPrice(tt.entries)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("WeightedAverageUnitPrice() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -32,8 +32,10 @@ import (
 	"errors"
 	"fmt"
 	"math"
+	"strconv"
 
 	pbc "github.com/rshade/finfocus-spec/sdk/go/proto/finfocus/v1"
+	plugintesting "github.com/rshade/finfocus-spec/sdk/go/testing"
 )
 
 // Validation error messages for GetProjectedCostRequest.
@@ -64,6 +66,29 @@ var (
 	)
 )
 
+// Validation error messages for GetRecommendationsRequest.
+var (
+	ErrRecommendationsRequestNil       = errors.New("request is required")
+	ErrTargetResourceNil               = errors.New("target resource is required")
+	ErrTargetResourceProviderEmpty     = errors.New("target resource provider is required")
+	ErrTargetResourceTypeEmpty         = errors.New("target resource resource_type is required")
+	ErrProjectionPeriodInvalid         = errors.New("projection_period must be one of daily, monthly, or annual")
+	ErrRecommendationFilterInvalid     = errors.New("filter is invalid")
+	ErrRecommendationFilterEnumInvalid = errors.New("filter contains an unknown enum value")
+	ErrPageTokenInvalid                = errors.New("page_token is invalid")
+)
+
+// MaxTargetResources is the maximum number of target_resources allowed in a
+// GetRecommendationsRequest. It aliases plugintesting.MaxTargetResources, which the
+// contract tests enforce.
+const MaxTargetResources = plugintesting.MaxTargetResources
+
+// ErrTargetResourcesExceedLimit is returned when target_resources has more than
+// MaxTargetResources entries; its message is built from the limit.
+var ErrTargetResourcesExceedLimit = errors.New(
+	"target_resources exceeds maximum of " + strconv.Itoa(MaxTargetResources) + " resources",
+)
+
 // Validation error messages for EstimateCostResponse and GetProjectedCostResponse.
 var (
 	ErrEstimateCostResponseNil      = errors.New("response is required")
@@ -239,6 +264,102 @@ func ValidateActualCostRequest(req *pbc.GetActualCostRequest) error {
 	return nil
 }
 
+// ValidateGetRecommendationsRequest validates a GetRecommendationsRequest in one call.
+// This function is designed for use in both:
+//   - Core: Pre-flight validation before sending requests to plugins
+//   - Plugins: Defense-in-depth validation upon receiving requests
+//
+// Validation order (fail-fast):
+//  1. Request nil check
+//  2. target_resources count (at most MaxTargetResources)
+//  3. Each target resource is non-nil with a provider and resource_type
+//  4. projection_period is empty (default: monthly), "daily", "monthly", or "annual"
+//  5. Filter enum fields (category, action_type, priority, sort_by, sort_order) are known values
+//  6. Filter numeric ranges (see ValidateRecommendationFilter)
+//  7. page_token, if set, decodes with DecodePageToken
+//
+// usage_profile is not checked: unknown profiles are treated as UNSPECIFIED for forward
+// compatibility. page_size is not checked either, since PaginateRecommendations clamps it.
+//
+// Returns nil if the request is valid, or an error wrapping one of the
+// GetRecommendationsRequest sentinel errors that describes the first validation failure.
+func ValidateGetRecommendationsRequest(req *pbc.GetRecommendationsRequest) error {
+	if req == nil {
+		return ErrRecommendationsRequestNil
+	}
+
+	targets := req.GetTargetResources()
+	if len(targets) > MaxTargetResources {
+		return fmt.Errorf("%w: got %d", ErrTargetResourcesExceedLimit, len(targets))
+	}
+	for i, target := range targets {
+		switch {
+		case target == nil:
+			return fmt.Errorf("target_resources[%d]: %w", i, ErrTargetResourceNil)
+		case target.GetProvider() == "":
+			return fmt.Errorf("target_resources[%d]: %w", i, ErrTargetResourceProviderEmpty)
+		case target.GetResourceType() == "":
+			return fmt.Errorf("target_resources[%d]: %w", i, ErrTargetResourceTypeEmpty)
+		}
+	}
+
+	if err := ValidateProjectionPeriod(req.GetProjectionPeriod()); err != nil {
+		return err
+	}
+
+	if err := validateRecommendationFilterEnums(req.GetFilter()); err != nil {
+		return err
+	}
+	if err := ValidateRecommendationFilter(req.GetFilter()); err != nil {
+		return fmt.Errorf("%w: %w", ErrRecommendationFilterInvalid, err)
+	}
+
+	if token := req.GetPageToken(); token != "" {
+		if _, err := DecodePageToken(token); err != nil {
+			return fmt.Errorf("%w: %w", ErrPageTokenInvalid, err)
+		}
+	}
+
+	return nil
+}
+
+// ValidateProjectionPeriod checks that period is a projection period accepted by
+// GetRecommendationsRequest: "daily", "monthly", "annual", or empty (the monthly default).
+//
+// Returns nil if the period is valid, or an error wrapping ErrProjectionPeriodInvalid.
+func ValidateProjectionPeriod(period string) error {
+	if _, ok := ProjectionPeriodFactor(period); !ok {
+		return fmt.Errorf("%w: got %q", ErrProjectionPeriodInvalid, period)
+	}
+	return nil
+}
+
+// validateRecommendationFilterEnums checks that every enum field of filter holds a value
+// defined by the proto schema. A nil filter is valid.
+func validateRecommendationFilterEnums(filter *pbc.RecommendationFilter) error {
+	if filter == nil {
+		return nil
+	}
+
+	enums := []struct {
+		field   string
+		value   int32
+		defined map[int32]string
+	}{
+		{"category", int32(filter.GetCategory()), pbc.RecommendationCategory_name},
+		{"action_type", int32(filter.GetActionType()), pbc.RecommendationActionType_name},
+		{"priority", int32(filter.GetPriority()), pbc.RecommendationPriority_name},
+		{"sort_by", int32(filter.GetSortBy()), pbc.RecommendationSortBy_name},
+		{"sort_order", int32(filter.GetSortOrder()), pbc.SortOrder_name},
+	}
+	for _, e := range enums {
+		if _, ok := e.defined[e.value]; !ok {
+			return fmt.Errorf("%w: filter.%s = %d", ErrRecommendationFilterEnumInvalid, e.field, e.value)
+		}
+	}
+	return nil
+}
+
 // validateSpotRiskScore validates the spot_interruption_risk_score field.
 // Returns nil if score is effectively 0.0 (proto3 default) or a valid non-zero value.
 //
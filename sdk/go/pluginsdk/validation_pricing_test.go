@@ -154,6 +154,47 @@ func TestValidateEstimateCostResponse(t *testing.T) {
 	})
 }
 
+func TestWithExplanation(t *testing.T) {
+	t.Run("steps_attached_in_order", func(t *testing.T) {
+		steps := []string{
+			"t3.micro on-demand rate: 0.0104 USD/hour",
+			"0.0104 USD/hour x 730 hours x 2 instances = 15.18 USD",
+		}
+		resp := pluginsdk.NewEstimateCostResponse(
+			pluginsdk.WithEstimateCost("USD", 15.184),
+			pluginsdk.WithExplanation(steps),
+		)
+		assert.Equal(t, steps, resp.GetExplanation())
+
+		// Mutating the caller's slice must not affect the response.
+		steps[0] = "mutated"
+		assert.Equal(t, "t3.micro on-demand rate: 0.0104 USD/hour", resp.GetExplanation()[0])
+	})
+
+	t.Run("empty_explanation_omitted", func(t *testing.T) {
+		resp := pluginsdk.NewEstimateCostResponse(
+			pluginsdk.WithEstimateCost("USD", 15.184),
+			pluginsdk.WithExplanation([]string{}),
+		)
+		assert.Nil(t, resp.GetExplanation())
+
+		resp = pluginsdk.NewEstimateCostResponse(pluginsdk.WithExplanation(nil))
+		assert.Nil(t, resp.GetExplanation())
+	})
+
+	t.Run("steps_survive_validation", func(t *testing.T) {
+		steps := []string{"spot rate: 0.0031 USD/hour", "0.0031 USD/hour x 730 hours = 2.26 USD"}
+		resp := pluginsdk.NewEstimateCostResponse(
+			pluginsdk.WithEstimateCost("USD", 2.263),
+			pluginsdk.WithPricingCategory(pbc.FocusPricingCategory_FOCUS_PRICING_CATEGORY_DYNAMIC),
+			pluginsdk.WithSpotRisk(0.2),
+			pluginsdk.WithExplanation(steps),
+		)
+		require.NoError(t, pluginsdk.ValidateEstimateCostResponse(resp))
+		assert.Equal(t, steps, resp.GetExplanation())
+	})
+}
+
 func TestValidateGetProjectedCostResponse(t *testing.T) {
 	t.Run("nil_response", func(t *testing.T) {
 		err := pluginsdk.ValidateGetProjectedCostResponse(nil)
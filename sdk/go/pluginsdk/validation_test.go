@@ -2,6 +2,7 @@ package pluginsdk_test
 
 import (
 	"errors"
+	"strconv"
 	"strings"
 	"testing"
 	"time"
@@ -454,3 +455,143 @@ func BenchmarkIsUtilizationValid_Invalid(b *testing.B) {
 		_ = pluginsdk.IsUtilizationValid(1.5)
 	}
 }
+
+func TestValidateGetRecommendationsRequest(t *testing.T) {
+	target := &pbc.ResourceDescriptor{Provider: "aws", ResourceType: "ec2"}
+	overLimit := make([]*pbc.ResourceDescriptor, pluginsdk.MaxTargetResources+1)
+	for i := range overLimit {
+		overLimit[i] = target
+	}
+
+	tests := []struct {
+		name    string
+		req     *pbc.GetRecommendationsRequest
+		wantErr error
+	}{
+		{
+			name:    "nil request returns error",
+			req:     nil,
+			wantErr: pluginsdk.ErrRecommendationsRequestNil,
+		},
+		{
+			name:    "empty request returns nil",
+			req:     &pbc.GetRecommendationsRequest{},
+			wantErr: nil,
+		},
+		{
+			name: "valid request returns nil",
+			req: &pbc.GetRecommendationsRequest{
+				Filter: &pbc.RecommendationFilter{
+					Category:           pbc.RecommendationCategory_RECOMMENDATION_CATEGORY_COST,
+					Priority:           pbc.RecommendationPriority_RECOMMENDATION_PRIORITY_HIGH,
+					SortBy:             pbc.RecommendationSortBy_RECOMMENDATION_SORT_BY_ESTIMATED_SAVINGS,
+					SortOrder:          pbc.SortOrder_SORT_ORDER_DESC,
+					MinConfidenceScore: 0.8,
+				},
+				ProjectionPeriod: "annual",
+				PageSize:         10,
+				PageToken:        pluginsdk.EncodePageToken(20),
+				TargetResources:  overLimit[:pluginsdk.MaxTargetResources],
+			},
+			wantErr: nil,
+		},
+		{
+			name:    "over-limit target list returns error",
+			req:     &pbc.GetRecommendationsRequest{TargetResources: overLimit},
+			wantErr: pluginsdk.ErrTargetResourcesExceedLimit,
+		},
+		{
+			name: "nil target resource returns error",
+			req: &pbc.GetRecommendationsRequest{
+				TargetResources: []*pbc.ResourceDescriptor{target, nil},
+			},
+			wantErr: pluginsdk.ErrTargetResourceNil,
+		},
+		{
+			name: "target resource without provider returns error",
+			req: &pbc.GetRecommendationsRequest{
+				TargetResources: []*pbc.ResourceDescriptor{{ResourceType: "ec2"}},
+			},
+			wantErr: pluginsdk.ErrTargetResourceProviderEmpty,
+		},
+		{
+			name: "target resource without resource_type returns error",
+			req: &pbc.GetRecommendationsRequest{
+				TargetResources: []*pbc.ResourceDescriptor{{Provider: "aws"}},
+			},
+			wantErr: pluginsdk.ErrTargetResourceTypeEmpty,
+		},
+		{
+			name:    "invalid projection period returns error",
+			req:     &pbc.GetRecommendationsRequest{ProjectionPeriod: "weekly"},
+			wantErr: pluginsdk.ErrProjectionPeriodInvalid,
+		},
+		{
+			name: "unknown filter enum returns error",
+			req: &pbc.GetRecommendationsRequest{
+				Filter: &pbc.RecommendationFilter{ActionType: pbc.RecommendationActionType(999)},
+			},
+			wantErr: pluginsdk.ErrRecommendationFilterEnumInvalid,
+		},
+		{
+			name: "out-of-range filter value returns error",
+			req: &pbc.GetRecommendationsRequest{
+				Filter: &pbc.RecommendationFilter{MinEstimatedSavings: -1},
+			},
+			wantErr: pluginsdk.ErrRecommendationFilterInvalid,
+		},
+		{
+			name:    "malformed page token returns error",
+			req:     &pbc.GetRecommendationsRequest{PageToken: "not base64!"},
+			wantErr: pluginsdk.ErrPageTokenInvalid,
+		},
+		{
+			name:    "negative page token offset returns error",
+			req:     &pbc.GetRecommendationsRequest{PageToken: pluginsdk.EncodePageToken(-5)},
+			wantErr: pluginsdk.ErrPageTokenInvalid,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := pluginsdk.ValidateGetRecommendationsRequest(tt.req)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("ValidateGetRecommendationsRequest() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestErrTargetResourcesExceedLimitNamesLimit(t *testing.T) {
+	want := "maximum of " + strconv.Itoa(pluginsdk.MaxTargetResources) + " resources"
+	if msg := pluginsdk.ErrTargetResourcesExceedLimit.Error(); !strings.Contains(msg, want) {
+		t.Errorf("ErrTargetResourcesExceedLimit = %q, want it to contain %q", msg, want)
+	}
+}
+
+func TestValidateGetRecommendationsRequest_ErrorIdentifiesTarget(t *testing.T) {
+	req := &pbc.GetRecommendationsRequest{
+		TargetResources: []*pbc.ResourceDescriptor{
+			{Provider: "aws", ResourceType: "ec2"},
+			{Provider: "aws"},
+		},
+	}
+
+	err := pluginsdk.ValidateGetRecommendationsRequest(req)
+	if err == nil || !strings.Contains(err.Error(), "target_resources[1]") {
+		t.Errorf("ValidateGetRecommendationsRequest() error = %v, want it to name target_resources[1]", err)
+	}
+}
+
+func TestValidateProjectionPeriod(t *testing.T) {
+	for _, period := range []string{"", "daily", "monthly", "annual"} {
+		if err := pluginsdk.ValidateProjectionPeriod(period); err != nil {
+			t.Errorf("ValidateProjectionPeriod(%q) = %v, want nil", period, err)
+		}
+	}
+	for _, period := range []string{"weekly", "Monthly", "yearly", " monthly"} {
+		if err := pluginsdk.ValidateProjectionPeriod(period); !errors.Is(err, pluginsdk.ErrProjectionPeriodInvalid) {
+			t.Errorf("ValidateProjectionPeriod(%q) = %v, want ErrProjectionPeriodInvalid", period, err)
+		}
+	}
+}
@@ -0,0 +1,148 @@
+package pricing
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+	"sync"
+	"time"
+)
+
+// Adaptive timeout defaults.
+const (
+	defaultAdaptivePercentile = 0.99 // Base timeouts on p99 latency
+	defaultAdaptiveMultiplier = 1.5  // 50% headroom over the percentile latency
+	defaultAdaptiveMinSamples = 20   // Observations required before a method adapts
+	defaultAdaptiveWindowSize = 1000 // Most recent observations kept per method
+)
+
+// AdaptiveTimeoutConfig holds configuration for adaptive timeouts.
+type AdaptiveTimeoutConfig struct {
+	Percentile float64 // Latency percentile timeouts are based on (0.0-1.0], e.g. 0.99 for p99
+	Multiplier float64 // Headroom applied to the percentile latency (>= 1.0)
+	MinSamples int     // Observations required before a method's timeout adapts
+	WindowSize int     // Number of most recent observations kept per method (>= MinSamples)
+}
+
+// NewDefaultAdaptiveTimeoutConfig creates an adaptive timeout config that uses p99 latency x 1.5.
+func NewDefaultAdaptiveTimeoutConfig() *AdaptiveTimeoutConfig {
+	return &AdaptiveTimeoutConfig{
+		Percentile: defaultAdaptivePercentile,
+		Multiplier: defaultAdaptiveMultiplier,
+		MinSamples: defaultAdaptiveMinSamples,
+		WindowSize: defaultAdaptiveWindowSize,
+	}
+}
+
+// Validate checks if the adaptive timeout config has valid parameters.
+func (ac *AdaptiveTimeoutConfig) Validate() error {
+	if !(ac.Percentile > 0 && ac.Percentile <= 1) {
+		return errors.New("percentile must be greater than 0.0 and at most 1.0")
+	}
+	if !(ac.Multiplier >= 1) || math.IsInf(ac.Multiplier, 0) {
+		return errors.New("multiplier must be a finite value of at least 1.0")
+	}
+	if ac.MinSamples <= 0 {
+		return errors.New("min samples must be positive")
+	}
+	if ac.WindowSize < ac.MinSamples {
+		return errors.New("window size must be at least min samples")
+	}
+	return nil
+}
+
+// AdaptiveTimeout learns per-method timeouts from observed latencies.
+//
+// Once a method has at least MinSamples observations, its timeout is the configured percentile
+// of the most recent WindowSize latencies multiplied by Multiplier, bounded below by the minimum
+// timeout accepted by TimeoutConfig (1s) and above by the TimeoutConfig's GlobalTimeout. Until
+// then, the static timeout from TimeoutConfig.GetTimeoutForMethod is used.
+//
+// An AdaptiveTimeout is safe for concurrent use.
+//
+// Example:
+//
+//	adaptive, _ := NewAdaptiveTimeout(NewDefaultTimeoutConfig(), nil)
+//	start := time.Now()
+//	err := callPlugin(ctx)
+//	adaptive.Observe(MethodGetActualCost, time.Since(start))
+//	ctx, cancel := context.WithTimeout(ctx, adaptive.TimeoutFor(MethodGetActualCost))
+type AdaptiveTimeout struct {
+	mu       sync.Mutex
+	timeouts *TimeoutConfig
+	config   *AdaptiveTimeoutConfig
+	samples  map[string]*latencyWindow
+}
+
+// latencyWindow is a fixed-size ring of the most recent latencies observed for a method.
+type latencyWindow struct {
+	latencies []time.Duration
+	next      int
+}
+
+// NewAdaptiveTimeout creates an adaptive timeout that falls back to and is bounded by timeouts.
+// A nil timeouts or config uses the corresponding defaults.
+func NewAdaptiveTimeout(timeouts *TimeoutConfig, config *AdaptiveTimeoutConfig) (*AdaptiveTimeout, error) {
+	if timeouts == nil {
+		timeouts = NewDefaultTimeoutConfig()
+	}
+	if config == nil {
+		config = NewDefaultAdaptiveTimeoutConfig()
+	}
+
+	if err := timeouts.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid timeout config: %w", err)
+	}
+	if err := config.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid adaptive timeout config: %w", err)
+	}
+
+	return &AdaptiveTimeout{
+		timeouts: timeouts,
+		config:   config,
+		samples:  make(map[string]*latencyWindow),
+	}, nil
+}
+
+// Observe records the latency of a completed call to method. Negative durations are ignored.
+func (at *AdaptiveTimeout) Observe(method string, d time.Duration) {
+	if d < 0 {
+		return
+	}
+
+	at.mu.Lock()
+	defer at.mu.Unlock()
+
+	window, ok := at.samples[method]
+	if !ok {
+		window = &latencyWindow{latencies: make([]time.Duration, 0, at.config.WindowSize)}
+		at.samples[method] = window
+	}
+	if len(window.latencies) < at.config.WindowSize {
+		window.latencies = append(window.latencies, d)
+		return
+	}
+	window.latencies[window.next] = d
+	window.next = (window.next + 1) % at.config.WindowSize
+}
+
+// TimeoutFor returns the timeout to use for the next call to method.
+func (at *AdaptiveTimeout) TimeoutFor(method string) time.Duration {
+	at.mu.Lock()
+	defer at.mu.Unlock()
+
+	window, ok := at.samples[method]
+	if !ok || len(window.latencies) < at.config.MinSamples {
+		return at.timeouts.GetTimeoutForMethod(method)
+	}
+
+	sorted := slices.Clone(window.latencies)
+	slices.Sort(sorted)
+	// Nearest-rank percentile
+	rank := int(math.Ceil(at.config.Percentile * float64(len(sorted))))
+	latency := sorted[max(rank, 1)-1]
+
+	timeout := time.Duration(float64(latency) * at.config.Multiplier)
+	return min(max(timeout, minimumTimeout), at.timeouts.GlobalTimeout)
+}
@@ -0,0 +1,143 @@
+package pricing_test
+
+import (
+	"math"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/rshade/finfocus-spec/sdk/go/pricing"
+)
+
+// observeN records n observations of d for method.
+func observeN(at *pricing.AdaptiveTimeout, method string, d time.Duration, n int) {
+	for range n {
+		at.Observe(method, d)
+	}
+}
+
+func newTestAdaptiveTimeout(t *testing.T, config *pricing.AdaptiveTimeoutConfig) *pricing.AdaptiveTimeout {
+	t.Helper()
+	at, err := pricing.NewAdaptiveTimeout(pricing.NewDefaultTimeoutConfig(), config)
+	if err != nil {
+		t.Fatalf("NewAdaptiveTimeout() unexpected error: %v", err)
+	}
+	return at
+}
+
+func TestAdaptiveTimeoutFallsBackToStatic(t *testing.T) {
+	at := newTestAdaptiveTimeout(t, nil)
+	static := pricing.NewDefaultTimeoutConfig()
+
+	if got := at.TimeoutFor(pricing.MethodGetActualCost); got != static.GetActualCostTimeout {
+		t.Errorf("TimeoutFor() with no samples = %v, want static %v", got, static.GetActualCostTimeout)
+	}
+
+	// Fewer than MinSamples observations keep the static timeout.
+	observeN(at, pricing.MethodGetActualCost, 2*time.Second, 19)
+	if got := at.TimeoutFor(pricing.MethodGetActualCost); got != static.GetActualCostTimeout {
+		t.Errorf("TimeoutFor() below MinSamples = %v, want static %v", got, static.GetActualCostTimeout)
+	}
+}
+
+func TestAdaptiveTimeoutPercentile(t *testing.T) {
+	at := newTestAdaptiveTimeout(t, nil)
+
+	// p99 of 99 x 2s and 1 x 20s is 2s, so the outlier does not inflate the timeout.
+	observeN(at, pricing.MethodGetActualCost, 2*time.Second, 99)
+	at.Observe(pricing.MethodGetActualCost, 20*time.Second)
+
+	if got, want := at.TimeoutFor(pricing.MethodGetActualCost), 3*time.Second; got != want {
+		t.Errorf("TimeoutFor() = %v, want p99 x 1.5 = %v", got, want)
+	}
+}
+
+func TestAdaptiveTimeoutBounds(t *testing.T) {
+	at := newTestAdaptiveTimeout(t, nil)
+
+	observeN(at, pricing.MethodName, 10*time.Millisecond, 50)
+	if got := at.TimeoutFor(pricing.MethodName); got != time.Second {
+		t.Errorf("TimeoutFor() for fast method = %v, want the 1s minimum", got)
+	}
+
+	observeN(at, pricing.MethodGetPricingSpec, 2*time.Minute, 50)
+	global := pricing.NewDefaultTimeoutConfig().GlobalTimeout
+	if got := at.TimeoutFor(pricing.MethodGetPricingSpec); got != global {
+		t.Errorf("TimeoutFor() for slow method = %v, want GlobalTimeout %v", got, global)
+	}
+}
+
+func TestAdaptiveTimeoutWindowAndIsolation(t *testing.T) {
+	at := newTestAdaptiveTimeout(t, &pricing.AdaptiveTimeoutConfig{
+		Percentile: 0.5,
+		Multiplier: 1,
+		MinSamples: 5,
+		WindowSize: 10,
+	})
+
+	observeN(at, pricing.MethodGetActualCost, 10*time.Second, 10)
+	observeN(at, pricing.MethodGetProjectedCost, 4*time.Second, 10)
+
+	// Newer observations push the old ones out of the window.
+	observeN(at, pricing.MethodGetActualCost, 2*time.Second, 10)
+	if got := at.TimeoutFor(pricing.MethodGetActualCost); got != 2*time.Second {
+		t.Errorf("TimeoutFor(GetActualCost) = %v, want 2s after the window rolled over", got)
+	}
+	if got := at.TimeoutFor(pricing.MethodGetProjectedCost); got != 4*time.Second {
+		t.Errorf("TimeoutFor(GetProjectedCost) = %v, want 4s unaffected by other methods", got)
+	}
+}
+
+func TestAdaptiveTimeoutConfigValidation(t *testing.T) {
+	tests := []struct {
+		name       string
+		percentile float64
+		multiplier float64
+		minSamples int
+		windowSize int
+	}{
+		{"zero percentile", 0, 1.5, 1, 1},
+		{"percentile above 1", 1.1, 1.5, 1, 1},
+		{"NaN percentile", math.NaN(), 1.5, 1, 1},
+		{"multiplier below 1", 0.99, 0.5, 1, 1},
+		{"zero min samples", 0.99, 1.5, 0, 1},
+		{"window below min samples", 0.99, 1.5, 10, 5},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := &pricing.AdaptiveTimeoutConfig{
+				Percentile: tt.percentile,
+				Multiplier: tt.multiplier,
+				MinSamples: tt.minSamples,
+				WindowSize: tt.windowSize,
+			}
+			if _, err := pricing.NewAdaptiveTimeout(nil, config); err == nil {
+				t.Error("NewAdaptiveTimeout() expected error, got nil")
+			}
+		})
+	}
+
+	if err := pricing.NewDefaultAdaptiveTimeoutConfig().Validate(); err != nil {
+		t.Errorf("Default config should be valid, got: %v", err)
+	}
+}
+
+func TestAdaptiveTimeoutConcurrentAccess(t *testing.T) {
+	at := newTestAdaptiveTimeout(t, nil)
+	var wg sync.WaitGroup
+
+	for i := range 100 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			at.Observe(pricing.MethodGetActualCost, time.Duration(i)*time.Millisecond)
+			_ = at.TimeoutFor(pricing.MethodGetActualCost)
+		}()
+	}
+	wg.Wait()
+
+	if got := at.TimeoutFor(pricing.MethodGetActualCost); got < time.Second {
+		t.Errorf("TimeoutFor() = %v, want at least the 1s minimum", got)
+	}
+}
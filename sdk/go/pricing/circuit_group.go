@@ -0,0 +1,98 @@
+package pricing
+
+import (
+	"fmt"
+	"sync"
+)
+
+// RPC method names used as canonical keys by TimeoutConfig.GetTimeoutForMethod and
+// CircuitBreakerGroup.
+const (
+	// MethodName is the Name RPC.
+	MethodName = "Name"
+	// MethodSupports is the Supports RPC.
+	MethodSupports = "Supports"
+	// MethodGetActualCost is the GetActualCost RPC.
+	MethodGetActualCost = "GetActualCost"
+	// MethodGetProjectedCost is the GetProjectedCost RPC.
+	MethodGetProjectedCost = "GetProjectedCost"
+	// MethodGetPricingSpec is the GetPricingSpec RPC.
+	MethodGetPricingSpec = "GetPricingSpec"
+)
+
+// CircuitBreakerGroup maintains an independent CircuitBreaker per RPC method, so a failing
+// backend API opens only the affected method's circuit while the rest of the plugin stays
+// available. Breakers for the canonical method names are created up front; breakers for any
+// other method name are created on first use with the same configuration.
+// It is safe for concurrent use.
+type CircuitBreakerGroup struct {
+	mu       sync.Mutex
+	name     string
+	config   CircuitBreakerConfig
+	breakers map[string]*CircuitBreaker
+}
+
+// NewCircuitBreakerGroup creates a breaker group whose per-method breakers share the given
+// configuration. A nil config uses NewDefaultCircuitBreakerConfig.
+func NewCircuitBreakerGroup(name string, config *CircuitBreakerConfig) (*CircuitBreakerGroup, error) {
+	if config == nil {
+		config = NewDefaultCircuitBreakerConfig()
+	}
+	if err := config.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid circuit breaker config: %w", err)
+	}
+
+	group := &CircuitBreakerGroup{
+		name:     name,
+		config:   *config,
+		breakers: make(map[string]*CircuitBreaker),
+	}
+	for _, method := range []string{
+		MethodName, MethodSupports, MethodGetActualCost, MethodGetProjectedCost, MethodGetPricingSpec,
+	} {
+		group.breakers[method] = group.newBreaker(method)
+	}
+	return group, nil
+}
+
+// Name returns the breaker group name.
+func (g *CircuitBreakerGroup) Name() string {
+	return g.name
+}
+
+// Breaker returns the circuit breaker for the given RPC method, creating it if needed.
+func (g *CircuitBreakerGroup) Breaker(method string) *CircuitBreaker {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	cb, ok := g.breakers[method]
+	if !ok {
+		cb = g.newBreaker(method)
+		g.breakers[method] = cb
+	}
+	return cb
+}
+
+// Execute wraps a call to the given RPC method with that method's circuit breaker.
+func (g *CircuitBreakerGroup) Execute(method string, fn func() error) error {
+	return g.Breaker(method).Execute(fn)
+}
+
+// States returns the current state of every breaker in the group, keyed by method name.
+func (g *CircuitBreakerGroup) States() map[string]CircuitBreakerState {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	states := make(map[string]CircuitBreakerState, len(g.breakers))
+	for method, cb := range g.breakers {
+		states[method] = cb.State()
+	}
+	return states
+}
+
+// newBreaker creates a breaker for a method using a private copy of the group config.
+func (g *CircuitBreakerGroup) newBreaker(method string) *CircuitBreaker {
+	config := g.config
+	cb, _ := NewCircuitBreaker(g.name+"/"+method, &config) // Group config was validated at construction
+	return cb
+}
@@ -0,0 +1,79 @@
+package pricing_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/rshade/finfocus-spec/sdk/go/pricing"
+)
+
+func TestCircuitBreakerGroupIsolatesMethods(t *testing.T) {
+	config := pricing.NewDefaultCircuitBreakerConfig()
+	config.RequestVolumeThreshold = 1
+	config.FailureThreshold = 2
+	group, err := pricing.NewCircuitBreakerGroup("aws-plugin", config)
+	if err != nil {
+		t.Fatalf("NewCircuitBreakerGroup() unexpected error: %v", err)
+	}
+
+	backendErr := errors.New("billing API unavailable")
+	for range 2 {
+		if err := group.Execute(pricing.MethodGetActualCost, func() error { return backendErr }); err == nil {
+			t.Fatal("Expected GetActualCost to fail")
+		}
+	}
+
+	if state := group.Breaker(pricing.MethodGetActualCost).State(); state != pricing.CircuitOpen {
+		t.Errorf("Expected GetActualCost circuit open, got %v", state)
+	}
+	if err := group.Execute(pricing.MethodName, func() error { return nil }); err != nil {
+		t.Errorf("Expected Name RPC to remain available, got %v", err)
+	}
+
+	err = group.Execute(pricing.MethodGetActualCost, func() error { return nil })
+	var pluginErr *pricing.PluginError
+	if !errors.As(err, &pluginErr) || pluginErr.Code != pricing.ErrorCodeCircuitOpen {
+		t.Errorf("Expected CircuitOpen for GetActualCost, got %v", err)
+	}
+}
+
+func TestCircuitBreakerGroupStates(t *testing.T) {
+	group, err := pricing.NewCircuitBreakerGroup("aws-plugin", nil)
+	if err != nil {
+		t.Fatalf("NewCircuitBreakerGroup() unexpected error: %v", err)
+	}
+
+	states := group.States()
+	for _, method := range []string{
+		pricing.MethodName, pricing.MethodSupports, pricing.MethodGetActualCost,
+		pricing.MethodGetProjectedCost, pricing.MethodGetPricingSpec,
+	} {
+		if state, ok := states[method]; !ok || state != pricing.CircuitClosed {
+			t.Errorf("Expected closed breaker for %s, got %v (present=%v)", method, state, ok)
+		}
+	}
+
+	// Non-canonical methods get a breaker on first use
+	group.Breaker("GetRecommendations").ForceOpen()
+	if state := group.States()["GetRecommendations"]; state != pricing.CircuitOpen {
+		t.Errorf("Expected GetRecommendations breaker open, got %v", state)
+	}
+	if group.Breaker(pricing.MethodName).Name() != "aws-plugin/Name" {
+		t.Errorf("Unexpected breaker name %q", group.Breaker(pricing.MethodName).Name())
+	}
+}
+
+func TestCircuitBreakerGroupInvalidConfig(t *testing.T) {
+	config := pricing.NewDefaultCircuitBreakerConfig()
+	config.FailureThreshold = 0
+	if _, err := pricing.NewCircuitBreakerGroup("aws-plugin", config); err == nil {
+		t.Error("Expected error for invalid config")
+	}
+}
+
+func TestTimeoutConfigMethodConstants(t *testing.T) {
+	config := pricing.NewDefaultTimeoutConfig()
+	if config.GetTimeoutForMethod(pricing.MethodGetActualCost) != config.GetActualCostTimeout {
+		t.Error("Expected MethodGetActualCost to map to GetActualCostTimeout")
+	}
+}
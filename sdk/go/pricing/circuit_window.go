@@ -0,0 +1,68 @@
+package pricing
+
+import "time"
+
+// slidingWindow counts requests and failures over a trailing time window divided into
+// fixed-width buckets. Buckets older than the window are discarded lazily on access.
+// It is not safe for concurrent use; CircuitBreaker guards it with its mutex.
+type slidingWindow struct {
+	bucketWidth time.Duration
+	buckets     []windowBucket
+}
+
+// windowBucket holds the counts for one bucket-width slice of time.
+type windowBucket struct {
+	epoch    int64 // Bucket number since the Unix epoch (time / bucketWidth)
+	requests int64
+	failures int64
+}
+
+// newSlidingWindow creates a window of the given duration split into bucketCount buckets.
+func newSlidingWindow(duration time.Duration, bucketCount int) *slidingWindow {
+	width := duration / time.Duration(bucketCount)
+	if width <= 0 {
+		width = 1
+	}
+	return &slidingWindow{
+		bucketWidth: width,
+		buckets:     make([]windowBucket, bucketCount),
+	}
+}
+
+// epochAt returns the bucket number containing t.
+func (w *slidingWindow) epochAt(t time.Time) int64 {
+	return t.UnixNano() / int64(w.bucketWidth)
+}
+
+// record adds one request, counted as a failure if failed, to the bucket containing now.
+func (w *slidingWindow) record(now time.Time, failed bool) {
+	epoch := w.epochAt(now)
+	b := &w.buckets[epoch%int64(len(w.buckets))]
+	if b.epoch != epoch {
+		*b = windowBucket{epoch: epoch}
+	}
+	b.requests++
+	if failed {
+		b.failures++
+	}
+}
+
+// counts returns the total requests and failures recorded within the window ending at now.
+func (w *slidingWindow) counts(now time.Time) (int64, int64) {
+	current := w.epochAt(now)
+	oldest := current - int64(len(w.buckets)) + 1
+
+	var requests, failures int64
+	for _, b := range w.buckets {
+		if b.epoch >= oldest && b.epoch <= current {
+			requests += b.requests
+			failures += b.failures
+		}
+	}
+	return requests, failures
+}
+
+// reset discards all recorded counts.
+func (w *slidingWindow) reset() {
+	clear(w.buckets)
+}
@@ -0,0 +1,175 @@
+package pricing
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+)
+
+// Commitment validation errors.
+var (
+	// ErrInvalidCommitmentTerm is returned when a commitment has a term of zero or fewer months.
+	ErrInvalidCommitmentTerm = errors.New("commitment term_months must be > 0")
+
+	// ErrNegativeCommitmentCost is returned when a commitment has a negative upfront or monthly cost.
+	ErrNegativeCommitmentCost = errors.New("commitment costs must be >= 0")
+
+	// ErrElapsedOutOfRange is returned when elapsed months are negative or exceed the commitment term.
+	ErrElapsedOutOfRange = errors.New("elapsed months must be between 0 and the commitment term")
+
+	// ErrIncompatibleCommitmentBilling is returned when a commitment type is paired with a billing
+	// mode it cannot be billed in, such as reserved with per_second.
+	ErrIncompatibleCommitmentBilling = errors.New("billing mode is incompatible with commitment type")
+
+	// ErrNegativeCommittedUsage is returned when a committed usage has negative units or rates.
+	ErrNegativeCommittedUsage = errors.New("committed usage units and rates must be >= 0")
+)
+
+// Commitment describes a fixed-term pricing commitment such as a reserved instance,
+// savings plan, or committed use discount.
+type Commitment struct {
+	// TermMonths is the length of the commitment in months (e.g., 12 for 1-year, 36 for 3-year).
+	TermMonths int
+
+	// UpfrontCost is the amount paid at purchase (all-upfront or partial-upfront payment options).
+	UpfrontCost float64
+
+	// MonthlyCost is the recurring monthly charge (no-upfront or partial-upfront payment options).
+	MonthlyCost float64
+}
+
+// TotalCost returns the full value of the commitment over its term.
+// Formula: total = upfront + monthly * term_months
+func (c Commitment) TotalCost() float64 {
+	return c.UpfrontCost + c.MonthlyCost*float64(c.TermMonths)
+}
+
+// Validate checks that the commitment has a positive term and non-negative costs.
+func (c Commitment) Validate() error {
+	if c.TermMonths <= 0 {
+		return ErrInvalidCommitmentTerm
+	}
+	if c.UpfrontCost < 0 || c.MonthlyCost < 0 {
+		return ErrNegativeCommitmentCost
+	}
+	return nil
+}
+
+// CommitmentLiability calculates the remaining value of a commitment cancelled after elapsedMonths.
+// The total commitment value (upfront plus recurring charges) is prorated evenly across the term,
+// so the result is both the prorated refund of an amortized upfront payment and the remaining
+// liability for unpaid monthly charges.
+// Formula: remaining = total * (term_months - elapsed) / term_months
+//
+// Parameters:
+//   - commitment: The commitment being cancelled
+//   - elapsedMonths: Whole months of the term already used (0 to TermMonths inclusive)
+//
+// Returns an error if the commitment is invalid or elapsedMonths is outside [0, TermMonths].
+//
+// Example:
+//
+//	1-year commitment, $1200 upfront, cancelled after 3 months
+//	Total: $1200
+//	Remaining: $1200 * (12 - 3) / 12 = $900.00
+func CommitmentLiability(commitment Commitment, elapsedMonths int) (float64, error) {
+	if err := commitment.Validate(); err != nil {
+		return 0, err
+	}
+	if elapsedMonths < 0 || elapsedMonths > commitment.TermMonths {
+		return 0, fmt.Errorf("%w: elapsed=%d, term=%d", ErrElapsedOutOfRange, elapsedMonths, commitment.TermMonths)
+	}
+
+	remainingMonths := commitment.TermMonths - elapsedMonths
+	return commitment.TotalCost() * float64(remainingMonths) / float64(commitment.TermMonths), nil
+}
+
+// CommittedUsage describes usage-based pricing with a committed baseline and on-demand overage,
+// such as DynamoDB provisioned capacity with auto-scaling above it.
+type CommittedUsage struct {
+	// CommittedUnits is the baseline quantity paid for whether or not it is used.
+	CommittedUnits float64
+
+	// CommittedRate is the price per committed unit.
+	CommittedRate float64
+
+	// OverageRate is the price per unit used beyond CommittedUnits.
+	OverageRate float64
+}
+
+// Validate checks that the committed units and both rates are non-negative.
+func (u CommittedUsage) Validate() error {
+	if u.CommittedUnits < 0 || u.CommittedRate < 0 || u.OverageRate < 0 {
+		return ErrNegativeCommittedUsage
+	}
+	return nil
+}
+
+// Cost returns the charge for actualUnits of usage. The committed baseline is always billed in
+// full at CommittedRate, and only usage beyond it is billed at OverageRate.
+// Formula: cost = committed_units * committed_rate + max(0, actual - committed_units) * overage_rate
+//
+// Returns ErrNegativeCommittedUsage if the commitment is invalid, or ErrNegativeQuantity if
+// actualUnits is negative.
+//
+// Example:
+//
+//	100 committed units at $0.50, overage at $0.80
+//	80 units used:  100 * 0.50 = $50.00
+//	130 units used: 100 * 0.50 + 30 * 0.80 = $74.00
+func (u CommittedUsage) Cost(actualUnits float64) (float64, error) {
+	if err := u.Validate(); err != nil {
+		return 0, err
+	}
+	if actualUnits < 0 {
+		return 0, fmt.Errorf("%w: actual units %v", ErrNegativeQuantity, actualUnits)
+	}
+
+	overageUnits := max(0, actualUnits-u.CommittedUnits)
+	return u.CommittedUnits*u.CommittedRate + overageUnits*u.OverageRate, nil
+}
+
+// commitmentBillingModes returns the recurring billing modes a commitment pricing model can be
+// billed in, or nil if mode is not a commitment. Commitments are fixed rates over whole hours or
+// longer, so sub-hourly, usage-based, and other pricing-model modes contradict them.
+func commitmentBillingModes(mode BillingMode) []BillingMode {
+	switch mode {
+	case Reserved, SavingsPlan, CommittedUse:
+		return []BillingMode{
+			PerHour, PerDay, PerMonth, PerYear,
+			PerCPUHour, PerCPUMonth, PerVCPUHour, PerMemoryGBHour, PerMemoryGBMonth,
+		}
+	default:
+		return nil
+	}
+}
+
+// ValidateCommitmentBilling checks that a commitment type and a recurring billing mode agree.
+//
+// Commitment pricing models (reserved, savings_plan, committed_use) must be paired with an
+// hourly-or-coarser time or compute billing mode (per_hour, per_day, per_month, per_year,
+// per_cpu_hour, per_vcpu_hour, ...). Any other commitmentType, such as on_demand or spot, or an
+// empty commitmentType, means there is no commitment and any valid billing mode is accepted.
+//
+// Returns an error wrapping ErrInvalidBillingMode if either mode is not a known billing mode, or
+// ErrIncompatibleCommitmentBilling if the pairing is contradictory.
+//
+// Example:
+//
+//	ValidateCommitmentBilling(Reserved, PerHour)    // nil
+//	ValidateCommitmentBilling(Reserved, PerRequest) // ErrIncompatibleCommitmentBilling
+//	ValidateCommitmentBilling(OnDemand, PerSecond)  // nil
+func ValidateCommitmentBilling(commitmentType BillingMode, billingMode BillingMode) error {
+	if commitmentType != "" && !ValidBillingMode(string(commitmentType)) {
+		return fmt.Errorf("commitment type: %w: %q", ErrInvalidBillingMode, commitmentType)
+	}
+	if !ValidBillingMode(string(billingMode)) {
+		return fmt.Errorf("%w: %q", ErrInvalidBillingMode, billingMode)
+	}
+
+	allowed := commitmentBillingModes(commitmentType)
+	if allowed == nil || slices.Contains(allowed, billingMode) {
+		return nil
+	}
+	return fmt.Errorf("%w: %s cannot be billed %s", ErrIncompatibleCommitmentBilling, commitmentType, billingMode)
+}
@@ -0,0 +1,153 @@
+package pricing_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/rshade/finfocus-spec/sdk/go/pricing"
+)
+
+func TestCommitmentLiability(t *testing.T) {
+	oneYearUpfront := pricing.Commitment{TermMonths: 12, UpfrontCost: 1200}
+	threeYearPartial := pricing.Commitment{TermMonths: 36, UpfrontCost: 1800, MonthlyCost: 50}
+
+	tests := []struct {
+		name       string
+		commitment pricing.Commitment
+		elapsed    int
+		expected   float64
+	}{
+		{"Cancel before any usage", oneYearUpfront, 0, 1200},
+		{"Cancel halfway", oneYearUpfront, 6, 600},
+		{"Cancel after 3 months", oneYearUpfront, 3, 900},
+		{"Cancel at end of term", oneYearUpfront, 12, 0},
+		{"Partial upfront halfway", threeYearPartial, 18, (1800 + 50*36) / 2.0},
+		{"Monthly only before usage", pricing.Commitment{TermMonths: 12, MonthlyCost: 100}, 0, 1200},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := pricing.CommitmentLiability(tt.commitment, tt.elapsed)
+			if err != nil {
+				t.Fatalf("CommitmentLiability() unexpected error: %v", err)
+			}
+			if !almostEqual(got, tt.expected) {
+				t.Errorf("CommitmentLiability() = %v, want %v", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestCommitmentLiabilityErrors(t *testing.T) {
+	tests := []struct {
+		name       string
+		commitment pricing.Commitment
+		elapsed    int
+		wantErr    error
+	}{
+		{"Elapsed beyond term", pricing.Commitment{TermMonths: 12, UpfrontCost: 1200}, 13, pricing.ErrElapsedOutOfRange},
+		{"Negative elapsed", pricing.Commitment{TermMonths: 12, UpfrontCost: 1200}, -1, pricing.ErrElapsedOutOfRange},
+		{"Zero term", pricing.Commitment{TermMonths: 0, UpfrontCost: 1200}, 0, pricing.ErrInvalidCommitmentTerm},
+		{"Negative cost", pricing.Commitment{TermMonths: 12, MonthlyCost: -1}, 0, pricing.ErrNegativeCommitmentCost},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := pricing.CommitmentLiability(tt.commitment, tt.elapsed)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("CommitmentLiability() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestValidateCommitmentBilling(t *testing.T) {
+	tests := []struct {
+		name           string
+		commitmentType pricing.BillingMode
+		billingMode    pricing.BillingMode
+		wantErr        error
+	}{
+		{"Reserved per hour", pricing.Reserved, pricing.PerHour, nil},
+		{"Savings plan per hour", pricing.SavingsPlan, pricing.PerHour, nil},
+		{"Committed use per vCPU hour", pricing.CommittedUse, pricing.PerVCPUHour, nil},
+		{"Reserved per year", pricing.Reserved, pricing.PerYear, nil},
+		{"Reserved per request", pricing.Reserved, pricing.PerRequest, pricing.ErrIncompatibleCommitmentBilling},
+		{"Reserved per second", pricing.Reserved, pricing.PerSecond, pricing.ErrIncompatibleCommitmentBilling},
+		{"Savings plan with spot", pricing.SavingsPlan, pricing.Spot, pricing.ErrIncompatibleCommitmentBilling},
+		{"On-demand per second", pricing.OnDemand, pricing.PerSecond, nil},
+		{"No commitment", "", pricing.PerRequest, nil},
+		{"Unknown commitment type", "lifetime", pricing.PerHour, pricing.ErrInvalidBillingMode},
+		{"Unknown billing mode", pricing.Reserved, "per_fortnight", pricing.ErrInvalidBillingMode},
+		{"Unknown billing mode without commitment", pricing.OnDemand, "", pricing.ErrInvalidBillingMode},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := pricing.ValidateCommitmentBilling(tt.commitmentType, tt.billingMode)
+			if tt.wantErr == nil {
+				if err != nil {
+					t.Errorf("ValidateCommitmentBilling() unexpected error: %v", err)
+				}
+				return
+			}
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("ValidateCommitmentBilling() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestCommittedUsageCost(t *testing.T) {
+	usage := pricing.CommittedUsage{CommittedUnits: 100, CommittedRate: 0.50, OverageRate: 0.80}
+
+	tests := []struct {
+		name     string
+		usage    pricing.CommittedUsage
+		actual   float64
+		expected float64
+	}{
+		{"Below commitment bills baseline", usage, 80, 50},
+		{"No usage bills baseline", usage, 0, 50},
+		{"Exactly at commitment", usage, 100, 50},
+		{"Above commitment adds overage", usage, 130, 50 + 30*0.80},
+		{"No commitment is all overage", pricing.CommittedUsage{OverageRate: 0.80}, 10, 8},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.usage.Cost(tt.actual)
+			if err != nil {
+				t.Fatalf("Cost() unexpected error: %v", err)
+			}
+			if !almostEqual(got, tt.expected) {
+				t.Errorf("Cost() = %v, want %v", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestCommittedUsageCostErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		usage   pricing.CommittedUsage
+		actual  float64
+		wantErr error
+	}{
+		{"Negative usage", pricing.CommittedUsage{CommittedUnits: 100, CommittedRate: 0.5}, -1,
+			pricing.ErrNegativeQuantity},
+		{"Negative committed units", pricing.CommittedUsage{CommittedUnits: -1}, 10,
+			pricing.ErrNegativeCommittedUsage},
+		{"Negative overage rate", pricing.CommittedUsage{CommittedUnits: 100, OverageRate: -0.8}, 10,
+			pricing.ErrNegativeCommittedUsage},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := tt.usage.Cost(tt.actual)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("Cost() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
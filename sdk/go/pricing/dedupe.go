@@ -0,0 +1,63 @@
+package pricing
+
+import (
+	pbc "github.com/rshade/finfocus-spec/sdk/go/proto/finfocus/v1"
+)
+
+// DeduplicateCosts collapses actual cost results that describe the same cost, so that
+// combining overlapping sources (e.g., AWS CUR and Cost Explorer) does not double-count.
+//
+// Results are grouped by key(result). Within each group a single survivor is kept:
+// the result whose source appears earliest in sourcePriority. Sources not listed rank
+// below every listed source, and ties keep the first result encountered. The output
+// preserves the order in which each key was first seen. Nil results are dropped.
+//
+// Parameters:
+//   - results: Cost results from one or more sources
+//   - key: Identifies results describing the same cost (e.g., resource ID + period start)
+//   - sourcePriority: Sources in order of authority, most authoritative first
+//
+// Example:
+//
+//	key := func(r *pbc.ActualCostResult) string {
+//	    return r.GetFocusRecord().GetResourceId() + "|" + r.GetTimestamp().AsTime().String()
+//	}
+//	merged := pricing.DeduplicateCosts(append(curResults, ceResults...), key, "aws-cur", "aws-cost-explorer")
+func DeduplicateCosts(
+	results []*pbc.ActualCostResult,
+	key func(*pbc.ActualCostResult) string,
+	sourcePriority ...string,
+) []*pbc.ActualCostResult {
+	rank := make(map[string]int, len(sourcePriority))
+	for i, source := range sourcePriority {
+		if _, seen := rank[source]; !seen {
+			rank[source] = i
+		}
+	}
+	sourceRank := func(r *pbc.ActualCostResult) int {
+		if i, ok := rank[r.GetSource()]; ok {
+			return i
+		}
+		return len(sourcePriority)
+	}
+
+	deduped := make([]*pbc.ActualCostResult, 0, len(results))
+	index := make(map[string]int, len(results))
+	for _, r := range results {
+		if r == nil {
+			continue
+		}
+		k := key(r)
+		i, seen := index[k]
+		if !seen {
+			index[k] = len(deduped)
+			deduped = append(deduped, r)
+			continue
+		}
+		if sourceRank(r) < sourceRank(deduped[i]) {
+			deduped[i] = r
+		}
+	}
+
+	return deduped
+}
@@ -0,0 +1,90 @@
+package pricing_test
+
+import (
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/rshade/finfocus-spec/sdk/go/pricing"
+	pbc "github.com/rshade/finfocus-spec/sdk/go/proto/finfocus/v1"
+)
+
+func costResult(resourceID string, day int, source string, cost float64) *pbc.ActualCostResult {
+	return &pbc.ActualCostResult{
+		Timestamp:   timestamppb.New(time.Date(2024, time.June, day, 0, 0, 0, 0, time.UTC)),
+		Cost:        cost,
+		Source:      source,
+		FocusRecord: &pbc.FocusCostRecord{ResourceId: resourceID},
+	}
+}
+
+func resourcePeriodKey(r *pbc.ActualCostResult) string {
+	return r.GetFocusRecord().GetResourceId() + "|" + r.GetTimestamp().AsTime().Format(time.RFC3339)
+}
+
+func TestDeduplicateCosts(t *testing.T) {
+	t.Run("same resource and period collapse to one", func(t *testing.T) {
+		results := []*pbc.ActualCostResult{
+			costResult("i-1", 1, "aws-cur", 10),
+			costResult("i-1", 1, "aws-cost-explorer", 10.5),
+		}
+		got := pricing.DeduplicateCosts(results, resourcePeriodKey, "aws-cur", "aws-cost-explorer")
+		if len(got) != 1 {
+			t.Fatalf("len = %d, want 1", len(got))
+		}
+	})
+
+	t.Run("priority determines the survivor", func(t *testing.T) {
+		results := []*pbc.ActualCostResult{
+			costResult("i-1", 1, "aws-cost-explorer", 10.5),
+			costResult("i-1", 1, "unknown-source", 9),
+			costResult("i-1", 1, "aws-cur", 10),
+		}
+		got := pricing.DeduplicateCosts(results, resourcePeriodKey, "aws-cur", "aws-cost-explorer")
+		if len(got) != 1 || got[0].GetSource() != "aws-cur" {
+			t.Fatalf("got %v, want single aws-cur result", got)
+		}
+
+		got = pricing.DeduplicateCosts(results, resourcePeriodKey, "aws-cost-explorer", "aws-cur")
+		if len(got) != 1 || got[0].GetSource() != "aws-cost-explorer" {
+			t.Fatalf("got %v, want single aws-cost-explorer result", got)
+		}
+	})
+
+	t.Run("unlisted sources keep first occurrence", func(t *testing.T) {
+		results := []*pbc.ActualCostResult{
+			costResult("i-1", 1, "kubecost", 1),
+			costResult("i-1", 1, "flexera", 2),
+		}
+		got := pricing.DeduplicateCosts(results, resourcePeriodKey)
+		if len(got) != 1 || got[0].GetSource() != "kubecost" {
+			t.Fatalf("got %v, want first (kubecost) result", got)
+		}
+	})
+
+	t.Run("non-overlapping results are preserved in order", func(t *testing.T) {
+		results := []*pbc.ActualCostResult{
+			costResult("i-1", 1, "aws-cur", 10),
+			costResult("i-2", 1, "aws-cur", 20),
+			costResult("i-1", 2, "aws-cur", 30),
+			nil,
+		}
+		got := pricing.DeduplicateCosts(results, resourcePeriodKey, "aws-cur")
+		if len(got) != 3 {
+			t.Fatalf("len = %d, want 3", len(got))
+		}
+		for i, want := range []float64{10, 20, 30} {
+			if got[i].GetCost() != want {
+				t.Errorf("got[%d].Cost = %v, want %v", i, got[i].GetCost(), want)
+			}
+		}
+	})
+
+	t.Run("empty input returns empty", func(t *testing.T) {
+		got := pricing.DeduplicateCosts(nil, resourcePeriodKey, "aws-cur")
+		if got == nil || len(got) != 0 {
+			t.Errorf("got %v, want empty non-nil slice", got)
+		}
+	})
+}
@@ -0,0 +1,49 @@
+package pricing
+
+import (
+	"errors"
+	"fmt"
+	"math"
+)
+
+var (
+	// ErrNonFiniteCost is returned when a period cost is NaN or infinite.
+	ErrNonFiniteCost = errors.New("period cost must be a finite value")
+
+	// ErrUndefinedPercentChange is returned when the previous period cost is zero, so the
+	// percentage change is undefined. The absolute change is still valid.
+	ErrUndefinedPercentChange = errors.New("percent change is undefined for a zero previous cost")
+)
+
+// PeriodDelta calculates the change in cost between two periods (e.g., month over month).
+// Formula: absolute = current - previous, percent = absolute / |previous| * 100
+//
+// Dividing by the magnitude of previous keeps the sign of percent aligned with the direction
+// of the change when the previous period is a net credit.
+//
+// Parameters:
+//   - previous: Cost of the earlier period
+//   - current: Cost of the later period, in the same currency
+//
+// Returns the absolute change and the percentage change. When previous is zero the percentage
+// is undefined: the absolute change is returned with a percent of 0 and
+// ErrUndefinedPercentChange, so callers can report "new spend" rather than a fake percentage.
+// Errors wrap ErrNonFiniteCost when either cost is NaN or infinite.
+//
+// Example:
+//
+//	Previous: $1,000, Current: $1,250
+//	Absolute: $250, Percent: 25%
+func PeriodDelta(previous, current float64) (float64, float64, error) {
+	for _, cost := range []float64{previous, current} {
+		if math.IsNaN(cost) || math.IsInf(cost, 0) {
+			return 0, 0, fmt.Errorf("%w: %v", ErrNonFiniteCost, cost)
+		}
+	}
+
+	absolute := current - previous
+	if previous == 0 {
+		return absolute, 0, ErrUndefinedPercentChange
+	}
+	return absolute, absolute / math.Abs(previous) * percentMultiplier, nil
+}
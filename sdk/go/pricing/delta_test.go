@@ -0,0 +1,72 @@
+package pricing_test
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/rshade/finfocus-spec/sdk/go/pricing"
+)
+
+func TestPeriodDelta(t *testing.T) {
+	tests := []struct {
+		name         string
+		previous     float64
+		current      float64
+		wantAbsolute float64
+		wantPercent  float64
+	}{
+		{"Increase", 1000, 1250, 250, 25},
+		{"Decrease", 1000, 800, -200, -20},
+		{"No change", 500, 500, 0, 0},
+		{"Credit to charge", -100, 50, 150, 150},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			absolute, percent, err := pricing.PeriodDelta(tt.previous, tt.current)
+			if err != nil {
+				t.Fatalf("PeriodDelta() unexpected error: %v", err)
+			}
+			if !almostEqual(absolute, tt.wantAbsolute) {
+				t.Errorf("PeriodDelta() absolute = %v, want %v", absolute, tt.wantAbsolute)
+			}
+			if !almostEqual(percent, tt.wantPercent) {
+				t.Errorf("PeriodDelta() percent = %v, want %v", percent, tt.wantPercent)
+			}
+		})
+	}
+}
+
+func TestPeriodDeltaZeroPrevious(t *testing.T) {
+	absolute, percent, err := pricing.PeriodDelta(0, 300)
+	if !errors.Is(err, pricing.ErrUndefinedPercentChange) {
+		t.Fatalf("PeriodDelta() error = %v, want %v", err, pricing.ErrUndefinedPercentChange)
+	}
+	if absolute != 300 {
+		t.Errorf("PeriodDelta() absolute = %v, want 300", absolute)
+	}
+	if percent != 0 {
+		t.Errorf("PeriodDelta() percent = %v, want 0", percent)
+	}
+}
+
+func TestPeriodDeltaNonFinite(t *testing.T) {
+	tests := []struct {
+		name     string
+		previous float64
+		current  float64
+	}{
+		{"NaN previous", math.NaN(), 100},
+		{"Infinite current", 100, math.Inf(1)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, _, err := pricing.PeriodDelta(tt.previous, tt.current)
+			if !errors.Is(err, pricing.ErrNonFiniteCost) {
+				t.Errorf("PeriodDelta() error = %v, want %v", err, pricing.ErrNonFiniteCost)
+			}
+		})
+	}
+}
@@ -45,6 +45,13 @@ const (
 	PerMemoryGBMonth BillingMode = "per_memory_gb_month"
 )
 
+// Serverless and container billing modes (Lambda, Cloud Run, Fargate).
+const (
+	PerGBSecond        BillingMode = "per_gb_second"         // Memory allocated per second
+	PerVCPUSecond      BillingMode = "per_vcpu_second"       // vCPU allocated per second
+	PerRequestGBSecond BillingMode = "per_request_gb_second" // GB-seconds consumed per request duration
+)
+
 // I/O-based billing modes.
 const (
 	PerIOPS            BillingMode = "per_iops"
@@ -90,6 +97,25 @@ const (
 	UnitRU      Unit = "RU"
 )
 
+// Time, storage, and I/O unit constants.
+const (
+	UnitSecond Unit = "second"
+	UnitMinute Unit = "minute"
+	UnitDay    Unit = "day"
+	UnitMonth  Unit = "month"
+	UnitYear   Unit = "year"
+	UnitGB     Unit = "GB"
+	UnitGBHour Unit = "GB-hour"
+	UnitGBDay  Unit = "GB-day"
+	UnitIOPS   Unit = "IOPS"
+)
+
+// Serverless unit constants.
+const (
+	UnitGBSecond   Unit = "GB-second"
+	UnitVCPUSecond Unit = "vCPU-second"
+)
+
 // String returns the unit as its string value.
 func (u Unit) String() string { return string(u) }
 
@@ -105,6 +131,8 @@ func getAllBillingModes() []BillingMode {
 		PerAPICall, PerLookup, PerQuery,
 		// Compute-based
 		PerCPUHour, PerCPUMonth, PerVCPUHour, PerMemoryGBHour, PerMemoryGBMonth,
+		// Serverless
+		PerGBSecond, PerVCPUSecond, PerRequestGBSecond,
 		// I/O-based
 		PerIOPS, PerProvisionedIOPS, PerDataTransferGB, PerBandwidthGB,
 		// Database-specific
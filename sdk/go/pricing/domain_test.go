@@ -25,6 +25,11 @@ func TestValidBillingMode(t *testing.T) {
 		{"per_gb_hour valid", "per_gb_hour", true},
 		{"per_gb_day valid", "per_gb_day", true},
 
+		// Serverless
+		{"per_gb_second valid", "per_gb_second", true},
+		{"per_vcpu_second valid", "per_vcpu_second", true},
+		{"per_request_gb_second valid", "per_request_gb_second", true},
+
 		// Usage-based
 		{"per_request valid", "per_request", true},
 		{"per_operation valid", "per_operation", true},
@@ -180,7 +185,7 @@ func TestAllBillingModesCompleteness(t *testing.T) {
 	for i, mode := range allModesStr {
 		allModes[i] = pricing.BillingMode(mode)
 	}
-	expectedCount := 40 // Based on the constants defined (added Tiered, NotImplemented)
+	expectedCount := 43 // Based on the constants defined (added serverless GB/vCPU-second modes)
 	if len(allModes) != expectedCount {
 		t.Errorf("GetAllBillingModes length = %d, want %d", len(allModes), expectedCount)
 	}
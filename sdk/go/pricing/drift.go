@@ -0,0 +1,243 @@
+package pricing
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+
+	pbc "github.com/rshade/finfocus-spec/sdk/go/proto/finfocus/v1"
+)
+
+var (
+	// ErrNilPricingSpec is returned when a pricing spec comparison receives a nil spec.
+	ErrNilPricingSpec = errors.New("pricing spec cannot be nil")
+
+	// ErrDuplicatePricingTier is returned when a pricing spec comparison receives a spec with two
+	// tiers that share a min_quantity, since tiers are matched by min_quantity.
+	ErrDuplicatePricingTier = errors.New("pricing spec has duplicate tier min_quantity")
+)
+
+// percentMultiplier converts a fractional change into a percentage.
+const percentMultiplier = 100.0
+
+// PricingDiffKind describes how a field differs between two pricing specs.
+type PricingDiffKind string
+
+const (
+	// PricingDiffChanged means the field is present in both specs with different values.
+	PricingDiffChanged PricingDiffKind = "changed"
+	// PricingDiffAdded means the field (a pricing tier) is present only in the new spec.
+	PricingDiffAdded PricingDiffKind = "added"
+	// PricingDiffRemoved means the field (a pricing tier) is present only in the old spec.
+	PricingDiffRemoved PricingDiffKind = "removed"
+)
+
+// PricingDiff is a single difference between two versions of a pricing spec.
+type PricingDiff struct {
+	// Field is the proto field name, such as "rate_per_unit". Tier fields are keyed by the
+	// tier's min_quantity, such as "pricing_tiers[min_quantity=100].rate_per_unit".
+	Field string
+
+	// Kind is how the field differs.
+	Kind PricingDiffKind
+
+	// Old is the formatted old value; empty for added tiers.
+	Old string
+
+	// New is the formatted new value; empty for removed tiers.
+	New string
+
+	// PercentChange is the rate change percentage for rate_per_unit fields (see
+	// DiffPricingSpecs for how zero rates are handled), and 0 for other fields.
+	PercentChange float64
+}
+
+// String formats the difference as "field: old -> new", "field: added new", or
+// "field: removed old".
+func (d PricingDiff) String() string {
+	switch d.Kind {
+	case PricingDiffAdded:
+		return fmt.Sprintf("%s: added %s", d.Field, d.New)
+	case PricingDiffRemoved:
+		return fmt.Sprintf("%s: removed %s", d.Field, d.Old)
+	default:
+		return fmt.Sprintf("%s: %s -> %s", d.Field, d.Old, d.New)
+	}
+}
+
+// ComparePricingSpecs returns the structured differences between two versions of a pricing
+// spec, for tooling that reports price changes since a previous run.
+//
+// The fields compared are rate_per_unit, billing_mode, unit, currency, assumptions
+// (order-sensitive), and pricing_tiers. Tiers are matched by min_quantity: a tier in both specs
+// reports changes to its max_quantity and rate_per_unit, and a tier in only one spec is reported
+// as added or removed. Tier descriptions are not compared. Differences are returned with the
+// scalar fields first, then old tiers in order, then added tiers in order.
+//
+// Returns ErrNilPricingSpec if either spec is nil, or an error wrapping ErrDuplicatePricingTier
+// if either spec has two tiers with the same min_quantity.
+//
+// Example:
+//
+//	diffs, err := pricing.ComparePricingSpecs(lastRun, current)
+//	for _, d := range diffs {
+//	    if d.Field == "rate_per_unit" && d.PercentChange > 10 {
+//	        alert(d) // "rate_per_unit: 0.08 -> 0.09"
+//	    }
+//	}
+func ComparePricingSpecs(oldSpec, newSpec *pbc.PricingSpec) ([]PricingDiff, error) {
+	if oldSpec == nil || newSpec == nil {
+		return nil, ErrNilPricingSpec
+	}
+
+	var diffs []PricingDiff
+	if d, ok := diffRate("rate_per_unit", oldSpec.GetRatePerUnit(), newSpec.GetRatePerUnit()); ok {
+		diffs = append(diffs, d)
+	}
+	if oldSpec.GetBillingMode() != newSpec.GetBillingMode() {
+		diffs = append(diffs, changedField("billing_mode", oldSpec.GetBillingMode(), newSpec.GetBillingMode()))
+	}
+	if oldSpec.GetUnit() != newSpec.GetUnit() {
+		diffs = append(diffs, changedField("unit", oldSpec.GetUnit(), newSpec.GetUnit()))
+	}
+	if oldSpec.GetCurrency() != newSpec.GetCurrency() {
+		diffs = append(diffs, changedField("currency", oldSpec.GetCurrency(), newSpec.GetCurrency()))
+	}
+	if !slices.Equal(oldSpec.GetAssumptions(), newSpec.GetAssumptions()) {
+		diffs = append(diffs, changedField("assumptions",
+			fmt.Sprintf("%q", oldSpec.GetAssumptions()), fmt.Sprintf("%q", newSpec.GetAssumptions())))
+	}
+
+	tierDiffs, err := diffTiers(oldSpec.GetPricingTiers(), newSpec.GetPricingTiers())
+	if err != nil {
+		return nil, err
+	}
+	return append(diffs, tierDiffs...), nil
+}
+
+// DiffPricingSpecs compares two versions of a pricing spec to detect upstream price drift.
+//
+// It returns the percentage change in rate_per_unit and a human-readable list of changed fields,
+// formatted with PricingDiff.String from the differences found by ComparePricingSpecs.
+//
+// Rate change percentage:
+//   - (new - old) / old * 100 when the old rate is non-zero
+//   - 0 when both rates are zero
+//   - +Inf when the old rate is zero and the new rate is positive (-Inf if negative)
+//
+// Returns the errors of ComparePricingSpecs.
+//
+// Example:
+//
+//	pct, changes, err := pricing.DiffPricingSpecs(lastRun, current)
+//	// pct = 12.5, changes = ["rate_per_unit: 0.08 -> 0.09"]
+func DiffPricingSpecs(oldSpec, newSpec *pbc.PricingSpec) (float64, []string, error) {
+	diffs, err := ComparePricingSpecs(oldSpec, newSpec)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	var changes []string
+	for _, d := range diffs {
+		changes = append(changes, d.String())
+	}
+	return rateChangePercent(oldSpec.GetRatePerUnit(), newSpec.GetRatePerUnit()), changes, nil
+}
+
+// changedField returns a PricingDiffChanged difference for a non-rate field.
+func changedField(field, oldValue, newValue string) PricingDiff {
+	return PricingDiff{Field: field, Kind: PricingDiffChanged, Old: oldValue, New: newValue}
+}
+
+// diffRate returns the difference between two rates, or false if they are equal.
+func diffRate(field string, oldRate, newRate float64) (PricingDiff, bool) {
+	if oldRate == newRate {
+		return PricingDiff{}, false
+	}
+	d := changedField(field, fmt.Sprintf("%g", oldRate), fmt.Sprintf("%g", newRate))
+	d.PercentChange = rateChangePercent(oldRate, newRate)
+	return d, true
+}
+
+// diffTiers matches tiers by min_quantity and returns their differences.
+func diffTiers(oldTiers, newTiers []*pbc.PricingTier) ([]PricingDiff, error) {
+	oldByMin, err := tiersByMin("old", oldTiers)
+	if err != nil {
+		return nil, err
+	}
+	newByMin, err := tiersByMin("new", newTiers)
+	if err != nil {
+		return nil, err
+	}
+
+	var diffs []PricingDiff
+	for _, oldTier := range oldTiers {
+		key := tierKey(oldTier)
+		newTier, ok := newByMin[oldTier.GetMinQuantity()]
+		if !ok {
+			diffs = append(diffs, PricingDiff{Field: key, Kind: PricingDiffRemoved, Old: formatTier(oldTier)})
+			continue
+		}
+		if oldTier.GetMaxQuantity() != newTier.GetMaxQuantity() {
+			diffs = append(diffs, changedField(key+".max_quantity",
+				formatTierMax(oldTier.GetMaxQuantity()), formatTierMax(newTier.GetMaxQuantity())))
+		}
+		if d, changed := diffRate(key+".rate_per_unit", oldTier.GetRatePerUnit(), newTier.GetRatePerUnit()); changed {
+			diffs = append(diffs, d)
+		}
+	}
+	for _, newTier := range newTiers {
+		if _, ok := oldByMin[newTier.GetMinQuantity()]; !ok {
+			diffs = append(diffs, PricingDiff{Field: tierKey(newTier), Kind: PricingDiffAdded, New: formatTier(newTier)})
+		}
+	}
+	return diffs, nil
+}
+
+// tiersByMin indexes tiers by min_quantity. which names the spec ("old" or "new") in the error
+// returned when two tiers share a min_quantity.
+func tiersByMin(which string, tiers []*pbc.PricingTier) (map[float64]*pbc.PricingTier, error) {
+	byMin := make(map[float64]*pbc.PricingTier, len(tiers))
+	for _, tier := range tiers {
+		if _, dup := byMin[tier.GetMinQuantity()]; dup {
+			return nil, fmt.Errorf("%w: min_quantity=%g in %s spec", ErrDuplicatePricingTier, tier.GetMinQuantity(), which)
+		}
+		byMin[tier.GetMinQuantity()] = tier
+	}
+	return byMin, nil
+}
+
+// tierKey returns the field name identifying a tier, such as "pricing_tiers[min_quantity=100]".
+func tierKey(tier *pbc.PricingTier) string {
+	return fmt.Sprintf("pricing_tiers[min_quantity=%g]", tier.GetMinQuantity())
+}
+
+// formatTier formats a tier as its quantity range and rate, such as "[0, 100) at 0.023".
+func formatTier(tier *pbc.PricingTier) string {
+	return fmt.Sprintf("[%g, %s) at %g",
+		tier.GetMinQuantity(), formatTierMax(tier.GetMaxQuantity()), tier.GetRatePerUnit())
+}
+
+// formatTierMax formats a tier's max_quantity, where 0 means unlimited.
+func formatTierMax(maxQuantity float64) string {
+	if maxQuantity == 0 {
+		return "unlimited"
+	}
+	return fmt.Sprintf("%g", maxQuantity)
+}
+
+// rateChangePercent returns the percentage change from oldRate to newRate.
+func rateChangePercent(oldRate, newRate float64) float64 {
+	switch {
+	case oldRate == newRate:
+		return 0
+	case oldRate == 0:
+		if newRate > 0 {
+			return math.Inf(1)
+		}
+		return math.Inf(-1)
+	default:
+		return (newRate - oldRate) / math.Abs(oldRate) * percentMultiplier
+	}
+}
@@ -0,0 +1,214 @@
+package pricing_test
+
+import (
+	"errors"
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/rshade/finfocus-spec/sdk/go/pricing"
+	pbc "github.com/rshade/finfocus-spec/sdk/go/proto/finfocus/v1"
+)
+
+func baseDriftSpec() *pbc.PricingSpec {
+	return &pbc.PricingSpec{
+		Provider:     "aws",
+		ResourceType: "ec2",
+		Sku:          "t3.micro",
+		Region:       "us-east-1",
+		BillingMode:  string(pricing.PerHour),
+		RatePerUnit:  0.08,
+		Currency:     "USD",
+		Unit:         string(pricing.UnitHour),
+		Assumptions:  []string{"Linux", "shared tenancy"},
+	}
+}
+
+func TestDiffPricingSpecs(t *testing.T) {
+	t.Run("identical specs produce no changes", func(t *testing.T) {
+		pct, changes, err := pricing.DiffPricingSpecs(baseDriftSpec(), baseDriftSpec())
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if pct != 0 {
+			t.Errorf("rate change = %v, want 0", pct)
+		}
+		if len(changes) != 0 {
+			t.Errorf("changes = %v, want none", changes)
+		}
+	})
+
+	t.Run("rate increase produces positive percentage", func(t *testing.T) {
+		updated := baseDriftSpec()
+		updated.RatePerUnit = 0.09
+
+		pct, changes, err := pricing.DiffPricingSpecs(baseDriftSpec(), updated)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if !almostEqual(pct, 12.5) {
+			t.Errorf("rate change = %v, want 12.5", pct)
+		}
+		if len(changes) != 1 || !strings.HasPrefix(changes[0], "rate_per_unit:") {
+			t.Errorf("changes = %v, want a single rate_per_unit change", changes)
+		}
+	})
+
+	t.Run("rate decrease produces negative percentage", func(t *testing.T) {
+		updated := baseDriftSpec()
+		updated.RatePerUnit = 0.04
+
+		pct, _, err := pricing.DiffPricingSpecs(baseDriftSpec(), updated)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if !almostEqual(pct, -50) {
+			t.Errorf("rate change = %v, want -50", pct)
+		}
+	})
+
+	t.Run("billing mode and other field changes listed", func(t *testing.T) {
+		updated := baseDriftSpec()
+		updated.BillingMode = string(pricing.PerSecond)
+		updated.Unit = "second"
+		updated.Currency = "EUR"
+		updated.Assumptions = []string{"Linux"}
+
+		pct, changes, err := pricing.DiffPricingSpecs(baseDriftSpec(), updated)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if pct != 0 {
+			t.Errorf("rate change = %v, want 0", pct)
+		}
+		want := []string{"billing_mode: per_hour -> per_second", "unit:", "currency: USD -> EUR", "assumptions:"}
+		if len(changes) != len(want) {
+			t.Fatalf("changes = %v, want %d entries", changes, len(want))
+		}
+		for i, prefix := range want {
+			if !strings.HasPrefix(changes[i], prefix) {
+				t.Errorf("changes[%d] = %q, want prefix %q", i, changes[i], prefix)
+			}
+		}
+	})
+
+	t.Run("zero old rate yields infinite percentage", func(t *testing.T) {
+		oldSpec := baseDriftSpec()
+		oldSpec.RatePerUnit = 0
+
+		pct, _, err := pricing.DiffPricingSpecs(oldSpec, baseDriftSpec())
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if !math.IsInf(pct, 1) {
+			t.Errorf("rate change = %v, want +Inf", pct)
+		}
+	})
+
+	t.Run("nil input returns error", func(t *testing.T) {
+		if _, _, err := pricing.DiffPricingSpecs(nil, baseDriftSpec()); !errors.Is(err, pricing.ErrNilPricingSpec) {
+			t.Errorf("nil old: err = %v, want ErrNilPricingSpec", err)
+		}
+		if _, _, err := pricing.DiffPricingSpecs(baseDriftSpec(), nil); !errors.Is(err, pricing.ErrNilPricingSpec) {
+			t.Errorf("nil new: err = %v, want ErrNilPricingSpec", err)
+		}
+	})
+}
+
+func TestComparePricingSpecs(t *testing.T) {
+	t.Run("rate change carries old, new, and percentage", func(t *testing.T) {
+		updated := baseDriftSpec()
+		updated.RatePerUnit = 0.1
+
+		diffs, err := pricing.ComparePricingSpecs(baseDriftSpec(), updated)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(diffs) != 1 {
+			t.Fatalf("diffs = %v, want 1 entry", diffs)
+		}
+		d := diffs[0]
+		if d.Field != "rate_per_unit" || d.Kind != pricing.PricingDiffChanged || d.Old != "0.08" || d.New != "0.1" {
+			t.Errorf("diff = %+v, want rate_per_unit changed 0.08 -> 0.1", d)
+		}
+		if !almostEqual(d.PercentChange, 25) {
+			t.Errorf("PercentChange = %v, want 25", d.PercentChange)
+		}
+	})
+
+	t.Run("non-rate fields have no percentage", func(t *testing.T) {
+		updated := baseDriftSpec()
+		updated.BillingMode = string(pricing.PerSecond)
+
+		diffs, err := pricing.ComparePricingSpecs(baseDriftSpec(), updated)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(diffs) != 1 || diffs[0].Field != "billing_mode" || diffs[0].PercentChange != 0 {
+			t.Errorf("diffs = %+v, want a single billing_mode change without percentage", diffs)
+		}
+	})
+
+	t.Run("tiers are matched by min_quantity", func(t *testing.T) {
+		oldSpec := baseDriftSpec()
+		oldSpec.PricingTiers = []*pbc.PricingTier{
+			{MinQuantity: 0, MaxQuantity: 100, RatePerUnit: 0.1},
+			{MinQuantity: 100, MaxQuantity: 1000, RatePerUnit: 0.08},
+			{MinQuantity: 1000, RatePerUnit: 0.05},
+		}
+		newSpec := baseDriftSpec()
+		newSpec.PricingTiers = []*pbc.PricingTier{
+			{MinQuantity: 0, MaxQuantity: 100, RatePerUnit: 0.1},
+			{MinQuantity: 100, MaxQuantity: 500, RatePerUnit: 0.06},
+			{MinQuantity: 500, RatePerUnit: 0.04},
+		}
+
+		diffs, err := pricing.ComparePricingSpecs(oldSpec, newSpec)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		want := []string{
+			"pricing_tiers[min_quantity=100].max_quantity: 1000 -> 500",
+			"pricing_tiers[min_quantity=100].rate_per_unit: 0.08 -> 0.06",
+			"pricing_tiers[min_quantity=1000]: removed [1000, unlimited) at 0.05",
+			"pricing_tiers[min_quantity=500]: added [500, unlimited) at 0.04",
+		}
+		if len(diffs) != len(want) {
+			t.Fatalf("diffs = %v, want %d entries", diffs, len(want))
+		}
+		for i, w := range want {
+			if got := diffs[i].String(); got != w {
+				t.Errorf("diffs[%d] = %q, want %q", i, got, w)
+			}
+		}
+		if diffs[2].Kind != pricing.PricingDiffRemoved || diffs[3].Kind != pricing.PricingDiffAdded {
+			t.Errorf("kinds = %s, %s, want removed, added", diffs[2].Kind, diffs[3].Kind)
+		}
+		if !almostEqual(diffs[1].PercentChange, -25) {
+			t.Errorf("tier PercentChange = %v, want -25", diffs[1].PercentChange)
+		}
+	})
+
+	t.Run("nil input returns error", func(t *testing.T) {
+		if _, err := pricing.ComparePricingSpecs(nil, baseDriftSpec()); !errors.Is(err, pricing.ErrNilPricingSpec) {
+			t.Errorf("err = %v, want ErrNilPricingSpec", err)
+		}
+	})
+
+	t.Run("duplicate min_quantity returns error", func(t *testing.T) {
+		tiered := baseDriftSpec()
+		tiered.PricingTiers = []*pbc.PricingTier{{MinQuantity: 0, RatePerUnit: 0.1}}
+		duplicated := baseDriftSpec()
+		duplicated.PricingTiers = []*pbc.PricingTier{
+			{MinQuantity: 0, MaxQuantity: 100, RatePerUnit: 0.1},
+			{MinQuantity: 0, RatePerUnit: 0.05},
+		}
+
+		if _, err := pricing.ComparePricingSpecs(duplicated, tiered); !errors.Is(err, pricing.ErrDuplicatePricingTier) {
+			t.Errorf("duplicate old tiers: err = %v, want ErrDuplicatePricingTier", err)
+		}
+		if _, _, err := pricing.DiffPricingSpecs(tiered, duplicated); !errors.Is(err, pricing.ErrDuplicatePricingTier) {
+			t.Errorf("duplicate new tiers: err = %v, want ErrDuplicatePricingTier", err)
+		}
+	})
+}
@@ -7,7 +7,10 @@ import (
 	"fmt"
 	"math"
 	"math/big"
+	"regexp"
 	"strings"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	"google.golang.org/grpc/codes"
@@ -113,6 +116,9 @@ const (
 	defaultRequestVolumeThreshold = 10               // Default minimum requests before evaluating circuit state
 	consecutiveFailureMultiplier  = 2                // Multiplier for consecutive failure limit calculation
 	defaultFailureRateThreshold   = 0.5              // Default failure rate threshold (50%)
+	defaultHalfOpenMaxProbes      = 0                // Default concurrent half-open probes (0 = unlimited)
+	defaultWindowDuration         = 60 * time.Second // Default sliding window for failure evaluation
+	defaultWindowBuckets          = 6                // Default number of buckets in the sliding window
 
 	// RPC method timeout constants.
 	defaultNameTimeout             = 5 * time.Second  // Default timeout for Name RPC
@@ -152,6 +158,7 @@ type PluginError struct {
 	Timestamp  time.Time
 	Retryable  bool
 	RetryAfter *time.Duration
+	Cause      error // Underlying error, if any; exposed to errors.Is/As via Unwrap
 }
 
 // NewTransientError creates a new transient error.
@@ -213,12 +220,22 @@ func NewFormattedConfigurationError(code ErrorCode, params map[string]string) *P
 	return NewConfigurationError(code, message).WithDetails(convertToInterface(params))
 }
 
-// Error implements the error interface.
+// Error implements the error interface. The underlying cause, if set, is appended.
 func (e *PluginError) Error() string {
+	msg := fmt.Sprintf("[%s:%s] %s", e.Category, e.Code, e.Message)
 	if len(e.Details) > 0 {
-		return fmt.Sprintf("[%s:%s] %s (details: %v)", e.Category, e.Code, e.Message, e.Details)
+		msg = fmt.Sprintf("%s (details: %v)", msg, e.Details)
 	}
-	return fmt.Sprintf("[%s:%s] %s", e.Category, e.Code, e.Message)
+	if e.Cause != nil {
+		msg = fmt.Sprintf("%s: %v", msg, e.Cause)
+	}
+	return msg
+}
+
+// Unwrap returns the underlying cause so errors.Is and errors.As can match it
+// (e.g., context.DeadlineExceeded beneath a NETWORK_TIMEOUT PluginError).
+func (e *PluginError) Unwrap() error {
+	return e.Cause
 }
 
 // IsRetryable returns whether the error should be retried.
@@ -232,6 +249,11 @@ func (e *PluginError) GetRetryAfter() *time.Duration {
 }
 
 // GetGRPCStatus converts the plugin error to a gRPC status.
+//
+// The status message is the Error string, and the status carries a pbc.ErrorDetail with the
+// error code, category, details map, and retry-after (see ToProtoErrorDetail) so that clients
+// can recover them with ExtractErrorDetails instead of parsing the message. If the detail
+// cannot be attached, the status is returned without it.
 func (e *PluginError) GetGRPCStatus() *status.Status {
 	var code codes.Code
 
@@ -258,7 +280,119 @@ func (e *PluginError) GetGRPCStatus() *status.Status {
 		code = codes.Internal
 	}
 
-	return status.New(code, e.Error())
+	st := status.New(code, e.Error())
+	if withDetail, err := st.WithDetails(e.ToProtoErrorDetail()); err == nil {
+		return withDetail
+	}
+	return st
+}
+
+// ExtractErrorDetails recovers the PluginError carried by an error returned from a plugin RPC.
+//
+// The error must be a gRPC status error with a pbc.ErrorDetail attached (as produced by
+// GetGRPCStatus); the code, category, message, details, and retry-after are restored from it.
+// Details values are strings after the round trip, and retry-after has whole-second precision.
+// Returns (nil, false) when err is nil, is not a gRPC status error, or carries no ErrorDetail.
+func ExtractErrorDetails(err error) (*PluginError, bool) {
+	if err == nil {
+		return nil, false
+	}
+	st, ok := status.FromError(err)
+	if !ok {
+		return nil, false
+	}
+	return pluginErrorFromStatusDetails(st)
+}
+
+// pluginErrorFromStatusDetails returns the PluginError described by the first pbc.ErrorDetail
+// attached to st.
+func pluginErrorFromStatusDetails(st *status.Status) (*PluginError, bool) {
+	for _, d := range st.Details() {
+		if detail, ok := d.(*pbc.ErrorDetail); ok {
+			return FromProtoErrorDetail(detail), true
+		}
+	}
+	return nil, false
+}
+
+// grpcStatusMessagePattern matches the "[category:CODE] message" prefix written by Error,
+// which GetGRPCStatus uses as the status message.
+//
+//nolint:gochecknoglobals // precompiled regex for status message parsing
+var grpcStatusMessagePattern = regexp.MustCompile(`(?s)^\[[a-z]+:([A-Z_]+)\] (.*)$`)
+
+// FromGRPCStatus reconstructs a PluginError from a gRPC status received by a client.
+//
+// A pbc.ErrorDetail attached by GetGRPCStatus takes precedence (see ExtractErrorDetails).
+// Otherwise, if the status message carries the "[category:CODE]" prefix written by GetGRPCStatus and
+// CODE is a known ErrorCode, that code is restored exactly and the prefix is stripped from the
+// message. Otherwise the gRPC code is mapped to the closest ErrorCode and the message is kept
+// as-is; codes with no closer match (e.g., Internal, Unknown) map to TEMPORARY_FAILURE.
+// Category and retryability follow GetErrorMapping. Returns nil for a nil or OK status.
+func FromGRPCStatus(st *status.Status) *PluginError {
+	if st == nil || st.Code() == codes.OK {
+		return nil
+	}
+
+	if pluginErr, ok := pluginErrorFromStatusDetails(st); ok {
+		return pluginErr
+	}
+
+	message := st.Message()
+	mapping := GetErrorMapping()
+	if m := grpcStatusMessagePattern.FindStringSubmatch(message); m != nil {
+		if _, known := mapping[ErrorCode(m[1])]; known {
+			return newPluginErrorForCode(ErrorCode(m[1]), m[2])
+		}
+	}
+
+	var code ErrorCode
+	//nolint:exhaustive // Unlisted codes fall back to TEMPORARY_FAILURE.
+	switch st.Code() {
+	case codes.Unavailable:
+		code = ErrorCodeServiceUnavailable
+	case codes.DeadlineExceeded:
+		code = ErrorCodeNetworkTimeout
+	case codes.ResourceExhausted:
+		code = ErrorCodeRateLimited
+	case codes.InvalidArgument, codes.OutOfRange:
+		code = ErrorCodeInvalidResource
+	case codes.NotFound:
+		code = ErrorCodeResourceNotFound
+	case codes.PermissionDenied:
+		code = ErrorCodePermissionDenied
+	case codes.Unauthenticated:
+		code = ErrorCodeInvalidCredentials
+	case codes.Unimplemented:
+		code = ErrorCodeUnsupportedRegion
+	case codes.DataLoss:
+		code = ErrorCodeDataCorruption
+	case codes.FailedPrecondition:
+		code = ErrorCodePluginNotConfigured
+	default:
+		code = ErrorCodeTemporaryFailure
+	}
+	return newPluginErrorForCode(code, message)
+}
+
+// newPluginErrorForCode creates a PluginError using the category assigned to code by GetErrorMapping.
+func newPluginErrorForCode(code ErrorCode, message string) *PluginError {
+	switch GetErrorMapping()[code] {
+	case TransientError:
+		return NewTransientError(code, message, nil)
+	case ConfigurationError:
+		return NewConfigurationError(code, message)
+	case PermanentError:
+		return NewPermanentError(code, message)
+	default:
+		return NewPermanentError(code, message)
+	}
+}
+
+// WithCause records the underlying error that caused this plugin error.
+func (e *PluginError) WithCause(err error) *PluginError {
+	e.Cause = err
+	return e
 }
 
 // WithDetails adds details to the error.
@@ -580,6 +714,9 @@ type RetryPolicy struct {
 	Multiplier      float64       // Exponential backoff multiplier
 	JitterFactor    float64       // Jitter factor for randomizing delays (0.0-0.5)
 	RetryableErrors []ErrorCode   // Specific error codes that should be retried
+	// FullJitter switches CalculateDelay to AWS-style full jitter: a random delay between
+	// 0 and the computed backoff. When false, JitterFactor applies +/- jitter around it.
+	FullJitter bool
 }
 
 // NewDefaultRetryPolicy creates a retry policy with sensible defaults.
@@ -681,10 +818,11 @@ func (rp *RetryPolicy) ShouldRetry(err error, attempt int) bool {
 	return false
 }
 
-// CalculateDelay calculates the delay for the given retry attempt.
-func (rp *RetryPolicy) CalculateDelay(attempt int) time.Duration {
+// backoffDelay returns the exponential backoff delay for the attempt, capped at MaxDelay,
+// without jitter.
+func (rp *RetryPolicy) backoffDelay(attempt int) float64 {
 	if attempt < 0 {
-		return rp.BaseDelay
+		return float64(rp.BaseDelay)
 	}
 
 	// Calculate exponential backoff delay
@@ -694,6 +832,44 @@ func (rp *RetryPolicy) CalculateDelay(attempt int) time.Duration {
 	if delay > float64(rp.MaxDelay) {
 		delay = float64(rp.MaxDelay)
 	}
+	return delay
+}
+
+// DelaySchedule returns the deterministic (jitter-excluded) backoff delay for each of the
+// first attempts retries, so operators can inspect or log a policy's schedule before running it.
+// Element i is the delay CalculateDelay(i) would produce with jitter disabled.
+// Returns nil if attempts <= 0.
+//
+// Example:
+//
+//	policy := &RetryPolicy{BaseDelay: time.Second, MaxDelay: 10 * time.Second, Multiplier: 2}
+//	policy.DelaySchedule(5) // [1s 2s 4s 8s 10s]
+func (rp *RetryPolicy) DelaySchedule(attempts int) []time.Duration {
+	if attempts <= 0 {
+		return nil
+	}
+	schedule := make([]time.Duration, attempts)
+	for i := range schedule {
+		schedule[i] = time.Duration(rp.backoffDelay(i))
+	}
+	return schedule
+}
+
+// CalculateDelay calculates the delay for the given retry attempt.
+//
+// By default, JitterFactor randomizes the backoff by +/- JitterFactor of its value.
+// With FullJitter enabled, the delay is instead chosen uniformly between 0 and the backoff.
+func (rp *RetryPolicy) CalculateDelay(attempt int) time.Duration {
+	if attempt < 0 {
+		return rp.BaseDelay
+	}
+
+	delay := rp.backoffDelay(attempt)
+
+	// Full jitter spreads retries across the whole backoff interval
+	if rp.FullJitter {
+		return time.Duration(delay * secureRandFloat())
+	}
 
 	// Add jitter to prevent thundering herd problem
 	if rp.JitterFactor > 0 {
@@ -709,11 +885,37 @@ func (rp *RetryPolicy) CalculateDelay(attempt int) time.Duration {
 	return time.Duration(delay)
 }
 
+// CalculateDelayForError calculates the delay before retrying after err on the given attempt.
+//
+// Precedence: when err is (or wraps) a PluginError with a RetryAfter hint, such as a server's
+// Retry-After for ErrorCodeRateLimited, the delay is max(CalculateDelay(attempt), RetryAfter).
+// The hint is a lower bound: it is never shortened by jitter and is not capped by MaxDelay,
+// so a retry never happens sooner than the server asked. Without a hint, the result is
+// CalculateDelay(attempt).
+func (rp *RetryPolicy) CalculateDelayForError(attempt int, err error) time.Duration {
+	delay := rp.CalculateDelay(attempt)
+
+	var pluginErr *PluginError
+	if errors.As(err, &pluginErr) {
+		if retryAfter := pluginErr.GetRetryAfter(); retryAfter != nil && *retryAfter > delay {
+			delay = *retryAfter
+		}
+	}
+	return delay
+}
+
 // RetryFunc represents a function that can be retried.
 type RetryFunc func() error
 
 // RetryWithPolicy executes a function with retry logic based on the provided policy.
+// The wait between attempts honors PluginError RetryAfter hints (see CalculateDelayForError).
 func RetryWithPolicy(ctx context.Context, policy *RetryPolicy, fn RetryFunc) error {
+	return retryWithPolicy(ctx, policy, nil, fn)
+}
+
+// retryWithPolicy implements RetryWithPolicy and RetryWithBudget.
+// A nil budget allows every retry permitted by the policy.
+func retryWithPolicy(ctx context.Context, policy *RetryPolicy, budget *RetryBudget, fn RetryFunc) error {
 	if policy == nil {
 		policy = NewDefaultRetryPolicy()
 	}
@@ -749,8 +951,13 @@ func RetryWithPolicy(ctx context.Context, policy *RetryPolicy, fn RetryFunc) err
 			break
 		}
 
+		// Stop retrying once the shared retry budget is exhausted
+		if budget != nil && !budget.TryConsume() {
+			return fmt.Errorf("%w: %w", ErrRetryBudgetExhausted, lastErr)
+		}
+
 		// Calculate and wait for the delay
-		delay := policy.CalculateDelay(attempt)
+		delay := policy.CalculateDelayForError(attempt, err)
 		select {
 		case <-ctx.Done():
 			return ctx.Err()
@@ -801,6 +1008,9 @@ type CircuitBreakerConfig struct {
 	RequestVolumeThreshold  int           // Minimum requests before evaluating circuit state
 	FailureRateThreshold    float64       // Failure rate threshold (0.0-1.0) for opening circuit
 	ConsecutiveFailureLimit int           // Maximum consecutive failures before forcing open
+	HalfOpenMaxProbes       int           // Maximum concurrent probe requests in half-open (0 = unlimited)
+	WindowDuration          time.Duration // Sliding window for failure evaluation (0 = lifetime totals)
+	WindowBuckets           int           // Number of buckets in the sliding window (0 = default)
 }
 
 // NewDefaultCircuitBreakerConfig creates a circuit breaker config with sensible defaults.
@@ -812,6 +1022,9 @@ func NewDefaultCircuitBreakerConfig() *CircuitBreakerConfig {
 		RequestVolumeThreshold:  defaultRequestVolumeThreshold,
 		FailureRateThreshold:    defaultFailureRateThreshold,                            // 50% failure rate
 		ConsecutiveFailureLimit: defaultFailureThreshold * consecutiveFailureMultiplier, // Double the failure threshold
+		HalfOpenMaxProbes:       defaultHalfOpenMaxProbes,
+		WindowDuration:          defaultWindowDuration,
+		WindowBuckets:           defaultWindowBuckets,
 	}
 }
 
@@ -835,6 +1048,15 @@ func (cbc *CircuitBreakerConfig) Validate() error {
 	if cbc.ConsecutiveFailureLimit <= 0 {
 		return errors.New("consecutive failure limit must be positive")
 	}
+	if cbc.HalfOpenMaxProbes < 0 {
+		return errors.New("half-open max probes must not be negative")
+	}
+	if cbc.WindowDuration < 0 {
+		return errors.New("window duration must not be negative")
+	}
+	if cbc.WindowBuckets < 0 {
+		return errors.New("window buckets must not be negative")
+	}
 	return nil
 }
 
@@ -847,23 +1069,31 @@ type CircuitBreakerMetrics struct {
 	LastFailureTime     time.Time // Time of last failure
 	LastSuccessTime     time.Time // Time of last success
 	StateTransitions    int64     // Number of state transitions
+	InFlightProbes      int       // Half-open probe requests allowed but not yet recorded
+	WindowedRequests    int64     // Requests within the sliding window (lifetime total if windowing is disabled)
+	WindowedFailures    int64     // Failures within the sliding window (lifetime total if windowing is disabled)
 }
 
-// FailureRate calculates the current failure rate.
+// FailureRate calculates the failure rate over the sliding window, so it reflects recent
+// requests rather than a lifetime average.
 func (cbm *CircuitBreakerMetrics) FailureRate() float64 {
-	if cbm.TotalRequests == 0 {
+	if cbm.WindowedRequests == 0 {
 		return 0.0
 	}
-	return float64(cbm.FailedRequests) / float64(cbm.TotalRequests)
+	return float64(cbm.WindowedFailures) / float64(cbm.WindowedRequests)
 }
 
 // CircuitBreaker implements the circuit breaker pattern for plugin reliability.
+// It is safe for concurrent use.
 type CircuitBreaker struct {
+	mu        sync.Mutex
 	name      string
 	state     CircuitBreakerState
 	config    *CircuitBreakerConfig
 	metrics   *CircuitBreakerMetrics
-	stateTime time.Time // Time of last state change
+	window    *slidingWindow // nil when windowing is disabled
+	stateTime time.Time      // Time of last state change
+	period    uint64         // Incremented on every state change; identifies the half-open period of a probe slot
 }
 
 // NewCircuitBreaker creates a new circuit breaker with the given configuration.
@@ -876,11 +1106,21 @@ func NewCircuitBreaker(name string, config *CircuitBreakerConfig) (*CircuitBreak
 		return nil, fmt.Errorf("invalid circuit breaker config: %w", err)
 	}
 
+	var window *slidingWindow
+	if config.WindowDuration > 0 {
+		buckets := config.WindowBuckets
+		if buckets == 0 {
+			buckets = defaultWindowBuckets
+		}
+		window = newSlidingWindow(config.WindowDuration, buckets)
+	}
+
 	return &CircuitBreaker{
 		name:      name,
 		state:     CircuitClosed,
 		config:    config,
 		metrics:   &CircuitBreakerMetrics{},
+		window:    window,
 		stateTime: time.Now(),
 	}, nil
 }
@@ -901,36 +1141,123 @@ func (cb *CircuitBreaker) Name() string {
 
 // State returns the current circuit breaker state.
 func (cb *CircuitBreaker) State() CircuitBreakerState {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
 	return cb.state
 }
 
 // Metrics returns a copy of the current metrics.
 func (cb *CircuitBreaker) Metrics() CircuitBreakerMetrics {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+	cb.refreshWindowedCounts()
 	return *cb.metrics // Return copy to prevent external modification
 }
 
 // IsRequestAllowed determines if a request should be allowed based on circuit state.
+//
+// It does not reserve a half-open probe slot, because the caller has no way to release it:
+// in half-open state it only reports whether a slot is currently free. Use Allow or Execute
+// to have concurrent half-open probes limited to HalfOpenMaxProbes.
 func (cb *CircuitBreaker) IsRequestAllowed() bool {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+
+	return cb.admit(false) != nil
+}
+
+// Allow determines if a request should be allowed and, if so, returns a function that records
+// its outcome: nil records a success, and any other error a failure.
+//
+// In half-open state, at most HalfOpenMaxProbes requests are allowed in flight at once. An
+// allowed probe holds its slot until done is called, so call done exactly once for every
+// allowed request, even if the request panics. Calls after the first are ignored. A request
+// allowed while the circuit was closed never holds a slot, even if the circuit has become
+// half-open by the time done is called.
+//
+// Example:
+//
+//	done, ok := breaker.Allow()
+//	if !ok {
+//	    return errCircuitOpen
+//	}
+//	err := callPlugin()
+//	done(err)
+func (cb *CircuitBreaker) Allow() (func(err error), bool) {
+	cb.mu.Lock()
+	slot := cb.admit(true)
+	cb.mu.Unlock()
+	if slot == nil {
+		return nil, false
+	}
+
+	var once sync.Once
+	return func(err error) {
+		once.Do(func() {
+			cb.releaseProbe(slot)
+			if err != nil {
+				cb.RecordFailure(err)
+			} else {
+				cb.RecordSuccess()
+			}
+		})
+	}, true
+}
+
+// probeSlot records whether an allowed request holds a half-open probe slot, and in which
+// half-open period it was taken.
+type probeSlot struct {
+	held   bool
+	period uint64
+}
+
+// admit checks whether a request may proceed, moving an open circuit to half-open once the
+// recovery timeout has passed. It returns nil if the request is rejected. When reserve is true
+// and the circuit is half-open, the returned slot holds one of the HalfOpenMaxProbes slots.
+func (cb *CircuitBreaker) admit(reserve bool) *probeSlot {
 	switch cb.state {
 	case CircuitClosed:
-		return true
+		return &probeSlot{}
 	case CircuitOpen:
 		// Check if recovery timeout has passed
-		if time.Since(cb.stateTime) >= cb.config.RecoveryTimeout {
-			cb.setState(CircuitHalfOpen)
-			return true
+		if time.Since(cb.stateTime) < cb.config.RecoveryTimeout {
+			return nil
 		}
-		return false
+		cb.setState(CircuitHalfOpen)
 	case CircuitHalfOpen:
-		// Allow limited requests to test if service has recovered
-		return true
 	default:
-		return false
+		return nil
+	}
+
+	// Allow limited requests to test if service has recovered
+	if cb.config.HalfOpenMaxProbes > 0 && cb.metrics.InFlightProbes >= cb.config.HalfOpenMaxProbes {
+		return nil
+	}
+	if !reserve {
+		return &probeSlot{}
+	}
+	cb.metrics.InFlightProbes++
+	return &probeSlot{held: true, period: cb.period}
+}
+
+// releaseProbe frees the half-open probe slot held by slot, if any. Slots taken in an earlier
+// half-open period were already discarded by the state change that ended it.
+func (cb *CircuitBreaker) releaseProbe(slot *probeSlot) {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+
+	if slot.held && slot.period == cb.period && cb.metrics.InFlightProbes > 0 {
+		cb.metrics.InFlightProbes--
 	}
+	slot.held = false
 }
 
 // RecordSuccess records a successful request.
 func (cb *CircuitBreaker) RecordSuccess() {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+
+	cb.recordWindowed(false)
 	cb.metrics.TotalRequests++
 	cb.metrics.SuccessfulRequests++
 	cb.metrics.ConsecutiveFailures = 0
@@ -947,6 +1274,10 @@ func (cb *CircuitBreaker) RecordSuccess() {
 
 // RecordFailure records a failed request and updates circuit state if necessary.
 func (cb *CircuitBreaker) RecordFailure(_ error) {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+
+	cb.recordWindowed(true)
 	cb.metrics.TotalRequests++
 	cb.metrics.FailedRequests++
 	cb.metrics.ConsecutiveFailures++
@@ -956,17 +1287,38 @@ func (cb *CircuitBreaker) RecordFailure(_ error) {
 	cb.evaluateCircuitState()
 }
 
+// recordWindowed adds a request outcome to the sliding window, if enabled.
+func (cb *CircuitBreaker) recordWindowed(failed bool) {
+	if cb.window != nil {
+		cb.window.record(time.Now(), failed)
+	}
+}
+
+// refreshWindowedCounts updates the windowed metrics from the sliding window, or from the
+// lifetime totals when windowing is disabled.
+func (cb *CircuitBreaker) refreshWindowedCounts() {
+	if cb.window == nil {
+		cb.metrics.WindowedRequests = cb.metrics.TotalRequests
+		cb.metrics.WindowedFailures = cb.metrics.FailedRequests
+		return
+	}
+	cb.metrics.WindowedRequests, cb.metrics.WindowedFailures = cb.window.counts(time.Now())
+}
+
 // evaluateCircuitState checks if the circuit should be opened based on failure metrics.
+// Volume, failure count, and failure rate are evaluated over the sliding window.
 func (cb *CircuitBreaker) evaluateCircuitState() {
+	cb.refreshWindowedCounts()
+
 	// Don't evaluate if we don't have enough requests
-	if cb.metrics.TotalRequests < int64(cb.config.RequestVolumeThreshold) {
+	if cb.metrics.WindowedRequests < int64(cb.config.RequestVolumeThreshold) {
 		return
 	}
 
 	// Check consecutive failures
 	shouldOpen := cb.metrics.ConsecutiveFailures >= cb.config.ConsecutiveFailureLimit ||
 		// Check failure threshold
-		cb.metrics.FailedRequests >= int64(cb.config.FailureThreshold) ||
+		cb.metrics.WindowedFailures >= int64(cb.config.FailureThreshold) ||
 		// Check failure rate
 		cb.metrics.FailureRate() >= cb.config.FailureRateThreshold
 
@@ -981,6 +1333,8 @@ func (cb *CircuitBreaker) setState(newState CircuitBreakerState) {
 		cb.state = newState
 		cb.stateTime = time.Now()
 		cb.metrics.StateTransitions++
+		cb.metrics.InFlightProbes = 0
+		cb.period++
 
 		// Reset success counter when entering half-open state
 		if newState == CircuitHalfOpen {
@@ -1000,42 +1354,72 @@ func (cb *CircuitBreaker) resetMetrics() {
 	cb.metrics.SuccessfulRequests = 0
 	cb.metrics.FailedRequests = 0
 	cb.metrics.ConsecutiveFailures = 0
+	cb.metrics.WindowedRequests = 0
+	cb.metrics.WindowedFailures = 0
+	if cb.window != nil {
+		cb.window.reset()
+	}
 	// Keep LastFailureTime and LastSuccessTime for monitoring
 	// Keep StateTransitions for monitoring
 }
 
-// Execute wraps a function call with circuit breaker logic.
+// Execute wraps a function call with circuit breaker logic. A half-open probe slot taken for
+// the call is released even if fn panics.
 func (cb *CircuitBreaker) Execute(fn func() error) error {
-	if !cb.IsRequestAllowed() {
-		return NewTransientError(
-			ErrorCodeCircuitOpen,
-			fmt.Sprintf("Circuit breaker '%s' is open", cb.name),
-			&cb.config.RecoveryTimeout,
-		)
+	return cb.execute(fn, func(err error) bool { return err != nil })
+}
+
+// execute runs fn if the circuit allows it and records the outcome, counting the errors for
+// which isFailure returns true as failures and everything else as a success. If fn panics, its
+// probe slot is released and no outcome is recorded.
+func (cb *CircuitBreaker) execute(fn func() error, isFailure func(error) bool) error {
+	cb.mu.Lock()
+	slot := cb.admit(true)
+	cb.mu.Unlock()
+	if slot == nil {
+		return cb.openError()
 	}
+	defer cb.releaseProbe(slot)
 
 	err := fn()
-	if err != nil {
+	if isFailure(err) {
 		cb.RecordFailure(err)
-		return err
+	} else {
+		cb.RecordSuccess()
 	}
+	return err
+}
 
-	cb.RecordSuccess()
-	return nil
+// openError returns the CIRCUIT_OPEN transient error for a rejected request, with the
+// recovery timeout as its retry-after hint.
+func (cb *CircuitBreaker) openError() error {
+	return NewTransientError(
+		ErrorCodeCircuitOpen,
+		fmt.Sprintf("Circuit breaker '%s' is open", cb.name),
+		&cb.config.RecoveryTimeout,
+	)
 }
 
 // ForceOpen forces the circuit breaker to open state.
 func (cb *CircuitBreaker) ForceOpen() {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
 	cb.setState(CircuitOpen)
 }
 
 // ForceClose forces the circuit breaker to closed state and resets metrics.
 func (cb *CircuitBreaker) ForceClose() {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
 	cb.setState(CircuitClosed)
 }
 
 // String returns a string representation of the circuit breaker state.
 func (cb *CircuitBreaker) String() string {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+	cb.refreshWindowedCounts()
+
 	var stateStr string
 	switch cb.state {
 	case CircuitClosed:
@@ -1140,18 +1524,19 @@ func (tc *TimeoutConfig) Validate() error {
 	return nil
 }
 
-// GetTimeoutForMethod returns the appropriate timeout for a given RPC method.
+// GetTimeoutForMethod returns the appropriate timeout for a given RPC method (see MethodName
+// and related constants). Unknown methods use GlobalTimeout.
 func (tc *TimeoutConfig) GetTimeoutForMethod(method string) time.Duration {
 	switch method {
-	case "Name":
+	case MethodName:
 		return tc.NameTimeout
-	case "Supports":
+	case MethodSupports:
 		return tc.SupportsTimeout
-	case "GetActualCost":
+	case MethodGetActualCost:
 		return tc.GetActualCostTimeout
-	case "GetProjectedCost":
+	case MethodGetProjectedCost:
 		return tc.GetProjectedCostTimeout
-	case "GetPricingSpec":
+	case MethodGetPricingSpec:
 		return tc.GetPricingSpecTimeout
 	default:
 		return tc.GlobalTimeout
@@ -1161,6 +1546,8 @@ func (tc *TimeoutConfig) GetTimeoutForMethod(method string) time.Duration {
 // TimeoutWrapper provides timeout-aware execution for functions.
 type TimeoutWrapper struct {
 	config *TimeoutConfig
+	// abandoned counts ExecuteWithTimeout goroutines still running after their call returned.
+	abandoned atomic.Int64
 }
 
 // NewTimeoutWrapper creates a new timeout wrapper with the given configuration.
@@ -1182,7 +1569,21 @@ func NewDefaultTimeoutWrapper() *TimeoutWrapper {
 	return wrapper
 }
 
+// Goroutine states shared between ExecuteWithTimeout and the goroutine running fn.
+const (
+	timeoutCallRunning int32 = iota
+	timeoutCallDone
+	timeoutCallAbandoned
+)
+
 // ExecuteWithTimeout executes a function with a method-specific timeout.
+//
+// fn runs on a separate goroutine so that the call returns as soon as the timeout expires,
+// even if fn ignores its context. In that case the goroutine is abandoned: it keeps running
+// until fn returns, and its result is discarded (the result channel is buffered, so the
+// goroutine never blocks on send). Under load, a fn that ignores cancellation can therefore
+// accumulate goroutines; AbandonedGoroutines reports how many are still running. Callers
+// that cannot tolerate this should use ExecuteWithTimeoutBlocking.
 func (tw *TimeoutWrapper) ExecuteWithTimeout(
 	ctx context.Context,
 	method string,
@@ -1196,8 +1597,12 @@ func (tw *TimeoutWrapper) ExecuteWithTimeout(
 
 	// Execute function in a goroutine to handle timeout
 	errChan := make(chan error, 1)
+	var state atomic.Int32
 	go func() {
 		errChan <- fn(timeoutCtx)
+		if !state.CompareAndSwap(timeoutCallRunning, timeoutCallDone) {
+			tw.abandoned.Add(-1)
+		}
 	}()
 
 	// Wait for completion or timeout
@@ -1205,15 +1610,57 @@ func (tw *TimeoutWrapper) ExecuteWithTimeout(
 	case err := <-errChan:
 		return err
 	case <-timeoutCtx.Done():
-		if timeoutCtx.Err() == context.DeadlineExceeded {
-			return NewTransientError(
-				ErrorCodeNetworkTimeout,
-				fmt.Sprintf("Operation '%s' timed out after %v", method, timeout),
-				nil,
-			)
+		// Count before publishing the abandoned state so the goroutine's decrement
+		// can never precede this increment.
+		tw.abandoned.Add(1)
+		if !state.CompareAndSwap(timeoutCallRunning, timeoutCallAbandoned) {
+			tw.abandoned.Add(-1)
 		}
-		return timeoutCtx.Err()
+		return tw.timeoutResult(timeoutCtx, method, timeout)
+	}
+}
+
+// ExecuteWithTimeoutBlocking executes a function with a method-specific timeout on the
+// caller's goroutine, so no goroutine can outlive the call.
+//
+// The timeout is enforced only through the context passed to fn: the call returns when fn
+// returns, so fn must honor ctx cancellation. If fn fails after the timeout has expired, a
+// NETWORK_TIMEOUT transient error is returned, matching ExecuteWithTimeout.
+func (tw *TimeoutWrapper) ExecuteWithTimeoutBlocking(
+	ctx context.Context,
+	method string,
+	fn func(context.Context) error,
+) error {
+	timeout := tw.config.GetTimeoutForMethod(method)
+
+	timeoutCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	err := fn(timeoutCtx)
+	if err != nil && timeoutCtx.Err() != nil {
+		return tw.timeoutResult(timeoutCtx, method, timeout)
+	}
+	return err
+}
+
+// AbandonedGoroutines returns the number of goroutines started by ExecuteWithTimeout that
+// are still running after their call returned because the timeout expired or the context
+// was cancelled. The count drops as those goroutines finish.
+func (tw *TimeoutWrapper) AbandonedGoroutines() int64 {
+	return tw.abandoned.Load()
+}
+
+// timeoutResult returns the error for a call whose timeout context is done: a NETWORK_TIMEOUT
+// transient error if the deadline expired, otherwise the context's error.
+func (tw *TimeoutWrapper) timeoutResult(timeoutCtx context.Context, method string, timeout time.Duration) error {
+	if errors.Is(timeoutCtx.Err(), context.DeadlineExceeded) {
+		return NewTransientError(
+			ErrorCodeNetworkTimeout,
+			fmt.Sprintf("Operation '%s' timed out after %v", method, timeout),
+			nil,
+		)
 	}
+	return timeoutCtx.Err()
 }
 
 // ExecuteWithGlobalTimeout executes a function with the global timeout.
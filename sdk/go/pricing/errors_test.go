@@ -1,9 +1,16 @@
 package pricing_test
 
 import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
 	"testing"
 	"time"
 
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
 	"github.com/rshade/finfocus-spec/sdk/go/pricing"
 	pbc "github.com/rshade/finfocus-spec/sdk/go/proto/finfocus/v1"
 )
@@ -143,6 +150,158 @@ func TestErrorCategoryProtoConversion(t *testing.T) {
 	}
 }
 
+// TestPluginErrorWithCause tests that a wrapped cause is reachable through errors.Is and errors.As.
+func TestPluginErrorWithCause(t *testing.T) {
+	pluginErr := pricing.NewTransientError(pricing.ErrorCodeNetworkTimeout, "pricing API timed out", nil).
+		WithCause(context.DeadlineExceeded)
+
+	if !errors.Is(pluginErr, context.DeadlineExceeded) {
+		t.Error("Expected errors.Is to match the wrapped cause")
+	}
+	if !strings.HasSuffix(pluginErr.Error(), ": "+context.DeadlineExceeded.Error()) {
+		t.Errorf("Expected Error() to append the cause, got %q", pluginErr.Error())
+	}
+
+	// The PluginError is still reachable through further wrapping
+	wrapped := fmt.Errorf("GetActualCost: %w", pluginErr)
+	var target *pricing.PluginError
+	if !errors.As(wrapped, &target) || target.Code != pricing.ErrorCodeNetworkTimeout {
+		t.Errorf("Expected errors.As to find the PluginError, got %v", target)
+	}
+	if !errors.Is(wrapped, context.DeadlineExceeded) {
+		t.Error("Expected errors.Is to traverse through the PluginError to its cause")
+	}
+	if !pricing.IsTransientError(wrapped) {
+		t.Error("Expected wrapped error to be classified as transient")
+	}
+}
+
+// TestPluginErrorWithoutCause tests that Unwrap and Error are unchanged when no cause is set.
+func TestPluginErrorWithoutCause(t *testing.T) {
+	pluginErr := pricing.NewPermanentError(pricing.ErrorCodeInvalidResource, "bad resource")
+
+	if pluginErr.Unwrap() != nil {
+		t.Errorf("Expected nil Unwrap without a cause, got %v", pluginErr.Unwrap())
+	}
+	if got := pluginErr.Error(); got != "[permanent:INVALID_RESOURCE] bad resource" {
+		t.Errorf("Unexpected Error() without cause: %q", got)
+	}
+}
+
+func TestFromGRPCStatusRoundTrip(t *testing.T) {
+	for code, category := range pricing.GetErrorMapping() {
+		t.Run(string(code), func(t *testing.T) {
+			var original *pricing.PluginError
+			switch category {
+			case pricing.TransientError:
+				original = pricing.NewTransientError(code, "upstream failed", nil)
+			case pricing.ConfigurationError:
+				original = pricing.NewConfigurationError(code, "upstream failed")
+			default:
+				original = pricing.NewPermanentError(code, "upstream failed")
+			}
+
+			st := original.GetGRPCStatus()
+			// The second status drops the structured detail, leaving only the message prefix.
+			for _, s := range []*status.Status{st, status.New(st.Code(), st.Message())} {
+				restored := pricing.FromGRPCStatus(s)
+				if restored == nil {
+					t.Fatal("FromGRPCStatus() returned nil")
+				}
+				if restored.Code != code || restored.Category != category {
+					t.Errorf("FromGRPCStatus() = %s/%s, want %s/%s", restored.Category, restored.Code, category, code)
+				}
+				if restored.IsRetryable() != original.IsRetryable() {
+					t.Errorf("IsRetryable() = %v, want %v", restored.IsRetryable(), original.IsRetryable())
+				}
+				if restored.Message != "upstream failed" {
+					t.Errorf("Message = %q, want %q", restored.Message, "upstream failed")
+				}
+			}
+		})
+	}
+}
+
+func TestFromGRPCStatusPlainStatus(t *testing.T) {
+	tests := []struct {
+		grpcCode      codes.Code
+		wantCode      pricing.ErrorCode
+		wantRetryable bool
+	}{
+		{codes.Unavailable, pricing.ErrorCodeServiceUnavailable, true},
+		{codes.DeadlineExceeded, pricing.ErrorCodeNetworkTimeout, true},
+		{codes.ResourceExhausted, pricing.ErrorCodeRateLimited, true},
+		{codes.InvalidArgument, pricing.ErrorCodeInvalidResource, false},
+		{codes.NotFound, pricing.ErrorCodeResourceNotFound, false},
+		{codes.Unauthenticated, pricing.ErrorCodeInvalidCredentials, false},
+		{codes.FailedPrecondition, pricing.ErrorCodePluginNotConfigured, false},
+		{codes.Internal, pricing.ErrorCodeTemporaryFailure, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.grpcCode.String(), func(t *testing.T) {
+			restored := pricing.FromGRPCStatus(status.New(tt.grpcCode, "[backend] request failed"))
+			if restored.Code != tt.wantCode || restored.IsRetryable() != tt.wantRetryable {
+				t.Errorf("FromGRPCStatus(%s) = %s (retryable=%v), want %s (retryable=%v)",
+					tt.grpcCode, restored.Code, restored.IsRetryable(), tt.wantCode, tt.wantRetryable)
+			}
+			if restored.Message != "[backend] request failed" {
+				t.Errorf("Message = %q, want original status message", restored.Message)
+			}
+		})
+	}
+
+	if pricing.FromGRPCStatus(nil) != nil || pricing.FromGRPCStatus(status.New(codes.OK, "")) != nil {
+		t.Error("Expected nil for nil and OK statuses")
+	}
+}
+
+func TestExtractErrorDetails(t *testing.T) {
+	retryAfter := 45 * time.Second
+	original := pricing.NewTransientError(pricing.ErrorCodeRateLimited, "quota exceeded", &retryAfter).
+		WithDetails(map[string]interface{}{"region": "us-east-1", "limit": 100})
+
+	restored, ok := pricing.ExtractErrorDetails(original.GetGRPCStatus().Err())
+	if !ok {
+		t.Fatal("ExtractErrorDetails() found no structured details")
+	}
+	if restored.Code != pricing.ErrorCodeRateLimited || restored.Category != pricing.TransientError {
+		t.Errorf("ExtractErrorDetails() = %s/%s, want %s/%s",
+			restored.Category, restored.Code, pricing.TransientError, pricing.ErrorCodeRateLimited)
+	}
+	if restored.Message != "quota exceeded" {
+		t.Errorf("Message = %q, want %q", restored.Message, "quota exceeded")
+	}
+	if restored.GetRetryAfter() == nil || *restored.GetRetryAfter() != retryAfter {
+		t.Errorf("RetryAfter = %v, want %v", restored.GetRetryAfter(), retryAfter)
+	}
+	if restored.Details["region"] != "us-east-1" || restored.Details["limit"] != "100" {
+		t.Errorf("Details = %v, want region and limit preserved as strings", restored.Details)
+	}
+	if !restored.IsRetryable() {
+		t.Error("Expected restored transient error to be retryable")
+	}
+}
+
+func TestExtractErrorDetailsWithoutDetails(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+	}{
+		{"nil error", nil},
+		{"non-gRPC error", errors.New("plain failure")},
+		{"status without details", status.Error(codes.Unavailable, "backend down")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if pluginErr, ok := pricing.ExtractErrorDetails(tt.err); ok || pluginErr != nil {
+				t.Errorf("ExtractErrorDetails() = (%v, %v), want (nil, false)", pluginErr, ok)
+			}
+		})
+	}
+}
+
 // TestPluginErrorDetails tests PluginError structure and methods.
 func TestPluginErrorDetails(t *testing.T) {
 	retryAfter := 30 * time.Second
@@ -245,6 +404,227 @@ func TestRetryPolicyBasics(t *testing.T) {
 	}
 }
 
+// TestRetryPolicyDelaySchedule tests the nominal, unjittered delay of each retry attempt.
+func TestRetryPolicyDelaySchedule(t *testing.T) {
+	policy := &pricing.RetryPolicy{
+		BaseDelay:    time.Second,
+		MaxDelay:     10 * time.Second,
+		Multiplier:   2,
+		JitterFactor: 0.5,
+	}
+
+	expected := []time.Duration{
+		time.Second, 2 * time.Second, 4 * time.Second, 8 * time.Second, 10 * time.Second,
+	}
+	schedule := policy.DelaySchedule(len(expected))
+	if len(schedule) != len(expected) {
+		t.Fatalf("DelaySchedule() returned %d delays, want %d", len(schedule), len(expected))
+	}
+	for i, want := range expected {
+		if schedule[i] != want {
+			t.Errorf("DelaySchedule()[%d] = %v, want %v", i, schedule[i], want)
+		}
+	}
+
+	if got := policy.DelaySchedule(0); got != nil {
+		t.Errorf("DelaySchedule(0) = %v, want nil", got)
+	}
+}
+
+// TestRetryPolicyFullJitter tests that full jitter keeps each delay between zero and its nominal value.
+func TestRetryPolicyFullJitter(t *testing.T) {
+	policy := &pricing.RetryPolicy{
+		BaseDelay:  100 * time.Millisecond,
+		MaxDelay:   time.Second,
+		Multiplier: 2,
+		FullJitter: true,
+	}
+
+	schedule := policy.DelaySchedule(5)
+	for attempt, ceiling := range schedule {
+		for range 50 {
+			delay := policy.CalculateDelay(attempt)
+			if delay < 0 || delay > ceiling {
+				t.Fatalf("CalculateDelay(%d) = %v, want within [0, %v]", attempt, delay, ceiling)
+			}
+		}
+	}
+}
+
+// TestRetryPolicyDefaultJitterUnchanged tests that proportional jitter still applies by default.
+func TestRetryPolicyDefaultJitterUnchanged(t *testing.T) {
+	policy := &pricing.RetryPolicy{
+		BaseDelay:    time.Second,
+		MaxDelay:     10 * time.Second,
+		Multiplier:   2,
+		JitterFactor: 0.1,
+	}
+
+	for range 50 {
+		delay := policy.CalculateDelay(2)
+		if delay < 3600*time.Millisecond || delay > 4400*time.Millisecond {
+			t.Fatalf("CalculateDelay(2) = %v, want within 4s +/- 10%%", delay)
+		}
+	}
+}
+
+// rateLimited returns a rate-limit error carrying a server Retry-After hint.
+func rateLimited(retryAfter time.Duration) *pricing.PluginError {
+	return pricing.NewTransientError(pricing.ErrorCodeRateLimited, "slow down", &retryAfter)
+}
+
+func TestRetryPolicyCalculateDelayForError(t *testing.T) {
+	policy := &pricing.RetryPolicy{
+		BaseDelay:  100 * time.Millisecond,
+		MaxDelay:   time.Second,
+		Multiplier: 2,
+	}
+	longHint := 30 * time.Second
+	shortHint := 10 * time.Millisecond
+
+	tests := []struct {
+		name string
+		err  error
+		want time.Duration
+	}{
+		{
+			"no hint uses backoff",
+			pricing.NewTransientError(pricing.ErrorCodeNetworkTimeout, "timeout", nil),
+			400 * time.Millisecond,
+		},
+		{"plain error uses backoff", errors.New("boom"), 400 * time.Millisecond},
+		{"longer hint wins and ignores MaxDelay", rateLimited(longHint), longHint},
+		{"shorter hint keeps backoff", rateLimited(shortHint), 400 * time.Millisecond},
+		{"wrapped hint", fmt.Errorf("call failed: %w", rateLimited(longHint)), longHint},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := policy.CalculateDelayForError(2, tt.err); got != tt.want {
+				t.Errorf("CalculateDelayForError(2) = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRetryWithPolicyHonorsRetryAfter(t *testing.T) {
+	policy := &pricing.RetryPolicy{
+		MaxRetries:      1,
+		BaseDelay:       time.Millisecond,
+		MaxDelay:        2 * time.Millisecond,
+		Multiplier:      2,
+		RetryableErrors: []pricing.ErrorCode{pricing.ErrorCodeRateLimited},
+	}
+	retryAfter := 50 * time.Millisecond
+
+	calls := 0
+	start := time.Now()
+	err := pricing.RetryWithPolicy(context.Background(), policy, func() error {
+		calls++
+		if calls == 1 {
+			return rateLimited(retryAfter)
+		}
+		return nil
+	})
+	elapsed := time.Since(start)
+
+	if err != nil {
+		t.Fatalf("RetryWithPolicy() unexpected error: %v", err)
+	}
+	if calls != 2 {
+		t.Errorf("Expected 2 calls, got %d", calls)
+	}
+	if elapsed < retryAfter {
+		t.Errorf("RetryWithPolicy() retried after %v, want at least the %v RetryAfter hint", elapsed, retryAfter)
+	}
+}
+
+func TestTimeoutWrapperAbandonedGoroutines(t *testing.T) {
+	wrapper := pricing.NewDefaultTimeoutWrapper()
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	release := make(chan struct{})
+	err := wrapper.ExecuteWithTimeout(ctx, pricing.MethodGetActualCost, func(context.Context) error {
+		<-release // ignores its context
+		return nil
+	})
+
+	var pluginErr *pricing.PluginError
+	if !errors.As(err, &pluginErr) || pluginErr.Code != pricing.ErrorCodeNetworkTimeout {
+		t.Fatalf("ExecuteWithTimeout() error = %v, want NETWORK_TIMEOUT", err)
+	}
+	if got := wrapper.AbandonedGoroutines(); got != 1 {
+		t.Errorf("AbandonedGoroutines() = %d, want 1 while fn is still running", got)
+	}
+
+	close(release)
+	deadline := time.Now().Add(time.Second)
+	for wrapper.AbandonedGoroutines() != 0 && time.Now().Before(deadline) {
+		time.Sleep(time.Millisecond)
+	}
+	if got := wrapper.AbandonedGoroutines(); got != 0 {
+		t.Errorf("AbandonedGoroutines() = %d, want 0 after fn returned", got)
+	}
+}
+
+func TestTimeoutWrapperCompletedCallsNotAbandoned(t *testing.T) {
+	wrapper := pricing.NewDefaultTimeoutWrapper()
+
+	for range 100 {
+		if err := wrapper.ExecuteWithTimeout(context.Background(), pricing.MethodName, func(context.Context) error {
+			return nil
+		}); err != nil {
+			t.Fatalf("ExecuteWithTimeout() unexpected error: %v", err)
+		}
+	}
+	if got := wrapper.AbandonedGoroutines(); got != 0 {
+		t.Errorf("AbandonedGoroutines() = %d, want 0", got)
+	}
+}
+
+func TestTimeoutWrapperExecuteWithTimeoutBlocking(t *testing.T) {
+	wrapper := pricing.NewDefaultTimeoutWrapper()
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	// finished is written without synchronization: the race detector would flag it
+	// if fn did not run on the caller's goroutine.
+	finished := false
+	err := wrapper.ExecuteWithTimeoutBlocking(ctx, pricing.MethodGetActualCost, func(fnCtx context.Context) error {
+		<-fnCtx.Done()
+		finished = true
+		return fnCtx.Err()
+	})
+
+	var pluginErr *pricing.PluginError
+	if !errors.As(err, &pluginErr) || pluginErr.Code != pricing.ErrorCodeNetworkTimeout {
+		t.Fatalf("ExecuteWithTimeoutBlocking() error = %v, want NETWORK_TIMEOUT", err)
+	}
+	if !finished {
+		t.Error("Expected fn to have returned before ExecuteWithTimeoutBlocking returned")
+	}
+	if got := wrapper.AbandonedGoroutines(); got != 0 {
+		t.Errorf("AbandonedGoroutines() = %d, want 0", got)
+	}
+}
+
+func TestTimeoutWrapperExecuteWithTimeoutBlockingResult(t *testing.T) {
+	wrapper := pricing.NewDefaultTimeoutWrapper()
+	fnErr := errors.New("upstream rejected request")
+
+	if err := wrapper.ExecuteWithTimeoutBlocking(context.Background(), pricing.MethodName, func(context.Context) error {
+		return nil
+	}); err != nil {
+		t.Errorf("ExecuteWithTimeoutBlocking() = %v, want nil", err)
+	}
+	if err := wrapper.ExecuteWithTimeoutBlocking(context.Background(), pricing.MethodName, func(context.Context) error {
+		return fnErr
+	}); !errors.Is(err, fnErr) {
+		t.Errorf("ExecuteWithTimeoutBlocking() = %v, want fn's error", err)
+	}
+}
+
 // TestCircuitBreakerBasics tests basic circuit breaker functionality.
 func TestCircuitBreakerBasics(t *testing.T) {
 	breaker := pricing.NewDefaultCircuitBreaker("test-breaker")
@@ -270,6 +650,245 @@ func TestCircuitBreakerBasics(t *testing.T) {
 	}
 }
 
+// halfOpenBreaker returns a breaker that has just entered the half-open state.
+func halfOpenBreaker(t *testing.T, maxProbes int) *pricing.CircuitBreaker {
+	t.Helper()
+	config := pricing.NewDefaultCircuitBreakerConfig()
+	config.RecoveryTimeout = time.Millisecond
+	config.HalfOpenMaxProbes = maxProbes
+	breaker, err := pricing.NewCircuitBreaker("probe-breaker", config)
+	if err != nil {
+		t.Fatalf("NewCircuitBreaker() unexpected error: %v", err)
+	}
+	breaker.ForceOpen()
+	time.Sleep(2 * time.Millisecond)
+	return breaker
+}
+
+func TestCircuitBreakerHalfOpenMaxProbes(t *testing.T) {
+	breaker := halfOpenBreaker(t, 2)
+
+	done1, ok1 := breaker.Allow()
+	done2, ok2 := breaker.Allow()
+	if !ok1 || !ok2 {
+		t.Fatal("Expected the first 2 half-open probes to be allowed")
+	}
+	if breaker.State() != pricing.CircuitHalfOpen {
+		t.Fatalf("Expected state HalfOpen, got %v", breaker.State())
+	}
+	if _, ok := breaker.Allow(); ok {
+		t.Error("Expected third concurrent probe to be rejected")
+	}
+	if breaker.IsRequestAllowed() {
+		t.Error("Expected IsRequestAllowed to report no free probe slot")
+	}
+	if got := breaker.Metrics().InFlightProbes; got != 2 {
+		t.Errorf("Expected 2 in-flight probes, got %d", got)
+	}
+
+	// Recording an outcome frees a probe slot, and only once
+	done1(nil)
+	done1(nil)
+	if got := breaker.Metrics().InFlightProbes; got != 1 {
+		t.Errorf("Expected 1 in-flight probe after done, got %d", got)
+	}
+	if !breaker.IsRequestAllowed() {
+		t.Error("Expected probe to be allowed after a slot was freed")
+	}
+	if got := breaker.Metrics().InFlightProbes; got != 1 {
+		t.Errorf("Expected IsRequestAllowed not to reserve a slot, got %d in flight", got)
+	}
+	done2(nil)
+}
+
+func TestCircuitBreakerHalfOpenExecuteRejects(t *testing.T) {
+	breaker := halfOpenBreaker(t, 1)
+
+	done, ok := breaker.Allow()
+	if !ok {
+		t.Fatal("Expected first half-open probe to be allowed")
+	}
+	defer done(nil)
+
+	err := breaker.Execute(func() error { return nil })
+	var pluginErr *pricing.PluginError
+	if !errors.As(err, &pluginErr) || pluginErr.Code != pricing.ErrorCodeCircuitOpen {
+		t.Errorf("Expected CircuitOpen error while probe in flight, got %v", err)
+	}
+}
+
+func TestCircuitBreakerHalfOpenUnlimitedProbes(t *testing.T) {
+	breaker := halfOpenBreaker(t, 0)
+
+	for i := range 10 {
+		if _, ok := breaker.Allow(); !ok {
+			t.Fatalf("Expected probe %d to be allowed with unlimited probes", i)
+		}
+	}
+}
+
+func TestCircuitBreakerDefaultProbesUnlimited(t *testing.T) {
+	if got := pricing.NewDefaultCircuitBreakerConfig().HalfOpenMaxProbes; got != 0 {
+		t.Errorf("Expected default HalfOpenMaxProbes 0 (unlimited), got %d", got)
+	}
+}
+
+func TestCircuitBreakerExecutePanicReleasesProbe(t *testing.T) {
+	breaker := halfOpenBreaker(t, 1)
+
+	func() {
+		defer func() {
+			if r := recover(); r == nil {
+				t.Fatal("Expected Execute to propagate the panic")
+			}
+		}()
+		_ = breaker.Execute(func() error { panic("plugin crashed") })
+	}()
+
+	if got := breaker.Metrics().InFlightProbes; got != 0 {
+		t.Errorf("Expected the panicking probe's slot to be released, got %d in flight", got)
+	}
+	if err := breaker.Execute(func() error { return nil }); err != nil {
+		t.Errorf("Expected a new probe to be allowed after the panic, got %v", err)
+	}
+}
+
+func TestCircuitBreakerClosedRequestDoesNotReleaseProbe(t *testing.T) {
+	config := pricing.NewDefaultCircuitBreakerConfig()
+	config.RecoveryTimeout = time.Millisecond
+	config.HalfOpenMaxProbes = 1
+	breaker, err := pricing.NewCircuitBreaker("race-breaker", config)
+	if err != nil {
+		t.Fatalf("NewCircuitBreaker() unexpected error: %v", err)
+	}
+
+	// Admitted while closed: holds no probe slot.
+	closedDone, ok := breaker.Allow()
+	if !ok {
+		t.Fatal("Expected request to be allowed while closed")
+	}
+
+	breaker.ForceOpen()
+	time.Sleep(2 * time.Millisecond)
+	probeDone, ok := breaker.Allow()
+	if !ok || breaker.State() != pricing.CircuitHalfOpen {
+		t.Fatalf("Expected a half-open probe to be allowed, got ok=%v state=%v", ok, breaker.State())
+	}
+
+	// The closed-state request finishing must not free the probe's slot.
+	closedDone(nil)
+	if got := breaker.Metrics().InFlightProbes; got != 1 {
+		t.Errorf("Expected the probe to keep its slot, got %d in flight", got)
+	}
+	if _, ok = breaker.Allow(); ok {
+		t.Error("Expected a second probe to be rejected while the first is in flight")
+	}
+
+	probeDone(nil)
+	if got := breaker.Metrics().InFlightProbes; got != 0 {
+		t.Errorf("Expected the probe's slot to be released, got %d in flight", got)
+	}
+}
+
+// windowedBreaker returns a breaker with a short sliding window that only opens on failure count.
+func windowedBreaker(t *testing.T, window time.Duration) *pricing.CircuitBreaker {
+	t.Helper()
+	config := pricing.NewDefaultCircuitBreakerConfig()
+	config.WindowDuration = window
+	config.WindowBuckets = 5
+	config.RequestVolumeThreshold = 4
+	config.FailureThreshold = 3
+	config.FailureRateThreshold = 1
+	config.ConsecutiveFailureLimit = 100
+	breaker, err := pricing.NewCircuitBreaker("window-breaker", config)
+	if err != nil {
+		t.Fatalf("NewCircuitBreaker() unexpected error: %v", err)
+	}
+	return breaker
+}
+
+func TestCircuitBreakerWindowExpiresOldRequests(t *testing.T) {
+	breaker := windowedBreaker(t, 50*time.Millisecond)
+
+	breaker.RecordFailure(errors.New("boom"))
+	breaker.RecordFailure(errors.New("boom"))
+	metrics := breaker.Metrics()
+	if rate := metrics.FailureRate(); rate != 1 {
+		t.Fatalf("Expected failure rate 1.0 within window, got %v", rate)
+	}
+
+	time.Sleep(70 * time.Millisecond)
+
+	metrics = breaker.Metrics()
+	if metrics.FailureRate() != 0 || metrics.WindowedRequests != 0 {
+		t.Errorf("Expected empty window after expiry, got rate=%v requests=%d",
+			metrics.FailureRate(), metrics.WindowedRequests)
+	}
+	if metrics.TotalRequests != 2 {
+		t.Errorf("Expected lifetime TotalRequests 2, got %d", metrics.TotalRequests)
+	}
+}
+
+func TestCircuitBreakerWindowIgnoresStaleFailures(t *testing.T) {
+	breaker := windowedBreaker(t, 50*time.Millisecond)
+
+	// Two stale failures fall out of the window before fresh traffic arrives
+	breaker.RecordFailure(errors.New("boom"))
+	breaker.RecordFailure(errors.New("boom"))
+	time.Sleep(70 * time.Millisecond)
+
+	breaker.RecordSuccess()
+	breaker.RecordSuccess()
+	breaker.RecordSuccess()
+	breaker.RecordFailure(errors.New("boom"))
+
+	// Lifetime totals (3 failures of 6) would trip FailureThreshold; the window holds only 1
+	if breaker.State() != pricing.CircuitClosed {
+		t.Errorf("Expected circuit to stay closed, got %v", breaker.State())
+	}
+}
+
+func TestCircuitBreakerWindowOpensOnFreshFailures(t *testing.T) {
+	breaker := windowedBreaker(t, time.Minute)
+
+	breaker.RecordSuccess()
+	for range 3 {
+		breaker.RecordFailure(errors.New("boom"))
+	}
+
+	if breaker.State() != pricing.CircuitOpen {
+		t.Errorf("Expected circuit to open on 3 failures within window, got %v", breaker.State())
+	}
+}
+
+func TestCircuitBreakerWindowDisabledUsesLifetimeTotals(t *testing.T) {
+	breaker := windowedBreaker(t, 0)
+
+	breaker.RecordFailure(errors.New("boom"))
+	breaker.RecordSuccess()
+	time.Sleep(10 * time.Millisecond)
+
+	metrics := breaker.Metrics()
+	if metrics.WindowedRequests != 2 || metrics.FailureRate() != 0.5 {
+		t.Errorf("Expected lifetime counts (2 requests, rate 0.5), got requests=%d rate=%v",
+			metrics.WindowedRequests, metrics.FailureRate())
+	}
+}
+
+func TestCircuitBreakerConfigNegativeValues(t *testing.T) {
+	config := pricing.NewDefaultCircuitBreakerConfig()
+	config.HalfOpenMaxProbes = -1
+	if err := config.Validate(); err == nil {
+		t.Error("Expected error for negative HalfOpenMaxProbes")
+	}
+
+	config = pricing.NewDefaultCircuitBreakerConfig()
+	config.WindowDuration = -time.Second
+	if err := config.Validate(); err == nil {
+		t.Error("Expected error for negative WindowDuration")
+	}
+}
+
 // TestTimeoutConfigBasics tests basic timeout configuration functionality.
 func TestTimeoutConfigBasics(t *testing.T) {
 	config := pricing.NewDefaultTimeoutConfig()
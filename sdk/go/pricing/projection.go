@@ -0,0 +1,97 @@
+package pricing
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// Period factors used to convert a per-period rate into a monthly cost.
+//
+// A month is defined as 1/12 of a 365-day year, matching the 730-hour month used by AWS,
+// Azure, and GCP pricing calculators. Every factor derives from that month, so converting
+// between periods is consistent (a daily rate is exactly 24 times the hourly rate).
+const (
+	// HoursPerMonth is the number of hours in an average month (730).
+	HoursPerMonth = 730.0
+
+	// MinutesPerMonth is the number of minutes in an average month (730 * 60).
+	MinutesPerMonth = HoursPerMonth * 60
+
+	// SecondsPerMonth is the number of seconds in an average month (730 * 3600 = 2,628,000).
+	SecondsPerMonth = MinutesPerMonth * 60
+
+	// DaysPerMonth is the number of days in an average month (730 / 24 = 365 / 12, about 30.42).
+	DaysPerMonth = HoursPerMonth / 24
+
+	// MonthsPerYear is the number of months in a year, used to spread yearly rates.
+	MonthsPerYear = 12.0
+)
+
+// ErrMonthlyProjectionUndefined is returned when a billing mode has no fixed period,
+// so a monthly cost cannot be derived from rate and quantity alone (e.g., per_request, on_demand).
+var ErrMonthlyProjectionUndefined = errors.New("monthly projection undefined for billing mode")
+
+// MonthlyPeriodFactor returns the number of billing periods in a month for a time-based
+// billing mode. It returns false for modes without a fixed period, such as usage-based
+// modes (per_request, per_request_gb_second) and pricing models (on_demand, reserved).
+func MonthlyPeriodFactor(mode BillingMode) (float64, bool) {
+	switch mode {
+	case PerHour, PerGBHour, PerCPUHour, PerVCPUHour, PerMemoryGBHour:
+		return HoursPerMonth, true
+	case PerMinute:
+		return MinutesPerMonth, true
+	case PerSecond, PerGBSecond, PerVCPUSecond:
+		return SecondsPerMonth, true
+	case PerDay, PerGBDay:
+		return DaysPerMonth, true
+	case PerMonth, PerGBMonth, PerCPUMonth, PerMemoryGBMonth:
+		return 1, true
+	case PerYear:
+		return 1 / MonthsPerYear, true
+	default:
+		return 0, false
+	}
+}
+
+// ProjectMonthlyCost projects the monthly cost of a resource billed under a time-based mode.
+// Formula: monthly_cost = ratePerUnit * quantity * period_factor
+//
+// Parameters:
+//   - mode: The billing mode of the rate (e.g., per_hour, per_gb_month)
+//   - ratePerUnit: Price per unit per billing period
+//   - quantity: Number of units consumed continuously over the month (instances, GB, vCPUs)
+//
+// Returns ErrMonthlyProjectionUndefined for modes without a fixed period (see MonthlyPeriodFactor).
+//
+// Example:
+//
+//	ProjectMonthlyCost(PerHour, 0.0104, 2)    = 0.0104 * 2 * 730  = $15.18
+//	ProjectMonthlyCost(PerGBMonth, 0.08, 100) = 0.08 * 100 * 1    = $8.00
+//	ProjectMonthlyCost(PerYear, 120, 1)       = 120 * 1 * (1/12)  = $10.00
+func ProjectMonthlyCost(mode BillingMode, ratePerUnit, quantity float64) (float64, error) {
+	factor, ok := MonthlyPeriodFactor(mode)
+	if !ok {
+		return 0, fmt.Errorf("%w: %s", ErrMonthlyProjectionUndefined, mode)
+	}
+	return ratePerUnit * quantity * factor, nil
+}
+
+// daysInCalendarYear returns the number of days in the given calendar year: 366 for leap
+// years (Gregorian rules) and 365 otherwise.
+func daysInCalendarYear(year int) int {
+	return time.Date(year, time.December, 31, 0, 0, 0, 0, time.UTC).YearDay()
+}
+
+// AnnualizeFromDaily projects a daily cost over the actual number of days in the given year.
+// Unlike monthly * 12 or daily * 365 approximations, this accounts for leap years, which keeps
+// annual figures aligned with billing reconciliation.
+// Formula: annual_cost = dailyCost * days_in_year (365, or 366 in leap years)
+//
+// Example:
+//
+//	AnnualizeFromDaily(10, 2023) = 10 * 365 = $3650.00
+//	AnnualizeFromDaily(10, 2024) = 10 * 366 = $3660.00
+func AnnualizeFromDaily(dailyCost float64, year int) float64 {
+	return dailyCost * float64(daysInCalendarYear(year))
+}
@@ -0,0 +1,113 @@
+package pricing_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/rshade/finfocus-spec/sdk/go/pricing"
+)
+
+func TestProjectMonthlyCost(t *testing.T) {
+	tests := []struct {
+		name     string
+		mode     pricing.BillingMode
+		rate     float64
+		quantity float64
+		expected float64
+	}{
+		{"Per hour", pricing.PerHour, 0.0104, 2, 15.184},
+		{"Per minute", pricing.PerMinute, 0.001, 1, 43.8},
+		{"Per second", pricing.PerSecond, 0.000001, 1, 2.628},
+		{"Per day", pricing.PerDay, 1, 1, 730.0 / 24},
+		{"Per month", pricing.PerMonth, 10, 3, 30},
+		{"Per year", pricing.PerYear, 120, 1, 10},
+		{"Per GB month", pricing.PerGBMonth, 0.08, 100, 8},
+		{"Per GB hour", pricing.PerGBHour, 0.0001, 100, 7.3},
+		{"Per vCPU hour", pricing.PerVCPUHour, 0.04, 4, 116.8},
+		{"Per GB second", pricing.PerGBSecond, 0.0000025, 0.5, 3.285},
+		{"Per vCPU second", pricing.PerVCPUSecond, 0.00001, 0.25, 6.57},
+		{"Zero quantity", pricing.PerHour, 0.5, 0, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := pricing.ProjectMonthlyCost(tt.mode, tt.rate, tt.quantity)
+			if err != nil {
+				t.Fatalf("ProjectMonthlyCost() unexpected error: %v", err)
+			}
+			if !almostEqual(got, tt.expected) {
+				t.Errorf("ProjectMonthlyCost() = %v, want %v", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestProjectMonthlyCostUndefined(t *testing.T) {
+	for _, mode := range []pricing.BillingMode{
+		pricing.PerRequest, pricing.PerInvocation, pricing.PerRequestGBSecond,
+		pricing.OnDemand, pricing.Reserved, pricing.Tiered,
+	} {
+		t.Run(mode.String(), func(t *testing.T) {
+			_, err := pricing.ProjectMonthlyCost(mode, 1, 1)
+			if !errors.Is(err, pricing.ErrMonthlyProjectionUndefined) {
+				t.Errorf("ProjectMonthlyCost(%s) error = %v, want ErrMonthlyProjectionUndefined", mode, err)
+			}
+		})
+	}
+}
+
+func TestPeriodFactorConstants(t *testing.T) {
+	if pricing.SecondsPerMonth != 2628000 {
+		t.Errorf("SecondsPerMonth = %v, want 2628000", pricing.SecondsPerMonth)
+	}
+	if pricing.MinutesPerMonth != 43800 {
+		t.Errorf("MinutesPerMonth = %v, want 43800", pricing.MinutesPerMonth)
+	}
+}
+
+func TestAnnualizeFromDaily(t *testing.T) {
+	tests := []struct {
+		name      string
+		dailyCost float64
+		year      int
+		expected  float64
+	}{
+		{"Leap year uses 366 days", 10, 2024, 3660},
+		{"Common year uses 365 days", 10, 2023, 3650},
+		{"Century non-leap year", 1, 1900, 365},
+		{"Quadricentennial leap year", 1, 2000, 366},
+		{"Zero daily cost", 0, 2024, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := pricing.AnnualizeFromDaily(tt.dailyCost, tt.year)
+			if !almostEqual(got, tt.expected) {
+				t.Errorf("AnnualizeFromDaily(%v, %d) = %v, want %v", tt.dailyCost, tt.year, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestAnnualizeFromDailyConsecutiveYears(t *testing.T) {
+	const dailyCost = 2.5
+	for year := 2020; year <= 2028; year++ {
+		days := 365.0
+		if year%4 == 0 {
+			days = 366
+		}
+		got := pricing.AnnualizeFromDaily(dailyCost, year)
+		if !almostEqual(got, dailyCost*days) {
+			t.Errorf("AnnualizeFromDaily(%v, %d) = %v, want %v", dailyCost, year, got, dailyCost*days)
+		}
+	}
+
+	// Four consecutive years always contain exactly one leap day (outside century exceptions)
+	var total float64
+	for year := 2021; year <= 2024; year++ {
+		total += pricing.AnnualizeFromDaily(1, year)
+	}
+	if !almostEqual(total, 1461) {
+		t.Errorf("AnnualizeFromDaily over 2021-2024 = %v, want 1461", total)
+	}
+}
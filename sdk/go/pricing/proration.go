@@ -0,0 +1,54 @@
+package pricing
+
+import (
+	"errors"
+	"time"
+)
+
+// ErrInvalidTimeRange is returned when a proration window ends before it starts.
+var ErrInvalidTimeRange = errors.New("end time must not precede start time")
+
+// ProrateCost calculates the portion of a monthly cost incurred during the window [start, end).
+//
+// The fraction of each calendar month covered by the window is computed against the actual
+// length of that month (28-31 days), so a full February and a full March each prorate to
+// exactly one monthlyCost. Windows crossing month boundaries sum the per-month fractions.
+// Times are evaluated in UTC so daylight-saving transitions do not distort month lengths.
+//
+// Parameters:
+//   - monthlyCost: The cost for one full calendar month
+//   - start: Inclusive start of the usage window
+//   - end: Exclusive end of the usage window
+//
+// Returns ErrInvalidTimeRange if end precedes start. An empty window (start == end) costs 0.
+//
+// Example:
+//
+//	monthlyCost=$300, start=Jun 16 00:00, end=Jul 16 00:00
+//	June: 15 of 30 days = 0.5000 * $300 = $150.00
+//	July: 15 of 31 days = 0.4839 * $300 = $145.16
+//	Total: $295.16
+func ProrateCost(monthlyCost float64, start, end time.Time) (float64, error) {
+	if end.Before(start) {
+		return 0, ErrInvalidTimeRange
+	}
+
+	start = start.UTC()
+	end = end.UTC()
+
+	fraction := 0.0
+	for cursor := start; cursor.Before(end); {
+		monthStart := time.Date(cursor.Year(), cursor.Month(), 1, 0, 0, 0, 0, time.UTC)
+		nextMonth := monthStart.AddDate(0, 1, 0)
+
+		segmentEnd := end
+		if nextMonth.Before(end) {
+			segmentEnd = nextMonth
+		}
+
+		fraction += float64(segmentEnd.Sub(cursor)) / float64(nextMonth.Sub(monthStart))
+		cursor = segmentEnd
+	}
+
+	return monthlyCost * fraction, nil
+}
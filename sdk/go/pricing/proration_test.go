@@ -0,0 +1,66 @@
+package pricing_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/rshade/finfocus-spec/sdk/go/pricing"
+)
+
+func utcDate(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+func TestProrateCost(t *testing.T) {
+	tests := []struct {
+		name     string
+		start    time.Time
+		end      time.Time
+		expected float64
+	}{
+		{"Full 30-day month", utcDate(2024, time.June, 1), utcDate(2024, time.July, 1), 300},
+		{"Full 31-day month", utcDate(2024, time.July, 1), utcDate(2024, time.August, 1), 300},
+		{"Full leap February", utcDate(2024, time.February, 1), utcDate(2024, time.March, 1), 300},
+		{"Half of June", utcDate(2024, time.June, 1), utcDate(2024, time.June, 16), 150},
+		{"One day of February 2023", utcDate(2023, time.February, 10), utcDate(2023, time.February, 11), 300.0 / 28},
+		{"Crosses month boundary", utcDate(2024, time.June, 16), utcDate(2024, time.July, 16), 150 + 300*15.0/31},
+		{"Spans three months", utcDate(2024, time.January, 31), utcDate(2024, time.March, 2), 300/31.0 + 300 + 300/31.0},
+		{"Crosses year boundary", utcDate(2023, time.December, 1), utcDate(2024, time.February, 1), 600},
+		{"Empty window", utcDate(2024, time.June, 1), utcDate(2024, time.June, 1), 0},
+		{"Half day", utcDate(2024, time.June, 1), utcDate(2024, time.June, 1).Add(12 * time.Hour), 5},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := pricing.ProrateCost(300, tt.start, tt.end)
+			if err != nil {
+				t.Fatalf("ProrateCost() unexpected error: %v", err)
+			}
+			if !almostEqual(got, tt.expected) {
+				t.Errorf("ProrateCost() = %v, want %v", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestProrateCostNonUTC(t *testing.T) {
+	loc := time.FixedZone("UTC-5", -5*60*60)
+	start := time.Date(2024, time.June, 1, 0, 0, 0, 0, loc)
+	end := start.Add(24 * time.Hour)
+
+	got, err := pricing.ProrateCost(300, start, end)
+	if err != nil {
+		t.Fatalf("ProrateCost() unexpected error: %v", err)
+	}
+	if !almostEqual(got, 10) {
+		t.Errorf("ProrateCost() = %v, want 10", got)
+	}
+}
+
+func TestProrateCostInvalidRange(t *testing.T) {
+	_, err := pricing.ProrateCost(300, utcDate(2024, time.June, 2), utcDate(2024, time.June, 1))
+	if !errors.Is(err, pricing.ErrInvalidTimeRange) {
+		t.Errorf("ProrateCost() error = %v, want ErrInvalidTimeRange", err)
+	}
+}
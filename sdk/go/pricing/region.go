@@ -0,0 +1,168 @@
+package pricing
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/rshade/finfocus-spec/sdk/go/pluginsdk/mapping"
+)
+
+// Region normalization errors.
+var (
+	// ErrEmptyRegion is returned when the region string is empty or whitespace.
+	ErrEmptyRegion = errors.New("region is required")
+
+	// ErrUnknownRegion is returned when the region cannot be mapped to a known region of the provider.
+	ErrUnknownRegion = errors.New("unknown region")
+)
+
+// awsRegions maps AWS region codes to the display names used by the console and Price List API.
+//
+//nolint:gochecknoglobals // Intentional: read-only reference data
+var awsRegions = map[string]string{
+	"af-south-1":     "Africa (Cape Town)",
+	"ap-east-1":      "Asia Pacific (Hong Kong)",
+	"ap-northeast-1": "Asia Pacific (Tokyo)",
+	"ap-northeast-2": "Asia Pacific (Seoul)",
+	"ap-northeast-3": "Asia Pacific (Osaka)",
+	"ap-south-1":     "Asia Pacific (Mumbai)",
+	"ap-south-2":     "Asia Pacific (Hyderabad)",
+	"ap-southeast-1": "Asia Pacific (Singapore)",
+	"ap-southeast-2": "Asia Pacific (Sydney)",
+	"ap-southeast-3": "Asia Pacific (Jakarta)",
+	"ap-southeast-4": "Asia Pacific (Melbourne)",
+	"ap-southeast-5": "Asia Pacific (Malaysia)",
+	"ap-southeast-7": "Asia Pacific (Thailand)",
+	"ca-central-1":   "Canada (Central)",
+	"ca-west-1":      "Canada West (Calgary)",
+	"eu-central-1":   "Europe (Frankfurt)",
+	"eu-central-2":   "Europe (Zurich)",
+	"eu-north-1":     "Europe (Stockholm)",
+	"eu-south-1":     "Europe (Milan)",
+	"eu-south-2":     "Europe (Spain)",
+	"eu-west-1":      "Europe (Ireland)",
+	"eu-west-2":      "Europe (London)",
+	"eu-west-3":      "Europe (Paris)",
+	"il-central-1":   "Israel (Tel Aviv)",
+	"me-central-1":   "Middle East (UAE)",
+	"me-south-1":     "Middle East (Bahrain)",
+	"mx-central-1":   "Mexico (Central)",
+	"sa-east-1":      "South America (Sao Paulo)",
+	"us-east-1":      "US East (N. Virginia)",
+	"us-east-2":      "US East (Ohio)",
+	"us-gov-east-1":  "AWS GovCloud (US-East)",
+	"us-gov-west-1":  "AWS GovCloud (US-West)",
+	"us-west-1":      "US West (N. California)",
+	"us-west-2":      "US West (Oregon)",
+}
+
+// azureRegions contains the programmatic names of Azure public cloud regions. Display names
+// ("East US 2") map to these by lowercasing and removing spaces.
+//
+//nolint:gochecknoglobals // Intentional: read-only reference data
+var azureRegions = map[string]struct{}{
+	"australiacentral": {}, "australiaeast": {}, "australiasoutheast": {},
+	"brazilsouth": {}, "canadacentral": {}, "canadaeast": {},
+	"centralindia": {}, "centralus": {}, "eastasia": {},
+	"eastus": {}, "eastus2": {}, "francecentral": {},
+	"germanywestcentral": {}, "israelcentral": {}, "italynorth": {},
+	"japaneast": {}, "japanwest": {}, "koreacentral": {},
+	"koreasouth": {}, "mexicocentral": {}, "newzealandnorth": {},
+	"northcentralus": {}, "northeurope": {}, "norwayeast": {},
+	"polandcentral": {}, "qatarcentral": {}, "southafricanorth": {},
+	"southcentralus": {}, "southeastasia": {}, "southindia": {},
+	"spaincentral": {}, "swedencentral": {}, "switzerlandnorth": {},
+	"uaenorth": {}, "uksouth": {}, "ukwest": {},
+	"westcentralus": {}, "westeurope": {}, "westindia": {},
+	"westus": {}, "westus2": {}, "westus3": {},
+}
+
+// NormalizeRegion converts a region string to the canonical programmatic region of the
+// provider, so pricing lookups key on one spelling regardless of where the region came from.
+//
+// Accepted forms per provider:
+//   - aws: region codes ("us-east-1"), display names ("US East (N. Virginia)"), and
+//     availability zones ("us-east-1a")
+//   - azure: programmatic names ("eastus") and display names ("East US")
+//   - gcp: region names ("us-central1") and zones ("us-central1-a")
+//
+// Matching is case-insensitive and ignores surrounding whitespace. Kubernetes and custom
+// providers have no region catalog, so their regions are returned trimmed but otherwise unchanged.
+//
+// Parameters:
+//   - provider: The provider name (see GetAllProviders)
+//   - region: The region string to normalize
+//
+// Returns ErrEmptyRegion for an empty region, ErrInvalidProvider for an unknown provider, and
+// ErrUnknownRegion when the region does not match any known region of the provider.
+//
+// Example:
+//
+//	region, err := pricing.NormalizeRegion("aws", "US East (N. Virginia)") // "us-east-1"
+//	region, err = pricing.NormalizeRegion("azure", "East US 2")            // "eastus2"
+//	region, err = pricing.NormalizeRegion("gcp", "us-central1-a")          // "us-central1"
+func NormalizeRegion(provider, region string) (string, error) {
+	trimmed := strings.TrimSpace(region)
+	if trimmed == "" {
+		return "", ErrEmptyRegion
+	}
+
+	var canonical string
+	switch Provider(provider) {
+	case AWS:
+		canonical = normalizeAWSRegion(trimmed)
+	case Azure:
+		canonical = normalizeAzureRegion(trimmed)
+	case GCP:
+		canonical = normalizeGCPRegion(trimmed)
+	case Kubernetes, Custom:
+		return trimmed, nil
+	default:
+		return "", fmt.Errorf("%w: %q", ErrInvalidProvider, provider)
+	}
+
+	if canonical == "" {
+		return "", fmt.Errorf("%w: %q for provider %s", ErrUnknownRegion, region, provider)
+	}
+	return canonical, nil
+}
+
+// normalizeAWSRegion returns the AWS region code for region, or "" if it is not a known region.
+func normalizeAWSRegion(region string) string {
+	code := strings.ToLower(region)
+	if _, ok := awsRegions[code]; ok {
+		return code
+	}
+	for c, name := range awsRegions {
+		if strings.EqualFold(name, region) {
+			return c
+		}
+	}
+	if code = mapping.ExtractAWSRegionFromAZ(code); code != "" {
+		if _, ok := awsRegions[code]; ok {
+			return code
+		}
+	}
+	return ""
+}
+
+// normalizeAzureRegion returns the Azure programmatic region name for region, or "" if it is
+// not a known region.
+func normalizeAzureRegion(region string) string {
+	name := strings.ToLower(strings.ReplaceAll(region, " ", ""))
+	if _, ok := azureRegions[name]; ok {
+		return name
+	}
+	return ""
+}
+
+// normalizeGCPRegion returns the GCP region for a region or zone name, or "" if it is not a
+// known region.
+func normalizeGCPRegion(region string) string {
+	name := strings.ToLower(region)
+	if mapping.IsValidGCPRegion(name) {
+		return name
+	}
+	return mapping.ExtractGCPRegionFromZone(name)
+}
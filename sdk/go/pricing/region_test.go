@@ -0,0 +1,87 @@
+package pricing_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/rshade/finfocus-spec/sdk/go/pricing"
+)
+
+func TestNormalizeRegion(t *testing.T) {
+	tests := []struct {
+		name     string
+		provider string
+		region   string
+		want     string
+	}{
+		{"AWS region code", "aws", "us-east-1", "us-east-1"},
+		{"AWS display name", "aws", "US East (N. Virginia)", "us-east-1"},
+		{"AWS display name case-insensitive", "aws", "europe (frankfurt)", "eu-central-1"},
+		{"AWS availability zone", "aws", "eu-west-2b", "eu-west-2"},
+		{"AWS uppercase code with whitespace", "aws", "  US-WEST-2 ", "us-west-2"},
+		{"Azure programmatic name", "azure", "eastus", "eastus"},
+		{"Azure display name", "azure", "East US 2", "eastus2"},
+		{"Azure multi-word display name", "azure", "Germany West Central", "germanywestcentral"},
+		{"GCP region", "gcp", "us-central1", "us-central1"},
+		{"GCP zone", "gcp", "europe-west4-a", "europe-west4"},
+		{"GCP uppercase region", "gcp", "ASIA-EAST1", "asia-east1"},
+		{"Kubernetes region passes through", "kubernetes", " on-prem-dc1 ", "on-prem-dc1"},
+		{"Custom region passes through", "custom", "Lab Rack 4", "Lab Rack 4"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := pricing.NormalizeRegion(tt.provider, tt.region)
+			if err != nil {
+				t.Fatalf("NormalizeRegion(%q, %q) error = %v", tt.provider, tt.region, err)
+			}
+			if got != tt.want {
+				t.Errorf("NormalizeRegion(%q, %q) = %q, want %q", tt.provider, tt.region, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNormalizeRegion_CanonicalIsStable(t *testing.T) {
+	for _, tt := range []struct{ provider, region string }{
+		{"aws", "ap-southeast-2"},
+		{"azure", "westeurope"},
+		{"gcp", "southamerica-east1"},
+	} {
+		got, err := pricing.NormalizeRegion(tt.provider, tt.region)
+		if err != nil || got != tt.region {
+			t.Errorf("NormalizeRegion(%q, %q) = (%q, %v), want canonical region unchanged",
+				tt.provider, tt.region, got, err)
+		}
+	}
+}
+
+func TestNormalizeRegion_Errors(t *testing.T) {
+	tests := []struct {
+		name     string
+		provider string
+		region   string
+		wantErr  error
+	}{
+		{"Unknown AWS region", "aws", "mars-north-1", pricing.ErrUnknownRegion},
+		{"AWS zone of unknown region", "aws", "mars-north-1a", pricing.ErrUnknownRegion},
+		{"Unknown Azure region", "azure", "Mars Central", pricing.ErrUnknownRegion},
+		{"Unknown GCP zone", "gcp", "mars-central1-a", pricing.ErrUnknownRegion},
+		{"Region of another provider", "gcp", "us-east-1", pricing.ErrUnknownRegion},
+		{"Empty region", "aws", "", pricing.ErrEmptyRegion},
+		{"Whitespace region", "azure", "   ", pricing.ErrEmptyRegion},
+		{"Invalid provider", "oracle", "us-ashburn-1", pricing.ErrInvalidProvider},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := pricing.NormalizeRegion(tt.provider, tt.region)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("NormalizeRegion(%q, %q) error = %v, want %v", tt.provider, tt.region, err, tt.wantErr)
+			}
+			if got != "" {
+				t.Errorf("NormalizeRegion(%q, %q) = %q, want empty on error", tt.provider, tt.region, got)
+			}
+		})
+	}
+}
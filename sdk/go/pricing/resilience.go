@@ -0,0 +1,109 @@
+package pricing
+
+import (
+	"context"
+	"errors"
+)
+
+// ResilientExecutor composes a RetryPolicy, a CircuitBreaker, and a TimeoutWrapper around
+// plugin calls in the correct order: the timeout is applied innermost to each attempt, the
+// circuit breaker wraps each timed attempt, and retries run outermost.
+//
+// Errors are classified at each layer:
+//   - Timeout: an attempt that exceeds the method timeout fails with a NETWORK_TIMEOUT
+//     transient error, which the default retry policies retry.
+//   - Circuit breaker: only failures that indicate an unhealthy backend (transient errors and
+//     errors that are not PluginErrors) count against the breaker. Permanent and configuration
+//     PluginErrors mean the backend answered, and a canceled caller context says nothing about
+//     the backend, so both are recorded as successes. While open, the breaker fails fast with
+//     a CIRCUIT_OPEN transient error without calling fn.
+//   - Retry: the RetryPolicy decides what to retry. CIRCUIT_OPEN is only retried by policies
+//     that list it (such as NewAggressiveRetryPolicy), after the breaker's recovery timeout.
+//
+// A nil component is left out of the chain: a nil RetryPolicy makes a single attempt, a nil
+// CircuitBreaker never short-circuits, and a nil TimeoutWrapper applies no timeout.
+//
+// A ResilientExecutor is safe for concurrent use if its components are.
+//
+// Example:
+//
+//	executor := NewResilientExecutor(
+//		NewDefaultRetryPolicy(),
+//		NewDefaultCircuitBreaker("aws-pricing"),
+//		NewDefaultTimeoutWrapper(),
+//	)
+//	err := executor.Execute(ctx, MethodGetActualCost, func(ctx context.Context) error {
+//		resp, err = client.GetActualCost(ctx, req)
+//		return err
+//	})
+type ResilientExecutor struct {
+	retry   *RetryPolicy
+	breaker *CircuitBreaker
+	timeout *TimeoutWrapper
+}
+
+// NewResilientExecutor creates a ResilientExecutor from its components, any of which may be nil.
+//
+// Parameters:
+//   - retry: Retry policy applied outermost (nil for a single attempt)
+//   - cb: Circuit breaker checked before each attempt (nil to disable)
+//   - timeout: Per-method timeout applied to each attempt (nil to disable)
+func NewResilientExecutor(retry *RetryPolicy, cb *CircuitBreaker, timeout *TimeoutWrapper) *ResilientExecutor {
+	return &ResilientExecutor{retry: retry, breaker: cb, timeout: timeout}
+}
+
+// Execute runs fn with retry, circuit breaker, and timeout protection. The method name (see
+// MethodName and related constants) selects the timeout from the TimeoutWrapper's config.
+//
+// Returns nil on success, the last attempt's error once the retry policy gives up, the
+// context's error if ctx is done between attempts, or an "invalid retry policy" error if the
+// RetryPolicy fails validation.
+func (re *ResilientExecutor) Execute(ctx context.Context, method string, fn func(context.Context) error) error {
+	attempt := func() error {
+		return re.executeWithBreaker(ctx, method, fn)
+	}
+	if re.retry == nil {
+		return attempt()
+	}
+	return RetryWithPolicy(ctx, re.retry, attempt)
+}
+
+// executeWithBreaker runs one timed attempt through the circuit breaker.
+func (re *ResilientExecutor) executeWithBreaker(
+	ctx context.Context,
+	method string,
+	fn func(context.Context) error,
+) error {
+	if re.breaker == nil {
+		return re.executeWithTimeout(ctx, method, fn)
+	}
+
+	return re.breaker.execute(func() error {
+		return re.executeWithTimeout(ctx, method, fn)
+	}, isBreakerFailure)
+}
+
+// executeWithTimeout runs fn under the method's timeout, if a TimeoutWrapper is configured.
+func (re *ResilientExecutor) executeWithTimeout(
+	ctx context.Context,
+	method string,
+	fn func(context.Context) error,
+) error {
+	if re.timeout == nil {
+		return fn(ctx)
+	}
+	return re.timeout.ExecuteWithTimeout(ctx, method, fn)
+}
+
+// isBreakerFailure reports whether err indicates an unhealthy backend and should count
+// against the circuit breaker.
+func isBreakerFailure(err error) bool {
+	if err == nil || errors.Is(err, context.Canceled) {
+		return false
+	}
+	var pluginErr *PluginError
+	if errors.As(err, &pluginErr) {
+		return pluginErr.Category == TransientError
+	}
+	return true
+}
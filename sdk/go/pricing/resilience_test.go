@@ -0,0 +1,135 @@
+package pricing_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/rshade/finfocus-spec/sdk/go/pricing"
+)
+
+// oneSecondTimeouts returns a timeout wrapper using the minimum allowed timeout for every method.
+func oneSecondTimeouts(t *testing.T) *pricing.TimeoutWrapper {
+	t.Helper()
+	wrapper, err := pricing.NewTimeoutWrapper(&pricing.TimeoutConfig{
+		NameTimeout:             time.Second,
+		SupportsTimeout:         time.Second,
+		GetActualCostTimeout:    time.Second,
+		GetProjectedCostTimeout: time.Second,
+		GetPricingSpecTimeout:   time.Second,
+		GlobalTimeout:           time.Second,
+	})
+	if err != nil {
+		t.Fatalf("NewTimeoutWrapper() unexpected error: %v", err)
+	}
+	return wrapper
+}
+
+func TestResilientExecutor_TransientFailureRetriesThroughBreaker(t *testing.T) {
+	breaker := pricing.NewDefaultCircuitBreaker("transient")
+	executor := pricing.NewResilientExecutor(fastRetryPolicy(3), breaker, pricing.NewDefaultTimeoutWrapper())
+
+	calls := 0
+	err := executor.Execute(context.Background(), pricing.MethodGetActualCost, func(context.Context) error {
+		calls++
+		if calls < 3 {
+			return pricing.NewTransientError(pricing.ErrorCodeNetworkTimeout, "connection reset", nil)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Execute() unexpected error: %v", err)
+	}
+	if calls != 3 {
+		t.Errorf("fn called %d times, want 3", calls)
+	}
+
+	metrics := breaker.Metrics()
+	if metrics.FailedRequests != 2 || metrics.SuccessfulRequests != 1 {
+		t.Errorf("breaker recorded %d failures and %d successes, want 2 and 1",
+			metrics.FailedRequests, metrics.SuccessfulRequests)
+	}
+}
+
+func TestResilientExecutor_OpenBreakerShortCircuits(t *testing.T) {
+	breaker := pricing.NewDefaultCircuitBreaker("open")
+	breaker.ForceOpen()
+	executor := pricing.NewResilientExecutor(fastRetryPolicy(3), breaker, pricing.NewDefaultTimeoutWrapper())
+
+	calls := 0
+	err := executor.Execute(context.Background(), pricing.MethodGetActualCost, func(context.Context) error {
+		calls++
+		return nil
+	})
+	if calls != 0 {
+		t.Errorf("fn called %d times with an open breaker, want 0", calls)
+	}
+
+	var pluginErr *pricing.PluginError
+	if !errors.As(err, &pluginErr) || pluginErr.Code != pricing.ErrorCodeCircuitOpen {
+		t.Fatalf("Execute() error = %v, want CIRCUIT_OPEN", err)
+	}
+}
+
+func TestResilientExecutor_TimeoutIsRetryable(t *testing.T) {
+	breaker := pricing.NewDefaultCircuitBreaker("timeout")
+	executor := pricing.NewResilientExecutor(fastRetryPolicy(1), breaker, oneSecondTimeouts(t))
+
+	calls := 0
+	err := executor.Execute(context.Background(), pricing.MethodGetActualCost, func(ctx context.Context) error {
+		calls++
+		if calls == 1 {
+			<-ctx.Done()
+			return ctx.Err()
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Execute() unexpected error: %v", err)
+	}
+	if calls != 2 {
+		t.Errorf("fn called %d times, want a retry after the timeout", calls)
+	}
+	if metrics := breaker.Metrics(); metrics.FailedRequests != 1 {
+		t.Errorf("breaker recorded %d failures, want the timeout counted", metrics.FailedRequests)
+	}
+}
+
+func TestResilientExecutor_PermanentErrorNotRetried(t *testing.T) {
+	breaker := pricing.NewDefaultCircuitBreaker("permanent")
+	executor := pricing.NewResilientExecutor(fastRetryPolicy(3), breaker, pricing.NewDefaultTimeoutWrapper())
+
+	permanent := pricing.NewPermanentError(pricing.ErrorCodeInvalidResource, "unknown instance type")
+	calls := 0
+	err := executor.Execute(context.Background(), pricing.MethodGetProjectedCost, func(context.Context) error {
+		calls++
+		return permanent
+	})
+	if !errors.Is(err, permanent) {
+		t.Fatalf("Execute() error = %v, want the permanent error", err)
+	}
+	if calls != 1 {
+		t.Errorf("fn called %d times, want 1", calls)
+	}
+	if metrics := breaker.Metrics(); metrics.FailedRequests != 0 {
+		t.Errorf("breaker recorded %d failures, want permanent errors ignored", metrics.FailedRequests)
+	}
+}
+
+func TestResilientExecutor_NilComponents(t *testing.T) {
+	executor := pricing.NewResilientExecutor(nil, nil, nil)
+
+	calls := 0
+	transient := pricing.NewTransientError(pricing.ErrorCodeTemporaryFailure, "try again", nil)
+	err := executor.Execute(context.Background(), pricing.MethodName, func(context.Context) error {
+		calls++
+		return transient
+	})
+	if !errors.Is(err, transient) {
+		t.Fatalf("Execute() error = %v, want the transient error", err)
+	}
+	if calls != 1 {
+		t.Errorf("fn called %d times without a retry policy, want 1", calls)
+	}
+}
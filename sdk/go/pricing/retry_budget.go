@@ -0,0 +1,93 @@
+package pricing
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+)
+
+// ErrRetryBudgetExhausted is returned by RetryWithBudget when a retry was needed but the
+// shared retry budget had no capacity left. It wraps the last error returned by the function.
+var ErrRetryBudgetExhausted = errors.New("retry budget exhausted")
+
+// RetryBudget caps the total number of retries across many calls within a sliding time window.
+//
+// A single RetryPolicy bounds retries for one call, but during an outage many concurrent
+// operations retrying independently multiply load on an already struggling cloud API.
+// Sharing one RetryBudget across those operations bounds the aggregate retry rate.
+//
+// RetryBudget is safe for concurrent use.
+type RetryBudget struct {
+	mu         sync.Mutex
+	maxRetries int
+	window     time.Duration
+	consumed   []time.Time // retry timestamps within the current window, oldest first
+}
+
+// NewRetryBudget creates a retry budget allowing at most maxRetriesPerWindow retries in any
+// sliding window of the given duration. A maxRetriesPerWindow <= 0 allows no retries.
+// A window <= 0 disables expiry, so at most maxRetriesPerWindow retries are ever allowed.
+//
+// Example:
+//
+//	budget := pricing.NewRetryBudget(20, time.Minute) // shared by all plugin operations
+//	err := pricing.RetryWithBudget(ctx, policy, budget, fetchPrices)
+func NewRetryBudget(maxRetriesPerWindow int, window time.Duration) *RetryBudget {
+	if maxRetriesPerWindow < 0 {
+		maxRetriesPerWindow = 0
+	}
+	return &RetryBudget{
+		maxRetries: maxRetriesPerWindow,
+		window:     window,
+		consumed:   make([]time.Time, 0, maxRetriesPerWindow),
+	}
+}
+
+// TryConsume reserves one retry from the budget. It returns false, without consuming
+// anything, if the budget has no capacity left in the current window.
+func (rb *RetryBudget) TryConsume() bool {
+	rb.mu.Lock()
+	defer rb.mu.Unlock()
+
+	now := time.Now()
+	rb.expire(now)
+	if len(rb.consumed) >= rb.maxRetries {
+		return false
+	}
+	rb.consumed = append(rb.consumed, now)
+	return true
+}
+
+// Remaining returns the number of retries still available in the current window.
+func (rb *RetryBudget) Remaining() int {
+	rb.mu.Lock()
+	defer rb.mu.Unlock()
+
+	rb.expire(time.Now())
+	return rb.maxRetries - len(rb.consumed)
+}
+
+// expire drops retries that have left the sliding window. Callers must hold rb.mu.
+func (rb *RetryBudget) expire(now time.Time) {
+	if rb.window <= 0 {
+		return
+	}
+	cutoff := now.Add(-rb.window)
+	i := 0
+	for i < len(rb.consumed) && !rb.consumed[i].After(cutoff) {
+		i++
+	}
+	if i > 0 {
+		rb.consumed = append(rb.consumed[:0], rb.consumed[i:]...)
+	}
+}
+
+// RetryWithBudget executes a function with retry logic based on the provided policy,
+// consuming one unit of the shared budget before each retry. The first attempt never
+// consumes budget. Once the budget is exhausted, it stops retrying and returns an error
+// wrapping both ErrRetryBudgetExhausted and the last error from fn.
+// A nil budget behaves exactly like RetryWithPolicy.
+func RetryWithBudget(ctx context.Context, policy *RetryPolicy, budget *RetryBudget, fn RetryFunc) error {
+	return retryWithPolicy(ctx, policy, budget, fn)
+}
@@ -0,0 +1,158 @@
+package pricing_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/rshade/finfocus-spec/sdk/go/pricing"
+)
+
+func fastRetryPolicy(maxRetries int) *pricing.RetryPolicy {
+	return &pricing.RetryPolicy{
+		MaxRetries:      maxRetries,
+		BaseDelay:       time.Millisecond,
+		MaxDelay:        2 * time.Millisecond,
+		Multiplier:      2.0,
+		RetryableErrors: []pricing.ErrorCode{pricing.ErrorCodeNetworkTimeout},
+	}
+}
+
+func TestRetryBudgetTryConsume(t *testing.T) {
+	budget := pricing.NewRetryBudget(3, time.Hour)
+
+	for i := range 3 {
+		if !budget.TryConsume() {
+			t.Fatalf("TryConsume() #%d = false, want true", i+1)
+		}
+	}
+	if budget.TryConsume() {
+		t.Error("TryConsume() after exhausting budget = true, want false")
+	}
+	if got := budget.Remaining(); got != 0 {
+		t.Errorf("Remaining() = %d, want 0", got)
+	}
+}
+
+func TestRetryBudgetWindowReplenishes(t *testing.T) {
+	window := 50 * time.Millisecond
+	budget := pricing.NewRetryBudget(1, window)
+
+	if !budget.TryConsume() {
+		t.Fatal("TryConsume() = false, want true")
+	}
+	if budget.TryConsume() {
+		t.Fatal("TryConsume() within window = true, want false")
+	}
+
+	time.Sleep(window + 20*time.Millisecond)
+	if !budget.TryConsume() {
+		t.Error("TryConsume() after window elapsed = false, want true")
+	}
+}
+
+func TestRetryBudgetZeroAndNoWindow(t *testing.T) {
+	if pricing.NewRetryBudget(0, time.Minute).TryConsume() {
+		t.Error("zero budget TryConsume() = true, want false")
+	}
+
+	noExpiry := pricing.NewRetryBudget(1, 0)
+	noExpiry.TryConsume()
+	time.Sleep(5 * time.Millisecond)
+	if noExpiry.TryConsume() {
+		t.Error("budget without window replenished, want no expiry")
+	}
+}
+
+func TestRetryBudgetConcurrent(t *testing.T) {
+	budget := pricing.NewRetryBudget(50, time.Hour)
+
+	var (
+		wg      sync.WaitGroup
+		mu      sync.Mutex
+		granted int
+	)
+	for range 200 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if budget.TryConsume() {
+				mu.Lock()
+				granted++
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+
+	if granted != 50 {
+		t.Errorf("granted %d retries across goroutines, want 50", granted)
+	}
+}
+
+func TestRetryWithBudget(t *testing.T) {
+	transient := pricing.NewTransientError(pricing.ErrorCodeNetworkTimeout, "timeout", nil)
+
+	t.Run("stops retrying when budget exhausted", func(t *testing.T) {
+		budget := pricing.NewRetryBudget(2, time.Hour)
+		calls := 0
+		err := pricing.RetryWithBudget(context.Background(), fastRetryPolicy(5), budget, func() error {
+			calls++
+			return transient
+		})
+		if !errors.Is(err, pricing.ErrRetryBudgetExhausted) {
+			t.Errorf("error = %v, want ErrRetryBudgetExhausted", err)
+		}
+		if !errors.Is(err, transient) {
+			t.Errorf("error = %v, want it to wrap the last function error", err)
+		}
+		if calls != 3 {
+			t.Errorf("calls = %d, want 3 (initial attempt + 2 budgeted retries)", calls)
+		}
+	})
+
+	t.Run("budget shared across calls", func(t *testing.T) {
+		budget := pricing.NewRetryBudget(1, time.Hour)
+		failing := func() error { return transient }
+
+		_ = pricing.RetryWithBudget(context.Background(), fastRetryPolicy(1), budget, failing)
+		calls := 0
+		err := pricing.RetryWithBudget(context.Background(), fastRetryPolicy(1), budget, func() error {
+			calls++
+			return transient
+		})
+		if !errors.Is(err, pricing.ErrRetryBudgetExhausted) {
+			t.Errorf("error = %v, want ErrRetryBudgetExhausted", err)
+		}
+		if calls != 1 {
+			t.Errorf("calls = %d, want 1 (no retries left in shared budget)", calls)
+		}
+	})
+
+	t.Run("success does not consume budget", func(t *testing.T) {
+		budget := pricing.NewRetryBudget(1, time.Hour)
+		err := pricing.RetryWithBudget(context.Background(), fastRetryPolicy(3), budget, func() error { return nil })
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if got := budget.Remaining(); got != 1 {
+			t.Errorf("Remaining() = %d, want 1", got)
+		}
+	})
+
+	t.Run("nil budget behaves like RetryWithPolicy", func(t *testing.T) {
+		calls := 0
+		err := pricing.RetryWithBudget(context.Background(), fastRetryPolicy(2), nil, func() error {
+			calls++
+			return transient
+		})
+		if errors.Is(err, pricing.ErrRetryBudgetExhausted) || !errors.Is(err, transient) {
+			t.Errorf("error = %v, want the function error only", err)
+		}
+		if calls != 3 {
+			t.Errorf("calls = %d, want 3", calls)
+		}
+	})
+}
@@ -0,0 +1,68 @@
+package pricing
+
+import (
+	"errors"
+	"fmt"
+	"math"
+)
+
+// Rightsizing errors.
+var (
+	// ErrInvalidUsage is returned when a rightsizing calculation receives zero or negative usage.
+	ErrInvalidUsage = errors.New("usage must be > 0")
+
+	// ErrNegativeSavings is returned when the proposed SKU costs more than the current one.
+	ErrNegativeSavings = errors.New("proposed rate exceeds current rate (negative savings)")
+)
+
+// RightsizeSavings calculates the monthly savings of moving a resource from its current SKU
+// to a proposed SKU billed under the same mode.
+// Formula: savings = monthly_cost(currentRate) - monthly_cost(proposedRate)
+//
+// Both rates are normalized to a monthly cost for the given usage:
+//   - Time-based modes (per_hour, per_gb_month, ...) use ProjectMonthlyCost, where usage is
+//     the quantity running continuously (instances, GB, vCPUs).
+//   - Modes without a fixed period (per_request, per_iops, ...) treat usage as the number of
+//     units consumed per month.
+//   - Pricing models (on_demand, reserved, tiered, ...) return ErrMonthlyProjectionUndefined.
+//
+// Parameters:
+//   - currentRate: Price per unit of the current SKU
+//   - proposedRate: Price per unit of the proposed SKU
+//   - mode: Billing mode shared by both rates
+//   - usage: Quantity consumed; must be > 0
+//
+// Rule: a proposal that costs more is not a rightsizing saving. When the proposed monthly cost
+// exceeds the current one, the (negative) difference is returned together with an error
+// wrapping ErrNegativeSavings, so callers can report the increase or discard the proposal.
+//
+// Example:
+//
+//	m5.xlarge ($0.192/hr) -> m5.large ($0.096/hr), 1 instance
+//	Current:  $0.192 * 1 * 730 = $140.16
+//	Proposed: $0.096 * 1 * 730 = $70.08
+//	Savings:  $70.08/month
+func RightsizeSavings(currentRate, proposedRate float64, mode BillingMode, usage float64) (float64, error) {
+	for _, rate := range []float64{currentRate, proposedRate} {
+		if rate < 0 || math.IsNaN(rate) || math.IsInf(rate, 0) {
+			return 0, fmt.Errorf("%w: %v", ErrInvalidRate, rate)
+		}
+	}
+	if usage <= 0 || math.IsNaN(usage) || math.IsInf(usage, 0) {
+		return 0, fmt.Errorf("%w: %v", ErrInvalidUsage, usage)
+	}
+
+	factor, ok := MonthlyPeriodFactor(mode)
+	if !ok {
+		if _, usageBased := ExpectedUnit(mode); !usageBased {
+			return 0, fmt.Errorf("%w: %s", ErrMonthlyProjectionUndefined, mode)
+		}
+		factor = 1
+	}
+
+	savings := (currentRate - proposedRate) * usage * factor
+	if savings < 0 {
+		return savings, fmt.Errorf("%w: %s: current=%v, proposed=%v", ErrNegativeSavings, mode, currentRate, proposedRate)
+	}
+	return savings, nil
+}
@@ -0,0 +1,72 @@
+package pricing_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/rshade/finfocus-spec/sdk/go/pricing"
+)
+
+func TestRightsizeSavings(t *testing.T) {
+	tests := []struct {
+		name         string
+		currentRate  float64
+		proposedRate float64
+		mode         pricing.BillingMode
+		usage        float64
+		expected     float64
+	}{
+		{"Downsize m5.xlarge to m5.large", 0.192, 0.096, pricing.PerHour, 1, 70.08},
+		{"Downsize fleet of 3 instances", 0.192, 0.096, pricing.PerHour, 3, 210.24},
+		{"Storage class change", 0.10, 0.08, pricing.PerGBMonth, 500, 10},
+		{"Usage-based requests per month", 0.0000004, 0.0000002, pricing.PerRequest, 10_000_000, 2},
+		{"Same rate", 0.1, 0.1, pricing.PerHour, 1, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := pricing.RightsizeSavings(tt.currentRate, tt.proposedRate, tt.mode, tt.usage)
+			if err != nil {
+				t.Fatalf("RightsizeSavings() unexpected error: %v", err)
+			}
+			if !almostEqual(got, tt.expected) {
+				t.Errorf("RightsizeSavings() = %v, want %v", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestRightsizeSavingsUpsize(t *testing.T) {
+	got, err := pricing.RightsizeSavings(0.096, 0.192, pricing.PerHour, 1)
+	if !errors.Is(err, pricing.ErrNegativeSavings) {
+		t.Fatalf("RightsizeSavings() error = %v, want ErrNegativeSavings", err)
+	}
+	if !almostEqual(got, -70.08) {
+		t.Errorf("RightsizeSavings() = %v, want the negative difference -70.08", got)
+	}
+}
+
+func TestRightsizeSavingsErrors(t *testing.T) {
+	tests := []struct {
+		name         string
+		currentRate  float64
+		proposedRate float64
+		mode         pricing.BillingMode
+		usage        float64
+		wantErr      error
+	}{
+		{"Zero usage", 0.192, 0.096, pricing.PerHour, 0, pricing.ErrInvalidUsage},
+		{"Negative usage", 0.192, 0.096, pricing.PerHour, -1, pricing.ErrInvalidUsage},
+		{"Negative rate", -0.1, 0.096, pricing.PerHour, 1, pricing.ErrInvalidRate},
+		{"Pricing model without unit", 0.192, 0.096, pricing.OnDemand, 1, pricing.ErrMonthlyProjectionUndefined},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := pricing.RightsizeSavings(tt.currentRate, tt.proposedRate, tt.mode, tt.usage)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("RightsizeSavings() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
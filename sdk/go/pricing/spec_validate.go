@@ -0,0 +1,61 @@
+package pricing
+
+import (
+	"errors"
+	"fmt"
+	"math"
+)
+
+// PricingSpec field validation errors.
+var (
+	// ErrInvalidProvider is returned when the provider is not one of GetAllProviders.
+	ErrInvalidProvider = errors.New("invalid provider")
+
+	// ErrEmptyResourceType is returned when the resource type is empty.
+	ErrEmptyResourceType = errors.New("resource_type is required")
+
+	// ErrInvalidBillingMode is returned when the billing mode is not one of GetAllBillingModes.
+	ErrInvalidBillingMode = errors.New("invalid billing_mode")
+
+	// ErrIncompatibleUnit is returned when the unit does not match the billing mode (see IsUnitCompatible).
+	ErrIncompatibleUnit = errors.New("unit is not compatible with billing_mode")
+
+	// ErrInvalidRate is returned when rate_per_unit is negative, NaN, or infinite.
+	ErrInvalidRate = errors.New("rate_per_unit must be a finite value >= 0")
+)
+
+// ValidatePricingSpecFields checks the internal consistency of a pricing spec before a plugin
+// returns it from GetPricingSpec. Unlike ValidatePricingSpec, which validates a JSON document
+// against the schema, this validates already-decoded field values.
+//
+// Checks performed:
+//   - provider is a valid Provider
+//   - resourceType is non-empty
+//   - mode is a valid BillingMode
+//   - unit is compatible with mode (only checked when mode is valid)
+//   - rate is finite and non-negative
+//
+// All problems are reported together: the returned error is a *ValidationErrors with one error
+// per failed check, and each can be matched with errors.Is (e.g., errors.Is(err, ErrIncompatibleUnit)).
+// Returns nil if the spec is consistent.
+func ValidatePricingSpecFields(provider, resourceType string, mode BillingMode, unit Unit, rate float64) error {
+	var errs ValidationErrors
+
+	if !ValidProvider(provider) {
+		errs.Add(fmt.Errorf("%w: %q", ErrInvalidProvider, provider))
+	}
+	if resourceType == "" {
+		errs.Add(ErrEmptyResourceType)
+	}
+	if !ValidBillingMode(string(mode)) {
+		errs.Add(fmt.Errorf("%w: %q", ErrInvalidBillingMode, mode))
+	} else if !IsUnitCompatible(mode, unit) {
+		expected, _ := ExpectedUnit(mode)
+		errs.Add(fmt.Errorf("%w: %q for %s (expected %q)", ErrIncompatibleUnit, unit, mode, expected))
+	}
+	if math.IsNaN(rate) || math.IsInf(rate, 0) || rate < 0 {
+		errs.Add(fmt.Errorf("%w: %v", ErrInvalidRate, rate))
+	}
+
+	return errs.Err()
+}
@@ -0,0 +1,62 @@
+package pricing_test
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/rshade/finfocus-spec/sdk/go/pricing"
+)
+
+func TestValidatePricingSpecFields(t *testing.T) {
+	tests := []struct {
+		name         string
+		provider     string
+		resourceType string
+		mode         pricing.BillingMode
+		unit         pricing.Unit
+		rate         float64
+		wantErrs     []error
+	}{
+		{"Valid hourly spec", "aws", "ec2", pricing.PerHour, pricing.UnitHour, 0.0104, nil},
+		{"Valid zero rate", "gcp", "storage", pricing.PerGBMonth, pricing.UnitGBMonth, 0, nil},
+		{"Valid pricing model without unit", "azure", "vm", pricing.Reserved, "", 0.05, nil},
+		{"Invalid provider", "oracle", "ec2", pricing.PerHour, pricing.UnitHour, 1, []error{pricing.ErrInvalidProvider}},
+		{"Empty resource type", "aws", "", pricing.PerHour, pricing.UnitHour, 1, []error{pricing.ErrEmptyResourceType}},
+		{
+			"Invalid billing mode", "aws", "ec2", pricing.BillingMode("per_fortnight"), pricing.UnitHour, 1,
+			[]error{pricing.ErrInvalidBillingMode},
+		},
+		{
+			"Incompatible unit", "aws", "ec2", pricing.PerHour, pricing.UnitGBMonth, 1,
+			[]error{pricing.ErrIncompatibleUnit},
+		},
+		{"Negative rate", "aws", "ec2", pricing.PerHour, pricing.UnitHour, -1, []error{pricing.ErrInvalidRate}},
+		{"NaN rate", "aws", "ec2", pricing.PerHour, pricing.UnitHour, math.NaN(), []error{pricing.ErrInvalidRate}},
+		{"Inf rate", "aws", "ec2", pricing.PerHour, pricing.UnitHour, math.Inf(1), []error{pricing.ErrInvalidRate}},
+		{
+			"Multiple problems reported together", "oracle", "", pricing.PerHour, pricing.UnitGBMonth, -1,
+			[]error{
+				pricing.ErrInvalidProvider, pricing.ErrEmptyResourceType,
+				pricing.ErrIncompatibleUnit, pricing.ErrInvalidRate,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := pricing.ValidatePricingSpecFields(tt.provider, tt.resourceType, tt.mode, tt.unit, tt.rate)
+			if len(tt.wantErrs) == 0 {
+				if err != nil {
+					t.Errorf("ValidatePricingSpecFields() unexpected error: %v", err)
+				}
+				return
+			}
+			for _, want := range tt.wantErrs {
+				if !errors.Is(err, want) {
+					t.Errorf("ValidatePricingSpecFields() error = %v, want it to include %v", err, want)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,42 @@
+package pricing
+
+import (
+	"errors"
+	"fmt"
+	"math"
+)
+
+// ErrInvalidProbability is returned when an interruption probability is outside [0, 1].
+var ErrInvalidProbability = errors.New("interruption probability must be between 0 and 1")
+
+// SpotAdjustedCost calculates the expected cost of running on spot capacity when an
+// interruption forces a fallback to on-demand capacity.
+// Formula: expected = (1 - p) * spotRate + p * onDemandRate
+//
+// The result is in the same unit and period as the input rates (e.g., $/hour).
+//
+// Parameters:
+//   - spotRate: Spot price per unit; must be a finite value >= 0
+//   - onDemandRate: On-demand price per unit paid after an interruption; must be a finite value >= 0
+//   - interruptionProbability: Probability (0-1) that the workload is interrupted and falls back
+//
+// Errors wrap ErrInvalidRate for a negative or non-finite rate and ErrInvalidProbability for a
+// probability outside [0, 1].
+//
+// Example:
+//
+//	Spot: $0.03/hr, On-demand: $0.10/hr, Interruption probability: 0.2
+//	Expected: 0.8 * $0.03 + 0.2 * $0.10 = $0.044/hr
+func SpotAdjustedCost(spotRate, onDemandRate, interruptionProbability float64) (float64, error) {
+	for _, rate := range []float64{spotRate, onDemandRate} {
+		if rate < 0 || math.IsNaN(rate) || math.IsInf(rate, 0) {
+			return 0, fmt.Errorf("%w: %v", ErrInvalidRate, rate)
+		}
+	}
+	if !(interruptionProbability >= 0 && interruptionProbability <= 1) {
+		return 0, fmt.Errorf("%w: %v", ErrInvalidProbability, interruptionProbability)
+	}
+
+	p := interruptionProbability
+	return (1-p)*spotRate + p*onDemandRate, nil
+}
@@ -0,0 +1,61 @@
+package pricing_test
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/rshade/finfocus-spec/sdk/go/pricing"
+)
+
+func TestSpotAdjustedCost(t *testing.T) {
+	tests := []struct {
+		name        string
+		spotRate    float64
+		onDemand    float64
+		probability float64
+		expected    float64
+	}{
+		{"Zero interruption is pure spot", 0.03, 0.10, 0, 0.03},
+		{"Certain interruption is pure on-demand", 0.03, 0.10, 1, 0.10},
+		{"Mid probability blend", 0.03, 0.10, 0.2, 0.044},
+		{"Even blend", 0.04, 0.10, 0.5, 0.07},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := pricing.SpotAdjustedCost(tt.spotRate, tt.onDemand, tt.probability)
+			if err != nil {
+				t.Fatalf("SpotAdjustedCost() unexpected error: %v", err)
+			}
+			if !almostEqual(got, tt.expected) {
+				t.Errorf("SpotAdjustedCost() = %v, want %v", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestSpotAdjustedCostErrors(t *testing.T) {
+	tests := []struct {
+		name        string
+		spotRate    float64
+		onDemand    float64
+		probability float64
+		wantErr     error
+	}{
+		{"Probability above 1", 0.03, 0.10, 1.5, pricing.ErrInvalidProbability},
+		{"Negative probability", 0.03, 0.10, -0.1, pricing.ErrInvalidProbability},
+		{"NaN probability", 0.03, 0.10, math.NaN(), pricing.ErrInvalidProbability},
+		{"Negative spot rate", -0.03, 0.10, 0.2, pricing.ErrInvalidRate},
+		{"Infinite on-demand rate", 0.03, math.Inf(1), 0.2, pricing.ErrInvalidRate},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := pricing.SpotAdjustedCost(tt.spotRate, tt.onDemand, tt.probability)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("SpotAdjustedCost() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
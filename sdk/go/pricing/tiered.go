@@ -0,0 +1,121 @@
+package pricing
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Tiered pricing validation errors.
+var (
+	// ErrNoTiers is returned when a tiered cost calculation receives no tiers.
+	ErrNoTiers = errors.New("at least one pricing tier is required")
+
+	// ErrInvalidTierOrder is returned when tier bounds are not strictly ascending,
+	// which would make tier bands overlap.
+	ErrInvalidTierOrder = errors.New("tiers must be sorted by up_to and non-overlapping")
+
+	// ErrUnboundedTierNotLast is returned when a tier other than the last has UpTo == 0.
+	ErrUnboundedTierNotLast = errors.New("only the last tier may be unbounded (up_to == 0)")
+
+	// ErrNegativeTierRate is returned when a tier has a negative rate_per_unit.
+	ErrNegativeTierRate = errors.New("tier rate_per_unit must be >= 0")
+
+	// ErrNegativeQuantity is returned when a tiered cost calculation receives a negative quantity.
+	ErrNegativeQuantity = errors.New("quantity must be >= 0")
+
+	// ErrQuantityExceedsTiers is returned when the quantity is larger than the upper bound
+	// of the last tier and no unbounded tier is defined.
+	ErrQuantityExceedsTiers = errors.New("quantity exceeds the upper bound of the last tier")
+)
+
+// Tier is a single band in a graduated (tiered) pricing structure.
+//
+// UpTo is the inclusive upper bound of the band, expressed in the same unit as the quantity
+// passed to CalculateTieredCost. The band starts where the previous tier ended (or at 0 for
+// the first tier). An UpTo of 0 on the last tier means the band is unbounded.
+type Tier struct {
+	// UpTo is the upper bound of this tier's band. 0 means unbounded (last tier only).
+	UpTo float64
+
+	// RatePerUnit is the price applied to each unit that falls within this tier's band.
+	RatePerUnit float64
+}
+
+// ValidateTiers checks that tiers form a valid graduated pricing structure.
+//
+// Tiers must be non-empty, sorted by strictly ascending UpTo, have non-negative rates,
+// and only the last tier may be unbounded (UpTo == 0).
+func ValidateTiers(tiers []Tier) error {
+	if len(tiers) == 0 {
+		return ErrNoTiers
+	}
+
+	prevUpTo := 0.0
+	for i, tier := range tiers {
+		if tier.RatePerUnit < 0 {
+			return fmt.Errorf("tier %d: %w", i, ErrNegativeTierRate)
+		}
+		if tier.UpTo == 0 {
+			if i != len(tiers)-1 {
+				return fmt.Errorf("tier %d: %w", i, ErrUnboundedTierNotLast)
+			}
+			continue
+		}
+		if tier.UpTo <= prevUpTo {
+			return fmt.Errorf("tier %d (up_to=%g) after up_to=%g: %w", i, tier.UpTo, prevUpTo, ErrInvalidTierOrder)
+		}
+		prevUpTo = tier.UpTo
+	}
+
+	return nil
+}
+
+// CalculateTieredCost calculates the cost of a quantity under graduated tiered pricing.
+//
+// Each tier's rate applies only to the units that fall within that tier's band, so the
+// total is the sum of the per-band costs (as used by S3 storage and data-transfer pricing).
+// This differs from volume pricing, where a single tier rate applies to the whole quantity.
+//
+// Parameters:
+//   - tiers: Tier bands sorted by ascending UpTo; the last tier may use UpTo == 0 for unbounded
+//   - quantity: Total usage in the tier unit (e.g., GB-month)
+//
+// Returns an error if the tiers are invalid (see ValidateTiers), the quantity is negative,
+// or the quantity exceeds the last tier's bound when no unbounded tier is defined.
+//
+// Example:
+//
+//	tiers: [{UpTo: 50, Rate: 0.023}, {UpTo: 500, Rate: 0.022}, {UpTo: 0, Rate: 0.021}]
+//	quantity=600
+//	  50 * 0.023 = 1.15
+//	 450 * 0.022 = 9.90
+//	 100 * 0.021 = 2.10
+//	Total: $13.15
+func CalculateTieredCost(tiers []Tier, quantity float64) (float64, error) {
+	if err := ValidateTiers(tiers); err != nil {
+		return 0, err
+	}
+	if quantity < 0 {
+		return 0, ErrNegativeQuantity
+	}
+
+	total := 0.0
+	lower := 0.0
+	for _, tier := range tiers {
+		if quantity <= lower {
+			return total, nil
+		}
+		upper := tier.UpTo
+		if upper == 0 || quantity < upper {
+			upper = quantity
+		}
+		total += (upper - lower) * tier.RatePerUnit
+		lower = upper
+	}
+
+	if quantity > lower {
+		return 0, fmt.Errorf("quantity %g > %g: %w", quantity, lower, ErrQuantityExceedsTiers)
+	}
+
+	return total, nil
+}
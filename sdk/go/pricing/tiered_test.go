@@ -0,0 +1,81 @@
+package pricing_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/rshade/finfocus-spec/sdk/go/pricing"
+)
+
+// s3StandardTiers mirrors S3 Standard storage pricing: first 50 TB, next 450 TB, over 500 TB.
+func s3StandardTiers() []pricing.Tier {
+	return []pricing.Tier{
+		{UpTo: 50, RatePerUnit: 0.023},
+		{UpTo: 500, RatePerUnit: 0.022},
+		{UpTo: 0, RatePerUnit: 0.021},
+	}
+}
+
+func TestCalculateTieredCost(t *testing.T) {
+	tests := []struct {
+		name     string
+		tiers    []pricing.Tier
+		quantity float64
+		expected float64
+	}{
+		{"Zero quantity", s3StandardTiers(), 0, 0},
+		{"Within first tier", s3StandardTiers(), 10, 0.23},
+		{"Exactly first tier bound", s3StandardTiers(), 50, 1.15},
+		{"Spans two tiers", s3StandardTiers(), 100, 1.15 + 1.10},
+		{"Spans all tiers", s3StandardTiers(), 600, 1.15 + 9.90 + 2.10},
+		{"Single unbounded tier", []pricing.Tier{{UpTo: 0, RatePerUnit: 0.09}}, 1000, 90},
+		{"Bounded last tier at limit", []pricing.Tier{{UpTo: 10, RatePerUnit: 1}, {UpTo: 20, RatePerUnit: 0.5}}, 20, 15},
+		{"Free first tier", []pricing.Tier{{UpTo: 1, RatePerUnit: 0}, {UpTo: 0, RatePerUnit: 0.09}}, 11, 0.9},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := pricing.CalculateTieredCost(tt.tiers, tt.quantity)
+			if err != nil {
+				t.Fatalf("CalculateTieredCost() unexpected error: %v", err)
+			}
+			if !almostEqual(got, tt.expected) {
+				t.Errorf("CalculateTieredCost() = %v, want %v", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestCalculateTieredCostErrors(t *testing.T) {
+	tests := []struct {
+		name     string
+		tiers    []pricing.Tier
+		quantity float64
+		wantErr  error
+	}{
+		{"No tiers", nil, 10, pricing.ErrNoTiers},
+		{"Unsorted tiers", []pricing.Tier{{UpTo: 500, RatePerUnit: 1}, {UpTo: 50, RatePerUnit: 1}}, 10, pricing.ErrInvalidTierOrder},
+		{"Duplicate bounds", []pricing.Tier{{UpTo: 50, RatePerUnit: 1}, {UpTo: 50, RatePerUnit: 1}}, 10, pricing.ErrInvalidTierOrder},
+		{"Unbounded tier not last", []pricing.Tier{{UpTo: 0, RatePerUnit: 1}, {UpTo: 50, RatePerUnit: 1}}, 10, pricing.ErrUnboundedTierNotLast},
+		{"Negative rate", []pricing.Tier{{UpTo: 0, RatePerUnit: -1}}, 10, pricing.ErrNegativeTierRate},
+		{"Negative quantity", s3StandardTiers(), -1, pricing.ErrNegativeQuantity},
+		{"Exceeds bounded tiers", []pricing.Tier{{UpTo: 10, RatePerUnit: 1}}, 11, pricing.ErrQuantityExceedsTiers},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := pricing.CalculateTieredCost(tt.tiers, tt.quantity)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("CalculateTieredCost() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func BenchmarkCalculateTieredCost(b *testing.B) {
+	tiers := s3StandardTiers()
+	b.ResetTimer()
+	for range b.N {
+		_, _ = pricing.CalculateTieredCost(tiers, 600)
+	}
+}
@@ -0,0 +1,89 @@
+package pricing
+
+import "slices"
+
+// compatibleUnits returns the units accepted for a billing mode, canonical unit first.
+// It returns nil for pricing models (on_demand, reserved, tiered, ...) that do not
+// prescribe a unit of measurement.
+func compatibleUnits(mode BillingMode) []Unit {
+	switch mode {
+	// Time-based
+	case PerHour, PerCPUHour, PerVCPUHour:
+		return []Unit{UnitHour}
+	case PerMinute:
+		return []Unit{UnitMinute}
+	case PerSecond:
+		return []Unit{UnitSecond}
+	case PerDay:
+		return []Unit{UnitDay}
+	case PerMonth, PerCPUMonth:
+		return []Unit{UnitMonth}
+	case PerYear:
+		return []Unit{UnitYear}
+	// Storage-based
+	case PerGBMonth, PerMemoryGBMonth:
+		return []Unit{UnitGBMonth}
+	case PerGBHour, PerMemoryGBHour:
+		return []Unit{UnitGBHour}
+	case PerGBDay:
+		return []Unit{UnitGBDay}
+	// Serverless
+	case PerGBSecond, PerRequestGBSecond:
+		return []Unit{UnitGBSecond}
+	case PerVCPUSecond:
+		return []Unit{UnitVCPUSecond}
+	// Usage-based
+	case PerRequest, PerOperation, PerTransaction, PerExecution, PerInvocation,
+		PerAPICall, PerLookup, PerQuery:
+		return []Unit{UnitRequest}
+	// I/O-based
+	case PerIOPS, PerProvisionedIOPS:
+		return []Unit{UnitIOPS}
+	case PerDataTransferGB, PerBandwidthGB:
+		return []Unit{UnitGB}
+	// Database-specific
+	case PerRCU:
+		return []Unit{UnitRCU}
+	case PerWCU:
+		return []Unit{UnitWCU}
+	case PerDTU:
+		return []Unit{UnitDTU}
+	case PerRU:
+		return []Unit{UnitRU}
+	default:
+		return nil
+	}
+}
+
+// ExpectedUnit returns the canonical unit for a billing mode (e.g., GB-month for per_gb_month).
+// It returns false for pricing models such as on_demand or tiered that do not imply a unit,
+// and for unrecognized billing modes.
+func ExpectedUnit(mode BillingMode) (Unit, bool) {
+	units := compatibleUnits(mode)
+	if len(units) == 0 {
+		return "", false
+	}
+	return units[0], true
+}
+
+// IsUnitCompatible reports whether unit is a valid unit of measurement for the billing mode.
+// It catches misconfigured pricing specs, such as a per_hour rate reported with a GB-month unit.
+//
+// Pricing models that do not imply a unit (on_demand, reserved, spot, tiered, ...) accept any
+// unit. Unrecognized billing modes are never compatible.
+//
+// Example:
+//
+//	IsUnitCompatible(PerGBMonth, UnitGBMonth) // true
+//	IsUnitCompatible(PerHour, UnitGBMonth)    // false
+//	IsUnitCompatible(OnDemand, UnitHour)      // true (no unit constraint)
+func IsUnitCompatible(mode BillingMode, unit Unit) bool {
+	if !ValidBillingMode(string(mode)) {
+		return false
+	}
+	units := compatibleUnits(mode)
+	if len(units) == 0 {
+		return true
+	}
+	return slices.Contains(units, unit)
+}
@@ -0,0 +1,80 @@
+package pricing_test
+
+import (
+	"testing"
+
+	"github.com/rshade/finfocus-spec/sdk/go/pricing"
+)
+
+func TestExpectedUnit(t *testing.T) {
+	tests := []struct {
+		mode     pricing.BillingMode
+		expected pricing.Unit
+		ok       bool
+	}{
+		{pricing.PerHour, pricing.UnitHour, true},
+		{pricing.PerVCPUHour, pricing.UnitHour, true},
+		{pricing.PerSecond, pricing.UnitSecond, true},
+		{pricing.PerGBMonth, pricing.UnitGBMonth, true},
+		{pricing.PerGBHour, pricing.UnitGBHour, true},
+		{pricing.PerRequest, pricing.UnitRequest, true},
+		{pricing.PerGBSecond, pricing.UnitGBSecond, true},
+		{pricing.PerVCPUSecond, pricing.UnitVCPUSecond, true},
+		{pricing.PerRequestGBSecond, pricing.UnitGBSecond, true},
+		{pricing.PerInvocation, pricing.UnitRequest, true},
+		{pricing.PerDataTransferGB, pricing.UnitGB, true},
+		{pricing.PerRCU, pricing.UnitRCU, true},
+		{pricing.PerWCU, pricing.UnitWCU, true},
+		{pricing.PerDTU, pricing.UnitDTU, true},
+		{pricing.PerRU, pricing.UnitRU, true},
+		{pricing.OnDemand, "", false},
+		{pricing.Tiered, "", false},
+		{pricing.BillingMode("per_fortnight"), "", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.mode.String(), func(t *testing.T) {
+			got, ok := pricing.ExpectedUnit(tt.mode)
+			if got != tt.expected || ok != tt.ok {
+				t.Errorf("ExpectedUnit(%s) = (%q, %v), want (%q, %v)", tt.mode, got, ok, tt.expected, tt.ok)
+			}
+		})
+	}
+}
+
+func TestIsUnitCompatible(t *testing.T) {
+	tests := []struct {
+		name     string
+		mode     pricing.BillingMode
+		unit     pricing.Unit
+		expected bool
+	}{
+		{"per_gb_month with GB-month", pricing.PerGBMonth, pricing.UnitGBMonth, true},
+		{"per_rcu with RCU", pricing.PerRCU, pricing.UnitRCU, true},
+		{"per_hour with hour", pricing.PerHour, pricing.UnitHour, true},
+		{"per_hour with GB-month", pricing.PerHour, pricing.UnitGBMonth, false},
+		{"per_gb_month with hour", pricing.PerGBMonth, pricing.UnitHour, false},
+		{"per_rcu with WCU", pricing.PerRCU, pricing.UnitWCU, false},
+		{"per_request with unknown", pricing.PerRequest, pricing.UnitUnknown, false},
+		{"on_demand accepts any unit", pricing.OnDemand, pricing.UnitHour, true},
+		{"invalid mode", pricing.BillingMode("per_fortnight"), pricing.UnitHour, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := pricing.IsUnitCompatible(tt.mode, tt.unit); got != tt.expected {
+				t.Errorf("IsUnitCompatible(%s, %s) = %v, want %v", tt.mode, tt.unit, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestExpectedUnitIsCompatible(t *testing.T) {
+	for _, s := range pricing.GetAllBillingModes() {
+		mode := pricing.BillingMode(s)
+		unit, ok := pricing.ExpectedUnit(mode)
+		if ok && !pricing.IsUnitCompatible(mode, unit) {
+			t.Errorf("ExpectedUnit(%s) = %q is not compatible with its own mode", mode, unit)
+		}
+	}
+}
@@ -46,6 +46,7 @@ const schemaJSON = `{
             "flat", "per_day", "per_month", "per_year",
             "per_cpu_hour", "per_cpu_month", "per_vcpu_hour",
             "per_memory_gb_hour", "per_memory_gb_month",
+            "per_gb_second", "per_vcpu_second", "per_request_gb_second",
             "per_iops", "per_provisioned_iops",
             "per_rcu", "per_wcu", "per_dtu", "per_ru",
             "on_demand", "reserved", "spot", "preemptible",
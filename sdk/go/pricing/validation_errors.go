@@ -0,0 +1,64 @@
+package pricing
+
+import "strings"
+
+// ValidationErrors collects every failure found by a validator, so callers see all problems
+// with an input at once instead of fixing them one at a time.
+//
+// The zero value is empty and ready to use. Error lists each failure on its own line, and
+// Unwrap exposes the individual failures to errors.Is and errors.As.
+//
+// Example:
+//
+//	var errs pricing.ValidationErrors
+//	if spec.GetResourceType() == "" {
+//	    errs.Add(pricing.ErrEmptyResourceType)
+//	}
+//	if spec.GetRatePerUnit() < 0 {
+//	    errs.Add(fmt.Errorf("%w: %v", pricing.ErrInvalidRate, spec.GetRatePerUnit()))
+//	}
+//	return errs.Err()
+type ValidationErrors struct {
+	errs []error
+}
+
+// Add records err. Nil errors are ignored, and the failures of a nested ValidationErrors are
+// added individually.
+func (e *ValidationErrors) Add(err error) {
+	if err == nil {
+		return
+	}
+	if nested, ok := err.(*ValidationErrors); ok { //nolint:errorlint // flattening only direct nesting
+		e.errs = append(e.errs, nested.errs...)
+		return
+	}
+	e.errs = append(e.errs, err)
+}
+
+// HasErrors reports whether any failures have been recorded.
+func (e *ValidationErrors) HasErrors() bool {
+	return len(e.errs) > 0
+}
+
+// Err returns e if any failures have been recorded, and nil otherwise. Return it from
+// validators instead of e itself, which would be a non-nil error even when empty.
+func (e *ValidationErrors) Err() error {
+	if !e.HasErrors() {
+		return nil
+	}
+	return e
+}
+
+// Error lists each recorded failure on its own line.
+func (e *ValidationErrors) Error() string {
+	msgs := make([]string, len(e.errs))
+	for i, err := range e.errs {
+		msgs[i] = err.Error()
+	}
+	return strings.Join(msgs, "\n")
+}
+
+// Unwrap returns the recorded failures, for errors.Is and errors.As.
+func (e *ValidationErrors) Unwrap() []error {
+	return e.errs
+}
@@ -0,0 +1,63 @@
+package pricing_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/rshade/finfocus-spec/sdk/go/pricing"
+)
+
+func TestValidationErrors(t *testing.T) {
+	t.Run("empty collection is not an error", func(t *testing.T) {
+		var errs pricing.ValidationErrors
+		errs.Add(nil)
+		if errs.HasErrors() {
+			t.Error("HasErrors() = true, want false")
+		}
+		if err := errs.Err(); err != nil {
+			t.Errorf("Err() = %v, want nil", err)
+		}
+	})
+
+	t.Run("lists each failure on its own line", func(t *testing.T) {
+		var errs pricing.ValidationErrors
+		errs.Add(pricing.ErrEmptyResourceType)
+		errs.Add(fmt.Errorf("%w: %v", pricing.ErrInvalidRate, -1))
+
+		if !errs.HasErrors() {
+			t.Fatal("HasErrors() = false, want true")
+		}
+		want := pricing.ErrEmptyResourceType.Error() + "\n" + pricing.ErrInvalidRate.Error() + ": -1"
+		if got := errs.Err().Error(); got != want {
+			t.Errorf("Error() = %q, want %q", got, want)
+		}
+	})
+
+	t.Run("errors.Is and errors.As reach each failure", func(t *testing.T) {
+		var errs pricing.ValidationErrors
+		errs.Add(pricing.ErrEmptyResourceType)
+		errs.Add(fmt.Errorf("rate: %w", pricing.ErrInvalidRate))
+		err := fmt.Errorf("validating spec: %w", errs.Err())
+
+		if !errors.Is(err, pricing.ErrEmptyResourceType) || !errors.Is(err, pricing.ErrInvalidRate) {
+			t.Errorf("errors.Is failed to match the collected errors in %v", err)
+		}
+		var collected *pricing.ValidationErrors
+		if !errors.As(err, &collected) || len(collected.Unwrap()) != 2 {
+			t.Errorf("errors.As did not find the ValidationErrors with 2 failures in %v", err)
+		}
+	})
+
+	t.Run("nested collections are flattened", func(t *testing.T) {
+		var inner, outer pricing.ValidationErrors
+		inner.Add(pricing.ErrEmptyResourceType)
+		inner.Add(pricing.ErrInvalidRate)
+		outer.Add(pricing.ErrInvalidProvider)
+		outer.Add(&inner)
+
+		if got := len(outer.Unwrap()); got != 3 {
+			t.Errorf("len(Unwrap()) = %d, want 3", got)
+		}
+	})
+}
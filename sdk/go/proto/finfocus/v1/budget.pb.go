@@ -370,16 +370,19 @@ func (x *BudgetAmount) GetCurrency() string {
 	return ""
 }
 
-// BudgetFilter allows narrowing down budgets by provider, region, resource type, or tags.
-// All fields are optional - empty filter matches all budgets.
+// BudgetFilter allows narrowing down budgets by provider, region, resource type, tags,
+// period, or health status. All fields are optional - empty filter matches all budgets.
+// When used as a Budget's scope, periods and health_statuses are not meaningful and are ignored.
 type BudgetFilter struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Providers     []string               `protobuf:"bytes,1,rep,name=providers,proto3" json:"providers,omitempty"`                                                                 // Cloud provider restrictions (optional)
-	Regions       []string               `protobuf:"bytes,2,rep,name=regions,proto3" json:"regions,omitempty"`                                                                     // Geographic region restrictions (optional)
-	ResourceTypes []string               `protobuf:"bytes,3,rep,name=resource_types,json=resourceTypes,proto3" json:"resource_types,omitempty"`                                    // Resource type restrictions (optional)
-	Tags          map[string]string      `protobuf:"bytes,4,rep,name=tags,proto3" json:"tags,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // Tag-based filtering (optional)
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Providers      []string               `protobuf:"bytes,1,rep,name=providers,proto3" json:"providers,omitempty"`                                                                             // Cloud provider restrictions (optional)
+	Regions        []string               `protobuf:"bytes,2,rep,name=regions,proto3" json:"regions,omitempty"`                                                                                 // Geographic region restrictions (optional)
+	ResourceTypes  []string               `protobuf:"bytes,3,rep,name=resource_types,json=resourceTypes,proto3" json:"resource_types,omitempty"`                                                // Resource type restrictions (optional)
+	Tags           map[string]string      `protobuf:"bytes,4,rep,name=tags,proto3" json:"tags,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`             // Tag-based filtering (optional)
+	Periods        []BudgetPeriod         `protobuf:"varint,5,rep,packed,name=periods,proto3,enum=finfocus.v1.BudgetPeriod" json:"periods,omitempty"`                                           // Budget period restrictions (optional)
+	HealthStatuses []BudgetHealthStatus   `protobuf:"varint,6,rep,packed,name=health_statuses,json=healthStatuses,proto3,enum=finfocus.v1.BudgetHealthStatus" json:"health_statuses,omitempty"` // Health status restrictions (optional)
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *BudgetFilter) Reset() {
@@ -440,6 +443,20 @@ func (x *BudgetFilter) GetTags() map[string]string {
 	return nil
 }
 
+func (x *BudgetFilter) GetPeriods() []BudgetPeriod {
+	if x != nil {
+		return x.Periods
+	}
+	return nil
+}
+
+func (x *BudgetFilter) GetHealthStatuses() []BudgetHealthStatus {
+	if x != nil {
+		return x.HealthStatuses
+	}
+	return nil
+}
+
 // BudgetThreshold defines alert points with percentages and trigger types.
 type BudgetThreshold struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -807,12 +824,14 @@ const file_finfocus_v1_budget_proto_rawDesc = "" +
 	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"@\n" +
 	"\fBudgetAmount\x12\x14\n" +
 	"\x05limit\x18\x01 \x01(\x01R\x05limit\x12\x1a\n" +
-	"\bcurrency\x18\x02 \x01(\tR\bcurrency\"\xdf\x01\n" +
+	"\bcurrency\x18\x02 \x01(\tR\bcurrency\"\xde\x02\n" +
 	"\fBudgetFilter\x12\x1c\n" +
 	"\tproviders\x18\x01 \x03(\tR\tproviders\x12\x18\n" +
 	"\aregions\x18\x02 \x03(\tR\aregions\x12%\n" +
 	"\x0eresource_types\x18\x03 \x03(\tR\rresourceTypes\x127\n" +
-	"\x04tags\x18\x04 \x03(\v2#.finfocus.v1.BudgetFilter.TagsEntryR\x04tags\x1a7\n" +
+	"\x04tags\x18\x04 \x03(\v2#.finfocus.v1.BudgetFilter.TagsEntryR\x04tags\x123\n" +
+	"\aperiods\x18\x05 \x03(\x0e2\x19.finfocus.v1.BudgetPeriodR\aperiods\x12H\n" +
+	"\x0fhealth_statuses\x18\x06 \x03(\x0e2\x1f.finfocus.v1.BudgetHealthStatusR\x0ehealthStatuses\x1a7\n" +
 	"\tTagsEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
 	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xbe\x01\n" +
@@ -902,17 +921,19 @@ var file_finfocus_v1_budget_proto_depIdxs = []int32{
 	13, // 6: finfocus.v1.Budget.updated_at:type_name -> google.protobuf.Timestamp
 	11, // 7: finfocus.v1.Budget.metadata:type_name -> finfocus.v1.Budget.MetadataEntry
 	12, // 8: finfocus.v1.BudgetFilter.tags:type_name -> finfocus.v1.BudgetFilter.TagsEntry
-	1,  // 9: finfocus.v1.BudgetThreshold.type:type_name -> finfocus.v1.ThresholdType
-	13, // 10: finfocus.v1.BudgetThreshold.triggered_at:type_name -> google.protobuf.Timestamp
-	2,  // 11: finfocus.v1.BudgetStatus.health:type_name -> finfocus.v1.BudgetHealthStatus
-	5,  // 12: finfocus.v1.GetBudgetsRequest.filter:type_name -> finfocus.v1.BudgetFilter
-	3,  // 13: finfocus.v1.GetBudgetsResponse.budgets:type_name -> finfocus.v1.Budget
-	10, // 14: finfocus.v1.GetBudgetsResponse.summary:type_name -> finfocus.v1.BudgetSummary
-	15, // [15:15] is the sub-list for method output_type
-	15, // [15:15] is the sub-list for method input_type
-	15, // [15:15] is the sub-list for extension type_name
-	15, // [15:15] is the sub-list for extension extendee
-	0,  // [0:15] is the sub-list for field type_name
+	0,  // 9: finfocus.v1.BudgetFilter.periods:type_name -> finfocus.v1.BudgetPeriod
+	2,  // 10: finfocus.v1.BudgetFilter.health_statuses:type_name -> finfocus.v1.BudgetHealthStatus
+	1,  // 11: finfocus.v1.BudgetThreshold.type:type_name -> finfocus.v1.ThresholdType
+	13, // 12: finfocus.v1.BudgetThreshold.triggered_at:type_name -> google.protobuf.Timestamp
+	2,  // 13: finfocus.v1.BudgetStatus.health:type_name -> finfocus.v1.BudgetHealthStatus
+	5,  // 14: finfocus.v1.GetBudgetsRequest.filter:type_name -> finfocus.v1.BudgetFilter
+	3,  // 15: finfocus.v1.GetBudgetsResponse.budgets:type_name -> finfocus.v1.Budget
+	10, // 16: finfocus.v1.GetBudgetsResponse.summary:type_name -> finfocus.v1.BudgetSummary
+	17, // [17:17] is the sub-list for method output_type
+	17, // [17:17] is the sub-list for method input_type
+	17, // [17:17] is the sub-list for extension type_name
+	17, // [17:17] is the sub-list for extension extendee
+	0,  // [0:17] is the sub-list for field type_name
 }
 
 func init() { file_finfocus_v1_budget_proto_init() }
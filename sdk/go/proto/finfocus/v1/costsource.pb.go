@@ -626,6 +626,8 @@ const (
 	RecommendationSortBy_RECOMMENDATION_SORT_BY_PRIORITY          RecommendationSortBy = 2
 	RecommendationSortBy_RECOMMENDATION_SORT_BY_CREATED_AT        RecommendationSortBy = 3
 	RecommendationSortBy_RECOMMENDATION_SORT_BY_CONFIDENCE        RecommendationSortBy = 4
+	// Combined score of estimated savings, priority, and confidence (see pluginsdk.ScoreRecommendation)
+	RecommendationSortBy_RECOMMENDATION_SORT_BY_SCORE RecommendationSortBy = 5
 )
 
 // Enum value maps for RecommendationSortBy.
@@ -636,6 +638,7 @@ var (
 		2: "RECOMMENDATION_SORT_BY_PRIORITY",
 		3: "RECOMMENDATION_SORT_BY_CREATED_AT",
 		4: "RECOMMENDATION_SORT_BY_CONFIDENCE",
+		5: "RECOMMENDATION_SORT_BY_SCORE",
 	}
 	RecommendationSortBy_value = map[string]int32{
 		"RECOMMENDATION_SORT_BY_UNSPECIFIED":       0,
@@ -643,6 +646,7 @@ var (
 		"RECOMMENDATION_SORT_BY_PRIORITY":          2,
 		"RECOMMENDATION_SORT_BY_CREATED_AT":        3,
 		"RECOMMENDATION_SORT_BY_CONFIDENCE":        4,
+		"RECOMMENDATION_SORT_BY_SCORE":             5,
 	}
 )
 
@@ -1585,8 +1589,23 @@ type GetProjectedCostResponse struct {
 	// as 0.95 (95% confidence). The SDK validates but does not populate this default.
 	// When set without prediction intervals, the value is ignored.
 	ConfidenceLevel *float64 `protobuf:"fixed64,12,opt,name=confidence_level,json=confidenceLevel,proto3,oneof" json:"confidence_level,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+	// billing_mode is the billing mode unit_price is quoted in (e.g., "per_hour",
+	// "per_gb_month", "tiered"), matching PricingSpec.billing_mode.
+	// Empty when the plugin does not report it.
+	BillingMode string `protobuf:"bytes,13,opt,name=billing_mode,json=billingMode,proto3" json:"billing_mode,omitempty"`
+	// rate_per_unit is the base rate per unit of billing_mode, matching
+	// PricingSpec.rate_per_unit. For tiered pricing, see pricing_tiers.
+	RatePerUnit float64 `protobuf:"fixed64,14,opt,name=rate_per_unit,json=ratePerUnit,proto3" json:"rate_per_unit,omitempty"`
+	// unit is the unit the rate applies to (e.g., "hour", "GB-month", "request"),
+	// matching PricingSpec.unit.
+	Unit string `protobuf:"bytes,15,opt,name=unit,proto3" json:"unit,omitempty"`
+	// assumptions contains human-readable strings explaining how cost_per_month
+	// was derived (e.g., "730 hours per month", "100 GB provisioned").
+	Assumptions []string `protobuf:"bytes,16,rep,name=assumptions,proto3" json:"assumptions,omitempty"`
+	// pricing_tiers contains the tiered pricing breakdown when billing_mode is "tiered".
+	PricingTiers  []*PricingTier `protobuf:"bytes,17,rep,name=pricing_tiers,json=pricingTiers,proto3" json:"pricing_tiers,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *GetProjectedCostResponse) Reset() {
@@ -1703,6 +1722,41 @@ func (x *GetProjectedCostResponse) GetConfidenceLevel() float64 {
 	return 0
 }
 
+func (x *GetProjectedCostResponse) GetBillingMode() string {
+	if x != nil {
+		return x.BillingMode
+	}
+	return ""
+}
+
+func (x *GetProjectedCostResponse) GetRatePerUnit() float64 {
+	if x != nil {
+		return x.RatePerUnit
+	}
+	return 0
+}
+
+func (x *GetProjectedCostResponse) GetUnit() string {
+	if x != nil {
+		return x.Unit
+	}
+	return ""
+}
+
+func (x *GetProjectedCostResponse) GetAssumptions() []string {
+	if x != nil {
+		return x.Assumptions
+	}
+	return nil
+}
+
+func (x *GetProjectedCostResponse) GetPricingTiers() []*PricingTier {
+	if x != nil {
+		return x.PricingTiers
+	}
+	return nil
+}
+
 // GetPricingSpecRequest contains the resource descriptor for pricing specification.
 type GetPricingSpecRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -3572,8 +3626,14 @@ type EstimateCostResponse struct {
 	//
 	// Validation: Use pluginsdk.ValidateEstimateCostResponse() to verify all constraints.
 	SpotInterruptionRiskScore float64 `protobuf:"fixed64,4,opt,name=spot_interruption_risk_score,json=spotInterruptionRiskScore,proto3" json:"spot_interruption_risk_score,omitempty"`
-	unknownFields             protoimpl.UnknownFields
-	sizeCache                 protoimpl.SizeCache
+	// explanation contains the ordered, human-readable calculation steps used to
+	// derive cost_monthly (e.g., "0.0104 USD/hour x 730 hours x 2 instances = 15.18 USD")
+	// so users can audit the estimate. Empty when the plugin does not provide it.
+	//
+	// New in FinFocus 1.3+. Set via pluginsdk.WithExplanation().
+	Explanation   []string `protobuf:"bytes,5,rep,name=explanation,proto3" json:"explanation,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *EstimateCostResponse) Reset() {
@@ -3634,6 +3694,13 @@ func (x *EstimateCostResponse) GetSpotInterruptionRiskScore() float64 {
 	return 0
 }
 
+func (x *EstimateCostResponse) GetExplanation() []string {
+	if x != nil {
+		return x.Explanation
+	}
+	return nil
+}
+
 // GetRecommendationsRequest contains parameters for retrieving recommendations.
 type GetRecommendationsRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -3887,9 +3954,14 @@ type RecommendationFilter struct {
 	MaxAgeDays int32 `protobuf:"varint,15,opt,name=max_age_days,json=maxAgeDays,proto3" json:"max_age_days,omitempty"`
 	// resource_id filters for recommendations affecting a specific resource.
 	// Format is provider-specific (e.g., AWS instance ID, K8s resource name).
-	ResourceId    string `protobuf:"bytes,16,opt,name=resource_id,json=resourceId,proto3" json:"resource_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	ResourceId string `protobuf:"bytes,16,opt,name=resource_id,json=resourceId,proto3" json:"resource_id,omitempty"`
+	// exclude_unknown_confidence controls how min_confidence_score treats
+	// recommendations without a confidence score. By default (false) unknown
+	// confidence passes the filter; when true such recommendations are dropped.
+	// Has no effect when min_confidence_score is 0.
+	ExcludeUnknownConfidence bool `protobuf:"varint,17,opt,name=exclude_unknown_confidence,json=excludeUnknownConfidence,proto3" json:"exclude_unknown_confidence,omitempty"`
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
 }
 
 func (x *RecommendationFilter) Reset() {
@@ -4034,6 +4106,13 @@ func (x *RecommendationFilter) GetResourceId() string {
 	return ""
 }
 
+func (x *RecommendationFilter) GetExcludeUnknownConfidence() bool {
+	if x != nil {
+		return x.ExcludeUnknownConfidence
+	}
+	return false
+}
+
 // Recommendation represents a single cost optimization recommendation.
 type Recommendation struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -5722,6 +5801,474 @@ func (x *DryRunResponse) GetResourceTypeSupported() bool {
 	return false
 }
 
+// BatchProjectedCostRequest requests projected costs for many resources in one call.
+type BatchProjectedCostRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// resources to project, in order. Maximum 1000 resources per request.
+	Resources []*ResourceDescriptor `protobuf:"bytes,1,rep,name=resources,proto3" json:"resources,omitempty"`
+	// utilization_percentage is the default utilization applied to every resource,
+	// with the same semantics as GetProjectedCostRequest.utilization_percentage.
+	UtilizationPercentage float64 `protobuf:"fixed64,2,opt,name=utilization_percentage,json=utilizationPercentage,proto3" json:"utilization_percentage,omitempty"`
+	// usage_profile is applied to every resource, with the same semantics as
+	// GetProjectedCostRequest.usage_profile.
+	UsageProfile  UsageProfile `protobuf:"varint,3,opt,name=usage_profile,json=usageProfile,proto3,enum=finfocus.v1.UsageProfile" json:"usage_profile,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchProjectedCostRequest) Reset() {
+	*x = BatchProjectedCostRequest{}
+	mi := &file_finfocus_v1_costsource_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchProjectedCostRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchProjectedCostRequest) ProtoMessage() {}
+
+func (x *BatchProjectedCostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_finfocus_v1_costsource_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchProjectedCostRequest.ProtoReflect.Descriptor instead.
+func (*BatchProjectedCostRequest) Descriptor() ([]byte, []int) {
+	return file_finfocus_v1_costsource_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *BatchProjectedCostRequest) GetResources() []*ResourceDescriptor {
+	if x != nil {
+		return x.Resources
+	}
+	return nil
+}
+
+func (x *BatchProjectedCostRequest) GetUtilizationPercentage() float64 {
+	if x != nil {
+		return x.UtilizationPercentage
+	}
+	return 0
+}
+
+func (x *BatchProjectedCostRequest) GetUsageProfile() UsageProfile {
+	if x != nil {
+		return x.UsageProfile
+	}
+	return UsageProfile_USAGE_PROFILE_UNSPECIFIED
+}
+
+// BatchProjectedCostResponse contains one result per requested resource.
+type BatchProjectedCostResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// results are aligned with BatchProjectedCostRequest.resources:
+	// results[i] is the outcome for resources[i].
+	Results       []*BatchProjectedCostResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchProjectedCostResponse) Reset() {
+	*x = BatchProjectedCostResponse{}
+	mi := &file_finfocus_v1_costsource_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchProjectedCostResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchProjectedCostResponse) ProtoMessage() {}
+
+func (x *BatchProjectedCostResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_finfocus_v1_costsource_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchProjectedCostResponse.ProtoReflect.Descriptor instead.
+func (*BatchProjectedCostResponse) Descriptor() ([]byte, []int) {
+	return file_finfocus_v1_costsource_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *BatchProjectedCostResponse) GetResults() []*BatchProjectedCostResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+// BatchProjectedCostResult is the outcome of projecting a single resource in a batch.
+// Exactly one of response or error is set.
+type BatchProjectedCostResult struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// response is the projected cost when the resource was projected successfully.
+	Response *GetProjectedCostResponse `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
+	// error describes why the resource could not be projected. A failed resource
+	// does not fail the rest of the batch.
+	Error         *ErrorDetail `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchProjectedCostResult) Reset() {
+	*x = BatchProjectedCostResult{}
+	mi := &file_finfocus_v1_costsource_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchProjectedCostResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchProjectedCostResult) ProtoMessage() {}
+
+func (x *BatchProjectedCostResult) ProtoReflect() protoreflect.Message {
+	mi := &file_finfocus_v1_costsource_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchProjectedCostResult.ProtoReflect.Descriptor instead.
+func (*BatchProjectedCostResult) Descriptor() ([]byte, []int) {
+	return file_finfocus_v1_costsource_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *BatchProjectedCostResult) GetResponse() *GetProjectedCostResponse {
+	if x != nil {
+		return x.Response
+	}
+	return nil
+}
+
+func (x *BatchProjectedCostResult) GetError() *ErrorDetail {
+	if x != nil {
+		return x.Error
+	}
+	return nil
+}
+
+// GetSupportedResourcesRequest is used for the GetSupportedResources RPC call (empty request).
+type GetSupportedResourcesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSupportedResourcesRequest) Reset() {
+	*x = GetSupportedResourcesRequest{}
+	mi := &file_finfocus_v1_costsource_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSupportedResourcesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSupportedResourcesRequest) ProtoMessage() {}
+
+func (x *GetSupportedResourcesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_finfocus_v1_costsource_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSupportedResourcesRequest.ProtoReflect.Descriptor instead.
+func (*GetSupportedResourcesRequest) Descriptor() ([]byte, []int) {
+	return file_finfocus_v1_costsource_proto_rawDescGZIP(), []int{56}
+}
+
+// SupportedResourcesResponse lists the resources a plugin can price.
+type SupportedResourcesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// resources supported by the plugin, sorted by provider, then resource_type.
+	Resources     []*SupportedResource `protobuf:"bytes,1,rep,name=resources,proto3" json:"resources,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SupportedResourcesResponse) Reset() {
+	*x = SupportedResourcesResponse{}
+	mi := &file_finfocus_v1_costsource_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SupportedResourcesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SupportedResourcesResponse) ProtoMessage() {}
+
+func (x *SupportedResourcesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_finfocus_v1_costsource_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SupportedResourcesResponse.ProtoReflect.Descriptor instead.
+func (*SupportedResourcesResponse) Descriptor() ([]byte, []int) {
+	return file_finfocus_v1_costsource_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *SupportedResourcesResponse) GetResources() []*SupportedResource {
+	if x != nil {
+		return x.Resources
+	}
+	return nil
+}
+
+// SupportedResource is a provider and resource type pair a plugin can price.
+type SupportedResource struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// provider is the cloud provider (e.g., "aws", "azure", "gcp").
+	Provider string `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	// resource_type is the resource type (e.g., "aws:ec2/instance:Instance").
+	// Empty when every resource type of the provider is supported.
+	ResourceType string `protobuf:"bytes,2,opt,name=resource_type,json=resourceType,proto3" json:"resource_type,omitempty"`
+	// example_skus are optional representative SKUs (e.g., "t3.micro") to
+	// pre-fill pickers. Not an exhaustive list of supported SKUs.
+	ExampleSkus   []string `protobuf:"bytes,3,rep,name=example_skus,json=exampleSkus,proto3" json:"example_skus,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SupportedResource) Reset() {
+	*x = SupportedResource{}
+	mi := &file_finfocus_v1_costsource_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SupportedResource) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SupportedResource) ProtoMessage() {}
+
+func (x *SupportedResource) ProtoReflect() protoreflect.Message {
+	mi := &file_finfocus_v1_costsource_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SupportedResource.ProtoReflect.Descriptor instead.
+func (*SupportedResource) Descriptor() ([]byte, []int) {
+	return file_finfocus_v1_costsource_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *SupportedResource) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *SupportedResource) GetResourceType() string {
+	if x != nil {
+		return x.ResourceType
+	}
+	return ""
+}
+
+func (x *SupportedResource) GetExampleSkus() []string {
+	if x != nil {
+		return x.ExampleSkus
+	}
+	return nil
+}
+
+// SupportsBatchRequest checks many resources in one call.
+type SupportsBatchRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// resources to check, in order. Maximum 500 resources per request.
+	Resources     []*ResourceDescriptor `protobuf:"bytes,1,rep,name=resources,proto3" json:"resources,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SupportsBatchRequest) Reset() {
+	*x = SupportsBatchRequest{}
+	mi := &file_finfocus_v1_costsource_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SupportsBatchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SupportsBatchRequest) ProtoMessage() {}
+
+func (x *SupportsBatchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_finfocus_v1_costsource_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SupportsBatchRequest.ProtoReflect.Descriptor instead.
+func (*SupportsBatchRequest) Descriptor() ([]byte, []int) {
+	return file_finfocus_v1_costsource_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *SupportsBatchRequest) GetResources() []*ResourceDescriptor {
+	if x != nil {
+		return x.Resources
+	}
+	return nil
+}
+
+// SupportsBatchResponse contains one result per requested resource.
+type SupportsBatchResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// results are aligned with SupportsBatchRequest.resources:
+	// results[i] is the answer for resources[i].
+	Results       []*SupportsBatchResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SupportsBatchResponse) Reset() {
+	*x = SupportsBatchResponse{}
+	mi := &file_finfocus_v1_costsource_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SupportsBatchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SupportsBatchResponse) ProtoMessage() {}
+
+func (x *SupportsBatchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_finfocus_v1_costsource_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SupportsBatchResponse.ProtoReflect.Descriptor instead.
+func (*SupportsBatchResponse) Descriptor() ([]byte, []int) {
+	return file_finfocus_v1_costsource_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *SupportsBatchResponse) GetResults() []*SupportsBatchResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+// SupportsBatchResult is the answer for a single resource in a batch.
+type SupportsBatchResult struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// supported indicates whether the plugin can price the resource.
+	Supported bool `protobuf:"varint,1,opt,name=supported,proto3" json:"supported,omitempty"`
+	// reason explains why the resource is not supported, or why it could not
+	// be checked. Empty when supported is true.
+	Reason        string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SupportsBatchResult) Reset() {
+	*x = SupportsBatchResult{}
+	mi := &file_finfocus_v1_costsource_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SupportsBatchResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SupportsBatchResult) ProtoMessage() {}
+
+func (x *SupportsBatchResult) ProtoReflect() protoreflect.Message {
+	mi := &file_finfocus_v1_costsource_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SupportsBatchResult.ProtoReflect.Descriptor instead.
+func (*SupportsBatchResult) Descriptor() ([]byte, []int) {
+	return file_finfocus_v1_costsource_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *SupportsBatchResult) GetSupported() bool {
+	if x != nil {
+		return x.Supported
+	}
+	return false
+}
+
+func (x *SupportsBatchResult) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
 var File_finfocus_v1_costsource_proto protoreflect.FileDescriptor
 
 const file_finfocus_v1_costsource_proto_rawDesc = "" +
@@ -5775,7 +6322,7 @@ const file_finfocus_v1_costsource_proto_rawDesc = "" +
 	"growthRate\x88\x01\x01\x12\x17\n" +
 	"\adry_run\x18\x05 \x01(\bR\x06dryRun\x12>\n" +
 	"\rusage_profile\x18\x06 \x01(\x0e2\x19.finfocus.v1.UsageProfileR\fusageProfileB\x0e\n" +
-	"\f_growth_rate\"\xf3\x05\n" +
+	"\f_growth_rate\"\xaf\a\n" +
 	"\x18GetProjectedCostResponse\x12\x1d\n" +
 	"\n" +
 	"unit_price\x18\x01 \x01(\x01R\tunitPrice\x12\x1a\n" +
@@ -5791,7 +6338,12 @@ const file_finfocus_v1_costsource_proto_rawDesc = "" +
 	"\x19prediction_interval_lower\x18\n" +
 	" \x01(\x01H\x00R\x17predictionIntervalLower\x88\x01\x01\x12?\n" +
 	"\x19prediction_interval_upper\x18\v \x01(\x01H\x01R\x17predictionIntervalUpper\x88\x01\x01\x12.\n" +
-	"\x10confidence_level\x18\f \x01(\x01H\x02R\x0fconfidenceLevel\x88\x01\x01B\x1c\n" +
+	"\x10confidence_level\x18\f \x01(\x01H\x02R\x0fconfidenceLevel\x88\x01\x01\x12!\n" +
+	"\fbilling_mode\x18\r \x01(\tR\vbillingMode\x12\"\n" +
+	"\rrate_per_unit\x18\x0e \x01(\x01R\vratePerUnit\x12\x12\n" +
+	"\x04unit\x18\x0f \x01(\tR\x04unit\x12 \n" +
+	"\vassumptions\x18\x10 \x03(\tR\vassumptions\x12=\n" +
+	"\rpricing_tiers\x18\x11 \x03(\v2\x18.finfocus.v1.PricingTierR\fpricingTiersB\x1c\n" +
 	"\x1a_prediction_interval_lowerB\x1c\n" +
 	"\x1a_prediction_interval_upperB\x13\n" +
 	"\x11_confidence_level\"T\n" +
@@ -5946,12 +6498,13 @@ const file_finfocus_v1_costsource_proto_rawDesc = "" +
 	"\rresource_type\x18\x01 \x01(\tR\fresourceType\x127\n" +
 	"\n" +
 	"attributes\x18\x02 \x01(\v2\x17.google.protobuf.StructR\n" +
-	"attributes\"\xe4\x01\n" +
+	"attributes\"\x86\x02\n" +
 	"\x14EstimateCostResponse\x12\x1a\n" +
 	"\bcurrency\x18\x01 \x01(\tR\bcurrency\x12!\n" +
 	"\fcost_monthly\x18\x02 \x01(\x01R\vcostMonthly\x12L\n" +
 	"\x10pricing_category\x18\x03 \x01(\x0e2!.finfocus.v1.FocusPricingCategoryR\x0fpricingCategory\x12?\n" +
-	"\x1cspot_interruption_risk_score\x18\x04 \x01(\x01R\x19spotInterruptionRiskScore\"\x8b\x03\n" +
+	"\x1cspot_interruption_risk_score\x18\x04 \x01(\x01R\x19spotInterruptionRiskScore\x12 \n" +
+	"\vexplanation\x18\x05 \x03(\tR\vexplanation\"\x8b\x03\n" +
 	"\x19GetRecommendationsRequest\x129\n" +
 	"\x06filter\x18\x01 \x01(\v2!.finfocus.v1.RecommendationFilterR\x06filter\x12+\n" +
 	"\x11projection_period\x18\x02 \x01(\tR\x10projectionPeriod\x12\x1b\n" +
@@ -5964,7 +6517,7 @@ const file_finfocus_v1_costsource_proto_rawDesc = "" +
 	"\x1aGetRecommendationsResponse\x12E\n" +
 	"\x0frecommendations\x18\x01 \x03(\v2\x1b.finfocus.v1.RecommendationR\x0frecommendations\x12<\n" +
 	"\asummary\x18\x02 \x01(\v2\".finfocus.v1.RecommendationSummaryR\asummary\x12&\n" +
-	"\x0fnext_page_token\x18\x03 \x01(\tR\rnextPageToken\"\x98\x06\n" +
+	"\x0fnext_page_token\x18\x03 \x01(\tR\rnextPageToken\"\xd6\x06\n" +
 	"\x14RecommendationFilter\x12\x1a\n" +
 	"\bprovider\x18\x01 \x01(\tR\bprovider\x12\x16\n" +
 	"\x06region\x18\x02 \x01(\tR\x06region\x12#\n" +
@@ -5987,7 +6540,8 @@ const file_finfocus_v1_costsource_proto_rawDesc = "" +
 	"\fmax_age_days\x18\x0f \x01(\x05R\n" +
 	"maxAgeDays\x12\x1f\n" +
 	"\vresource_id\x18\x10 \x01(\tR\n" +
-	"resourceId\x1a7\n" +
+	"resourceId\x12<\n" +
+	"\x1aexclude_unknown_confidence\x18\x11 \x01(\bR\x18excludeUnknownConfidence\x1a7\n" +
 	"\tTagsEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
 	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xb8\t\n" +
@@ -6164,7 +6718,30 @@ const file_finfocus_v1_costsource_proto_rawDesc = "" +
 	"\x0efield_mappings\x18\x01 \x03(\v2\x19.finfocus.v1.FieldMappingR\rfieldMappings\x12/\n" +
 	"\x13configuration_valid\x18\x02 \x01(\bR\x12configurationValid\x121\n" +
 	"\x14configuration_errors\x18\x03 \x03(\tR\x13configurationErrors\x126\n" +
-	"\x17resource_type_supported\x18\x04 \x01(\bR\x15resourceTypeSupported*\x8c\x01\n" +
+	"\x17resource_type_supported\x18\x04 \x01(\bR\x15resourceTypeSupported\"\xd1\x01\n" +
+	"\x19BatchProjectedCostRequest\x12=\n" +
+	"\tresources\x18\x01 \x03(\v2\x1f.finfocus.v1.ResourceDescriptorR\tresources\x125\n" +
+	"\x16utilization_percentage\x18\x02 \x01(\x01R\x15utilizationPercentage\x12>\n" +
+	"\rusage_profile\x18\x03 \x01(\x0e2\x19.finfocus.v1.UsageProfileR\fusageProfile\"]\n" +
+	"\x1aBatchProjectedCostResponse\x12?\n" +
+	"\aresults\x18\x01 \x03(\v2%.finfocus.v1.BatchProjectedCostResultR\aresults\"\x8d\x01\n" +
+	"\x18BatchProjectedCostResult\x12A\n" +
+	"\bresponse\x18\x01 \x01(\v2%.finfocus.v1.GetProjectedCostResponseR\bresponse\x12.\n" +
+	"\x05error\x18\x02 \x01(\v2\x18.finfocus.v1.ErrorDetailR\x05error\"\x1e\n" +
+	"\x1cGetSupportedResourcesRequest\"Z\n" +
+	"\x1aSupportedResourcesResponse\x12<\n" +
+	"\tresources\x18\x01 \x03(\v2\x1e.finfocus.v1.SupportedResourceR\tresources\"w\n" +
+	"\x11SupportedResource\x12\x1a\n" +
+	"\bprovider\x18\x01 \x01(\tR\bprovider\x12#\n" +
+	"\rresource_type\x18\x02 \x01(\tR\fresourceType\x12!\n" +
+	"\fexample_skus\x18\x03 \x03(\tR\vexampleSkus\"U\n" +
+	"\x14SupportsBatchRequest\x12=\n" +
+	"\tresources\x18\x01 \x03(\v2\x1f.finfocus.v1.ResourceDescriptorR\tresources\"S\n" +
+	"\x15SupportsBatchResponse\x12:\n" +
+	"\aresults\x18\x01 \x03(\v2 .finfocus.v1.SupportsBatchResultR\aresults\"K\n" +
+	"\x13SupportsBatchResult\x12\x1c\n" +
+	"\tsupported\x18\x01 \x01(\bR\tsupported\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason*\x8c\x01\n" +
 	"\n" +
 	"MetricKind\x12\x1b\n" +
 	"\x17METRIC_KIND_UNSPECIFIED\x10\x00\x12 \n" +
@@ -6239,13 +6816,14 @@ const file_finfocus_v1_costsource_proto_rawDesc = "" +
 	"\x1bRECOMMENDATION_PRIORITY_LOW\x10\x01\x12\"\n" +
 	"\x1eRECOMMENDATION_PRIORITY_MEDIUM\x10\x02\x12 \n" +
 	"\x1cRECOMMENDATION_PRIORITY_HIGH\x10\x03\x12$\n" +
-	" RECOMMENDATION_PRIORITY_CRITICAL\x10\x04*\xdf\x01\n" +
+	" RECOMMENDATION_PRIORITY_CRITICAL\x10\x04*\x81\x02\n" +
 	"\x14RecommendationSortBy\x12&\n" +
 	"\"RECOMMENDATION_SORT_BY_UNSPECIFIED\x10\x00\x12,\n" +
 	"(RECOMMENDATION_SORT_BY_ESTIMATED_SAVINGS\x10\x01\x12#\n" +
 	"\x1fRECOMMENDATION_SORT_BY_PRIORITY\x10\x02\x12%\n" +
 	"!RECOMMENDATION_SORT_BY_CREATED_AT\x10\x03\x12%\n" +
-	"!RECOMMENDATION_SORT_BY_CONFIDENCE\x10\x04*P\n" +
+	"!RECOMMENDATION_SORT_BY_CONFIDENCE\x10\x04\x12 \n" +
+	"\x1cRECOMMENDATION_SORT_BY_SCORE\x10\x05*P\n" +
 	"\tSortOrder\x12\x1a\n" +
 	"\x16SORT_ORDER_UNSPECIFIED\x10\x00\x12\x12\n" +
 	"\x0eSORT_ORDER_ASC\x10\x01\x12\x13\n" +
@@ -6258,7 +6836,8 @@ const file_finfocus_v1_costsource_proto_rawDesc = "" +
 	"%DISMISSAL_REASON_TECHNICAL_CONSTRAINT\x10\x04\x12\x1d\n" +
 	"\x19DISMISSAL_REASON_DEFERRED\x10\x05\x12\x1f\n" +
 	"\x1bDISMISSAL_REASON_INACCURATE\x10\x06\x12\x1a\n" +
-	"\x16DISMISSAL_REASON_OTHER\x10\a2\xc3\a\n" +
+	"\x16DISMISSAL_REASON_OTHER\x10\a2\xca\n" +
+	"\n" +
 	"\x11CostSourceService\x12;\n" +
 	"\x04Name\x12\x18.finfocus.v1.NameRequest\x1a\x19.finfocus.v1.NameResponse\x12G\n" +
 	"\bSupports\x12\x1c.finfocus.v1.SupportsRequest\x1a\x1d.finfocus.v1.SupportsResponse\x12V\n" +
@@ -6271,7 +6850,11 @@ const file_finfocus_v1_costsource_proto_rawDesc = "" +
 	"\n" +
 	"GetBudgets\x12\x1e.finfocus.v1.GetBudgetsRequest\x1a\x1f.finfocus.v1.GetBudgetsResponse\x12V\n" +
 	"\rGetPluginInfo\x12!.finfocus.v1.GetPluginInfoRequest\x1a\".finfocus.v1.GetPluginInfoResponse\x12A\n" +
-	"\x06DryRun\x12\x1a.finfocus.v1.DryRunRequest\x1a\x1b.finfocus.v1.DryRunResponse2\xb3\x02\n" +
+	"\x06DryRun\x12\x1a.finfocus.v1.DryRunRequest\x1a\x1b.finfocus.v1.DryRunResponse\x12V\n" +
+	"\x10StreamActualCost\x12!.finfocus.v1.GetActualCostRequest\x1a\x1d.finfocus.v1.ActualCostResult0\x01\x12h\n" +
+	"\x15GetProjectedCostBatch\x12&.finfocus.v1.BatchProjectedCostRequest\x1a'.finfocus.v1.BatchProjectedCostResponse\x12k\n" +
+	"\x15GetSupportedResources\x12).finfocus.v1.GetSupportedResourcesRequest\x1a'.finfocus.v1.SupportedResourcesResponse\x12V\n" +
+	"\rSupportsBatch\x12!.finfocus.v1.SupportsBatchRequest\x1a\".finfocus.v1.SupportsBatchResponse2\xb3\x02\n" +
 	"\x14ObservabilityService\x12P\n" +
 	"\vHealthCheck\x12\x1f.finfocus.v1.HealthCheckRequest\x1a .finfocus.v1.HealthCheckResponse\x12M\n" +
 	"\n" +
@@ -6292,7 +6875,7 @@ func file_finfocus_v1_costsource_proto_rawDescGZIP() []byte {
 }
 
 var file_finfocus_v1_costsource_proto_enumTypes = make([]protoimpl.EnumInfo, 13)
-var file_finfocus_v1_costsource_proto_msgTypes = make([]protoimpl.MessageInfo, 72)
+var file_finfocus_v1_costsource_proto_msgTypes = make([]protoimpl.MessageInfo, 81)
 var file_finfocus_v1_costsource_proto_goTypes = []any{
 	(MetricKind)(0),                           // 0: finfocus.v1.MetricKind
 	(FallbackHint)(0),                         // 1: finfocus.v1.FallbackHint
@@ -6360,171 +6943,197 @@ var file_finfocus_v1_costsource_proto_goTypes = []any{
 	(*FieldMapping)(nil),                      // 63: finfocus.v1.FieldMapping
 	(*DryRunRequest)(nil),                     // 64: finfocus.v1.DryRunRequest
 	(*DryRunResponse)(nil),                    // 65: finfocus.v1.DryRunResponse
-	nil,                                       // 66: finfocus.v1.SupportsResponse.CapabilitiesEntry
-	nil,                                       // 67: finfocus.v1.GetActualCostRequest.TagsEntry
-	nil,                                       // 68: finfocus.v1.ResourceDescriptor.TagsEntry
-	nil,                                       // 69: finfocus.v1.PricingSpec.PluginMetadataEntry
-	nil,                                       // 70: finfocus.v1.ErrorDetail.DetailsEntry
-	nil,                                       // 71: finfocus.v1.MetricSample.LabelsEntry
-	nil,                                       // 72: finfocus.v1.LogEntry.FieldsEntry
-	nil,                                       // 73: finfocus.v1.RecommendationFilter.TagsEntry
-	nil,                                       // 74: finfocus.v1.Recommendation.MetadataEntry
-	nil,                                       // 75: finfocus.v1.ResourceRecommendationInfo.TagsEntry
-	nil,                                       // 76: finfocus.v1.ResourceUtilization.CustomMetricsEntry
-	nil,                                       // 77: finfocus.v1.ModifyAction.CurrentConfigEntry
-	nil,                                       // 78: finfocus.v1.ModifyAction.RecommendedConfigEntry
-	nil,                                       // 79: finfocus.v1.RecommendationSummary.CountByCategoryEntry
-	nil,                                       // 80: finfocus.v1.RecommendationSummary.SavingsByCategoryEntry
-	nil,                                       // 81: finfocus.v1.RecommendationSummary.CountByActionTypeEntry
-	nil,                                       // 82: finfocus.v1.RecommendationSummary.SavingsByActionTypeEntry
-	nil,                                       // 83: finfocus.v1.GetPluginInfoResponse.MetadataEntry
-	nil,                                       // 84: finfocus.v1.DryRunRequest.SimulationParametersEntry
-	(PluginCapability)(0),                     // 85: finfocus.v1.PluginCapability
-	(*timestamppb.Timestamp)(nil),             // 86: google.protobuf.Timestamp
-	(GrowthType)(0),                           // 87: finfocus.v1.GrowthType
-	(UsageProfile)(0),                         // 88: finfocus.v1.UsageProfile
-	(FocusPricingCategory)(0),                 // 89: finfocus.v1.FocusPricingCategory
-	(*FocusCostRecord)(nil),                   // 90: finfocus.v1.FocusCostRecord
-	(*structpb.Struct)(nil),                   // 91: google.protobuf.Struct
-	(RecommendationReason)(0),                 // 92: finfocus.v1.RecommendationReason
-	(FieldSupportStatus)(0),                   // 93: finfocus.v1.FieldSupportStatus
-	(*GetBudgetsRequest)(nil),                 // 94: finfocus.v1.GetBudgetsRequest
-	(*GetBudgetsResponse)(nil),                // 95: finfocus.v1.GetBudgetsResponse
+	(*BatchProjectedCostRequest)(nil),         // 66: finfocus.v1.BatchProjectedCostRequest
+	(*BatchProjectedCostResponse)(nil),        // 67: finfocus.v1.BatchProjectedCostResponse
+	(*BatchProjectedCostResult)(nil),          // 68: finfocus.v1.BatchProjectedCostResult
+	(*GetSupportedResourcesRequest)(nil),      // 69: finfocus.v1.GetSupportedResourcesRequest
+	(*SupportedResourcesResponse)(nil),        // 70: finfocus.v1.SupportedResourcesResponse
+	(*SupportedResource)(nil),                 // 71: finfocus.v1.SupportedResource
+	(*SupportsBatchRequest)(nil),              // 72: finfocus.v1.SupportsBatchRequest
+	(*SupportsBatchResponse)(nil),             // 73: finfocus.v1.SupportsBatchResponse
+	(*SupportsBatchResult)(nil),               // 74: finfocus.v1.SupportsBatchResult
+	nil,                                       // 75: finfocus.v1.SupportsResponse.CapabilitiesEntry
+	nil,                                       // 76: finfocus.v1.GetActualCostRequest.TagsEntry
+	nil,                                       // 77: finfocus.v1.ResourceDescriptor.TagsEntry
+	nil,                                       // 78: finfocus.v1.PricingSpec.PluginMetadataEntry
+	nil,                                       // 79: finfocus.v1.ErrorDetail.DetailsEntry
+	nil,                                       // 80: finfocus.v1.MetricSample.LabelsEntry
+	nil,                                       // 81: finfocus.v1.LogEntry.FieldsEntry
+	nil,                                       // 82: finfocus.v1.RecommendationFilter.TagsEntry
+	nil,                                       // 83: finfocus.v1.Recommendation.MetadataEntry
+	nil,                                       // 84: finfocus.v1.ResourceRecommendationInfo.TagsEntry
+	nil,                                       // 85: finfocus.v1.ResourceUtilization.CustomMetricsEntry
+	nil,                                       // 86: finfocus.v1.ModifyAction.CurrentConfigEntry
+	nil,                                       // 87: finfocus.v1.ModifyAction.RecommendedConfigEntry
+	nil,                                       // 88: finfocus.v1.RecommendationSummary.CountByCategoryEntry
+	nil,                                       // 89: finfocus.v1.RecommendationSummary.SavingsByCategoryEntry
+	nil,                                       // 90: finfocus.v1.RecommendationSummary.CountByActionTypeEntry
+	nil,                                       // 91: finfocus.v1.RecommendationSummary.SavingsByActionTypeEntry
+	nil,                                       // 92: finfocus.v1.GetPluginInfoResponse.MetadataEntry
+	nil,                                       // 93: finfocus.v1.DryRunRequest.SimulationParametersEntry
+	(PluginCapability)(0),                     // 94: finfocus.v1.PluginCapability
+	(*timestamppb.Timestamp)(nil),             // 95: google.protobuf.Timestamp
+	(GrowthType)(0),                           // 96: finfocus.v1.GrowthType
+	(UsageProfile)(0),                         // 97: finfocus.v1.UsageProfile
+	(FocusPricingCategory)(0),                 // 98: finfocus.v1.FocusPricingCategory
+	(*FocusCostRecord)(nil),                   // 99: finfocus.v1.FocusCostRecord
+	(*structpb.Struct)(nil),                   // 100: google.protobuf.Struct
+	(RecommendationReason)(0),                 // 101: finfocus.v1.RecommendationReason
+	(FieldSupportStatus)(0),                   // 102: finfocus.v1.FieldSupportStatus
+	(*GetBudgetsRequest)(nil),                 // 103: finfocus.v1.GetBudgetsRequest
+	(*GetBudgetsResponse)(nil),                // 104: finfocus.v1.GetBudgetsResponse
 }
 var file_finfocus_v1_costsource_proto_depIdxs = []int32{
 	0,   // 0: finfocus.v1.ImpactMetric.kind:type_name -> finfocus.v1.MetricKind
 	24,  // 1: finfocus.v1.SupportsRequest.resource:type_name -> finfocus.v1.ResourceDescriptor
-	66,  // 2: finfocus.v1.SupportsResponse.capabilities:type_name -> finfocus.v1.SupportsResponse.CapabilitiesEntry
+	75,  // 2: finfocus.v1.SupportsResponse.capabilities:type_name -> finfocus.v1.SupportsResponse.CapabilitiesEntry
 	0,   // 3: finfocus.v1.SupportsResponse.supported_metrics:type_name -> finfocus.v1.MetricKind
-	85,  // 4: finfocus.v1.SupportsResponse.capabilities_enum:type_name -> finfocus.v1.PluginCapability
-	86,  // 5: finfocus.v1.GetActualCostRequest.start:type_name -> google.protobuf.Timestamp
-	86,  // 6: finfocus.v1.GetActualCostRequest.end:type_name -> google.protobuf.Timestamp
-	67,  // 7: finfocus.v1.GetActualCostRequest.tags:type_name -> finfocus.v1.GetActualCostRequest.TagsEntry
+	94,  // 4: finfocus.v1.SupportsResponse.capabilities_enum:type_name -> finfocus.v1.PluginCapability
+	95,  // 5: finfocus.v1.GetActualCostRequest.start:type_name -> google.protobuf.Timestamp
+	95,  // 6: finfocus.v1.GetActualCostRequest.end:type_name -> google.protobuf.Timestamp
+	76,  // 7: finfocus.v1.GetActualCostRequest.tags:type_name -> finfocus.v1.GetActualCostRequest.TagsEntry
 	25,  // 8: finfocus.v1.GetActualCostResponse.results:type_name -> finfocus.v1.ActualCostResult
 	1,   // 9: finfocus.v1.GetActualCostResponse.fallback_hint:type_name -> finfocus.v1.FallbackHint
 	65,  // 10: finfocus.v1.GetActualCostResponse.dry_run_result:type_name -> finfocus.v1.DryRunResponse
 	24,  // 11: finfocus.v1.GetProjectedCostRequest.resource:type_name -> finfocus.v1.ResourceDescriptor
-	87,  // 12: finfocus.v1.GetProjectedCostRequest.growth_type:type_name -> finfocus.v1.GrowthType
-	88,  // 13: finfocus.v1.GetProjectedCostRequest.usage_profile:type_name -> finfocus.v1.UsageProfile
+	96,  // 12: finfocus.v1.GetProjectedCostRequest.growth_type:type_name -> finfocus.v1.GrowthType
+	97,  // 13: finfocus.v1.GetProjectedCostRequest.usage_profile:type_name -> finfocus.v1.UsageProfile
 	15,  // 14: finfocus.v1.GetProjectedCostResponse.impact_metrics:type_name -> finfocus.v1.ImpactMetric
-	87,  // 15: finfocus.v1.GetProjectedCostResponse.growth_type:type_name -> finfocus.v1.GrowthType
+	96,  // 15: finfocus.v1.GetProjectedCostResponse.growth_type:type_name -> finfocus.v1.GrowthType
 	65,  // 16: finfocus.v1.GetProjectedCostResponse.dry_run_result:type_name -> finfocus.v1.DryRunResponse
-	89,  // 17: finfocus.v1.GetProjectedCostResponse.pricing_category:type_name -> finfocus.v1.FocusPricingCategory
-	24,  // 18: finfocus.v1.GetPricingSpecRequest.resource:type_name -> finfocus.v1.ResourceDescriptor
-	27,  // 19: finfocus.v1.GetPricingSpecResponse.spec:type_name -> finfocus.v1.PricingSpec
-	68,  // 20: finfocus.v1.ResourceDescriptor.tags:type_name -> finfocus.v1.ResourceDescriptor.TagsEntry
-	87,  // 21: finfocus.v1.ResourceDescriptor.growth_type:type_name -> finfocus.v1.GrowthType
-	86,  // 22: finfocus.v1.ActualCostResult.timestamp:type_name -> google.protobuf.Timestamp
-	90,  // 23: finfocus.v1.ActualCostResult.focus_record:type_name -> finfocus.v1.FocusCostRecord
-	15,  // 24: finfocus.v1.ActualCostResult.impact_metrics:type_name -> finfocus.v1.ImpactMetric
-	26,  // 25: finfocus.v1.PricingSpec.metric_hints:type_name -> finfocus.v1.UsageMetricHint
-	69,  // 26: finfocus.v1.PricingSpec.plugin_metadata:type_name -> finfocus.v1.PricingSpec.PluginMetadataEntry
-	28,  // 27: finfocus.v1.PricingSpec.pricing_tiers:type_name -> finfocus.v1.PricingTier
-	3,   // 28: finfocus.v1.ErrorDetail.code:type_name -> finfocus.v1.ErrorCode
-	2,   // 29: finfocus.v1.ErrorDetail.category:type_name -> finfocus.v1.ErrorCategory
-	70,  // 30: finfocus.v1.ErrorDetail.details:type_name -> finfocus.v1.ErrorDetail.DetailsEntry
-	86,  // 31: finfocus.v1.ErrorDetail.timestamp:type_name -> google.protobuf.Timestamp
-	12,  // 32: finfocus.v1.HealthCheckResponse.status:type_name -> finfocus.v1.HealthCheckResponse.Status
-	86,  // 33: finfocus.v1.HealthCheckResponse.last_check_time:type_name -> google.protobuf.Timestamp
-	34,  // 34: finfocus.v1.GetMetricsResponse.metrics:type_name -> finfocus.v1.Metric
-	86,  // 35: finfocus.v1.GetMetricsResponse.timestamp:type_name -> google.protobuf.Timestamp
-	4,   // 36: finfocus.v1.Metric.type:type_name -> finfocus.v1.MetricType
-	35,  // 37: finfocus.v1.Metric.samples:type_name -> finfocus.v1.MetricSample
-	71,  // 38: finfocus.v1.MetricSample.labels:type_name -> finfocus.v1.MetricSample.LabelsEntry
-	86,  // 39: finfocus.v1.MetricSample.timestamp:type_name -> google.protobuf.Timestamp
-	39,  // 40: finfocus.v1.GetServiceLevelIndicatorsRequest.time_range:type_name -> finfocus.v1.TimeRange
-	38,  // 41: finfocus.v1.GetServiceLevelIndicatorsResponse.slis:type_name -> finfocus.v1.ServiceLevelIndicator
-	86,  // 42: finfocus.v1.GetServiceLevelIndicatorsResponse.measurement_time:type_name -> google.protobuf.Timestamp
-	5,   // 43: finfocus.v1.ServiceLevelIndicator.status:type_name -> finfocus.v1.SLIStatus
-	86,  // 44: finfocus.v1.TimeRange.start:type_name -> google.protobuf.Timestamp
-	86,  // 45: finfocus.v1.TimeRange.end:type_name -> google.protobuf.Timestamp
-	86,  // 46: finfocus.v1.LogEntry.timestamp:type_name -> google.protobuf.Timestamp
-	72,  // 47: finfocus.v1.LogEntry.fields:type_name -> finfocus.v1.LogEntry.FieldsEntry
-	42,  // 48: finfocus.v1.LogEntry.error_details:type_name -> finfocus.v1.ErrorDetails
-	91,  // 49: finfocus.v1.EstimateCostRequest.attributes:type_name -> google.protobuf.Struct
-	89,  // 50: finfocus.v1.EstimateCostResponse.pricing_category:type_name -> finfocus.v1.FocusPricingCategory
-	47,  // 51: finfocus.v1.GetRecommendationsRequest.filter:type_name -> finfocus.v1.RecommendationFilter
-	24,  // 52: finfocus.v1.GetRecommendationsRequest.target_resources:type_name -> finfocus.v1.ResourceDescriptor
-	88,  // 53: finfocus.v1.GetRecommendationsRequest.usage_profile:type_name -> finfocus.v1.UsageProfile
-	48,  // 54: finfocus.v1.GetRecommendationsResponse.recommendations:type_name -> finfocus.v1.Recommendation
-	58,  // 55: finfocus.v1.GetRecommendationsResponse.summary:type_name -> finfocus.v1.RecommendationSummary
-	6,   // 56: finfocus.v1.RecommendationFilter.category:type_name -> finfocus.v1.RecommendationCategory
-	7,   // 57: finfocus.v1.RecommendationFilter.action_type:type_name -> finfocus.v1.RecommendationActionType
-	73,  // 58: finfocus.v1.RecommendationFilter.tags:type_name -> finfocus.v1.RecommendationFilter.TagsEntry
-	8,   // 59: finfocus.v1.RecommendationFilter.priority:type_name -> finfocus.v1.RecommendationPriority
-	9,   // 60: finfocus.v1.RecommendationFilter.sort_by:type_name -> finfocus.v1.RecommendationSortBy
-	10,  // 61: finfocus.v1.RecommendationFilter.sort_order:type_name -> finfocus.v1.SortOrder
-	6,   // 62: finfocus.v1.Recommendation.category:type_name -> finfocus.v1.RecommendationCategory
-	7,   // 63: finfocus.v1.Recommendation.action_type:type_name -> finfocus.v1.RecommendationActionType
-	49,  // 64: finfocus.v1.Recommendation.resource:type_name -> finfocus.v1.ResourceRecommendationInfo
-	51,  // 65: finfocus.v1.Recommendation.rightsize:type_name -> finfocus.v1.RightsizeAction
-	52,  // 66: finfocus.v1.Recommendation.terminate:type_name -> finfocus.v1.TerminateAction
-	53,  // 67: finfocus.v1.Recommendation.commitment:type_name -> finfocus.v1.CommitmentAction
-	54,  // 68: finfocus.v1.Recommendation.kubernetes:type_name -> finfocus.v1.KubernetesAction
-	56,  // 69: finfocus.v1.Recommendation.modify:type_name -> finfocus.v1.ModifyAction
-	57,  // 70: finfocus.v1.Recommendation.impact:type_name -> finfocus.v1.RecommendationImpact
-	8,   // 71: finfocus.v1.Recommendation.priority:type_name -> finfocus.v1.RecommendationPriority
-	86,  // 72: finfocus.v1.Recommendation.created_at:type_name -> google.protobuf.Timestamp
-	74,  // 73: finfocus.v1.Recommendation.metadata:type_name -> finfocus.v1.Recommendation.MetadataEntry
-	92,  // 74: finfocus.v1.Recommendation.primary_reason:type_name -> finfocus.v1.RecommendationReason
-	92,  // 75: finfocus.v1.Recommendation.secondary_reasons:type_name -> finfocus.v1.RecommendationReason
-	75,  // 76: finfocus.v1.ResourceRecommendationInfo.tags:type_name -> finfocus.v1.ResourceRecommendationInfo.TagsEntry
-	50,  // 77: finfocus.v1.ResourceRecommendationInfo.utilization:type_name -> finfocus.v1.ResourceUtilization
-	76,  // 78: finfocus.v1.ResourceUtilization.custom_metrics:type_name -> finfocus.v1.ResourceUtilization.CustomMetricsEntry
-	50,  // 79: finfocus.v1.RightsizeAction.projected_utilization:type_name -> finfocus.v1.ResourceUtilization
-	55,  // 80: finfocus.v1.KubernetesAction.current_requests:type_name -> finfocus.v1.KubernetesResources
-	55,  // 81: finfocus.v1.KubernetesAction.recommended_requests:type_name -> finfocus.v1.KubernetesResources
-	55,  // 82: finfocus.v1.KubernetesAction.current_limits:type_name -> finfocus.v1.KubernetesResources
-	55,  // 83: finfocus.v1.KubernetesAction.recommended_limits:type_name -> finfocus.v1.KubernetesResources
-	77,  // 84: finfocus.v1.ModifyAction.current_config:type_name -> finfocus.v1.ModifyAction.CurrentConfigEntry
-	78,  // 85: finfocus.v1.ModifyAction.recommended_config:type_name -> finfocus.v1.ModifyAction.RecommendedConfigEntry
-	79,  // 86: finfocus.v1.RecommendationSummary.count_by_category:type_name -> finfocus.v1.RecommendationSummary.CountByCategoryEntry
-	80,  // 87: finfocus.v1.RecommendationSummary.savings_by_category:type_name -> finfocus.v1.RecommendationSummary.SavingsByCategoryEntry
-	81,  // 88: finfocus.v1.RecommendationSummary.count_by_action_type:type_name -> finfocus.v1.RecommendationSummary.CountByActionTypeEntry
-	82,  // 89: finfocus.v1.RecommendationSummary.savings_by_action_type:type_name -> finfocus.v1.RecommendationSummary.SavingsByActionTypeEntry
-	11,  // 90: finfocus.v1.DismissRecommendationRequest.reason:type_name -> finfocus.v1.DismissalReason
-	86,  // 91: finfocus.v1.DismissRecommendationRequest.expires_at:type_name -> google.protobuf.Timestamp
-	86,  // 92: finfocus.v1.DismissRecommendationResponse.dismissed_at:type_name -> google.protobuf.Timestamp
-	86,  // 93: finfocus.v1.DismissRecommendationResponse.expires_at:type_name -> google.protobuf.Timestamp
-	83,  // 94: finfocus.v1.GetPluginInfoResponse.metadata:type_name -> finfocus.v1.GetPluginInfoResponse.MetadataEntry
-	85,  // 95: finfocus.v1.GetPluginInfoResponse.capabilities:type_name -> finfocus.v1.PluginCapability
-	93,  // 96: finfocus.v1.FieldMapping.support_status:type_name -> finfocus.v1.FieldSupportStatus
-	24,  // 97: finfocus.v1.DryRunRequest.resource:type_name -> finfocus.v1.ResourceDescriptor
-	84,  // 98: finfocus.v1.DryRunRequest.simulation_parameters:type_name -> finfocus.v1.DryRunRequest.SimulationParametersEntry
-	63,  // 99: finfocus.v1.DryRunResponse.field_mappings:type_name -> finfocus.v1.FieldMapping
-	13,  // 100: finfocus.v1.CostSourceService.Name:input_type -> finfocus.v1.NameRequest
-	16,  // 101: finfocus.v1.CostSourceService.Supports:input_type -> finfocus.v1.SupportsRequest
-	18,  // 102: finfocus.v1.CostSourceService.GetActualCost:input_type -> finfocus.v1.GetActualCostRequest
-	20,  // 103: finfocus.v1.CostSourceService.GetProjectedCost:input_type -> finfocus.v1.GetProjectedCostRequest
-	22,  // 104: finfocus.v1.CostSourceService.GetPricingSpec:input_type -> finfocus.v1.GetPricingSpecRequest
-	43,  // 105: finfocus.v1.CostSourceService.EstimateCost:input_type -> finfocus.v1.EstimateCostRequest
-	45,  // 106: finfocus.v1.CostSourceService.GetRecommendations:input_type -> finfocus.v1.GetRecommendationsRequest
-	59,  // 107: finfocus.v1.CostSourceService.DismissRecommendation:input_type -> finfocus.v1.DismissRecommendationRequest
-	94,  // 108: finfocus.v1.CostSourceService.GetBudgets:input_type -> finfocus.v1.GetBudgetsRequest
-	61,  // 109: finfocus.v1.CostSourceService.GetPluginInfo:input_type -> finfocus.v1.GetPluginInfoRequest
-	64,  // 110: finfocus.v1.CostSourceService.DryRun:input_type -> finfocus.v1.DryRunRequest
-	30,  // 111: finfocus.v1.ObservabilityService.HealthCheck:input_type -> finfocus.v1.HealthCheckRequest
-	32,  // 112: finfocus.v1.ObservabilityService.GetMetrics:input_type -> finfocus.v1.GetMetricsRequest
-	36,  // 113: finfocus.v1.ObservabilityService.GetServiceLevelIndicators:input_type -> finfocus.v1.GetServiceLevelIndicatorsRequest
-	14,  // 114: finfocus.v1.CostSourceService.Name:output_type -> finfocus.v1.NameResponse
-	17,  // 115: finfocus.v1.CostSourceService.Supports:output_type -> finfocus.v1.SupportsResponse
-	19,  // 116: finfocus.v1.CostSourceService.GetActualCost:output_type -> finfocus.v1.GetActualCostResponse
-	21,  // 117: finfocus.v1.CostSourceService.GetProjectedCost:output_type -> finfocus.v1.GetProjectedCostResponse
-	23,  // 118: finfocus.v1.CostSourceService.GetPricingSpec:output_type -> finfocus.v1.GetPricingSpecResponse
-	44,  // 119: finfocus.v1.CostSourceService.EstimateCost:output_type -> finfocus.v1.EstimateCostResponse
-	46,  // 120: finfocus.v1.CostSourceService.GetRecommendations:output_type -> finfocus.v1.GetRecommendationsResponse
-	60,  // 121: finfocus.v1.CostSourceService.DismissRecommendation:output_type -> finfocus.v1.DismissRecommendationResponse
-	95,  // 122: finfocus.v1.CostSourceService.GetBudgets:output_type -> finfocus.v1.GetBudgetsResponse
-	62,  // 123: finfocus.v1.CostSourceService.GetPluginInfo:output_type -> finfocus.v1.GetPluginInfoResponse
-	65,  // 124: finfocus.v1.CostSourceService.DryRun:output_type -> finfocus.v1.DryRunResponse
-	31,  // 125: finfocus.v1.ObservabilityService.HealthCheck:output_type -> finfocus.v1.HealthCheckResponse
-	33,  // 126: finfocus.v1.ObservabilityService.GetMetrics:output_type -> finfocus.v1.GetMetricsResponse
-	37,  // 127: finfocus.v1.ObservabilityService.GetServiceLevelIndicators:output_type -> finfocus.v1.GetServiceLevelIndicatorsResponse
-	114, // [114:128] is the sub-list for method output_type
-	100, // [100:114] is the sub-list for method input_type
-	100, // [100:100] is the sub-list for extension type_name
-	100, // [100:100] is the sub-list for extension extendee
-	0,   // [0:100] is the sub-list for field type_name
+	98,  // 17: finfocus.v1.GetProjectedCostResponse.pricing_category:type_name -> finfocus.v1.FocusPricingCategory
+	28,  // 18: finfocus.v1.GetProjectedCostResponse.pricing_tiers:type_name -> finfocus.v1.PricingTier
+	24,  // 19: finfocus.v1.GetPricingSpecRequest.resource:type_name -> finfocus.v1.ResourceDescriptor
+	27,  // 20: finfocus.v1.GetPricingSpecResponse.spec:type_name -> finfocus.v1.PricingSpec
+	77,  // 21: finfocus.v1.ResourceDescriptor.tags:type_name -> finfocus.v1.ResourceDescriptor.TagsEntry
+	96,  // 22: finfocus.v1.ResourceDescriptor.growth_type:type_name -> finfocus.v1.GrowthType
+	95,  // 23: finfocus.v1.ActualCostResult.timestamp:type_name -> google.protobuf.Timestamp
+	99,  // 24: finfocus.v1.ActualCostResult.focus_record:type_name -> finfocus.v1.FocusCostRecord
+	15,  // 25: finfocus.v1.ActualCostResult.impact_metrics:type_name -> finfocus.v1.ImpactMetric
+	26,  // 26: finfocus.v1.PricingSpec.metric_hints:type_name -> finfocus.v1.UsageMetricHint
+	78,  // 27: finfocus.v1.PricingSpec.plugin_metadata:type_name -> finfocus.v1.PricingSpec.PluginMetadataEntry
+	28,  // 28: finfocus.v1.PricingSpec.pricing_tiers:type_name -> finfocus.v1.PricingTier
+	3,   // 29: finfocus.v1.ErrorDetail.code:type_name -> finfocus.v1.ErrorCode
+	2,   // 30: finfocus.v1.ErrorDetail.category:type_name -> finfocus.v1.ErrorCategory
+	79,  // 31: finfocus.v1.ErrorDetail.details:type_name -> finfocus.v1.ErrorDetail.DetailsEntry
+	95,  // 32: finfocus.v1.ErrorDetail.timestamp:type_name -> google.protobuf.Timestamp
+	12,  // 33: finfocus.v1.HealthCheckResponse.status:type_name -> finfocus.v1.HealthCheckResponse.Status
+	95,  // 34: finfocus.v1.HealthCheckResponse.last_check_time:type_name -> google.protobuf.Timestamp
+	34,  // 35: finfocus.v1.GetMetricsResponse.metrics:type_name -> finfocus.v1.Metric
+	95,  // 36: finfocus.v1.GetMetricsResponse.timestamp:type_name -> google.protobuf.Timestamp
+	4,   // 37: finfocus.v1.Metric.type:type_name -> finfocus.v1.MetricType
+	35,  // 38: finfocus.v1.Metric.samples:type_name -> finfocus.v1.MetricSample
+	80,  // 39: finfocus.v1.MetricSample.labels:type_name -> finfocus.v1.MetricSample.LabelsEntry
+	95,  // 40: finfocus.v1.MetricSample.timestamp:type_name -> google.protobuf.Timestamp
+	39,  // 41: finfocus.v1.GetServiceLevelIndicatorsRequest.time_range:type_name -> finfocus.v1.TimeRange
+	38,  // 42: finfocus.v1.GetServiceLevelIndicatorsResponse.slis:type_name -> finfocus.v1.ServiceLevelIndicator
+	95,  // 43: finfocus.v1.GetServiceLevelIndicatorsResponse.measurement_time:type_name -> google.protobuf.Timestamp
+	5,   // 44: finfocus.v1.ServiceLevelIndicator.status:type_name -> finfocus.v1.SLIStatus
+	95,  // 45: finfocus.v1.TimeRange.start:type_name -> google.protobuf.Timestamp
+	95,  // 46: finfocus.v1.TimeRange.end:type_name -> google.protobuf.Timestamp
+	95,  // 47: finfocus.v1.LogEntry.timestamp:type_name -> google.protobuf.Timestamp
+	81,  // 48: finfocus.v1.LogEntry.fields:type_name -> finfocus.v1.LogEntry.FieldsEntry
+	42,  // 49: finfocus.v1.LogEntry.error_details:type_name -> finfocus.v1.ErrorDetails
+	100, // 50: finfocus.v1.EstimateCostRequest.attributes:type_name -> google.protobuf.Struct
+	98,  // 51: finfocus.v1.EstimateCostResponse.pricing_category:type_name -> finfocus.v1.FocusPricingCategory
+	47,  // 52: finfocus.v1.GetRecommendationsRequest.filter:type_name -> finfocus.v1.RecommendationFilter
+	24,  // 53: finfocus.v1.GetRecommendationsRequest.target_resources:type_name -> finfocus.v1.ResourceDescriptor
+	97,  // 54: finfocus.v1.GetRecommendationsRequest.usage_profile:type_name -> finfocus.v1.UsageProfile
+	48,  // 55: finfocus.v1.GetRecommendationsResponse.recommendations:type_name -> finfocus.v1.Recommendation
+	58,  // 56: finfocus.v1.GetRecommendationsResponse.summary:type_name -> finfocus.v1.RecommendationSummary
+	6,   // 57: finfocus.v1.RecommendationFilter.category:type_name -> finfocus.v1.RecommendationCategory
+	7,   // 58: finfocus.v1.RecommendationFilter.action_type:type_name -> finfocus.v1.RecommendationActionType
+	82,  // 59: finfocus.v1.RecommendationFilter.tags:type_name -> finfocus.v1.RecommendationFilter.TagsEntry
+	8,   // 60: finfocus.v1.RecommendationFilter.priority:type_name -> finfocus.v1.RecommendationPriority
+	9,   // 61: finfocus.v1.RecommendationFilter.sort_by:type_name -> finfocus.v1.RecommendationSortBy
+	10,  // 62: finfocus.v1.RecommendationFilter.sort_order:type_name -> finfocus.v1.SortOrder
+	6,   // 63: finfocus.v1.Recommendation.category:type_name -> finfocus.v1.RecommendationCategory
+	7,   // 64: finfocus.v1.Recommendation.action_type:type_name -> finfocus.v1.RecommendationActionType
+	49,  // 65: finfocus.v1.Recommendation.resource:type_name -> finfocus.v1.ResourceRecommendationInfo
+	51,  // 66: finfocus.v1.Recommendation.rightsize:type_name -> finfocus.v1.RightsizeAction
+	52,  // 67: finfocus.v1.Recommendation.terminate:type_name -> finfocus.v1.TerminateAction
+	53,  // 68: finfocus.v1.Recommendation.commitment:type_name -> finfocus.v1.CommitmentAction
+	54,  // 69: finfocus.v1.Recommendation.kubernetes:type_name -> finfocus.v1.KubernetesAction
+	56,  // 70: finfocus.v1.Recommendation.modify:type_name -> finfocus.v1.ModifyAction
+	57,  // 71: finfocus.v1.Recommendation.impact:type_name -> finfocus.v1.RecommendationImpact
+	8,   // 72: finfocus.v1.Recommendation.priority:type_name -> finfocus.v1.RecommendationPriority
+	95,  // 73: finfocus.v1.Recommendation.created_at:type_name -> google.protobuf.Timestamp
+	83,  // 74: finfocus.v1.Recommendation.metadata:type_name -> finfocus.v1.Recommendation.MetadataEntry
+	101, // 75: finfocus.v1.Recommendation.primary_reason:type_name -> finfocus.v1.RecommendationReason
+	101, // 76: finfocus.v1.Recommendation.secondary_reasons:type_name -> finfocus.v1.RecommendationReason
+	84,  // 77: finfocus.v1.ResourceRecommendationInfo.tags:type_name -> finfocus.v1.ResourceRecommendationInfo.TagsEntry
+	50,  // 78: finfocus.v1.ResourceRecommendationInfo.utilization:type_name -> finfocus.v1.ResourceUtilization
+	85,  // 79: finfocus.v1.ResourceUtilization.custom_metrics:type_name -> finfocus.v1.ResourceUtilization.CustomMetricsEntry
+	50,  // 80: finfocus.v1.RightsizeAction.projected_utilization:type_name -> finfocus.v1.ResourceUtilization
+	55,  // 81: finfocus.v1.KubernetesAction.current_requests:type_name -> finfocus.v1.KubernetesResources
+	55,  // 82: finfocus.v1.KubernetesAction.recommended_requests:type_name -> finfocus.v1.KubernetesResources
+	55,  // 83: finfocus.v1.KubernetesAction.current_limits:type_name -> finfocus.v1.KubernetesResources
+	55,  // 84: finfocus.v1.KubernetesAction.recommended_limits:type_name -> finfocus.v1.KubernetesResources
+	86,  // 85: finfocus.v1.ModifyAction.current_config:type_name -> finfocus.v1.ModifyAction.CurrentConfigEntry
+	87,  // 86: finfocus.v1.ModifyAction.recommended_config:type_name -> finfocus.v1.ModifyAction.RecommendedConfigEntry
+	88,  // 87: finfocus.v1.RecommendationSummary.count_by_category:type_name -> finfocus.v1.RecommendationSummary.CountByCategoryEntry
+	89,  // 88: finfocus.v1.RecommendationSummary.savings_by_category:type_name -> finfocus.v1.RecommendationSummary.SavingsByCategoryEntry
+	90,  // 89: finfocus.v1.RecommendationSummary.count_by_action_type:type_name -> finfocus.v1.RecommendationSummary.CountByActionTypeEntry
+	91,  // 90: finfocus.v1.RecommendationSummary.savings_by_action_type:type_name -> finfocus.v1.RecommendationSummary.SavingsByActionTypeEntry
+	11,  // 91: finfocus.v1.DismissRecommendationRequest.reason:type_name -> finfocus.v1.DismissalReason
+	95,  // 92: finfocus.v1.DismissRecommendationRequest.expires_at:type_name -> google.protobuf.Timestamp
+	95,  // 93: finfocus.v1.DismissRecommendationResponse.dismissed_at:type_name -> google.protobuf.Timestamp
+	95,  // 94: finfocus.v1.DismissRecommendationResponse.expires_at:type_name -> google.protobuf.Timestamp
+	92,  // 95: finfocus.v1.GetPluginInfoResponse.metadata:type_name -> finfocus.v1.GetPluginInfoResponse.MetadataEntry
+	94,  // 96: finfocus.v1.GetPluginInfoResponse.capabilities:type_name -> finfocus.v1.PluginCapability
+	102, // 97: finfocus.v1.FieldMapping.support_status:type_name -> finfocus.v1.FieldSupportStatus
+	24,  // 98: finfocus.v1.DryRunRequest.resource:type_name -> finfocus.v1.ResourceDescriptor
+	93,  // 99: finfocus.v1.DryRunRequest.simulation_parameters:type_name -> finfocus.v1.DryRunRequest.SimulationParametersEntry
+	63,  // 100: finfocus.v1.DryRunResponse.field_mappings:type_name -> finfocus.v1.FieldMapping
+	24,  // 101: finfocus.v1.BatchProjectedCostRequest.resources:type_name -> finfocus.v1.ResourceDescriptor
+	97,  // 102: finfocus.v1.BatchProjectedCostRequest.usage_profile:type_name -> finfocus.v1.UsageProfile
+	68,  // 103: finfocus.v1.BatchProjectedCostResponse.results:type_name -> finfocus.v1.BatchProjectedCostResult
+	21,  // 104: finfocus.v1.BatchProjectedCostResult.response:type_name -> finfocus.v1.GetProjectedCostResponse
+	29,  // 105: finfocus.v1.BatchProjectedCostResult.error:type_name -> finfocus.v1.ErrorDetail
+	71,  // 106: finfocus.v1.SupportedResourcesResponse.resources:type_name -> finfocus.v1.SupportedResource
+	24,  // 107: finfocus.v1.SupportsBatchRequest.resources:type_name -> finfocus.v1.ResourceDescriptor
+	74,  // 108: finfocus.v1.SupportsBatchResponse.results:type_name -> finfocus.v1.SupportsBatchResult
+	13,  // 109: finfocus.v1.CostSourceService.Name:input_type -> finfocus.v1.NameRequest
+	16,  // 110: finfocus.v1.CostSourceService.Supports:input_type -> finfocus.v1.SupportsRequest
+	18,  // 111: finfocus.v1.CostSourceService.GetActualCost:input_type -> finfocus.v1.GetActualCostRequest
+	20,  // 112: finfocus.v1.CostSourceService.GetProjectedCost:input_type -> finfocus.v1.GetProjectedCostRequest
+	22,  // 113: finfocus.v1.CostSourceService.GetPricingSpec:input_type -> finfocus.v1.GetPricingSpecRequest
+	43,  // 114: finfocus.v1.CostSourceService.EstimateCost:input_type -> finfocus.v1.EstimateCostRequest
+	45,  // 115: finfocus.v1.CostSourceService.GetRecommendations:input_type -> finfocus.v1.GetRecommendationsRequest
+	59,  // 116: finfocus.v1.CostSourceService.DismissRecommendation:input_type -> finfocus.v1.DismissRecommendationRequest
+	103, // 117: finfocus.v1.CostSourceService.GetBudgets:input_type -> finfocus.v1.GetBudgetsRequest
+	61,  // 118: finfocus.v1.CostSourceService.GetPluginInfo:input_type -> finfocus.v1.GetPluginInfoRequest
+	64,  // 119: finfocus.v1.CostSourceService.DryRun:input_type -> finfocus.v1.DryRunRequest
+	18,  // 120: finfocus.v1.CostSourceService.StreamActualCost:input_type -> finfocus.v1.GetActualCostRequest
+	66,  // 121: finfocus.v1.CostSourceService.GetProjectedCostBatch:input_type -> finfocus.v1.BatchProjectedCostRequest
+	69,  // 122: finfocus.v1.CostSourceService.GetSupportedResources:input_type -> finfocus.v1.GetSupportedResourcesRequest
+	72,  // 123: finfocus.v1.CostSourceService.SupportsBatch:input_type -> finfocus.v1.SupportsBatchRequest
+	30,  // 124: finfocus.v1.ObservabilityService.HealthCheck:input_type -> finfocus.v1.HealthCheckRequest
+	32,  // 125: finfocus.v1.ObservabilityService.GetMetrics:input_type -> finfocus.v1.GetMetricsRequest
+	36,  // 126: finfocus.v1.ObservabilityService.GetServiceLevelIndicators:input_type -> finfocus.v1.GetServiceLevelIndicatorsRequest
+	14,  // 127: finfocus.v1.CostSourceService.Name:output_type -> finfocus.v1.NameResponse
+	17,  // 128: finfocus.v1.CostSourceService.Supports:output_type -> finfocus.v1.SupportsResponse
+	19,  // 129: finfocus.v1.CostSourceService.GetActualCost:output_type -> finfocus.v1.GetActualCostResponse
+	21,  // 130: finfocus.v1.CostSourceService.GetProjectedCost:output_type -> finfocus.v1.GetProjectedCostResponse
+	23,  // 131: finfocus.v1.CostSourceService.GetPricingSpec:output_type -> finfocus.v1.GetPricingSpecResponse
+	44,  // 132: finfocus.v1.CostSourceService.EstimateCost:output_type -> finfocus.v1.EstimateCostResponse
+	46,  // 133: finfocus.v1.CostSourceService.GetRecommendations:output_type -> finfocus.v1.GetRecommendationsResponse
+	60,  // 134: finfocus.v1.CostSourceService.DismissRecommendation:output_type -> finfocus.v1.DismissRecommendationResponse
+	104, // 135: finfocus.v1.CostSourceService.GetBudgets:output_type -> finfocus.v1.GetBudgetsResponse
+	62,  // 136: finfocus.v1.CostSourceService.GetPluginInfo:output_type -> finfocus.v1.GetPluginInfoResponse
+	65,  // 137: finfocus.v1.CostSourceService.DryRun:output_type -> finfocus.v1.DryRunResponse
+	25,  // 138: finfocus.v1.CostSourceService.StreamActualCost:output_type -> finfocus.v1.ActualCostResult
+	67,  // 139: finfocus.v1.CostSourceService.GetProjectedCostBatch:output_type -> finfocus.v1.BatchProjectedCostResponse
+	70,  // 140: finfocus.v1.CostSourceService.GetSupportedResources:output_type -> finfocus.v1.SupportedResourcesResponse
+	73,  // 141: finfocus.v1.CostSourceService.SupportsBatch:output_type -> finfocus.v1.SupportsBatchResponse
+	31,  // 142: finfocus.v1.ObservabilityService.HealthCheck:output_type -> finfocus.v1.HealthCheckResponse
+	33,  // 143: finfocus.v1.ObservabilityService.GetMetrics:output_type -> finfocus.v1.GetMetricsResponse
+	37,  // 144: finfocus.v1.ObservabilityService.GetServiceLevelIndicators:output_type -> finfocus.v1.GetServiceLevelIndicatorsResponse
+	127, // [127:145] is the sub-list for method output_type
+	109, // [109:127] is the sub-list for method input_type
+	109, // [109:109] is the sub-list for extension type_name
+	109, // [109:109] is the sub-list for extension extendee
+	0,   // [0:109] is the sub-list for field type_name
 }
 
 func init() { file_finfocus_v1_costsource_proto_init() }
@@ -6555,7 +7164,7 @@ func file_finfocus_v1_costsource_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_finfocus_v1_costsource_proto_rawDesc), len(file_finfocus_v1_costsource_proto_rawDesc)),
 			NumEnums:      13,
-			NumMessages:   72,
+			NumMessages:   81,
 			NumExtensions: 0,
 			NumServices:   2,
 		},
@@ -30,6 +30,10 @@ const (
 	CostSourceService_GetBudgets_FullMethodName            = "/finfocus.v1.CostSourceService/GetBudgets"
 	CostSourceService_GetPluginInfo_FullMethodName         = "/finfocus.v1.CostSourceService/GetPluginInfo"
 	CostSourceService_DryRun_FullMethodName                = "/finfocus.v1.CostSourceService/DryRun"
+	CostSourceService_StreamActualCost_FullMethodName      = "/finfocus.v1.CostSourceService/StreamActualCost"
+	CostSourceService_GetProjectedCostBatch_FullMethodName = "/finfocus.v1.CostSourceService/GetProjectedCostBatch"
+	CostSourceService_GetSupportedResources_FullMethodName = "/finfocus.v1.CostSourceService/GetSupportedResources"
+	CostSourceService_SupportsBatch_FullMethodName         = "/finfocus.v1.CostSourceService/SupportsBatch"
 )
 
 // CostSourceServiceClient is the client API for CostSourceService service.
@@ -170,6 +174,84 @@ type CostSourceServiceClient interface {
 	//	    log.Printf("%s: %v", fm.GetFieldName(), fm.GetSupportStatus())
 	//	}
 	DryRun(ctx context.Context, in *DryRunRequest, opts ...grpc.CallOption) (*DryRunResponse, error)
+	// StreamActualCost retrieves actual cost data as a stream of results
+	// instead of a single response. Use it for large time ranges where
+	// buffering every result in one GetActualCostResponse is impractical.
+	//
+	// The request is identical to GetActualCost. Each ActualCostResult is sent
+	// as soon as it is available; the stream ends when all results for the
+	// requested range have been sent. Plugins that page their GetActualCost
+	// responses should follow next_page_token internally and stream every page.
+	//
+	// Plugins that do not stream natively may adapt their unary GetActualCost
+	// handler; the Go SDK does this automatically.
+	//
+	// Error cases:
+	//   - InvalidArgument: Invalid request (same rules as GetActualCost)
+	//   - Unimplemented: Plugin does not support streaming
+	//   - Internal: Failure while retrieving cost data (results already sent
+	//     remain valid)
+	//
+	// Client-side example (Go):
+	//
+	//   stream, err := client.StreamActualCost(ctx, &GetActualCostRequest{...})
+	//   if err != nil {
+	//       return err
+	//   }
+	//   for stream.Receive() {
+	//       process(stream.Msg())
+	//   }
+	//   return stream.Err()
+	//
+	StreamActualCost(ctx context.Context, in *GetActualCostRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ActualCostResult], error)
+	// GetProjectedCostBatch calculates projected costs for many resources in a
+	// single call, avoiding one GetProjectedCost round-trip per resource when
+	// estimating a whole stack.
+	//
+	// Results are aligned with the request: results[i] is the outcome for
+	// resources[i]. A resource that cannot be projected gets an ErrorDetail in
+	// its result instead of failing the whole batch.
+	//
+	// Plugins that do not implement batching natively may project each resource
+	// with their GetProjectedCost handler; the Go SDK does this automatically.
+	//
+	// Error cases (whole batch):
+	//   - InvalidArgument: More than 1000 resources in the request
+	//   - Unimplemented: Plugin does not support batch projection
+	//   - Canceled/DeadlineExceeded: The call was cancelled before all
+	//     resources were projected
+	//
+	GetProjectedCostBatch(ctx context.Context, in *BatchProjectedCostRequest, opts ...grpc.CallOption) (*BatchProjectedCostResponse, error)
+	// GetSupportedResources lists the provider and resource type pairs the
+	// plugin can price, so tools can show coverage (e.g., in a resource picker)
+	// before a user runs an estimate. Use Supports to check a specific resource.
+	//
+	// Resources are sorted by provider, then resource_type. An empty
+	// resource_type means the plugin supports every resource type of that
+	// provider.
+	//
+	// Error cases:
+	//   - Unimplemented: Plugin cannot enumerate its supported resources
+	//
+	GetSupportedResources(ctx context.Context, in *GetSupportedResourcesRequest, opts ...grpc.CallOption) (*SupportedResourcesResponse, error)
+	// SupportsBatch checks many resources in a single call, avoiding one
+	// Supports round-trip per resource when planning a stack.
+	//
+	// Results are aligned with the request: results[i] is the answer for
+	// resources[i]. A resource that cannot be checked is reported as not
+	// supported, with the failure as its reason, instead of failing the whole
+	// batch.
+	//
+	// Plugins that do not implement batching natively may check each resource
+	// with their Supports handler; the Go SDK does this automatically.
+	//
+	// Error cases (whole batch):
+	//   - InvalidArgument: More than 500 resources in the request
+	//   - Unimplemented: Plugin does not support batch checks
+	//   - Canceled/DeadlineExceeded: The call was cancelled before all
+	//     resources were checked
+	//
+	SupportsBatch(ctx context.Context, in *SupportsBatchRequest, opts ...grpc.CallOption) (*SupportsBatchResponse, error)
 }
 
 type costSourceServiceClient struct {
@@ -290,6 +372,55 @@ func (c *costSourceServiceClient) DryRun(ctx context.Context, in *DryRunRequest,
 	return out, nil
 }
 
+func (c *costSourceServiceClient) StreamActualCost(ctx context.Context, in *GetActualCostRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ActualCostResult], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &CostSourceService_ServiceDesc.Streams[0], CostSourceService_StreamActualCost_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[GetActualCostRequest, ActualCostResult]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type CostSourceService_StreamActualCostClient = grpc.ServerStreamingClient[ActualCostResult]
+
+func (c *costSourceServiceClient) GetProjectedCostBatch(ctx context.Context, in *BatchProjectedCostRequest, opts ...grpc.CallOption) (*BatchProjectedCostResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchProjectedCostResponse)
+	err := c.cc.Invoke(ctx, CostSourceService_GetProjectedCostBatch_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *costSourceServiceClient) GetSupportedResources(ctx context.Context, in *GetSupportedResourcesRequest, opts ...grpc.CallOption) (*SupportedResourcesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SupportedResourcesResponse)
+	err := c.cc.Invoke(ctx, CostSourceService_GetSupportedResources_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *costSourceServiceClient) SupportsBatch(ctx context.Context, in *SupportsBatchRequest, opts ...grpc.CallOption) (*SupportsBatchResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SupportsBatchResponse)
+	err := c.cc.Invoke(ctx, CostSourceService_SupportsBatch_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // CostSourceServiceServer is the server API for CostSourceService service.
 // All implementations must embed UnimplementedCostSourceServiceServer
 // for forward compatibility.
@@ -428,6 +559,84 @@ type CostSourceServiceServer interface {
 	//	    log.Printf("%s: %v", fm.GetFieldName(), fm.GetSupportStatus())
 	//	}
 	DryRun(context.Context, *DryRunRequest) (*DryRunResponse, error)
+	// StreamActualCost retrieves actual cost data as a stream of results
+	// instead of a single response. Use it for large time ranges where
+	// buffering every result in one GetActualCostResponse is impractical.
+	//
+	// The request is identical to GetActualCost. Each ActualCostResult is sent
+	// as soon as it is available; the stream ends when all results for the
+	// requested range have been sent. Plugins that page their GetActualCost
+	// responses should follow next_page_token internally and stream every page.
+	//
+	// Plugins that do not stream natively may adapt their unary GetActualCost
+	// handler; the Go SDK does this automatically.
+	//
+	// Error cases:
+	//   - InvalidArgument: Invalid request (same rules as GetActualCost)
+	//   - Unimplemented: Plugin does not support streaming
+	//   - Internal: Failure while retrieving cost data (results already sent
+	//     remain valid)
+	//
+	// Client-side example (Go):
+	//
+	//   stream, err := client.StreamActualCost(ctx, &GetActualCostRequest{...})
+	//   if err != nil {
+	//       return err
+	//   }
+	//   for stream.Receive() {
+	//       process(stream.Msg())
+	//   }
+	//   return stream.Err()
+	//
+	StreamActualCost(*GetActualCostRequest, grpc.ServerStreamingServer[ActualCostResult]) error
+	// GetProjectedCostBatch calculates projected costs for many resources in a
+	// single call, avoiding one GetProjectedCost round-trip per resource when
+	// estimating a whole stack.
+	//
+	// Results are aligned with the request: results[i] is the outcome for
+	// resources[i]. A resource that cannot be projected gets an ErrorDetail in
+	// its result instead of failing the whole batch.
+	//
+	// Plugins that do not implement batching natively may project each resource
+	// with their GetProjectedCost handler; the Go SDK does this automatically.
+	//
+	// Error cases (whole batch):
+	//   - InvalidArgument: More than 1000 resources in the request
+	//   - Unimplemented: Plugin does not support batch projection
+	//   - Canceled/DeadlineExceeded: The call was cancelled before all
+	//     resources were projected
+	//
+	GetProjectedCostBatch(context.Context, *BatchProjectedCostRequest) (*BatchProjectedCostResponse, error)
+	// GetSupportedResources lists the provider and resource type pairs the
+	// plugin can price, so tools can show coverage (e.g., in a resource picker)
+	// before a user runs an estimate. Use Supports to check a specific resource.
+	//
+	// Resources are sorted by provider, then resource_type. An empty
+	// resource_type means the plugin supports every resource type of that
+	// provider.
+	//
+	// Error cases:
+	//   - Unimplemented: Plugin cannot enumerate its supported resources
+	//
+	GetSupportedResources(context.Context, *GetSupportedResourcesRequest) (*SupportedResourcesResponse, error)
+	// SupportsBatch checks many resources in a single call, avoiding one
+	// Supports round-trip per resource when planning a stack.
+	//
+	// Results are aligned with the request: results[i] is the answer for
+	// resources[i]. A resource that cannot be checked is reported as not
+	// supported, with the failure as its reason, instead of failing the whole
+	// batch.
+	//
+	// Plugins that do not implement batching natively may check each resource
+	// with their Supports handler; the Go SDK does this automatically.
+	//
+	// Error cases (whole batch):
+	//   - InvalidArgument: More than 500 resources in the request
+	//   - Unimplemented: Plugin does not support batch checks
+	//   - Canceled/DeadlineExceeded: The call was cancelled before all
+	//     resources were checked
+	//
+	SupportsBatch(context.Context, *SupportsBatchRequest) (*SupportsBatchResponse, error)
 	mustEmbedUnimplementedCostSourceServiceServer()
 }
 
@@ -471,6 +680,18 @@ func (UnimplementedCostSourceServiceServer) GetPluginInfo(context.Context, *GetP
 func (UnimplementedCostSourceServiceServer) DryRun(context.Context, *DryRunRequest) (*DryRunResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method DryRun not implemented")
 }
+func (UnimplementedCostSourceServiceServer) StreamActualCost(*GetActualCostRequest, grpc.ServerStreamingServer[ActualCostResult]) error {
+	return status.Error(codes.Unimplemented, "method StreamActualCost not implemented")
+}
+func (UnimplementedCostSourceServiceServer) GetProjectedCostBatch(context.Context, *BatchProjectedCostRequest) (*BatchProjectedCostResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetProjectedCostBatch not implemented")
+}
+func (UnimplementedCostSourceServiceServer) GetSupportedResources(context.Context, *GetSupportedResourcesRequest) (*SupportedResourcesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetSupportedResources not implemented")
+}
+func (UnimplementedCostSourceServiceServer) SupportsBatch(context.Context, *SupportsBatchRequest) (*SupportsBatchResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SupportsBatch not implemented")
+}
 func (UnimplementedCostSourceServiceServer) mustEmbedUnimplementedCostSourceServiceServer() {}
 func (UnimplementedCostSourceServiceServer) testEmbeddedByValue()                           {}
 
@@ -690,6 +911,71 @@ func _CostSourceService_DryRun_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CostSourceService_StreamActualCost_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetActualCostRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(CostSourceServiceServer).StreamActualCost(m, &grpc.GenericServerStream[GetActualCostRequest, ActualCostResult]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type CostSourceService_StreamActualCostServer = grpc.ServerStreamingServer[ActualCostResult]
+
+func _CostSourceService_GetProjectedCostBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchProjectedCostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CostSourceServiceServer).GetProjectedCostBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CostSourceService_GetProjectedCostBatch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CostSourceServiceServer).GetProjectedCostBatch(ctx, req.(*BatchProjectedCostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CostSourceService_GetSupportedResources_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSupportedResourcesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CostSourceServiceServer).GetSupportedResources(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CostSourceService_GetSupportedResources_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CostSourceServiceServer).GetSupportedResources(ctx, req.(*GetSupportedResourcesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CostSourceService_SupportsBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SupportsBatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CostSourceServiceServer).SupportsBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CostSourceService_SupportsBatch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CostSourceServiceServer).SupportsBatch(ctx, req.(*SupportsBatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // CostSourceService_ServiceDesc is the grpc.ServiceDesc for CostSourceService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -741,8 +1027,26 @@ var CostSourceService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DryRun",
 			Handler:    _CostSourceService_DryRun_Handler,
 		},
+		{
+			MethodName: "GetProjectedCostBatch",
+			Handler:    _CostSourceService_GetProjectedCostBatch_Handler,
+		},
+		{
+			MethodName: "GetSupportedResources",
+			Handler:    _CostSourceService_GetSupportedResources_Handler,
+		},
+		{
+			MethodName: "SupportsBatch",
+			Handler:    _CostSourceService_SupportsBatch_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamActualCost",
+			Handler:       _CostSourceService_StreamActualCost_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "finfocus/v1/costsource.proto",
 }
 
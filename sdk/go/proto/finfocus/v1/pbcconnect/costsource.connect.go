@@ -67,6 +67,18 @@ const (
 	// CostSourceServiceDryRunProcedure is the fully-qualified name of the CostSourceService's DryRun
 	// RPC.
 	CostSourceServiceDryRunProcedure = "/finfocus.v1.CostSourceService/DryRun"
+	// CostSourceServiceStreamActualCostProcedure is the fully-qualified name of the CostSourceService's
+	// StreamActualCost RPC.
+	CostSourceServiceStreamActualCostProcedure = "/finfocus.v1.CostSourceService/StreamActualCost"
+	// CostSourceServiceGetProjectedCostBatchProcedure is the fully-qualified name of the
+	// CostSourceService's GetProjectedCostBatch RPC.
+	CostSourceServiceGetProjectedCostBatchProcedure = "/finfocus.v1.CostSourceService/GetProjectedCostBatch"
+	// CostSourceServiceGetSupportedResourcesProcedure is the fully-qualified name of the
+	// CostSourceService's GetSupportedResources RPC.
+	CostSourceServiceGetSupportedResourcesProcedure = "/finfocus.v1.CostSourceService/GetSupportedResources"
+	// CostSourceServiceSupportsBatchProcedure is the fully-qualified name of the CostSourceService's
+	// SupportsBatch RPC.
+	CostSourceServiceSupportsBatchProcedure = "/finfocus.v1.CostSourceService/SupportsBatch"
 	// ObservabilityServiceHealthCheckProcedure is the fully-qualified name of the
 	// ObservabilityService's HealthCheck RPC.
 	ObservabilityServiceHealthCheckProcedure = "/finfocus.v1.ObservabilityService/HealthCheck"
@@ -210,6 +222,84 @@ type CostSourceServiceClient interface {
 	//	    log.Printf("%s: %v", fm.GetFieldName(), fm.GetSupportStatus())
 	//	}
 	DryRun(context.Context, *connect.Request[v1.DryRunRequest]) (*connect.Response[v1.DryRunResponse], error)
+	// StreamActualCost retrieves actual cost data as a stream of results
+	// instead of a single response. Use it for large time ranges where
+	// buffering every result in one GetActualCostResponse is impractical.
+	//
+	// The request is identical to GetActualCost. Each ActualCostResult is sent
+	// as soon as it is available; the stream ends when all results for the
+	// requested range have been sent. Plugins that page their GetActualCost
+	// responses should follow next_page_token internally and stream every page.
+	//
+	// Plugins that do not stream natively may adapt their unary GetActualCost
+	// handler; the Go SDK does this automatically.
+	//
+	// Error cases:
+	//   - InvalidArgument: Invalid request (same rules as GetActualCost)
+	//   - Unimplemented: Plugin does not support streaming
+	//   - Internal: Failure while retrieving cost data (results already sent
+	//     remain valid)
+	//
+	// Client-side example (Go):
+	//
+	//   stream, err := client.StreamActualCost(ctx, &GetActualCostRequest{...})
+	//   if err != nil {
+	//       return err
+	//   }
+	//   for stream.Receive() {
+	//       process(stream.Msg())
+	//   }
+	//   return stream.Err()
+	//
+	StreamActualCost(context.Context, *connect.Request[v1.GetActualCostRequest]) (*connect.ServerStreamForClient[v1.ActualCostResult], error)
+	// GetProjectedCostBatch calculates projected costs for many resources in a
+	// single call, avoiding one GetProjectedCost round-trip per resource when
+	// estimating a whole stack.
+	//
+	// Results are aligned with the request: results[i] is the outcome for
+	// resources[i]. A resource that cannot be projected gets an ErrorDetail in
+	// its result instead of failing the whole batch.
+	//
+	// Plugins that do not implement batching natively may project each resource
+	// with their GetProjectedCost handler; the Go SDK does this automatically.
+	//
+	// Error cases (whole batch):
+	//   - InvalidArgument: More than 1000 resources in the request
+	//   - Unimplemented: Plugin does not support batch projection
+	//   - Canceled/DeadlineExceeded: The call was cancelled before all
+	//     resources were projected
+	//
+	GetProjectedCostBatch(context.Context, *connect.Request[v1.BatchProjectedCostRequest]) (*connect.Response[v1.BatchProjectedCostResponse], error)
+	// GetSupportedResources lists the provider and resource type pairs the
+	// plugin can price, so tools can show coverage (e.g., in a resource picker)
+	// before a user runs an estimate. Use Supports to check a specific resource.
+	//
+	// Resources are sorted by provider, then resource_type. An empty
+	// resource_type means the plugin supports every resource type of that
+	// provider.
+	//
+	// Error cases:
+	//   - Unimplemented: Plugin cannot enumerate its supported resources
+	//
+	GetSupportedResources(context.Context, *connect.Request[v1.GetSupportedResourcesRequest]) (*connect.Response[v1.SupportedResourcesResponse], error)
+	// SupportsBatch checks many resources in a single call, avoiding one
+	// Supports round-trip per resource when planning a stack.
+	//
+	// Results are aligned with the request: results[i] is the answer for
+	// resources[i]. A resource that cannot be checked is reported as not
+	// supported, with the failure as its reason, instead of failing the whole
+	// batch.
+	//
+	// Plugins that do not implement batching natively may check each resource
+	// with their Supports handler; the Go SDK does this automatically.
+	//
+	// Error cases (whole batch):
+	//   - InvalidArgument: More than 500 resources in the request
+	//   - Unimplemented: Plugin does not support batch checks
+	//   - Canceled/DeadlineExceeded: The call was cancelled before all
+	//     resources were checked
+	//
+	SupportsBatch(context.Context, *connect.Request[v1.SupportsBatchRequest]) (*connect.Response[v1.SupportsBatchResponse], error)
 }
 
 // NewCostSourceServiceClient constructs a client for the finfocus.v1.CostSourceService service. By
@@ -289,6 +379,30 @@ func NewCostSourceServiceClient(httpClient connect.HTTPClient, baseURL string, o
 			connect.WithSchema(costSourceServiceMethods.ByName("DryRun")),
 			connect.WithClientOptions(opts...),
 		),
+		streamActualCost: connect.NewClient[v1.GetActualCostRequest, v1.ActualCostResult](
+			httpClient,
+			baseURL+CostSourceServiceStreamActualCostProcedure,
+			connect.WithSchema(costSourceServiceMethods.ByName("StreamActualCost")),
+			connect.WithClientOptions(opts...),
+		),
+		getProjectedCostBatch: connect.NewClient[v1.BatchProjectedCostRequest, v1.BatchProjectedCostResponse](
+			httpClient,
+			baseURL+CostSourceServiceGetProjectedCostBatchProcedure,
+			connect.WithSchema(costSourceServiceMethods.ByName("GetProjectedCostBatch")),
+			connect.WithClientOptions(opts...),
+		),
+		getSupportedResources: connect.NewClient[v1.GetSupportedResourcesRequest, v1.SupportedResourcesResponse](
+			httpClient,
+			baseURL+CostSourceServiceGetSupportedResourcesProcedure,
+			connect.WithSchema(costSourceServiceMethods.ByName("GetSupportedResources")),
+			connect.WithClientOptions(opts...),
+		),
+		supportsBatch: connect.NewClient[v1.SupportsBatchRequest, v1.SupportsBatchResponse](
+			httpClient,
+			baseURL+CostSourceServiceSupportsBatchProcedure,
+			connect.WithSchema(costSourceServiceMethods.ByName("SupportsBatch")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
@@ -305,6 +419,10 @@ type costSourceServiceClient struct {
 	getBudgets            *connect.Client[v1.GetBudgetsRequest, v1.GetBudgetsResponse]
 	getPluginInfo         *connect.Client[v1.GetPluginInfoRequest, v1.GetPluginInfoResponse]
 	dryRun                *connect.Client[v1.DryRunRequest, v1.DryRunResponse]
+	streamActualCost      *connect.Client[v1.GetActualCostRequest, v1.ActualCostResult]
+	getProjectedCostBatch *connect.Client[v1.BatchProjectedCostRequest, v1.BatchProjectedCostResponse]
+	getSupportedResources *connect.Client[v1.GetSupportedResourcesRequest, v1.SupportedResourcesResponse]
+	supportsBatch         *connect.Client[v1.SupportsBatchRequest, v1.SupportsBatchResponse]
 }
 
 // Name calls finfocus.v1.CostSourceService.Name.
@@ -362,6 +480,26 @@ func (c *costSourceServiceClient) DryRun(ctx context.Context, req *connect.Reque
 	return c.dryRun.CallUnary(ctx, req)
 }
 
+// StreamActualCost calls finfocus.v1.CostSourceService.StreamActualCost.
+func (c *costSourceServiceClient) StreamActualCost(ctx context.Context, req *connect.Request[v1.GetActualCostRequest]) (*connect.ServerStreamForClient[v1.ActualCostResult], error) {
+	return c.streamActualCost.CallServerStream(ctx, req)
+}
+
+// GetProjectedCostBatch calls finfocus.v1.CostSourceService.GetProjectedCostBatch.
+func (c *costSourceServiceClient) GetProjectedCostBatch(ctx context.Context, req *connect.Request[v1.BatchProjectedCostRequest]) (*connect.Response[v1.BatchProjectedCostResponse], error) {
+	return c.getProjectedCostBatch.CallUnary(ctx, req)
+}
+
+// GetSupportedResources calls finfocus.v1.CostSourceService.GetSupportedResources.
+func (c *costSourceServiceClient) GetSupportedResources(ctx context.Context, req *connect.Request[v1.GetSupportedResourcesRequest]) (*connect.Response[v1.SupportedResourcesResponse], error) {
+	return c.getSupportedResources.CallUnary(ctx, req)
+}
+
+// SupportsBatch calls finfocus.v1.CostSourceService.SupportsBatch.
+func (c *costSourceServiceClient) SupportsBatch(ctx context.Context, req *connect.Request[v1.SupportsBatchRequest]) (*connect.Response[v1.SupportsBatchResponse], error) {
+	return c.supportsBatch.CallUnary(ctx, req)
+}
+
 // CostSourceServiceHandler is an implementation of the finfocus.v1.CostSourceService service.
 type CostSourceServiceHandler interface {
 	// Name returns the display name of the cost source plugin.
@@ -494,6 +632,84 @@ type CostSourceServiceHandler interface {
 	//	    log.Printf("%s: %v", fm.GetFieldName(), fm.GetSupportStatus())
 	//	}
 	DryRun(context.Context, *connect.Request[v1.DryRunRequest]) (*connect.Response[v1.DryRunResponse], error)
+	// StreamActualCost retrieves actual cost data as a stream of results
+	// instead of a single response. Use it for large time ranges where
+	// buffering every result in one GetActualCostResponse is impractical.
+	//
+	// The request is identical to GetActualCost. Each ActualCostResult is sent
+	// as soon as it is available; the stream ends when all results for the
+	// requested range have been sent. Plugins that page their GetActualCost
+	// responses should follow next_page_token internally and stream every page.
+	//
+	// Plugins that do not stream natively may adapt their unary GetActualCost
+	// handler; the Go SDK does this automatically.
+	//
+	// Error cases:
+	//   - InvalidArgument: Invalid request (same rules as GetActualCost)
+	//   - Unimplemented: Plugin does not support streaming
+	//   - Internal: Failure while retrieving cost data (results already sent
+	//     remain valid)
+	//
+	// Client-side example (Go):
+	//
+	//   stream, err := client.StreamActualCost(ctx, &GetActualCostRequest{...})
+	//   if err != nil {
+	//       return err
+	//   }
+	//   for stream.Receive() {
+	//       process(stream.Msg())
+	//   }
+	//   return stream.Err()
+	//
+	StreamActualCost(context.Context, *connect.Request[v1.GetActualCostRequest], *connect.ServerStream[v1.ActualCostResult]) error
+	// GetProjectedCostBatch calculates projected costs for many resources in a
+	// single call, avoiding one GetProjectedCost round-trip per resource when
+	// estimating a whole stack.
+	//
+	// Results are aligned with the request: results[i] is the outcome for
+	// resources[i]. A resource that cannot be projected gets an ErrorDetail in
+	// its result instead of failing the whole batch.
+	//
+	// Plugins that do not implement batching natively may project each resource
+	// with their GetProjectedCost handler; the Go SDK does this automatically.
+	//
+	// Error cases (whole batch):
+	//   - InvalidArgument: More than 1000 resources in the request
+	//   - Unimplemented: Plugin does not support batch projection
+	//   - Canceled/DeadlineExceeded: The call was cancelled before all
+	//     resources were projected
+	//
+	GetProjectedCostBatch(context.Context, *connect.Request[v1.BatchProjectedCostRequest]) (*connect.Response[v1.BatchProjectedCostResponse], error)
+	// GetSupportedResources lists the provider and resource type pairs the
+	// plugin can price, so tools can show coverage (e.g., in a resource picker)
+	// before a user runs an estimate. Use Supports to check a specific resource.
+	//
+	// Resources are sorted by provider, then resource_type. An empty
+	// resource_type means the plugin supports every resource type of that
+	// provider.
+	//
+	// Error cases:
+	//   - Unimplemented: Plugin cannot enumerate its supported resources
+	//
+	GetSupportedResources(context.Context, *connect.Request[v1.GetSupportedResourcesRequest]) (*connect.Response[v1.SupportedResourcesResponse], error)
+	// SupportsBatch checks many resources in a single call, avoiding one
+	// Supports round-trip per resource when planning a stack.
+	//
+	// Results are aligned with the request: results[i] is the answer for
+	// resources[i]. A resource that cannot be checked is reported as not
+	// supported, with the failure as its reason, instead of failing the whole
+	// batch.
+	//
+	// Plugins that do not implement batching natively may check each resource
+	// with their Supports handler; the Go SDK does this automatically.
+	//
+	// Error cases (whole batch):
+	//   - InvalidArgument: More than 500 resources in the request
+	//   - Unimplemented: Plugin does not support batch checks
+	//   - Canceled/DeadlineExceeded: The call was cancelled before all
+	//     resources were checked
+	//
+	SupportsBatch(context.Context, *connect.Request[v1.SupportsBatchRequest]) (*connect.Response[v1.SupportsBatchResponse], error)
 }
 
 // NewCostSourceServiceHandler builds an HTTP handler from the service implementation. It returns
@@ -569,6 +785,30 @@ func NewCostSourceServiceHandler(svc CostSourceServiceHandler, opts ...connect.H
 		connect.WithSchema(costSourceServiceMethods.ByName("DryRun")),
 		connect.WithHandlerOptions(opts...),
 	)
+	costSourceServiceStreamActualCostHandler := connect.NewServerStreamHandler(
+		CostSourceServiceStreamActualCostProcedure,
+		svc.StreamActualCost,
+		connect.WithSchema(costSourceServiceMethods.ByName("StreamActualCost")),
+		connect.WithHandlerOptions(opts...),
+	)
+	costSourceServiceGetProjectedCostBatchHandler := connect.NewUnaryHandler(
+		CostSourceServiceGetProjectedCostBatchProcedure,
+		svc.GetProjectedCostBatch,
+		connect.WithSchema(costSourceServiceMethods.ByName("GetProjectedCostBatch")),
+		connect.WithHandlerOptions(opts...),
+	)
+	costSourceServiceGetSupportedResourcesHandler := connect.NewUnaryHandler(
+		CostSourceServiceGetSupportedResourcesProcedure,
+		svc.GetSupportedResources,
+		connect.WithSchema(costSourceServiceMethods.ByName("GetSupportedResources")),
+		connect.WithHandlerOptions(opts...),
+	)
+	costSourceServiceSupportsBatchHandler := connect.NewUnaryHandler(
+		CostSourceServiceSupportsBatchProcedure,
+		svc.SupportsBatch,
+		connect.WithSchema(costSourceServiceMethods.ByName("SupportsBatch")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/finfocus.v1.CostSourceService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case CostSourceServiceNameProcedure:
@@ -593,6 +833,14 @@ func NewCostSourceServiceHandler(svc CostSourceServiceHandler, opts ...connect.H
 			costSourceServiceGetPluginInfoHandler.ServeHTTP(w, r)
 		case CostSourceServiceDryRunProcedure:
 			costSourceServiceDryRunHandler.ServeHTTP(w, r)
+		case CostSourceServiceStreamActualCostProcedure:
+			costSourceServiceStreamActualCostHandler.ServeHTTP(w, r)
+		case CostSourceServiceGetProjectedCostBatchProcedure:
+			costSourceServiceGetProjectedCostBatchHandler.ServeHTTP(w, r)
+		case CostSourceServiceGetSupportedResourcesProcedure:
+			costSourceServiceGetSupportedResourcesHandler.ServeHTTP(w, r)
+		case CostSourceServiceSupportsBatchProcedure:
+			costSourceServiceSupportsBatchHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -646,6 +894,22 @@ func (UnimplementedCostSourceServiceHandler) DryRun(context.Context, *connect.Re
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("finfocus.v1.CostSourceService.DryRun is not implemented"))
 }
 
+func (UnimplementedCostSourceServiceHandler) StreamActualCost(context.Context, *connect.Request[v1.GetActualCostRequest], *connect.ServerStream[v1.ActualCostResult]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("finfocus.v1.CostSourceService.StreamActualCost is not implemented"))
+}
+
+func (UnimplementedCostSourceServiceHandler) GetProjectedCostBatch(context.Context, *connect.Request[v1.BatchProjectedCostRequest]) (*connect.Response[v1.BatchProjectedCostResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("finfocus.v1.CostSourceService.GetProjectedCostBatch is not implemented"))
+}
+
+func (UnimplementedCostSourceServiceHandler) GetSupportedResources(context.Context, *connect.Request[v1.GetSupportedResourcesRequest]) (*connect.Response[v1.SupportedResourcesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("finfocus.v1.CostSourceService.GetSupportedResources is not implemented"))
+}
+
+func (UnimplementedCostSourceServiceHandler) SupportsBatch(context.Context, *connect.Request[v1.SupportsBatchRequest]) (*connect.Response[v1.SupportsBatchResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("finfocus.v1.CostSourceService.SupportsBatch is not implemented"))
+}
+
 // ObservabilityServiceClient is a client for the finfocus.v1.ObservabilityService service.
 type ObservabilityServiceClient interface {
 	// HealthCheck returns the current health status of the plugin.
@@ -0,0 +1,63 @@
+package registry
+
+import (
+	"slices"
+	"sync"
+	"time"
+)
+
+// DiscoveryCache caches plugin manifests returned by discovery queries so that repeated
+// lookups do not hit the network. Entries expire after the TTL given to Set.
+//
+// The zero value is an empty cache ready to use. A DiscoveryCache is safe for concurrent use.
+type DiscoveryCache struct {
+	mu      sync.Mutex
+	entries map[string]discoveryCacheEntry
+}
+
+// discoveryCacheEntry is a cached discovery result and the time at which it expires.
+type discoveryCacheEntry struct {
+	manifests []PluginManifest
+	expiresAt time.Time
+}
+
+// NewDiscoveryCache creates an empty DiscoveryCache.
+func NewDiscoveryCache() *DiscoveryCache {
+	return &DiscoveryCache{}
+}
+
+// Get returns the manifests cached under key, or false if there is no entry or it has expired.
+// Expired entries are removed. The returned slice is a copy and may be modified by the caller.
+func (c *DiscoveryCache) Get(key string) ([]PluginManifest, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+	if !time.Now().Before(entry.expiresAt) {
+		delete(c.entries, key)
+		return nil, false
+	}
+	return slices.Clone(entry.manifests), true
+}
+
+// Set caches manifests under key for ttl, replacing any existing entry.
+// The manifests slice is copied. A ttl <= 0 removes the entry instead of caching it.
+func (c *DiscoveryCache) Set(key string, manifests []PluginManifest, ttl time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if ttl <= 0 {
+		delete(c.entries, key)
+		return
+	}
+	if c.entries == nil {
+		c.entries = make(map[string]discoveryCacheEntry)
+	}
+	c.entries[key] = discoveryCacheEntry{
+		manifests: slices.Clone(manifests),
+		expiresAt: time.Now().Add(ttl),
+	}
+}
@@ -0,0 +1,99 @@
+package registry_test
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/rshade/finfocus-spec/sdk/go/registry"
+)
+
+func testManifests(names ...string) []registry.PluginManifest {
+	manifests := make([]registry.PluginManifest, 0, len(names))
+	for _, name := range names {
+		manifests = append(manifests, registry.PluginManifest{
+			Metadata: registry.ManifestMetadata{Name: name, Version: "1.0.0"},
+		})
+	}
+	return manifests
+}
+
+func TestDiscoveryCacheWithinTTL(t *testing.T) {
+	cache := registry.NewDiscoveryCache()
+	cache.Set("registry:aws", testManifests("aws-public", "aws-ce"), time.Minute)
+
+	got, ok := cache.Get("registry:aws")
+	if !ok {
+		t.Fatal("Expected cached entry within TTL")
+	}
+	if len(got) != 2 || got[0].Metadata.Name != "aws-public" || got[1].Metadata.Name != "aws-ce" {
+		t.Errorf("Get() = %v, want the cached manifests", got)
+	}
+
+	// Modifying the returned slice must not affect the cached entry.
+	got[0].Metadata.Name = "changed"
+	again, _ := cache.Get("registry:aws")
+	if again[0].Metadata.Name != "aws-public" {
+		t.Errorf("Cached entry was modified through the returned slice: %q", again[0].Metadata.Name)
+	}
+}
+
+func TestDiscoveryCacheExpiry(t *testing.T) {
+	cache := registry.NewDiscoveryCache()
+	cache.Set("registry:aws", testManifests("aws-public"), 20*time.Millisecond)
+
+	time.Sleep(40 * time.Millisecond)
+
+	if got, ok := cache.Get("registry:aws"); ok {
+		t.Errorf("Expected entry to expire after TTL, got %v", got)
+	}
+}
+
+func TestDiscoveryCacheNonPositiveTTL(t *testing.T) {
+	cache := registry.NewDiscoveryCache()
+	cache.Set("registry:aws", testManifests("aws-public"), time.Minute)
+	cache.Set("registry:aws", testManifests("aws-public"), 0)
+
+	if _, ok := cache.Get("registry:aws"); ok {
+		t.Error("Expected a zero TTL to remove the entry")
+	}
+}
+
+func TestDiscoveryCacheKeyIsolation(t *testing.T) {
+	var cache registry.DiscoveryCache // zero value is usable
+	cache.Set("registry:aws", testManifests("aws-public"), time.Minute)
+	cache.Set("registry:gcp", testManifests("gcp-billing"), time.Minute)
+
+	aws, ok := cache.Get("registry:aws")
+	if !ok || len(aws) != 1 || aws[0].Metadata.Name != "aws-public" {
+		t.Errorf("Get(registry:aws) = %v, %v", aws, ok)
+	}
+	gcp, ok := cache.Get("registry:gcp")
+	if !ok || len(gcp) != 1 || gcp[0].Metadata.Name != "gcp-billing" {
+		t.Errorf("Get(registry:gcp) = %v, %v", gcp, ok)
+	}
+	if _, ok := cache.Get("registry:azure"); ok {
+		t.Error("Expected miss for a key that was never set")
+	}
+}
+
+func TestDiscoveryCacheConcurrentAccess(t *testing.T) {
+	cache := registry.NewDiscoveryCache()
+	var wg sync.WaitGroup
+
+	for i := range 16 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			key := fmt.Sprintf("registry:%d", i%4)
+			for range 100 {
+				cache.Set(key, testManifests("plugin"), time.Minute)
+				if got, ok := cache.Get(key); ok && len(got) != 1 {
+					t.Errorf("Get(%s) returned %d manifests, want 1", key, len(got))
+				}
+			}
+		}()
+	}
+	wg.Wait()
+}
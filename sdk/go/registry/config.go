@@ -0,0 +1,145 @@
+package registry
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ConfigFieldType represents the value type of a declared plugin configuration key.
+type ConfigFieldType string
+
+const (
+	// ConfigFieldTypeString accepts any string value.
+	ConfigFieldTypeString ConfigFieldType = "string"
+	// ConfigFieldTypeInteger accepts base-10 integers (e.g., "8080").
+	ConfigFieldTypeInteger ConfigFieldType = "integer"
+	// ConfigFieldTypeNumber accepts integers and decimals (e.g., "0.5").
+	ConfigFieldTypeNumber ConfigFieldType = "number"
+	// ConfigFieldTypeBoolean accepts values understood by strconv.ParseBool (e.g., "true", "0").
+	ConfigFieldTypeBoolean ConfigFieldType = "boolean"
+)
+
+// allConfigFieldTypes is a package-level slice containing all valid ConfigFieldType values.
+// This is allocated once at package initialization for zero-allocation validation.
+//
+//nolint:gochecknoglobals // Intentional optimization for zero-allocation validation
+var allConfigFieldTypes = []ConfigFieldType{
+	ConfigFieldTypeString, ConfigFieldTypeInteger, ConfigFieldTypeNumber, ConfigFieldTypeBoolean,
+}
+
+// AllConfigFieldTypes returns a slice of all supported configuration value types.
+func AllConfigFieldTypes() []ConfigFieldType {
+	return allConfigFieldTypes
+}
+
+// String returns the config field type as a string value (e.g., "integer").
+func (c ConfigFieldType) String() string {
+	return string(c)
+}
+
+// IsValidConfigFieldType checks if the given string represents a supported configuration value type.
+func IsValidConfigFieldType(fieldType string) bool {
+	t := ConfigFieldType(fieldType)
+	for _, validType := range allConfigFieldTypes {
+		if t == validType {
+			return true
+		}
+	}
+	return false
+}
+
+// ConfigField declares a single plugin configuration key in a manifest's config schema.
+type ConfigField struct {
+	// Key is the configuration key (e.g., "region", "credentials_path").
+	Key string `json:"key"`
+	// Type is one of the ConfigFieldType values.
+	Type ConfigFieldType `json:"type"`
+	// Required indicates the key must be supplied; defaults are not applied to required keys.
+	Required bool `json:"required,omitempty"`
+	// Default is the value used when an optional key is absent. Empty means no default.
+	Default string `json:"default,omitempty"`
+	// Description is a human-readable description of the key.
+	Description string `json:"description,omitempty"`
+}
+
+// ValidatePluginConfig checks a plugin configuration against its declared schema.
+// Every required key must be present, and every present value (including defaults for
+// absent optional keys) must parse as its declared type. Keys not declared in the schema
+// are ignored. Fields are checked in schema order and the first problem is returned.
+func ValidatePluginConfig(schema []ConfigField, config map[string]string) error {
+	for _, field := range schema {
+		if field.Key == "" {
+			return errors.New("config schema field key is required")
+		}
+		if !IsValidConfigFieldType(field.Type.String()) {
+			return fmt.Errorf("config key '%s' has invalid type '%s', must be one of: %s",
+				field.Key, field.Type, strings.Join(getAllConfigFieldTypeStrings(), ", "))
+		}
+
+		value, present := config[field.Key]
+		if !present {
+			if field.Required {
+				return fmt.Errorf("required config key '%s' is missing", field.Key)
+			}
+			if field.Default == "" {
+				continue
+			}
+			value = field.Default
+		}
+
+		if err := validateConfigValue(field.Type, value); err != nil {
+			return fmt.Errorf("config key '%s': %w", field.Key, err)
+		}
+	}
+
+	return nil
+}
+
+// ApplyConfigDefaults returns a copy of config with the schema's default values filled in
+// for absent optional keys. Present keys and required keys are never overwritten or added.
+// The input map is not modified.
+func ApplyConfigDefaults(schema []ConfigField, config map[string]string) map[string]string {
+	result := make(map[string]string, len(config)+len(schema))
+	for key, value := range config {
+		result[key] = value
+	}
+	for _, field := range schema {
+		if field.Required || field.Default == "" {
+			continue
+		}
+		if _, present := result[field.Key]; !present {
+			result[field.Key] = field.Default
+		}
+	}
+	return result
+}
+
+// getAllConfigFieldTypeStrings returns all valid config field types as strings.
+func getAllConfigFieldTypeStrings() []string {
+	strs := make([]string, len(allConfigFieldTypes))
+	for i, t := range allConfigFieldTypes {
+		strs[i] = t.String()
+	}
+	return strs
+}
+
+// validateConfigValue checks that value parses as the given config field type.
+func validateConfigValue(fieldType ConfigFieldType, value string) error {
+	var err error
+	switch fieldType {
+	case ConfigFieldTypeString:
+		return nil
+	case ConfigFieldTypeInteger:
+		_, err = strconv.ParseInt(value, 10, 64)
+	case ConfigFieldTypeNumber:
+		_, err = strconv.ParseFloat(value, 64)
+	case ConfigFieldTypeBoolean:
+		_, err = strconv.ParseBool(value)
+	}
+	if err != nil {
+		return fmt.Errorf("value '%s' is not a valid %s", value, fieldType)
+	}
+	return nil
+}
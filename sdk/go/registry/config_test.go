@@ -0,0 +1,143 @@
+package registry_test
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/rshade/finfocus-spec/sdk/go/registry"
+)
+
+func testConfigSchema() []registry.ConfigField {
+	return []registry.ConfigField{
+		{Key: "region", Type: registry.ConfigFieldTypeString, Required: true},
+		{Key: "credentials_path", Type: registry.ConfigFieldTypeString},
+		{Key: "max_retries", Type: registry.ConfigFieldTypeInteger, Default: "3"},
+		{Key: "sample_rate", Type: registry.ConfigFieldTypeNumber, Default: "0.5"},
+		{Key: "debug", Type: registry.ConfigFieldTypeBoolean, Default: "false"},
+	}
+}
+
+func TestValidatePluginConfig(t *testing.T) {
+	tests := []struct {
+		name          string
+		schema        []registry.ConfigField
+		config        map[string]string
+		errorContains string
+	}{
+		{
+			name:   "config satisfying schema",
+			schema: testConfigSchema(),
+			config: map[string]string{
+				"region": "us-east-1", "credentials_path": "/etc/creds", "max_retries": "5",
+				"sample_rate": "1", "debug": "true",
+			},
+		},
+		{
+			name:   "only required keys with defaults for the rest",
+			schema: testConfigSchema(),
+			config: map[string]string{"region": "us-east-1"},
+		},
+		{
+			name:   "undeclared keys are ignored",
+			schema: testConfigSchema(),
+			config: map[string]string{"region": "us-east-1", "extra": "value"},
+		},
+		{
+			name:          "missing required key",
+			schema:        testConfigSchema(),
+			config:        map[string]string{"max_retries": "5"},
+			errorContains: "required config key 'region' is missing",
+		},
+		{
+			name:          "integer type mismatch",
+			schema:        testConfigSchema(),
+			config:        map[string]string{"region": "us-east-1", "max_retries": "three"},
+			errorContains: "config key 'max_retries': value 'three' is not a valid integer",
+		},
+		{
+			name:          "boolean type mismatch",
+			schema:        testConfigSchema(),
+			config:        map[string]string{"region": "us-east-1", "debug": "yes"},
+			errorContains: "config key 'debug': value 'yes' is not a valid boolean",
+		},
+		{
+			name:          "invalid default",
+			schema:        []registry.ConfigField{{Key: "port", Type: registry.ConfigFieldTypeInteger, Default: "http"}},
+			config:        map[string]string{},
+			errorContains: "config key 'port': value 'http' is not a valid integer",
+		},
+		{
+			name:          "unknown field type",
+			schema:        []registry.ConfigField{{Key: "port", Type: "duration"}},
+			config:        map[string]string{"port": "1s"},
+			errorContains: "config key 'port' has invalid type 'duration'",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := registry.ValidatePluginConfig(tt.schema, tt.config)
+			if tt.errorContains == "" {
+				if err != nil {
+					t.Errorf("ValidatePluginConfig() unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil {
+				t.Fatalf("ValidatePluginConfig() expected error containing %q, got nil", tt.errorContains)
+			}
+			if !strings.Contains(err.Error(), tt.errorContains) {
+				t.Errorf("ValidatePluginConfig() error = %q, want it to contain %q", err.Error(), tt.errorContains)
+			}
+		})
+	}
+}
+
+func TestApplyConfigDefaults(t *testing.T) {
+	config := map[string]string{"region": "us-east-1", "max_retries": "5"}
+
+	got := registry.ApplyConfigDefaults(testConfigSchema(), config)
+
+	want := map[string]string{
+		"region":      "us-east-1",
+		"max_retries": "5", // present value is not overwritten
+		"sample_rate": "0.5",
+		"debug":       "false",
+	}
+	if len(got) != len(want) {
+		t.Fatalf("ApplyConfigDefaults() = %v, want %v", got, want)
+	}
+	for key, value := range want {
+		if got[key] != value {
+			t.Errorf("ApplyConfigDefaults()[%q] = %q, want %q", key, got[key], value)
+		}
+	}
+	if _, ok := got["credentials_path"]; ok {
+		t.Error("ApplyConfigDefaults() added a key with no default")
+	}
+	if len(config) != 2 {
+		t.Errorf("ApplyConfigDefaults() modified its input: %v", config)
+	}
+}
+
+func TestPluginManifestConfigSchemaJSON(t *testing.T) {
+	data := []byte(`{
+		"metadata": {"name": "aws-plugin"},
+		"config_schema": [
+			{"key": "region", "type": "string", "required": true},
+			{"key": "max_retries", "type": "integer", "default": "3"}
+		]
+	}`)
+
+	var manifest registry.PluginManifest
+	if err := json.Unmarshal(data, &manifest); err != nil {
+		t.Fatalf("json.Unmarshal() unexpected error: %v", err)
+	}
+	if len(manifest.ConfigSchema) != 2 {
+		t.Fatalf("ConfigSchema has %d fields, want 2", len(manifest.ConfigSchema))
+	}
+	if err := registry.ValidatePluginConfig(manifest.ConfigSchema, map[string]string{"region": "eu-west-1"}); err != nil {
+		t.Errorf("ValidatePluginConfig() unexpected error: %v", err)
+	}
+}
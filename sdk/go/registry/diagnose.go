@@ -0,0 +1,122 @@
+package registry
+
+import "fmt"
+
+// DiagnosticSeverity classifies a manifest diagnostic.
+type DiagnosticSeverity string
+
+const (
+	// DiagnosticSeverityError marks a problem that makes the manifest invalid.
+	DiagnosticSeverityError DiagnosticSeverity = "error"
+	// DiagnosticSeverityWarning marks a valid but risky or questionable declaration.
+	DiagnosticSeverityWarning DiagnosticSeverity = "warning"
+)
+
+// Diagnostic is a single problem found in a plugin manifest, located by field path for
+// editor integration.
+type Diagnostic struct {
+	// Path is the JSON field path of the problem (e.g., "specification.supported_providers[1]").
+	Path string `json:"path"`
+	// Severity is the severity of the problem.
+	Severity DiagnosticSeverity `json:"severity"`
+	// Message describes the problem.
+	Message string `json:"message"`
+}
+
+// String returns the diagnostic as "severity: path: message".
+func (d Diagnostic) String() string {
+	return fmt.Sprintf("%s: %s: %s", d.Severity, d.Path, d.Message)
+}
+
+// highRiskPermissions are system permissions that let a plugin modify the host or run
+// arbitrary code; DiagnoseManifest warns when a manifest requests them.
+//
+//nolint:gochecknoglobals // Intentional package-level lookup set for permission diagnostics
+var highRiskPermissions = map[SystemPermission]bool{
+	SystemPermissionFilesystemWrite: true,
+	SystemPermissionProcessSpawn:    true,
+}
+
+// DiagnoseManifest checks a plugin manifest and returns every problem found, rather than
+// stopping at the first one as ValidatePluginManifest does.
+//
+// Errors cover the plugin name, semantic version, supported providers, capabilities, system
+// permissions, security level, and authentication methods (unknown or duplicate values).
+// Warnings flag declarations that are valid but deserve review: high-risk permissions
+// (filesystem_write, process_spawn) and the "none" authentication method.
+//
+// Diagnostics are returned in field order. A nil manifest yields a single error diagnostic,
+// and a clean manifest yields none.
+func DiagnoseManifest(m *PluginManifest) []Diagnostic {
+	if m == nil {
+		return []Diagnostic{{Path: "", Severity: DiagnosticSeverityError, Message: "manifest is nil"}}
+	}
+
+	var d diagnostics
+	if err := ValidatePluginName(m.Metadata.Name); err != nil {
+		d.errorf("metadata.name", "%v", err)
+	}
+	if err := validateSemanticVersion(m.Metadata.Version); err != nil {
+		d.errorf("metadata.version", "%v", err)
+	}
+
+	if len(m.Specification.SupportedProviders) == 0 {
+		d.errorf("specification.supported_providers", "must contain at least one provider")
+	}
+	d.checkValues("specification.supported_providers", m.Specification.SupportedProviders,
+		"provider", IsValidProvider)
+	d.checkValues("specification.capabilities", m.Specification.Capabilities,
+		"capability", IsValidPluginCapability)
+
+	if sec := m.Security; sec != nil {
+		if sec.SecurityLevel != "" && !IsValidSecurityLevel(sec.SecurityLevel) {
+			d.errorf("security.security_level", "'%s' is not a valid security level", sec.SecurityLevel)
+		}
+		d.checkValues("security.permissions", sec.Permissions, "permission", IsValidSystemPermission)
+		for i, permission := range sec.Permissions {
+			if highRiskPermissions[SystemPermission(permission)] {
+				d.warnf(fmt.Sprintf("security.permissions[%d]", i),
+					"'%s' is a high-risk permission; confirm the plugin requires it", permission)
+			}
+		}
+	}
+
+	if req := m.Requirements; req != nil && req.RuntimeRequirements != nil {
+		path := "requirements.runtime_requirements.auth_methods"
+		methods := req.RuntimeRequirements.AuthMethods
+		d.checkValues(path, methods, "authentication method", IsValidAuthMethod)
+		for i, method := range methods {
+			if AuthMethod(method) == AuthMethodNone {
+				d.warnf(fmt.Sprintf("%s[%d]", path, i), "'none' accepts unauthenticated requests")
+			}
+		}
+	}
+
+	return d
+}
+
+// diagnostics accumulates the diagnostics of a manifest.
+type diagnostics []Diagnostic
+
+func (d *diagnostics) errorf(path, format string, args ...interface{}) {
+	*d = append(*d, Diagnostic{Path: path, Severity: DiagnosticSeverityError, Message: fmt.Sprintf(format, args...)})
+}
+
+func (d *diagnostics) warnf(path, format string, args ...interface{}) {
+	*d = append(*d, Diagnostic{Path: path, Severity: DiagnosticSeverityWarning, Message: fmt.Sprintf(format, args...)})
+}
+
+// checkValues reports unknown and duplicate entries of an enum-valued list.
+func (d *diagnostics) checkValues(path string, values []string, kind string, isValid func(string) bool) {
+	seen := make(map[string]bool, len(values))
+	for i, value := range values {
+		itemPath := fmt.Sprintf("%s[%d]", path, i)
+		switch {
+		case !isValid(value):
+			d.errorf(itemPath, "'%s' is not a valid %s", value, kind)
+		case seen[value]:
+			d.errorf(itemPath, "duplicate %s '%s'", kind, value)
+		}
+		seen[value] = true
+	}
+}
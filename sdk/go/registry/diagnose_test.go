@@ -0,0 +1,137 @@
+package registry_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/rshade/finfocus-spec/sdk/go/registry"
+)
+
+func cleanManifest() *registry.PluginManifest {
+	return &registry.PluginManifest{
+		Metadata: registry.ManifestMetadata{
+			Name:        "aws-cost-plugin",
+			Version:     "1.2.0",
+			Description: "AWS cost data plugin",
+			Author:      "FinFocus",
+		},
+		Specification: registry.ManifestSpecification{
+			SpecVersion:        "0.1.0",
+			SupportedProviders: []string{"aws"},
+			Capabilities:       []string{"cost_retrieval", "caching"},
+		},
+		Security: &registry.ManifestSecurity{
+			SecurityLevel: "verified",
+			Permissions:   []string{"network_access", "config_read"},
+		},
+		Requirements: &registry.ManifestRequirements{
+			RuntimeRequirements: &registry.ManifestRuntimeRequirements{AuthMethods: []string{"api_key"}},
+		},
+	}
+}
+
+func TestDiagnoseManifestClean(t *testing.T) {
+	if diags := registry.DiagnoseManifest(cleanManifest()); len(diags) != 0 {
+		t.Errorf("DiagnoseManifest() = %v, want no diagnostics", diags)
+	}
+}
+
+func TestDiagnoseManifestMultipleErrors(t *testing.T) {
+	m := cleanManifest()
+	m.Metadata.Name = "AWS_Plugin"
+	m.Metadata.Version = "v1"
+	m.Specification.SupportedProviders = []string{"aws", "oracle", "aws"}
+	m.Specification.Capabilities = []string{"cost_retrieval", "mind_reading"}
+	m.Security.SecurityLevel = "trusted"
+	m.Security.Permissions = []string{"root_access"}
+	m.Requirements.RuntimeRequirements.AuthMethods = []string{"kerberos"}
+
+	want := []struct {
+		path    string
+		message string
+	}{
+		{"metadata.name", "must start with a lowercase letter or digit"},
+		{"metadata.version", "not a valid semantic version"},
+		{"specification.supported_providers[1]", "'oracle' is not a valid provider"},
+		{"specification.supported_providers[2]", "duplicate provider 'aws'"},
+		{"specification.capabilities[1]", "'mind_reading' is not a valid capability"},
+		{"security.security_level", "'trusted' is not a valid security level"},
+		{"security.permissions[0]", "'root_access' is not a valid permission"},
+		{"requirements.runtime_requirements.auth_methods[0]", "'kerberos' is not a valid authentication method"},
+	}
+
+	diags := registry.DiagnoseManifest(m)
+	if len(diags) != len(want) {
+		t.Fatalf("DiagnoseManifest() returned %d diagnostics, want %d: %v", len(diags), len(want), diags)
+	}
+	for i, w := range want {
+		got := diags[i]
+		if got.Path != w.path {
+			t.Errorf("diagnostics[%d].Path = %q, want %q", i, got.Path, w.path)
+		}
+		if got.Severity != registry.DiagnosticSeverityError {
+			t.Errorf("diagnostics[%d].Severity = %q, want error", i, got.Severity)
+		}
+		if !strings.Contains(got.Message, w.message) {
+			t.Errorf("diagnostics[%d].Message = %q, want to contain %q", i, got.Message, w.message)
+		}
+	}
+}
+
+func TestDiagnoseManifestWarnings(t *testing.T) {
+	m := cleanManifest()
+	m.Metadata.Version = "latest"
+	m.Security.Permissions = []string{"network_access", "process_spawn", "filesystem_write"}
+	m.Requirements.RuntimeRequirements.AuthMethods = []string{"none"}
+
+	severities := map[string]registry.DiagnosticSeverity{}
+	for _, d := range registry.DiagnoseManifest(m) {
+		severities[d.Path] = d.Severity
+	}
+
+	want := map[string]registry.DiagnosticSeverity{
+		"metadata.version":                                  registry.DiagnosticSeverityError,
+		"security.permissions[1]":                           registry.DiagnosticSeverityWarning,
+		"security.permissions[2]":                           registry.DiagnosticSeverityWarning,
+		"requirements.runtime_requirements.auth_methods[0]": registry.DiagnosticSeverityWarning,
+	}
+	if len(severities) != len(want) {
+		t.Errorf("DiagnoseManifest() paths = %v, want %v", severities, want)
+	}
+	for path, severity := range want {
+		if severities[path] != severity {
+			t.Errorf("Severity at %s = %q, want %q", path, severities[path], severity)
+		}
+	}
+}
+
+func TestDiagnoseManifestMissingFields(t *testing.T) {
+	diags := registry.DiagnoseManifest(&registry.PluginManifest{})
+
+	paths := make([]string, 0, len(diags))
+	for _, d := range diags {
+		paths = append(paths, d.Path)
+	}
+	want := []string{"metadata.name", "metadata.version", "specification.supported_providers"}
+	if strings.Join(paths, ",") != strings.Join(want, ",") {
+		t.Errorf("DiagnoseManifest() paths = %v, want %v", paths, want)
+	}
+}
+
+func TestDiagnoseManifestNil(t *testing.T) {
+	diags := registry.DiagnoseManifest(nil)
+	if len(diags) != 1 || diags[0].Severity != registry.DiagnosticSeverityError {
+		t.Errorf("DiagnoseManifest(nil) = %v, want one error", diags)
+	}
+}
+
+func TestDiagnosticString(t *testing.T) {
+	d := registry.Diagnostic{
+		Path:     "metadata.name",
+		Severity: registry.DiagnosticSeverityError,
+		Message:  "plugin name cannot be empty",
+	}
+	if got, want := d.String(), "error: metadata.name: plugin name cannot be empty"; got != want {
+		t.Errorf("String() = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,82 @@
+package registry
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+// scpLikeGitPattern matches scp-style git remotes such as "git@github.com:org/repo.git".
+//
+//nolint:gochecknoglobals // precompiled regex for git remote validation to avoid repeated compilation
+var scpLikeGitPattern = regexp.MustCompile(`^[A-Za-z0-9._-]+@[A-Za-z0-9.-]+:[A-Za-z0-9._~/-]+$`)
+
+// ValidateDiscoveryLocator validates that a locator is well-formed for the given discovery source.
+//
+// IsValidDiscoverySource only checks the source enum; this function checks the locator itself so
+// that invalid locators are rejected at configuration time rather than at install time:
+//
+//   - filesystem: the path must not contain ".." traversal segments and must exist on disk
+//   - registry, url: the locator must be an absolute http or https URL with a host
+//   - git: the locator must be an https, ssh, or git URL, or an scp-style remote (user@host:path)
+func ValidateDiscoveryLocator(source DiscoverySource, locator string) error {
+	if locator == "" {
+		return fmt.Errorf("%s locator cannot be empty", source)
+	}
+
+	switch source {
+	case DiscoverySourceFilesystem:
+		return validateFilesystemLocator(locator)
+	case DiscoverySourceRegistry, DiscoverySourceURL:
+		return validateURLLocator(source, locator, "http", "https")
+	case DiscoverySourceGit:
+		if scpLikeGitPattern.MatchString(locator) {
+			return nil
+		}
+		return validateURLLocator(source, locator, "https", "ssh", "git")
+	default:
+		return fmt.Errorf("'%s' is not a valid discovery source", source)
+	}
+}
+
+// validateFilesystemLocator rejects path traversal and paths that do not exist.
+func validateFilesystemLocator(locator string) error {
+	for _, segment := range strings.FieldsFunc(locator, func(r rune) bool { return r == '/' || r == '\\' }) {
+		if segment == ".." {
+			return fmt.Errorf("filesystem locator '%s' must not contain '..' path traversal", locator)
+		}
+	}
+
+	if _, err := os.Stat(filepath.Clean(locator)); err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return fmt.Errorf("filesystem locator '%s' does not exist", locator)
+		}
+		return fmt.Errorf("filesystem locator '%s': %w", locator, err)
+	}
+
+	return nil
+}
+
+// validateURLLocator checks that a locator parses as an absolute URL with an allowed scheme and a host.
+func validateURLLocator(source DiscoverySource, locator string, schemes ...string) error {
+	parsed, err := url.Parse(locator)
+	if err != nil {
+		return fmt.Errorf("%s locator '%s' is not a valid URL: %w", source, locator, err)
+	}
+
+	if !slices.Contains(schemes, parsed.Scheme) {
+		return fmt.Errorf("%s locator '%s' must use one of the schemes: %s",
+			source, locator, strings.Join(schemes, ", "))
+	}
+
+	if parsed.Host == "" {
+		return fmt.Errorf("%s locator '%s' must include a host", source, locator)
+	}
+
+	return nil
+}
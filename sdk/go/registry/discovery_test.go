@@ -0,0 +1,56 @@
+package registry_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/rshade/finfocus-spec/sdk/go/registry"
+)
+
+func TestValidateDiscoveryLocator(t *testing.T) {
+	existingDir := t.TempDir()
+
+	tests := []struct {
+		name          string
+		source        registry.DiscoverySource
+		locator       string
+		expectErr     bool
+		errorContains string
+	}{
+		{"filesystem existing path", registry.DiscoverySourceFilesystem, existingDir, false, ""},
+		{"filesystem missing path", registry.DiscoverySourceFilesystem, existingDir + "/missing", true, "does not exist"},
+		{"filesystem traversal", registry.DiscoverySourceFilesystem, existingDir + "/../etc", true, "path traversal"},
+		{"filesystem relative traversal", registry.DiscoverySourceFilesystem, "../plugins", true, "path traversal"},
+		{"url https", registry.DiscoverySourceURL, "https://plugins.example.com/aws.tar.gz", false, ""},
+		{"url http", registry.DiscoverySourceURL, "http://localhost:8080/plugins", false, ""},
+		{"url bad scheme", registry.DiscoverySourceURL, "ftp://plugins.example.com", true, "schemes"},
+		{"url missing host", registry.DiscoverySourceURL, "https:///plugins", true, "host"},
+		{"url not absolute", registry.DiscoverySourceURL, "plugins/aws", true, "schemes"},
+		{"registry https", registry.DiscoverySourceRegistry, "https://registry.finfocus.dev", false, ""},
+		{"git https", registry.DiscoverySourceGit, "https://github.com/rshade/finfocus-plugin-aws.git", false, ""},
+		{"git ssh url", registry.DiscoverySourceGit, "ssh://git@github.com/rshade/finfocus-plugin-aws.git", false, ""},
+		{"git scp-like", registry.DiscoverySourceGit, "git@github.com:rshade/finfocus-plugin-aws.git", false, ""},
+		{"git http rejected", registry.DiscoverySourceGit, "http://github.com/rshade/repo.git", true, "schemes"},
+		{"git malformed", registry.DiscoverySourceGit, "github.com rshade repo", true, ""},
+		{"empty locator", registry.DiscoverySourceURL, "", true, "cannot be empty"},
+		{"invalid source", registry.DiscoverySource("ftp"), "ftp://example.com", true, "not a valid discovery source"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := registry.ValidateDiscoveryLocator(tt.source, tt.locator)
+			if !tt.expectErr {
+				if err != nil {
+					t.Errorf("ValidateDiscoveryLocator(%q, %q) unexpected error: %v", tt.source, tt.locator, err)
+				}
+				return
+			}
+			if err == nil {
+				t.Fatalf("ValidateDiscoveryLocator(%q, %q) expected error, got nil", tt.source, tt.locator)
+			}
+			if tt.errorContains != "" && !strings.Contains(err.Error(), tt.errorContains) {
+				t.Errorf("ValidateDiscoveryLocator() error = %q, want it to contain %q", err.Error(), tt.errorContains)
+			}
+		})
+	}
+}
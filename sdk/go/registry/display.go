@@ -0,0 +1,106 @@
+package registry
+
+// unknownDisplayName is the label used for empty enum values.
+const unknownDisplayName = "Unknown"
+
+// DisplayName returns a human-readable label for the security level, suitable for
+// operator-facing tooling (e.g., "Officially Verified" for official plugins).
+// Unrecognized values are returned verbatim, and an empty value returns "Unknown".
+func (s SecurityLevel) DisplayName() string {
+	switch s {
+	case SecurityLevelUntrusted:
+		return "Untrusted"
+	case SecurityLevelCommunity:
+		return "Community Verified"
+	case SecurityLevelVerified:
+		return "Verified"
+	case SecurityLevelOfficial:
+		return "Officially Verified"
+	default:
+		return fallbackDisplayName(string(s))
+	}
+}
+
+// DisplayName returns a human-readable label for the plugin capability
+// (e.g., "Cost Retrieval" for cost_retrieval).
+// Unrecognized values are returned verbatim, and an empty value returns "Unknown".
+func (p PluginCapability) DisplayName() string {
+	switch p {
+	case PluginCapabilityCostRetrieval:
+		return "Cost Retrieval"
+	case PluginCapabilityCostProjection:
+		return "Cost Projection"
+	case PluginCapabilityPricingSpecs:
+		return "Pricing Specs"
+	case PluginCapabilityHistoricalData:
+		return "Historical Data"
+	case PluginCapabilityRealTimeData:
+		return "Real-Time Data"
+	case PluginCapabilityBatchProcessing:
+		return "Batch Processing"
+	case PluginCapabilityRateLimiting:
+		return "Rate Limiting"
+	case PluginCapabilityCaching:
+		return "Caching"
+	case PluginCapabilityEncryption:
+		return "Encryption"
+	case PluginCapabilityCompression:
+		return "Compression"
+	case PluginCapabilityFiltering:
+		return "Filtering"
+	case PluginCapabilityAggregation:
+		return "Aggregation"
+	case PluginCapabilityMultiTenancy:
+		return "Multi-Tenancy"
+	case PluginCapabilityAuditLogging:
+		return "Audit Logging"
+	default:
+		return fallbackDisplayName(string(p))
+	}
+}
+
+// Description returns a one-sentence description of the plugin capability, matching
+// the documentation of its constant. It returns an empty string for unrecognized values.
+func (p PluginCapability) Description() string {
+	switch p {
+	case PluginCapabilityCostRetrieval:
+		return "Cost data retrieval capability."
+	case PluginCapabilityCostProjection:
+		return "Cost projection capability."
+	case PluginCapabilityPricingSpecs:
+		return "Pricing specification capability."
+	case PluginCapabilityHistoricalData:
+		return "Historical data support."
+	case PluginCapabilityRealTimeData:
+		return "Real-time data support."
+	case PluginCapabilityBatchProcessing:
+		return "Batch processing support."
+	case PluginCapabilityRateLimiting:
+		return "Rate limiting support."
+	case PluginCapabilityCaching:
+		return "Caching support."
+	case PluginCapabilityEncryption:
+		return "Encryption support."
+	case PluginCapabilityCompression:
+		return "Compression support."
+	case PluginCapabilityFiltering:
+		return "Filtering support."
+	case PluginCapabilityAggregation:
+		return "Aggregation support."
+	case PluginCapabilityMultiTenancy:
+		return "Multi-tenancy support."
+	case PluginCapabilityAuditLogging:
+		return "Audit logging support."
+	default:
+		return ""
+	}
+}
+
+// fallbackDisplayName returns the raw value for unrecognized enum values,
+// or "Unknown" when the value is empty.
+func fallbackDisplayName(value string) string {
+	if value == "" {
+		return unknownDisplayName
+	}
+	return value
+}
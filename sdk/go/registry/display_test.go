@@ -0,0 +1,74 @@
+package registry_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/rshade/finfocus-spec/sdk/go/registry"
+)
+
+func TestSecurityLevelDisplayName(t *testing.T) {
+	seen := make(map[string]registry.SecurityLevel)
+	for _, level := range registry.AllSecurityLevels() {
+		name := level.DisplayName()
+		if name == "" {
+			t.Errorf("SecurityLevel(%q).DisplayName() is empty", level)
+		}
+		if prev, ok := seen[name]; ok {
+			t.Errorf("SecurityLevel(%q) and (%q) share display name %q", prev, level, name)
+		}
+		seen[name] = level
+	}
+
+	if got := registry.SecurityLevelOfficial.DisplayName(); got != "Officially Verified" {
+		t.Errorf("SecurityLevelOfficial.DisplayName() = %q, expected %q", got, "Officially Verified")
+	}
+
+	tests := []struct {
+		level    registry.SecurityLevel
+		expected string
+	}{
+		{"", "Unknown"},
+		{"experimental", "experimental"},
+	}
+	for _, test := range tests {
+		if got := test.level.DisplayName(); got != test.expected {
+			t.Errorf("SecurityLevel(%q).DisplayName() = %q, expected %q", test.level, got, test.expected)
+		}
+	}
+}
+
+func TestPluginCapabilityDisplayName(t *testing.T) {
+	for _, capability := range registry.AllPluginCapabilities() {
+		if capability.DisplayName() == "" {
+			t.Errorf("PluginCapability(%q).DisplayName() is empty", capability)
+		}
+	}
+
+	if got := registry.PluginCapabilityCostRetrieval.DisplayName(); got != "Cost Retrieval" {
+		t.Errorf("PluginCapabilityCostRetrieval.DisplayName() = %q, expected %q", got, "Cost Retrieval")
+	}
+	if got := registry.PluginCapability("").DisplayName(); got != "Unknown" {
+		t.Errorf("PluginCapability(\"\").DisplayName() = %q, expected %q", got, "Unknown")
+	}
+	if got := registry.PluginCapability("teleport").DisplayName(); got != "teleport" {
+		t.Errorf("PluginCapability(\"teleport\").DisplayName() = %q, expected %q", got, "teleport")
+	}
+}
+
+func TestPluginCapabilityDescription(t *testing.T) {
+	for _, capability := range registry.AllPluginCapabilities() {
+		desc := capability.Description()
+		if desc == "" {
+			t.Errorf("PluginCapability(%q).Description() is empty", capability)
+			continue
+		}
+		if !strings.HasSuffix(desc, ".") {
+			t.Errorf("PluginCapability(%q).Description() = %q, expected a sentence", capability, desc)
+		}
+	}
+
+	if got := registry.PluginCapability("teleport").Description(); got != "" {
+		t.Errorf("PluginCapability(\"teleport\").Description() = %q, expected empty", got)
+	}
+}
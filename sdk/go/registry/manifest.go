@@ -0,0 +1,78 @@
+package registry
+
+// PluginManifest is the typed form of a plugin manifest document.
+// Its JSON layout matches the document accepted by ValidatePluginManifest.
+type PluginManifest struct {
+	// Metadata identifies the plugin.
+	Metadata ManifestMetadata `json:"metadata"`
+	// Specification describes the protocol surface the plugin implements.
+	Specification ManifestSpecification `json:"specification"`
+	// Installation describes how the plugin is installed.
+	Installation ManifestInstallation `json:"installation"`
+	// ConfigSchema declares the configuration keys the plugin accepts (see ValidatePluginConfig).
+	ConfigSchema []ConfigField `json:"config_schema,omitempty"`
+	// Security declares the plugin's trust level and required system permissions.
+	Security *ManifestSecurity `json:"security,omitempty"`
+	// Requirements declares the plugin's runtime requirements.
+	Requirements *ManifestRequirements `json:"requirements,omitempty"`
+}
+
+// ManifestMetadata contains the identifying metadata of a plugin manifest.
+type ManifestMetadata struct {
+	// Name is the unique plugin name (see ValidatePluginName).
+	Name string `json:"name"`
+	// Version is the semantic version of the plugin.
+	Version string `json:"version"`
+	// Description is a human-readable summary of the plugin.
+	Description string `json:"description"`
+	// Author is the plugin author or maintaining organization.
+	Author string `json:"author"`
+}
+
+// ManifestSpecification describes the spec version, providers, and service a plugin implements.
+type ManifestSpecification struct {
+	// SpecVersion is the finfocus-spec version the plugin was built against.
+	SpecVersion string `json:"spec_version"`
+	// SupportedProviders lists the cloud providers the plugin supports.
+	SupportedProviders []string `json:"supported_providers"`
+	// Capabilities lists the PluginCapability values the plugin offers.
+	Capabilities []string `json:"capabilities,omitempty"`
+	// ServiceDefinition describes the gRPC service exposed by the plugin.
+	ServiceDefinition ManifestServiceDefinition `json:"service_definition"`
+}
+
+// ManifestServiceDefinition describes the gRPC service exposed by a plugin.
+type ManifestServiceDefinition struct {
+	// ServiceName is the gRPC service name (e.g., "CostSourceService").
+	ServiceName string `json:"service_name"`
+	// PackageName is the protobuf package name (e.g., "finfocus.v1").
+	PackageName string `json:"package_name"`
+	// Methods lists the RPC methods implemented by the plugin.
+	Methods []string `json:"methods"`
+}
+
+// ManifestInstallation describes how a plugin is installed.
+type ManifestInstallation struct {
+	// InstallationMethod is one of the InstallationMethod values.
+	InstallationMethod string `json:"installation_method"`
+}
+
+// ManifestSecurity describes a plugin's trust level and required system permissions.
+type ManifestSecurity struct {
+	// SecurityLevel is one of the SecurityLevel values.
+	SecurityLevel string `json:"security_level,omitempty"`
+	// Permissions lists the SystemPermission values the plugin requires.
+	Permissions []string `json:"permissions,omitempty"`
+}
+
+// ManifestRequirements describes what a plugin needs from its runtime environment.
+type ManifestRequirements struct {
+	// RuntimeRequirements describes the plugin's RPC runtime requirements.
+	RuntimeRequirements *ManifestRuntimeRequirements `json:"runtime_requirements,omitempty"`
+}
+
+// ManifestRuntimeRequirements describes the RPC runtime a plugin requires.
+type ManifestRuntimeRequirements struct {
+	// AuthMethods lists the AuthMethod values the plugin supports.
+	AuthMethods []string `json:"auth_methods,omitempty"`
+}
@@ -0,0 +1,52 @@
+package registry
+
+import "github.com/rshade/finfocus-spec/sdk/go/pricing"
+
+// Canonical mapping between registry.Provider and pricing.Provider:
+//
+//	registry.ProviderAWS        <-> pricing.AWS        ("aws")
+//	registry.ProviderAzure      <-> pricing.Azure      ("azure")
+//	registry.ProviderGCP        <-> pricing.GCP        ("gcp")
+//	registry.ProviderKubernetes <-> pricing.Kubernetes ("kubernetes")
+//	registry.ProviderCustom     <-> pricing.Custom     ("custom")
+//
+// The two enums currently share the same wire values, but the mapping is explicit so that a
+// value added to only one package is reported as unmappable instead of being silently cast.
+
+// ToPricingProvider converts a registry provider to the equivalent pricing provider.
+// It returns false when the registry provider has no pricing equivalent.
+func ToPricingProvider(p Provider) (pricing.Provider, bool) {
+	switch p {
+	case ProviderAWS:
+		return pricing.AWS, true
+	case ProviderAzure:
+		return pricing.Azure, true
+	case ProviderGCP:
+		return pricing.GCP, true
+	case ProviderKubernetes:
+		return pricing.Kubernetes, true
+	case ProviderCustom:
+		return pricing.Custom, true
+	default:
+		return "", false
+	}
+}
+
+// FromPricingProvider converts a pricing provider to the equivalent registry provider.
+// It returns false when the pricing provider has no registry equivalent.
+func FromPricingProvider(p pricing.Provider) (Provider, bool) {
+	switch p {
+	case pricing.AWS:
+		return ProviderAWS, true
+	case pricing.Azure:
+		return ProviderAzure, true
+	case pricing.GCP:
+		return ProviderGCP, true
+	case pricing.Kubernetes:
+		return ProviderKubernetes, true
+	case pricing.Custom:
+		return ProviderCustom, true
+	default:
+		return "", false
+	}
+}
@@ -0,0 +1,58 @@
+package registry_test
+
+import (
+	"testing"
+
+	"github.com/rshade/finfocus-spec/sdk/go/pricing"
+	"github.com/rshade/finfocus-spec/sdk/go/registry"
+)
+
+func TestToPricingProvider(t *testing.T) {
+	for _, p := range registry.AllProviders() {
+		got, ok := registry.ToPricingProvider(p)
+		if !ok {
+			t.Errorf("ToPricingProvider(%q) reported no mapping", p)
+			continue
+		}
+		if got.String() != p.String() {
+			t.Errorf("ToPricingProvider(%q) = %q, want same wire value", p, got)
+		}
+		if !pricing.ValidProvider(got.String()) {
+			t.Errorf("ToPricingProvider(%q) = %q, which is not a valid pricing provider", p, got)
+		}
+	}
+
+	if got, ok := registry.ToPricingProvider(registry.Provider("oracle")); ok {
+		t.Errorf("ToPricingProvider(\"oracle\") = %q, true; want no mapping", got)
+	}
+}
+
+func TestFromPricingProvider(t *testing.T) {
+	for _, p := range pricing.GetAllProviders() {
+		got, ok := registry.FromPricingProvider(p)
+		if !ok {
+			t.Errorf("FromPricingProvider(%q) reported no mapping", p)
+			continue
+		}
+		if !registry.IsValidProvider(got.String()) {
+			t.Errorf("FromPricingProvider(%q) = %q, which is not a valid registry provider", p, got)
+		}
+	}
+
+	if got, ok := registry.FromPricingProvider(pricing.Provider("")); ok {
+		t.Errorf("FromPricingProvider(\"\") = %q, true; want no mapping", got)
+	}
+}
+
+func TestProviderConversionRoundTrip(t *testing.T) {
+	for _, p := range registry.AllProviders() {
+		pp, ok := registry.ToPricingProvider(p)
+		if !ok {
+			t.Fatalf("ToPricingProvider(%q) reported no mapping", p)
+		}
+		back, ok := registry.FromPricingProvider(pp)
+		if !ok || back != p {
+			t.Errorf("round trip %q -> %q -> %q (ok=%v)", p, pp, back, ok)
+		}
+	}
+}
@@ -49,6 +49,24 @@ func ValidatePluginManifest(manifestJSON []byte) error {
 	return nil
 }
 
+// ValidateUniqueNames checks that no two manifests in a set share the same plugin name.
+// Names are compared case-insensitively, so "aws-plugin" and "AWS-Plugin" are treated as
+// duplicates even though the latter would also fail ValidatePluginName. The returned error
+// names the first duplicated name and the indices of both occurrences. An empty set is valid.
+func ValidateUniqueNames(manifests []PluginManifest) error {
+	seen := make(map[string]int, len(manifests))
+	for i, manifest := range manifests {
+		key := strings.ToLower(manifest.Metadata.Name)
+		if first, exists := seen[key]; exists {
+			return fmt.Errorf("duplicate plugin name '%s' at indices %d and %d",
+				manifest.Metadata.Name, first, i)
+		}
+		seen[key] = i
+	}
+
+	return nil
+}
+
 // validateBasicStructure validates the basic structure of the manifest.
 func validateBasicStructure(manifest map[string]interface{}) error {
 	requiredFields := []string{"metadata", "specification", "installation"}
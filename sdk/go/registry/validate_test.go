@@ -394,3 +394,55 @@ func TestValidatePluginManifest_Examples(t *testing.T) {
 		}
 	}
 }
+
+func TestValidateUniqueNames(t *testing.T) {
+	manifest := func(name string) registry.PluginManifest {
+		return registry.PluginManifest{Metadata: registry.ManifestMetadata{Name: name}}
+	}
+
+	tests := []struct {
+		name          string
+		manifests     []registry.PluginManifest
+		expectErr     bool
+		errorContains string
+	}{
+		{
+			name:      "empty set",
+			manifests: nil,
+		},
+		{
+			name:      "unique names",
+			manifests: []registry.PluginManifest{manifest("aws-plugin"), manifest("azure-plugin"), manifest("gcp-plugin")},
+		},
+		{
+			name:          "one duplicate",
+			manifests:     []registry.PluginManifest{manifest("aws-plugin"), manifest("gcp-plugin"), manifest("aws-plugin")},
+			expectErr:     true,
+			errorContains: "duplicate plugin name 'aws-plugin' at indices 0 and 2",
+		},
+		{
+			name:          "case difference is a duplicate",
+			manifests:     []registry.PluginManifest{manifest("aws-plugin"), manifest("AWS-Plugin")},
+			expectErr:     true,
+			errorContains: "at indices 0 and 1",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := registry.ValidateUniqueNames(tt.manifests)
+			if !tt.expectErr {
+				if err != nil {
+					t.Errorf("ValidateUniqueNames() unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil {
+				t.Fatal("ValidateUniqueNames() expected error, got nil")
+			}
+			if !strings.Contains(err.Error(), tt.errorContains) {
+				t.Errorf("ValidateUniqueNames() error = %q, want it to contain %q", err.Error(), tt.errorContains)
+			}
+		})
+	}
+}
@@ -5,6 +5,9 @@ package testing
 import (
 	"context"
 	"fmt"
+	"maps"
+	"slices"
+	"testing"
 	"time"
 
 	pbc "github.com/rshade/finfocus-spec/sdk/go/proto/finfocus/v1"
@@ -210,6 +213,30 @@ func (r *ConformanceResult) Passed() bool {
 	return r.Summary.Failed == 0
 }
 
+// Satisfies reports whether every test required at level, or at a lower level, ran and passed.
+// It is false for every level when a Basic test failed.
+func (r *ConformanceResult) Satisfies(level ConformanceLevel) bool {
+	for _, test := range r.FailedTests() {
+		if test.MinLevel <= level {
+			return false
+		}
+	}
+	return level <= r.LevelAchieved
+}
+
+// FailedTests returns the results of the tests that failed, ordered by category.
+func (r *ConformanceResult) FailedTests() []TestResult {
+	var failed []TestResult
+	for _, category := range slices.Sorted(maps.Keys(r.Categories)) {
+		for _, test := range r.Categories[category].Results {
+			if !test.Success {
+				failed = append(failed, test)
+			}
+		}
+	}
+	return failed
+}
+
 // ConformanceSuiteTest represents a single conformance test with level and category.
 type ConformanceSuiteTest struct {
 	// Name is the unique test identifier.
@@ -314,6 +341,7 @@ func (s *ConformanceSuite) Run(impl pbc.CostSourceServiceServer) (*ConformanceRe
 		// Run the test
 		testResult := test.TestFunc(harness)
 		testResult.Category = test.Category
+		testResult.MinLevel = test.MinLevel
 
 		// Initialize category if needed
 		if result.Categories[test.Category] == nil {
@@ -379,6 +407,7 @@ func (s *ConformanceSuite) RunCategory(
 		// Run the test
 		testResult := test.TestFunc(harness)
 		testResult.Category = category
+		testResult.MinLevel = test.MinLevel
 		result.Results = append(result.Results, testResult)
 
 		if testResult.Success {
@@ -391,25 +420,20 @@ func (s *ConformanceSuite) RunCategory(
 	return result, nil
 }
 
-// determineLevelAchieved determines the highest conformance level passed.
-// Since TestResult doesn't store MinLevel, we use a simplified approach:
-//   - If no tests failed, return the target level.
-//   - If tests failed and target > Basic, return one level below target.
-//   - If tests failed at Basic level, return Basic (floor).
+// determineLevelAchieved determines the highest conformance level passed: the highest level,
+// up to targetLevel, at which no test of that level or of a lower level failed.
+// If a Basic test failed, Basic is returned as the floor; Summary.Failed > 0 and
+// Satisfies(ConformanceLevelBasic) report the failure.
 func determineLevelAchieved(result *ConformanceResult, targetLevel ConformanceLevel) ConformanceLevel {
-	// All tests passed at target level
-	if result.Summary.Failed == 0 {
-		return targetLevel
-	}
-
-	// Some tests failed - return one level below target, with Basic as the floor
-	if targetLevel > ConformanceLevelBasic {
-		return targetLevel - 1
+	achieved := targetLevel
+	for _, category := range result.Categories {
+		for _, test := range category.Results {
+			if !test.Success && test.MinLevel <= achieved {
+				achieved = test.MinLevel - 1
+			}
+		}
 	}
-
-	// At Basic level with failures - still return Basic as the floor
-	// The Summary.Failed > 0 already indicates failure status
-	return ConformanceLevelBasic
+	return max(achieved, ConformanceLevelBasic)
 }
 
 // AggregateResults aggregates results from multiple categories.
@@ -477,3 +501,31 @@ func RunAdvancedConformance(impl pbc.CostSourceServiceServer) (*ConformanceResul
 
 	return suite.Run(impl)
 }
+
+// RunConformanceSuite runs every conformance test against plugin, up to the Advanced level, and
+// returns the result of RunAdvancedConformance: LevelAchieved is the highest level at which the
+// plugin passed every test. Failed tests are logged with t.Logf but do not fail t, so callers
+// decide which level to require:
+//
+//	result := plugintesting.RunConformanceSuite(t, plugin)
+//	if !result.Satisfies(plugintesting.ConformanceLevelStandard) {
+//	    t.Errorf("plugin is not Standard conformant: %v", result.FailedTests())
+//	}
+func RunConformanceSuite(t *testing.T, plugin pbc.CostSourceServiceServer) *ConformanceResult {
+	t.Helper()
+
+	result, err := RunAdvancedConformance(plugin)
+	if err != nil {
+		t.Fatalf("conformance suite could not run: %v", err)
+	}
+
+	for _, test := range result.FailedTests() {
+		t.Logf("conformance test %s (%s) failed: %v", test.Method, test.MinLevel, test.Error)
+	}
+	if result.Satisfies(ConformanceLevelBasic) {
+		t.Logf("plugin %q satisfies %s conformance", result.PluginName, result.LevelAchieved)
+	} else {
+		t.Logf("plugin %q does not satisfy Basic conformance", result.PluginName)
+	}
+	return result
+}
@@ -2,6 +2,7 @@ package testing_test
 
 import (
 	"encoding/json"
+	"errors"
 	"testing"
 
 	plugintesting "github.com/rshade/finfocus-spec/sdk/go/testing"
@@ -301,3 +302,103 @@ func TestTestCategoryString(t *testing.T) {
 		})
 	}
 }
+
+// TestRunConformanceSuiteClassifiesLevel validates the one-call conformance classifier.
+func TestRunConformanceSuiteClassifiesLevel(t *testing.T) {
+	result := plugintesting.RunConformanceSuite(t, plugintesting.NewMockPlugin())
+
+	if result.PluginName != "mock-test-plugin" {
+		t.Errorf("PluginName = %q, want mock-test-plugin", result.PluginName)
+	}
+	if result.Summary.Total == 0 {
+		t.Fatal("Expected tests in result")
+	}
+	if !result.Satisfies(plugintesting.ConformanceLevelBasic) {
+		t.Errorf("Expected mock plugin to satisfy Basic conformance, failed tests: %v", result.FailedTests())
+	}
+
+	levels := make(map[plugintesting.ConformanceLevel]bool)
+	for _, category := range result.Categories {
+		for _, test := range category.Results {
+			levels[test.MinLevel] = true
+		}
+	}
+	if !levels[plugintesting.ConformanceLevelBasic] || !levels[plugintesting.ConformanceLevelAdvanced] {
+		t.Errorf("Expected tests from Basic through Advanced, got levels %v", levels)
+	}
+}
+
+// TestRunConformanceSuiteBasicFailure validates that failing Basic tests block certification.
+func TestRunConformanceSuiteBasicFailure(t *testing.T) {
+	plugin := plugintesting.ConfigurableErrorMockPlugin()
+	plugin.ShouldErrorOnName = true
+
+	result := plugintesting.RunConformanceSuite(t, plugin)
+
+	if result.Satisfies(plugintesting.ConformanceLevelBasic) {
+		t.Error("Expected plugin failing Name() not to satisfy Basic conformance")
+	}
+	if result.LevelAchieved != plugintesting.ConformanceLevelBasic {
+		t.Errorf("LevelAchieved = %s, want the Basic floor", result.LevelAchieved)
+	}
+	if len(result.FailedTests()) == 0 {
+		t.Error("Expected failed tests")
+	}
+	for _, test := range result.FailedTests() {
+		if test.Success || test.Error == nil {
+			t.Errorf("FailedTests() returned %s with Success=%v Error=%v", test.Method, test.Success, test.Error)
+		}
+	}
+}
+
+// TestConformanceSuiteLevelFromFailedTests validates that the level achieved stops below the
+// lowest level with a failed test, whatever the target level.
+func TestConformanceSuiteLevelFromFailedTests(t *testing.T) {
+	outcome := func(success bool) func(*plugintesting.TestHarness) plugintesting.TestResult {
+		return func(*plugintesting.TestHarness) plugintesting.TestResult {
+			result := plugintesting.TestResult{Method: "Name", Success: success}
+			if !success {
+				result.Error = errors.New("check failed")
+			}
+			return result
+		}
+	}
+
+	suite := plugintesting.NewConformanceSuiteWithConfig(plugintesting.SuiteConfig{
+		TargetLevel: plugintesting.ConformanceLevelAdvanced,
+	})
+	suite.AddTest(plugintesting.ConformanceSuiteTest{
+		Name: "basic", Category: plugintesting.CategoryRPCCorrectness,
+		MinLevel: plugintesting.ConformanceLevelBasic, TestFunc: outcome(true),
+	})
+	suite.AddTest(plugintesting.ConformanceSuiteTest{
+		Name: "standard", Category: plugintesting.CategoryConcurrency,
+		MinLevel: plugintesting.ConformanceLevelStandard, TestFunc: outcome(false),
+	})
+	suite.AddTest(plugintesting.ConformanceSuiteTest{
+		Name: "advanced", Category: plugintesting.CategoryPerformance,
+		MinLevel: plugintesting.ConformanceLevelAdvanced, TestFunc: outcome(true),
+	})
+
+	result, err := suite.Run(plugintesting.NewMockPlugin())
+	if err != nil {
+		t.Fatalf("Run() failed: %v", err)
+	}
+	if result.LevelAchieved != plugintesting.ConformanceLevelBasic {
+		t.Errorf("LevelAchieved = %s, want Basic", result.LevelAchieved)
+	}
+	if !result.Satisfies(plugintesting.ConformanceLevelBasic) {
+		t.Error("Expected Basic to be satisfied")
+	}
+	for _, level := range []plugintesting.ConformanceLevel{
+		plugintesting.ConformanceLevelStandard, plugintesting.ConformanceLevelAdvanced,
+	} {
+		if result.Satisfies(level) {
+			t.Errorf("Expected %s not to be satisfied", level)
+		}
+	}
+	failed := result.FailedTests()
+	if len(failed) != 1 || failed[0].MinLevel != plugintesting.ConformanceLevelStandard {
+		t.Errorf("FailedTests() = %+v, want the Standard test", failed)
+	}
+}
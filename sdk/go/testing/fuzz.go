@@ -0,0 +1,112 @@
+// Package testing provides a comprehensive testing framework for FinFocus plugins.
+// This file implements fuzzing helpers for plugin input handling.
+package testing
+
+import (
+	"context"
+	"math"
+	"strings"
+	"testing"
+
+	"google.golang.org/grpc/status"
+
+	pbc "github.com/rshade/finfocus-spec/sdk/go/proto/finfocus/v1"
+)
+
+// fuzzOversizedFieldLength is the length of oversized string fields in the seed corpus. Much
+// larger seeds stall the fuzzer while it minimizes interesting inputs.
+const fuzzOversizedFieldLength = 1024
+
+// FuzzResourceDescriptor fuzzes plugin's Supports, GetProjectedCost, and GetPricingSpec with
+// random and malformed ResourceDescriptors. Each call must not panic, and must return either a
+// non-nil response or a gRPC status error (plain Go errors reach clients as codes.Unknown with
+// no useful detail).
+//
+// The seed corpus covers a nil resource, empty fields, a valid resource, oversized fields,
+// invalid UTF-8, and NaN, infinite, and out-of-range utilization and growth rates. Methods are
+// called directly rather than over gRPC, so inputs that would fail proto marshaling still reach
+// the plugin.
+//
+// Example:
+//
+//	func FuzzMyPlugin(f *testing.F) {
+//	    plugintesting.FuzzResourceDescriptor(f, myplugin.New())
+//	}
+//
+// Run it with go test -fuzz=FuzzMyPlugin; plain go test runs only the seed corpus.
+func FuzzResourceDescriptor(f *testing.F, plugin pbc.CostSourceServiceServer) {
+	oversized := strings.Repeat("x", fuzzOversizedFieldLength)
+
+	// provider, resourceType, sku, region, id, arn, tagKey, tagValue, utilization, growthRate, nilResource
+	f.Add("", "", "", "", "", "", "", "", 0.0, 0.0, true)
+	f.Add("", "", "", "", "", "", "", "", 0.0, 0.0, false)
+	f.Add("aws", "ec2", "t3.micro", "us-east-1", "id-1", "arn:aws:ec2:us-east-1:123456789012:instance/i-1",
+		"env", "prod", 0.5, 0.1, false)
+	f.Add("aws", "aws:ec2/instance:Instance", "t3.micro", "us-east-1", "", "", "", "", 1.0, 0.0, false)
+	f.Add(oversized, oversized, oversized, oversized, oversized, oversized, oversized, oversized,
+		1.0, 1.0, false)
+	f.Add("\xff\xfe", "ec2\x00", "\xc3\x28", "us-east-1", "", "", "\xff", "\xff", 0.5, 0.0, false)
+	f.Add("aws", "ec2", "t3.micro", "us-east-1", "", "", "", "", math.NaN(), math.NaN(), false)
+	f.Add("gcp", "compute_engine", "n1-standard-1", "us-central1", "", "", "", "", math.Inf(1), math.Inf(-1), false)
+	f.Add("azure", "vm", "Standard_B1s", "eastus", "", "", "", "", -1.0, -2.0, false)
+
+	f.Fuzz(func(
+		t *testing.T,
+		provider, resourceType, sku, region, id, arn, tagKey, tagValue string,
+		utilization, growthRate float64,
+		nilResource bool,
+	) {
+		var resource *pbc.ResourceDescriptor
+		if !nilResource {
+			resource = &pbc.ResourceDescriptor{
+				Provider:              provider,
+				ResourceType:          resourceType,
+				Sku:                   sku,
+				Region:                region,
+				Id:                    id,
+				Arn:                   arn,
+				Tags:                  map[string]string{tagKey: tagValue},
+				UtilizationPercentage: &utilization,
+				GrowthType:            pbc.GrowthType_GROWTH_TYPE_LINEAR,
+				GrowthRate:            &growthRate,
+			}
+		}
+		ctx := context.Background()
+
+		fuzzCall(t, "Supports", resource, func() (bool, error) {
+			resp, err := plugin.Supports(ctx, &pbc.SupportsRequest{Resource: resource})
+			return resp != nil, err
+		})
+		fuzzCall(t, "GetProjectedCost", resource, func() (bool, error) {
+			resp, err := plugin.GetProjectedCost(ctx, &pbc.GetProjectedCostRequest{Resource: resource})
+			return resp != nil, err
+		})
+		fuzzCall(t, "GetPricingSpec", resource, func() (bool, error) {
+			resp, err := plugin.GetPricingSpec(ctx, &pbc.GetPricingSpecRequest{Resource: resource})
+			return resp != nil, err
+		})
+	})
+}
+
+// fuzzCall runs call, reporting a panic, a plain (non-gRPC) error, or a nil response without
+// an error as a test failure.
+func fuzzCall(t *testing.T, method string, resource *pbc.ResourceDescriptor, call func() (bool, error)) {
+	t.Helper()
+
+	defer func() {
+		if r := recover(); r != nil {
+			t.Fatalf("%s panicked with resource %v: %v", method, resource, r)
+		}
+	}()
+
+	hasResponse, err := call()
+	if err != nil {
+		if _, ok := status.FromError(err); !ok {
+			t.Errorf("%s returned a non-gRPC error with resource %v: %v", method, resource, err)
+		}
+		return
+	}
+	if !hasResponse {
+		t.Errorf("%s returned a nil response and no error with resource %v", method, resource)
+	}
+}
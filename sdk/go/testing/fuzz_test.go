@@ -0,0 +1,13 @@
+package testing_test
+
+import (
+	"testing"
+
+	plugintesting "github.com/rshade/finfocus-spec/sdk/go/testing"
+)
+
+// FuzzMockPluginResourceDescriptor runs the MockPlugin through the ResourceDescriptor fuzz
+// harness; plain go test runs the seed corpus.
+func FuzzMockPluginResourceDescriptor(f *testing.F) {
+	plugintesting.FuzzResourceDescriptor(f, plugintesting.NewMockPlugin())
+}
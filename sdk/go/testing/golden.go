@@ -0,0 +1,152 @@
+// Package testing provides a comprehensive testing framework for FinFocus plugins.
+// This file implements golden-file comparison for plugin responses.
+package testing
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	goldenFilePermissions = 0o600
+	goldenDirPermissions  = 0o750
+)
+
+// EnvUpdateGolden is the environment variable that makes AssertResponseMatchesGolden rewrite
+// golden files instead of comparing against them when set to "true". An environment variable
+// is used rather than a command-line flag so this package does not register flags on behalf
+// of the binaries that import it.
+const EnvUpdateGolden = "FINFOCUS_UPDATE_GOLDEN"
+
+// AssertResponseMatchesGolden compares resp with the golden file at goldenPath and reports a
+// test error showing the first differing line if they differ.
+//
+// The response is marshaled to canonical JSON: proto field names, unpopulated fields omitted,
+// object keys sorted, and two-space indentation, so the file diffs cleanly across versions.
+// Fields named in ignoreFields (proto names such as "timestamp") are removed at any depth
+// before comparing, for values that change from run to run.
+//
+// Set FINFOCUS_UPDATE_GOLDEN=true to create or rewrite the golden files:
+//
+//	FINFOCUS_UPDATE_GOLDEN=true go test ./... -run TestGolden
+//
+// Example:
+//
+//	resp, err := client.GetActualCost(ctx, req)
+//	if err != nil {
+//	    t.Fatal(err)
+//	}
+//	plugintesting.AssertResponseMatchesGolden(t, "testdata/actual_cost.golden.json", resp, "timestamp")
+func AssertResponseMatchesGolden(t testing.TB, goldenPath string, resp proto.Message, ignoreFields ...string) {
+	t.Helper()
+
+	got, err := canonicalGoldenJSON(resp, ignoreFields)
+	if err != nil {
+		t.Fatalf("failed to marshal response for golden comparison: %v", err)
+		return
+	}
+
+	if os.Getenv(EnvUpdateGolden) == "true" {
+		if err = os.MkdirAll(filepath.Dir(goldenPath), goldenDirPermissions); err != nil {
+			t.Fatalf("failed to create golden file directory: %v", err)
+			return
+		}
+		if err = os.WriteFile(goldenPath, got, goldenFilePermissions); err != nil {
+			t.Fatalf("failed to write golden file %s: %v", goldenPath, err)
+		}
+		return
+	}
+
+	want, err := os.ReadFile(goldenPath)
+	if errors.Is(err, os.ErrNotExist) {
+		t.Fatalf("golden file %s does not exist; set %s=true to create it", goldenPath, EnvUpdateGolden)
+		return
+	}
+	if err != nil {
+		t.Fatalf("failed to read golden file %s: %v", goldenPath, err)
+		return
+	}
+
+	if diff := firstGoldenDiff(want, got); diff != "" {
+		t.Errorf("response does not match golden file %s (set %s=true to accept):\n%s",
+			goldenPath, EnvUpdateGolden, diff)
+	}
+}
+
+// canonicalGoldenJSON marshals msg to indented JSON with sorted keys and ignoreFields removed.
+func canonicalGoldenJSON(msg proto.Message, ignoreFields []string) ([]byte, error) {
+	// protojson output is deliberately unstable, so round-trip it through encoding/json,
+	// which sorts object keys and formats consistently.
+	raw, err := protojson.MarshalOptions{UseProtoNames: true}.Marshal(msg)
+	if err != nil {
+		return nil, err
+	}
+	var value interface{}
+	if err = json.Unmarshal(raw, &value); err != nil {
+		return nil, err
+	}
+
+	if len(ignoreFields) > 0 {
+		ignored := make(map[string]bool, len(ignoreFields))
+		for _, field := range ignoreFields {
+			ignored[field] = true
+		}
+		removeGoldenFields(value, ignored)
+	}
+
+	out, err := json.MarshalIndent(value, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append(out, '\n'), nil
+}
+
+// removeGoldenFields deletes the ignored keys from every object nested in value.
+func removeGoldenFields(value interface{}, ignored map[string]bool) {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, child := range v {
+			if ignored[key] {
+				delete(v, key)
+				continue
+			}
+			removeGoldenFields(child, ignored)
+		}
+	case []interface{}:
+		for _, child := range v {
+			removeGoldenFields(child, ignored)
+		}
+	}
+}
+
+// firstGoldenDiff describes the first line where want and got differ, or returns "" if they
+// are equal.
+func firstGoldenDiff(want, got []byte) string {
+	if bytes.Equal(want, got) {
+		return ""
+	}
+	wantLines := strings.Split(string(want), "\n")
+	gotLines := strings.Split(string(got), "\n")
+	for i := 0; i < max(len(wantLines), len(gotLines)); i++ {
+		var wantLine, gotLine string
+		if i < len(wantLines) {
+			wantLine = wantLines[i]
+		}
+		if i < len(gotLines) {
+			gotLine = gotLines[i]
+		}
+		if wantLine != gotLine {
+			return fmt.Sprintf("line %d:\n  want: %s\n  got:  %s", i+1, wantLine, gotLine)
+		}
+	}
+	return "contents differ only in line endings"
+}
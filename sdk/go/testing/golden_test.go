@@ -0,0 +1,94 @@
+package testing_test
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pbc "github.com/rshade/finfocus-spec/sdk/go/proto/finfocus/v1"
+	plugintesting "github.com/rshade/finfocus-spec/sdk/go/testing"
+)
+
+// recordingTB captures golden assertion failures instead of failing the test.
+type recordingTB struct {
+	testing.TB
+
+	failures []string
+}
+
+func (r *recordingTB) Helper() {}
+
+func (r *recordingTB) Errorf(format string, args ...interface{}) {
+	r.failures = append(r.failures, fmt.Sprintf(format, args...))
+}
+
+func (r *recordingTB) Fatalf(format string, args ...interface{}) {
+	r.failures = append(r.failures, fmt.Sprintf(format, args...))
+}
+
+func goldenActualCostResponse(cost float64) *pbc.GetActualCostResponse {
+	return &pbc.GetActualCostResponse{
+		Results: []*pbc.ActualCostResult{{
+			Timestamp:   timestamppb.New(time.Now()),
+			Cost:        cost,
+			UsageAmount: 1,
+			UsageUnit:   "hour",
+			Source:      "golden-test",
+		}},
+		TotalCount: 1,
+	}
+}
+
+// setGoldenUpdate sets FINFOCUS_UPDATE_GOLDEN for the duration of the test.
+func setGoldenUpdate(t *testing.T, update bool) {
+	t.Helper()
+	t.Setenv(plugintesting.EnvUpdateGolden, strconv.FormatBool(update))
+}
+
+func TestAssertResponseMatchesGolden(t *testing.T) {
+	goldenPath := filepath.Join(t.TempDir(), "testdata", "actual_cost.golden.json")
+
+	setGoldenUpdate(t, true)
+	plugintesting.AssertResponseMatchesGolden(t, goldenPath, goldenActualCostResponse(0.05), "timestamp")
+
+	golden, err := os.ReadFile(goldenPath)
+	if err != nil {
+		t.Fatalf("golden file was not written: %v", err)
+	}
+	if strings.Contains(string(golden), "timestamp") {
+		t.Errorf("ignored field written to golden file:\n%s", golden)
+	}
+	if !strings.Contains(string(golden), `"usage_unit": "hour"`) {
+		t.Errorf("golden file does not use proto field names:\n%s", golden)
+	}
+
+	setGoldenUpdate(t, false)
+
+	t.Run("matches despite ignored field", func(t *testing.T) {
+		// A later timestamp must not cause a mismatch.
+		plugintesting.AssertResponseMatchesGolden(t, goldenPath, goldenActualCostResponse(0.05), "timestamp")
+	})
+
+	t.Run("reports changed field", func(t *testing.T) {
+		rec := &recordingTB{TB: t}
+		plugintesting.AssertResponseMatchesGolden(rec, goldenPath, goldenActualCostResponse(0.06), "timestamp")
+		if len(rec.failures) != 1 || !strings.Contains(rec.failures[0], `"cost": 0.06`) {
+			t.Errorf("expected one failure showing the changed cost, got %q", rec.failures)
+		}
+	})
+
+	t.Run("reports missing golden file", func(t *testing.T) {
+		rec := &recordingTB{TB: t}
+		missing := filepath.Join(t.TempDir(), "missing.golden.json")
+		plugintesting.AssertResponseMatchesGolden(rec, missing, goldenActualCostResponse(0.05))
+		if len(rec.failures) != 1 || !strings.Contains(rec.failures[0], plugintesting.EnvUpdateGolden) {
+			t.Errorf("expected one failure suggesting FINFOCUS_UPDATE_GOLDEN, got %q", rec.failures)
+		}
+	})
+}
@@ -4,12 +4,14 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"net"
+	"math"
+	"math/rand/v2"
 	"testing"
 	"time"
 
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/grpc/test/bufconn"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
@@ -102,6 +104,11 @@ type TestHarness struct {
 	listener *bufconn.Listener
 	client   pbc.CostSourceServiceClient
 	conn     *grpc.ClientConn
+
+	// Simulated network conditions; see WithLatency and WithErrorRate.
+	latencyMean   time.Duration
+	latencyJitter time.Duration
+	errorRate     float64
 }
 
 // NewTestHarness creates a new test harness for the given CostSource implementation.
@@ -122,13 +129,7 @@ func NewTestHarness(impl pbc.CostSourceServiceServer) *TestHarness {
 
 // Start initializes the client connection to the test server.
 func (h *TestHarness) Start(t testing.TB) {
-	//nolint:staticcheck // grpc.NewClient doesn't work with bufconn
-	conn, err := grpc.DialContext(context.Background(), "bufnet",
-		grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) {
-			return h.listener.Dial()
-		}),
-		grpc.WithTransportCredentials(insecure.NewCredentials()),
-	)
+	conn, err := h.createClientConnection()
 	if err != nil {
 		t.Fatalf("Failed to dial bufnet: %v", err)
 	}
@@ -152,6 +153,99 @@ func (h *TestHarness) Client() pbc.CostSourceServiceClient {
 	return h.client
 }
 
+// WithLatency delays every RPC made through the harness client by a random duration uniformly
+// distributed between mean-jitter and mean+jitter (never negative), simulating a slow and
+// variable network. A delay is cut short when the call's context ends, returning
+// DeadlineExceeded or Canceled, so client timeouts can be tested. Negative values are treated
+// as zero.
+//
+// Call it before Start:
+//
+//	harness := plugintesting.NewTestHarness(plugin).
+//	    WithLatency(50*time.Millisecond, 20*time.Millisecond).
+//	    WithErrorRate(0.1)
+//	harness.Start(t)
+func (h *TestHarness) WithLatency(mean, jitter time.Duration) *TestHarness {
+	h.latencyMean = max(mean, 0)
+	h.latencyJitter = max(jitter, 0)
+	return h
+}
+
+// WithErrorRate makes the given fraction (0.0-1.0) of RPCs made through the harness client fail
+// with codes.Unavailable before reaching the plugin, simulating a flaky network. Rates outside
+// the range are clamped and NaN disables injection. Call it before Start.
+func (h *TestHarness) WithErrorRate(rate float64) *TestHarness {
+	if math.IsNaN(rate) {
+		rate = 0
+	}
+	h.errorRate = min(max(rate, 0), 1)
+	return h
+}
+
+// simulatesNetwork reports whether WithLatency or WithErrorRate configured any faults.
+func (h *TestHarness) simulatesNetwork() bool {
+	return h.latencyMean > 0 || h.latencyJitter > 0 || h.errorRate > 0
+}
+
+// simulateNetwork waits for the simulated latency and then decides whether to inject an error.
+// It returns a status error if the context ends first or an error is injected.
+func (h *TestHarness) simulateNetwork(ctx context.Context, method string) error {
+	delay := h.latencyMean
+	if h.latencyJitter > 0 {
+		//nolint:gosec // Simulated network jitter does not need a cryptographic source
+		delay += time.Duration(rand.Int64N(int64(2*h.latencyJitter)+1)) - h.latencyJitter
+	}
+	if delay > 0 {
+		timer := time.NewTimer(delay)
+		defer timer.Stop()
+		select {
+		case <-ctx.Done():
+			return status.FromContextError(ctx.Err()).Err()
+		case <-timer.C:
+		}
+	}
+
+	//nolint:gosec // Simulated network errors do not need a cryptographic source
+	if h.errorRate > 0 && rand.Float64() < h.errorRate {
+		return status.Errorf(codes.Unavailable, "injected network error: %s", method)
+	}
+	return nil
+}
+
+// networkUnaryInterceptor applies the simulated network conditions to unary RPCs.
+func (h *TestHarness) networkUnaryInterceptor() grpc.UnaryClientInterceptor {
+	return func(
+		ctx context.Context,
+		method string,
+		req, reply interface{},
+		cc *grpc.ClientConn,
+		invoker grpc.UnaryInvoker,
+		opts ...grpc.CallOption,
+	) error {
+		if err := h.simulateNetwork(ctx, method); err != nil {
+			return err
+		}
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+}
+
+// networkStreamInterceptor applies the simulated network conditions when a stream is opened.
+func (h *TestHarness) networkStreamInterceptor() grpc.StreamClientInterceptor {
+	return func(
+		ctx context.Context,
+		desc *grpc.StreamDesc,
+		cc *grpc.ClientConn,
+		method string,
+		streamer grpc.Streamer,
+		opts ...grpc.CallOption,
+	) (grpc.ClientStream, error) {
+		if err := h.simulateNetwork(ctx, method); err != nil {
+			return nil, err
+		}
+		return streamer(ctx, desc, cc, method, opts...)
+	}
+}
+
 // TestResult represents the result of a single test operation.
 // It includes timing information, success status, and error details.
 type TestResult struct {
@@ -160,7 +254,8 @@ type TestResult struct {
 	Error    error
 	Duration time.Duration
 	Details  string
-	Category TestCategory // Category this test belongs to (for conformance suite)
+	Category TestCategory     // Category this test belongs to (for conformance suite)
+	MinLevel ConformanceLevel // Lowest level that requires this test (for conformance suite)
 }
 
 // ConformanceTest represents a single conformance test case.
@@ -0,0 +1,80 @@
+package testing_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pbc "github.com/rshade/finfocus-spec/sdk/go/proto/finfocus/v1"
+	plugintesting "github.com/rshade/finfocus-spec/sdk/go/testing"
+)
+
+func TestHarnessWithLatency(t *testing.T) {
+	harness := plugintesting.NewTestHarness(plugintesting.NewMockPlugin()).
+		WithLatency(30*time.Millisecond, 10*time.Millisecond)
+	harness.Start(t)
+	defer harness.Stop()
+
+	start := time.Now()
+	if _, err := harness.Client().Name(context.Background(), &pbc.NameRequest{}); err != nil {
+		t.Fatalf("Name() error = %v", err)
+	}
+	if elapsed := time.Since(start); elapsed < 20*time.Millisecond {
+		t.Errorf("Name() took %v, want at least mean-jitter (20ms)", elapsed)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Millisecond)
+	defer cancel()
+	_, err := harness.Client().Name(ctx, &pbc.NameRequest{})
+	if status.Code(err) != codes.DeadlineExceeded {
+		t.Errorf("Name() with short deadline code = %v, want DeadlineExceeded", status.Code(err))
+	}
+}
+
+func TestHarnessWithErrorRate(t *testing.T) {
+	t.Run("always fails", func(t *testing.T) {
+		harness := plugintesting.NewTestHarness(plugintesting.NewMockPlugin()).WithErrorRate(1)
+		harness.Start(t)
+		defer harness.Stop()
+
+		_, err := harness.Client().Name(context.Background(), &pbc.NameRequest{})
+		if status.Code(err) != codes.Unavailable {
+			t.Errorf("Name() code = %v, want Unavailable", status.Code(err))
+		}
+	})
+
+	t.Run("fails some calls", func(t *testing.T) {
+		harness := plugintesting.NewTestHarness(plugintesting.NewMockPlugin()).WithErrorRate(0.5)
+		harness.Start(t)
+		defer harness.Stop()
+
+		var succeeded, failed int
+		for range 200 {
+			_, err := harness.Client().Name(context.Background(), &pbc.NameRequest{})
+			switch status.Code(err) {
+			case codes.OK:
+				succeeded++
+			case codes.Unavailable:
+				failed++
+			default:
+				t.Fatalf("Name() unexpected error: %v", err)
+			}
+		}
+		if succeeded == 0 || failed == 0 {
+			t.Errorf("succeeded = %d, failed = %d; want a mix at a 50%% error rate", succeeded, failed)
+		}
+	})
+
+	t.Run("out of range rates are clamped", func(t *testing.T) {
+		harness := plugintesting.NewTestHarness(plugintesting.NewMockPlugin()).WithErrorRate(-1)
+		harness.Start(t)
+		defer harness.Stop()
+
+		if _, err := harness.Client().Name(context.Background(), &pbc.NameRequest{}); err != nil {
+			t.Errorf("Name() with negative error rate error = %v, want success", err)
+		}
+	})
+}
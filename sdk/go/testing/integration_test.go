@@ -995,9 +995,10 @@ func assertLogNotContains(t *testing.T, logOutput, unexpected, errMsg string) {
 //   - Calculate percentiles for latency to understand tail performance
 //   - Include resource_type and operation as metric dimensions
 //
-// Note: This example uses in-memory metrics collection for demonstration.
-// Production implementations should use a metrics library like Prometheus,
-// OpenTelemetry, or similar for proper aggregation and export.
+// Note: This example uses pluginsdk.DefaultMetricsRecorder for in-memory
+// metrics. Production implementations exporting metrics should also use a
+// metrics library like Prometheus (see pluginsdk.PluginMetrics), OpenTelemetry,
+// or similar for proper aggregation and export.
 func TestMetricsTrackingExample(t *testing.T) {
 	// T041: LatencyTracking subtest
 	t.Run("LatencyTracking", testMetricsLatencyTracking)
@@ -1018,77 +1019,18 @@ func TestMetricsTrackingExample(t *testing.T) {
 	t.Run("MetricsBestPractices", testMetricsBestPractices)
 }
 
-// metricsCollector is a simple in-memory metrics aggregator for demonstration.
-// In production, use a proper metrics library (Prometheus, OpenTelemetry).
-// This collector demonstrates the essential patterns:
-//   - Tracking individual request latencies
-//   - Counting success and error outcomes
-//   - Calculating percentiles for latency distribution
-type metricsCollector struct {
-	latencies    []time.Duration
-	successCount int
-	errorCount   int
-	errorCodes   map[string]int
-}
-
-func newMetricsCollector() *metricsCollector {
-	return &metricsCollector{
-		latencies:  make([]time.Duration, 0),
-		errorCodes: make(map[string]int),
-	}
-}
-
-// recordRequest records the outcome and latency of a request.
-func (m *metricsCollector) recordRequest(duration time.Duration, err error) {
-	m.latencies = append(m.latencies, duration)
-	if err != nil {
-		m.errorCount++
-		st, ok := status.FromError(err)
-		if ok {
-			m.errorCodes[st.Code().String()]++
-		} else {
-			m.errorCodes["Unknown"]++
-		}
-	} else {
-		m.successCount++
-	}
-}
-
-// calculatePercentile calculates the pth percentile of latencies.
-// Uses linear interpolation for more accurate results.
-func (m *metricsCollector) calculatePercentile(p float64) time.Duration {
-	if len(m.latencies) == 0 {
-		return 0
-	}
-
-	// Sort latencies for percentile calculation
-	sorted := make([]time.Duration, len(m.latencies))
-	copy(sorted, m.latencies)
-	sortDurations(sorted)
-
-	// Calculate index using linear interpolation
-	n := float64(len(sorted))
-	idx := (p / 100.0) * (n - 1)
-	lower := int(idx)
-	upper := lower + 1
-	if upper >= len(sorted) {
-		return sorted[len(sorted)-1]
-	}
-
-	// Linear interpolation between lower and upper bounds
-	weight := idx - float64(lower)
-	return time.Duration(
-		float64(sorted[lower])*(1-weight) + float64(sorted[upper])*weight,
-	)
-}
+// estimateCostMethod is the method name used to record EstimateCost metrics.
+// These tests record on the client side; plugins record server side with
+// pluginsdk.MetricsInterceptorWithRecorder, which uses the same recorder.
+const estimateCostMethod = "EstimateCost"
 
-// successRate returns the success rate as a percentage.
-func (m *metricsCollector) successRate() float64 {
-	total := m.successCount + m.errorCount
-	if total == 0 {
-		return 0
+// successAndErrorCounts splits a method's recorded calls into successes and errors.
+func successAndErrorCounts(metrics *pluginsdk.DefaultMetricsRecorder, method string) (int, int) {
+	errorCount := 0
+	for _, count := range metrics.ErrorCounts(method) {
+		errorCount += count
 	}
-	return float64(m.successCount) / float64(total) * 100
+	return metrics.RequestCount(method) - errorCount, errorCount
 }
 
 func testMetricsLatencyTracking(t *testing.T) {
@@ -1099,7 +1041,7 @@ func testMetricsLatencyTracking(t *testing.T) {
 
 	client := harness.Client()
 	ctx := context.Background()
-	metrics := newMetricsCollector()
+	metrics := pluginsdk.NewDefaultMetricsRecorder()
 
 	// Best Practice: Track latency for every request, successful or not
 	// This enables understanding of both happy path and error path performance
@@ -1115,7 +1057,7 @@ func testMetricsLatencyTracking(t *testing.T) {
 		})
 		duration := time.Since(start)
 
-		metrics.recordRequest(duration, err)
+		metrics.RecordLatency(estimateCostMethod, duration, err)
 
 		// Log each request with timing information
 		// Best Practice: Include operation and iteration for debugging
@@ -1123,23 +1065,16 @@ func testMetricsLatencyTracking(t *testing.T) {
 	}
 
 	// Verify latency tracking
-	if len(metrics.latencies) != 10 {
-		t.Errorf("Expected 10 latency measurements, got %d", len(metrics.latencies))
+	if count := metrics.RequestCount(estimateCostMethod); count != 10 {
+		t.Errorf("Expected 10 latency measurements, got %d", count)
 	}
 
-	// Calculate and log average latency
-	var totalLatency time.Duration
-	for _, d := range metrics.latencies {
-		totalLatency += d
-	}
-	avgLatency := totalLatency / time.Duration(len(metrics.latencies))
-	t.Logf("Average latency: %v", avgLatency)
+	// Log median latency
+	t.Logf("Median latency: %v", metrics.Percentile(estimateCostMethod, 50))
 
-	// Verify all latencies are positive (valid measurements)
-	for i, d := range metrics.latencies {
-		if d <= 0 {
-			t.Errorf("Latency %d should be positive, got %v", i, d)
-		}
+	// Verify all latencies are positive (valid measurements): the fastest is p0
+	if fastest := metrics.Percentile(estimateCostMethod, 0); fastest <= 0 {
+		t.Errorf("Latencies should be positive, fastest was %v", fastest)
 	}
 }
 
@@ -1152,7 +1087,7 @@ func testMetricsSuccessRateTracking(t *testing.T) {
 
 	client := harness.Client()
 	ctx := context.Background()
-	metrics := newMetricsCollector()
+	metrics := pluginsdk.NewDefaultMetricsRecorder()
 
 	// Make 8 successful requests
 	for range 8 {
@@ -1164,7 +1099,7 @@ func testMetricsSuccessRateTracking(t *testing.T) {
 			ResourceType: "aws:ec2/instance:Instance",
 			Attributes:   attrs,
 		})
-		metrics.recordRequest(time.Since(start), err)
+		metrics.RecordLatency(estimateCostMethod, time.Since(start), err)
 	}
 
 	// Create error mock for failed requests
@@ -1183,27 +1118,28 @@ func testMetricsSuccessRateTracking(t *testing.T) {
 			ResourceType: "invalid:resource:Type",
 			Attributes:   attrs,
 		})
-		metrics.recordRequest(time.Since(start), err)
+		metrics.RecordLatency(estimateCostMethod, time.Since(start), err)
 	}
 
 	// Verify success rate calculation
 	// Best Practice: Track success rate per operation and resource type
-	rate := metrics.successRate()
+	rate := metrics.SuccessRate(estimateCostMethod)
 	expectedRate := 80.0 // 8 success / 10 total = 80%
 	if rate != expectedRate {
 		t.Errorf("Expected success rate %.1f%%, got %.1f%%", expectedRate, rate)
 	}
 
 	// Verify counts
-	if metrics.successCount != 8 {
-		t.Errorf("Expected 8 successful requests, got %d", metrics.successCount)
+	successCount, errorCount := successAndErrorCounts(metrics, estimateCostMethod)
+	if successCount != 8 {
+		t.Errorf("Expected 8 successful requests, got %d", successCount)
 	}
-	if metrics.errorCount != 2 {
-		t.Errorf("Expected 2 failed requests, got %d", metrics.errorCount)
+	if errorCount != 2 {
+		t.Errorf("Expected 2 failed requests, got %d", errorCount)
 	}
 
 	t.Logf("Success rate: %.1f%% (%d/%d)",
-		rate, metrics.successCount, metrics.successCount+metrics.errorCount)
+		rate, successCount, metrics.RequestCount(estimateCostMethod))
 }
 
 func testMetricsErrorRateByCode(t *testing.T) {
@@ -1218,7 +1154,7 @@ func testMetricsErrorRateByCode(t *testing.T) {
 
 	client := harness.Client()
 	ctx := context.Background()
-	metrics := newMetricsCollector()
+	metrics := pluginsdk.NewDefaultMetricsRecorder()
 
 	// Generate errors
 	for range 5 {
@@ -1228,29 +1164,25 @@ func testMetricsErrorRateByCode(t *testing.T) {
 			ResourceType: "test:resource:Type",
 			Attributes:   attrs,
 		})
-		metrics.recordRequest(time.Since(start), err)
+		metrics.RecordLatency(estimateCostMethod, time.Since(start), err)
 	}
 
 	// Verify error code tracking
-	if len(metrics.errorCodes) == 0 {
+	errorCodes := metrics.ErrorCounts(estimateCostMethod)
+	if len(errorCodes) == 0 {
 		t.Error("Expected error codes to be tracked")
 	}
 
 	// Log error distribution
 	// Best Practice: Understanding error distribution helps prioritize fixes
 	t.Log("Error distribution by gRPC code:")
-	for code, count := range metrics.errorCodes {
+	for code, count := range errorCodes {
 		t.Logf("  %s: %d", code, count)
 	}
 
-	// Verify total errors matches error count
-	totalFromCodes := 0
-	for _, count := range metrics.errorCodes {
-		totalFromCodes += count
-	}
-	if totalFromCodes != metrics.errorCount {
-		t.Errorf("Error code counts (%d) don't match total errors (%d)",
-			totalFromCodes, metrics.errorCount)
+	// Verify every request was counted as an error under some code
+	if _, errorCount := successAndErrorCounts(metrics, estimateCostMethod); errorCount != 5 {
+		t.Errorf("Error code counts (%d) don't match total errors (5)", errorCount)
 	}
 }
 
@@ -1262,7 +1194,7 @@ func testMetricsPercentileCalculation(t *testing.T) {
 
 	client := harness.Client()
 	ctx := context.Background()
-	metrics := newMetricsCollector()
+	metrics := pluginsdk.NewDefaultMetricsRecorder()
 
 	// Make enough requests for meaningful percentile calculation
 	// Best Practice: Use at least 100 samples for accurate percentiles
@@ -1277,14 +1209,14 @@ func testMetricsPercentileCalculation(t *testing.T) {
 			ResourceType: "aws:ec2/instance:Instance",
 			Attributes:   attrs,
 		})
-		metrics.recordRequest(time.Since(start), err)
+		metrics.RecordLatency(estimateCostMethod, time.Since(start), err)
 	}
 
 	// Calculate percentiles
 	// Best Practice: p50, p95, p99 provide insight into typical and tail latency
-	p50 := metrics.calculatePercentile(50)
-	p95 := metrics.calculatePercentile(95)
-	p99 := metrics.calculatePercentile(99)
+	p50 := metrics.Percentile(estimateCostMethod, 50)
+	p95 := metrics.Percentile(estimateCostMethod, 95)
+	p99 := metrics.Percentile(estimateCostMethod, 99)
 
 	// Log percentile results
 	t.Logf("Latency percentiles (n=%d):", numRequests)
@@ -1313,19 +1245,12 @@ func testMetricsPercentileCalculation(t *testing.T) {
 	}
 }
 
-// dimensionedMetrics tracks metrics by operation and resource_type dimensions.
-// Best Practice: Track metrics by operation and resource_type dimensions
-// This enables drilling down into performance by specific resource types.
-type dimensionedMetrics struct {
-	byOperation    map[string]*metricsCollector
-	byResourceType map[string]*metricsCollector
-}
-
 func testMetricsWithDimensions(t *testing.T) {
-	dimMetrics := dimensionedMetrics{
-		byOperation:    make(map[string]*metricsCollector),
-		byResourceType: make(map[string]*metricsCollector),
-	}
+	// Best Practice: Track metrics by operation and resource_type dimensions
+	// This enables drilling down into performance by specific resource types.
+	// A recorder is keyed by name, so one recorder per dimension is enough.
+	byOperation := pluginsdk.NewDefaultMetricsRecorder()
+	byResourceType := pluginsdk.NewDefaultMetricsRecorder()
 
 	plugin := plugintesting.NewMockPlugin()
 	harness := plugintesting.NewTestHarness(plugin)
@@ -1343,14 +1268,6 @@ func testMetricsWithDimensions(t *testing.T) {
 	}
 
 	for _, resType := range resourceTypes {
-		// Initialize metrics for this resource type if needed
-		if _, ok := dimMetrics.byResourceType[resType]; !ok {
-			dimMetrics.byResourceType[resType] = newMetricsCollector()
-		}
-		if _, ok := dimMetrics.byOperation["EstimateCost"]; !ok {
-			dimMetrics.byOperation["EstimateCost"] = newMetricsCollector()
-		}
-
 		// Make requests for this resource type
 		for range 5 {
 			start := time.Now()
@@ -1364,35 +1281,35 @@ func testMetricsWithDimensions(t *testing.T) {
 			duration := time.Since(start)
 
 			// Record in both dimension buckets
-			dimMetrics.byResourceType[resType].recordRequest(duration, err)
-			dimMetrics.byOperation["EstimateCost"].recordRequest(duration, err)
+			byResourceType.RecordLatency(resType, duration, err)
+			byOperation.RecordLatency(estimateCostMethod, duration, err)
 		}
 	}
 
 	// Verify metrics by resource type
 	t.Log("Metrics by resource type:")
-	for resType, m := range dimMetrics.byResourceType {
-		rate := m.successRate()
-		p50 := m.calculatePercentile(50)
+	for _, resType := range byResourceType.Methods() {
+		count := byResourceType.RequestCount(resType)
 		t.Logf("  %s: success_rate=%.1f%%, p50=%v, requests=%d",
-			resType, rate, p50, len(m.latencies))
+			resType, byResourceType.SuccessRate(resType), byResourceType.Percentile(resType, 50), count)
 
 		// Verify each resource type has metrics
-		if len(m.latencies) != 5 {
-			t.Errorf("Resource type %s should have 5 requests, got %d",
-				resType, len(m.latencies))
+		if count != 5 {
+			t.Errorf("Resource type %s should have 5 requests, got %d", resType, count)
 		}
 	}
+	if len(byResourceType.Methods()) != len(resourceTypes) {
+		t.Errorf("Expected metrics for %d resource types, got %v", len(resourceTypes), byResourceType.Methods())
+	}
 
 	// Verify aggregate operation metrics
-	opMetrics := dimMetrics.byOperation["EstimateCost"]
 	expectedTotal := len(resourceTypes) * 5
-	if len(opMetrics.latencies) != expectedTotal {
-		t.Errorf("EstimateCost operation should have %d requests, got %d",
-			expectedTotal, len(opMetrics.latencies))
+	total := byOperation.RequestCount(estimateCostMethod)
+	if total != expectedTotal {
+		t.Errorf("EstimateCost operation should have %d requests, got %d", expectedTotal, total)
 	}
 	t.Logf("Aggregate EstimateCost: success_rate=%.1f%%, p50=%v, total=%d",
-		opMetrics.successRate(), opMetrics.calculatePercentile(50), len(opMetrics.latencies))
+		byOperation.SuccessRate(estimateCostMethod), byOperation.Percentile(estimateCostMethod, 50), total)
 }
 
 func testMetricsBestPractices(t *testing.T) {
@@ -1409,8 +1326,8 @@ func testMetricsBestPractices(t *testing.T) {
 
 	// Best Practice 1: Always track both latency AND outcome together
 	// This enables calculating latency separately for success vs error cases
-	successMetrics := newMetricsCollector()
-	errorMetrics := newMetricsCollector()
+	successMetrics := pluginsdk.NewDefaultMetricsRecorder()
+	errorMetrics := pluginsdk.NewDefaultMetricsRecorder()
 
 	// Successful request
 	start := time.Now()
@@ -1420,7 +1337,7 @@ func testMetricsBestPractices(t *testing.T) {
 		Attributes:   attrs,
 	})
 	if err == nil {
-		successMetrics.recordRequest(time.Since(start), nil)
+		successMetrics.RecordLatency(estimateCostMethod, time.Since(start), nil)
 	}
 
 	// Error request (using error plugin)
@@ -1436,15 +1353,15 @@ func testMetricsBestPractices(t *testing.T) {
 		Attributes:   attrs,
 	})
 	if err != nil {
-		errorMetrics.recordRequest(time.Since(start), err)
+		errorMetrics.RecordLatency(estimateCostMethod, time.Since(start), err)
 	}
 
 	// Verify separate tracking works
-	if successMetrics.successCount != 1 {
-		t.Errorf("Success metrics should have 1 success, got %d", successMetrics.successCount)
+	if successes, _ := successAndErrorCounts(successMetrics, estimateCostMethod); successes != 1 {
+		t.Errorf("Success metrics should have 1 success, got %d", successes)
 	}
-	if errorMetrics.errorCount != 1 {
-		t.Errorf("Error metrics should have 1 error, got %d", errorMetrics.errorCount)
+	if _, errs := successAndErrorCounts(errorMetrics, estimateCostMethod); errs != 1 {
+		t.Errorf("Error metrics should have 1 error, got %d", errs)
 	}
 
 	// Best Practice 2: Use standard field names for metric labels
@@ -1462,20 +1379,6 @@ func testMetricsBestPractices(t *testing.T) {
 	t.Log("Recommended statistics: p50, p95, p99, success_rate")
 }
 
-// sortDurations sorts a slice of durations in ascending order.
-// Uses simple insertion sort - efficient for small slices.
-func sortDurations(durations []time.Duration) {
-	for i := 1; i < len(durations); i++ {
-		key := durations[i]
-		j := i - 1
-		for j >= 0 && durations[j] > key {
-			durations[j+1] = durations[j]
-			j--
-		}
-		durations[j+1] = key
-	}
-}
-
 // =============================================================================
 // DISTRIBUTED TRACING EXAMPLE
 // =============================================================================
@@ -1900,15 +1803,13 @@ func testTracingSpanCreation(t *testing.T) {
 	}
 }
 
-// generateSpanID generates a 16-character hex span ID for demonstration.
-// In production, use proper span ID generation from your tracing library.
+// generateSpanID generates a 16-character hex span ID via pluginsdk.GenerateSpanID.
 func generateSpanID() string {
-	traceID, err := pluginsdk.GenerateTraceID()
+	spanID, err := pluginsdk.GenerateSpanID()
 	if err != nil {
 		return "0000000000000000"
 	}
-	// Use first 16 chars as span ID (simplified for demo)
-	return traceID[:16]
+	return spanID
 }
 
 func testTracingBestPractices(t *testing.T) {
@@ -1970,7 +1871,7 @@ func testTracingBestPractices(t *testing.T) {
 	spanID := generateSpanID()
 	logger.Info().
 		Str(pluginsdk.FieldTraceID, traceID).
-		Str("span_id", spanID).
+		Str(pluginsdk.FieldSpanID, spanID).
 		Str(pluginsdk.FieldOperation, "FetchPricingData").
 		Int64(pluginsdk.FieldDurationMs, 15).
 		Msg("Sub-operation completed")
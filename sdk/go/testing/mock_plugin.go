@@ -18,6 +18,7 @@ import (
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
+	"github.com/rshade/finfocus-spec/sdk/go/internal/budget"
 	"github.com/rshade/finfocus-spec/sdk/go/internal/utilization"
 	pbc "github.com/rshade/finfocus-spec/sdk/go/proto/finfocus/v1"
 )
@@ -111,6 +112,20 @@ type MockPlugin struct {
 	PricingSpecDelay   time.Duration
 	EstimateCostDelay  time.Duration
 
+	// Degraded response configuration for GetActualCost and GetRecommendations.
+	//
+	// PartialResultCount, when positive, caps the results in each response while leaving
+	// total_count and next_page_token as if the page were complete, simulating a backend that
+	// silently drops records. FailAfterN, when positive, lets each of the two RPCs succeed N
+	// times and then return Unavailable on every later call; combine it with ActualCostDelay or
+	// RecommendationsConfig.Delay for slow-then-fail scenarios. Use ResetCallCounts to start
+	// counting again.
+	PartialResultCount int
+	FailAfterN         int
+
+	actualCostCalls      atomic.Int64
+	recommendationsCalls atomic.Int64
+
 	// Data generation configuration
 	actualCostDataPoints atomic.Int64
 	BaseHourlyRate       float64
@@ -228,6 +243,36 @@ func (m *MockPlugin) SetActualCostDataPoints(n int) {
 	m.actualCostDataPoints.Store(int64(n))
 }
 
+// ResetCallCounts resets the GetActualCost and GetRecommendations call counts used by
+// FailAfterN, so both RPCs succeed again for the next FailAfterN calls.
+//
+// Thread Safety: This method uses atomic operations and is safe for concurrent use.
+func (m *MockPlugin) ResetCallCounts() {
+	m.actualCostCalls.Store(0)
+	m.recommendationsCalls.Store(0)
+}
+
+// checkFailAfterN counts a call to rpc and returns an Unavailable error once more than
+// FailAfterN calls have been made. It returns nil when FailAfterN is not positive.
+func (m *MockPlugin) checkFailAfterN(calls *atomic.Int64, rpc string) error {
+	if m.FailAfterN <= 0 {
+		return nil
+	}
+	if calls.Add(1) > int64(m.FailAfterN) {
+		return status.Errorf(codes.Unavailable, "mock error: %s failing after %d successful calls", rpc, m.FailAfterN)
+	}
+	return nil
+}
+
+// truncatePartial returns the first n items when n is positive and items is longer, for
+// PartialResultCount.
+func truncatePartial[T any](items []T, n int) []T {
+	if n > 0 && len(items) > n {
+		return items[:n]
+	}
+	return items
+}
+
 // SetFallbackHint sets the fallback hint to be returned in GetActualCost responses.
 //
 // Thread Safety: This method is NOT safe for concurrent use. All calls to
@@ -585,6 +630,10 @@ func (m *MockPlugin) GetActualCost(
 		return nil, status.Error(codes.NotFound, "mock error: actual cost data not available")
 	}
 
+	if err := m.checkFailAfterN(&m.actualCostCalls, "GetActualCost"); err != nil {
+		return nil, err
+	}
+
 	// T045: Check dry_run flag - return DryRunResponse if true
 	// Note: GetActualCostRequest uses resource_id instead of ResourceDescriptor.
 	// For dry-run mode, we return default field mappings since we can't determine
@@ -653,7 +702,7 @@ func (m *MockPlugin) GetActualCost(
 	}
 
 	return &pbc.GetActualCostResponse{
-		Results:       page,
+		Results:       truncatePartial(page, m.PartialResultCount),
 		FallbackHint:  m.FallbackHint,
 		NextPageToken: nextToken,
 		TotalCount:    totalCount,
@@ -1196,6 +1245,10 @@ func (m *MockPlugin) GetRecommendations(
 		return nil, status.Error(codes.Unavailable, msg)
 	}
 
+	if err := m.checkFailAfterN(&m.recommendationsCalls, "GetRecommendations"); err != nil {
+		return nil, err
+	}
+
 	// Validate request contract constraints (including MaxTargetResources limit)
 	if err := ValidateGetRecommendationsRequest(req); err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, "request validation failed: %v", err)
@@ -1234,6 +1287,7 @@ func (m *MockPlugin) GetRecommendations(
 			return nil, status.Errorf(codes.InvalidArgument, "invalid page token: %v", paginationErr)
 		}
 	}
+	recs = truncatePartial(recs, m.PartialResultCount)
 
 	// NOTE: This mock intentionally calculates summary from the paginated (current page)
 	// results, not the total filtered set. This is by design for testing pagination
@@ -1249,8 +1303,6 @@ func (m *MockPlugin) GetRecommendations(
 }
 
 // GetBudgets implements the mock GetBudgets RPC method.
-//
-//nolint:gocognit // Budget filtering logic requires multiple conditions
 func (m *MockPlugin) GetBudgets(
 	_ context.Context,
 	req *pbc.GetBudgetsRequest,
@@ -1264,58 +1316,11 @@ func (m *MockPlugin) GetBudgets(
 	if budgets == nil {
 		budgets = []*pbc.Budget{}
 	}
-
-	// Apply basic filtering if requested
-	if req.GetFilter() != nil {
-		filter := req.GetFilter()
-		if len(filter.GetProviders()) > 0 {
-			var filtered []*pbc.Budget
-			for _, budget := range budgets {
-				for _, provider := range filter.GetProviders() {
-					if strings.Contains(budget.GetSource(), provider) {
-						filtered = append(filtered, budget)
-						break
-					}
-				}
-			}
-			budgets = filtered
-		}
-	}
-
-	// Calculate summary
-	totalBudgets := len(budgets)
-	budgetsOk := 0
-	budgetsWarning := 0
-	budgetsCritical := 0
-	budgetsExceeded := 0
-
-	for _, budget := range budgets {
-		if budget.GetStatus() != nil {
-			switch budget.GetStatus().GetHealth() {
-			case pbc.BudgetHealthStatus_BUDGET_HEALTH_STATUS_OK,
-				pbc.BudgetHealthStatus_BUDGET_HEALTH_STATUS_UNSPECIFIED:
-				budgetsOk++
-			case pbc.BudgetHealthStatus_BUDGET_HEALTH_STATUS_WARNING:
-				budgetsWarning++
-			case pbc.BudgetHealthStatus_BUDGET_HEALTH_STATUS_CRITICAL:
-				budgetsCritical++
-			case pbc.BudgetHealthStatus_BUDGET_HEALTH_STATUS_EXCEEDED:
-				budgetsExceeded++
-			}
-		} else {
-			budgetsOk++ // No status means assume OK
-		}
-	}
+	budgets = budget.Filter(budgets, req.GetFilter())
 
 	return &pbc.GetBudgetsResponse{
 		Budgets: budgets,
-		Summary: &pbc.BudgetSummary{
-			TotalBudgets:    int32(totalBudgets),    //nolint:gosec // length will not exceed int32 max
-			BudgetsOk:       int32(budgetsOk),       //nolint:gosec // count will not exceed int32 max
-			BudgetsWarning:  int32(budgetsWarning),  //nolint:gosec // count will not exceed int32 max
-			BudgetsCritical: int32(budgetsCritical), //nolint:gosec // count will not exceed int32 max
-			BudgetsExceeded: int32(budgetsExceeded), //nolint:gosec // count will not exceed int32 max
-		},
+		Summary: budget.Summarize(budgets),
 	}, nil
 }
 
@@ -1493,13 +1498,16 @@ func matchesActionTypeFilter(rec *pbc.Recommendation, filter *pbc.Recommendation
 }
 
 // matchesConfidenceScoreFilter checks if the recommendation matches the confidence score filter.
+// Recommendations without a confidence score pass unless exclude_unknown_confidence is set.
 func matchesConfidenceScoreFilter(rec *pbc.Recommendation, filter *pbc.RecommendationFilter) bool {
 	minScore := filter.GetMinConfidenceScore()
 	if minScore <= 0 {
 		return true
 	}
-	recScore := rec.GetConfidenceScore()
-	return recScore > 0 && recScore >= minScore
+	if rec.ConfidenceScore == nil { //nolint:protogetter // direct access needed to distinguish nil from 0
+		return !filter.GetExcludeUnknownConfidence()
+	}
+	return rec.GetConfidenceScore() >= minScore
 }
 
 // matchesMockFilter checks if a recommendation matches the filter criteria.
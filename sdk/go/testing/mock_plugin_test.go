@@ -1,8 +1,14 @@
 package testing_test
 
 import (
+	"context"
 	"math"
 	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	pbc "github.com/rshade/finfocus-spec/sdk/go/proto/finfocus/v1"
 	pktesting "github.com/rshade/finfocus-spec/sdk/go/testing"
@@ -564,3 +570,76 @@ func TestGetSimpleResourceKeyExtraction(t *testing.T) {
 		t.Errorf("Expected spot risk score 0.75, got %f", resp.GetSpotInterruptionRiskScore())
 	}
 }
+
+func TestMockPluginPartialResultCount(t *testing.T) {
+	ctx := context.Background()
+	plugin := pktesting.NewMockPlugin()
+	plugin.PartialResultCount = 3
+	plugin.RecommendationsConfig.Recommendations = pktesting.GenerateSampleRecommendations(10)
+
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	costResp, err := plugin.GetActualCost(ctx, &pbc.GetActualCostRequest{
+		ResourceId: "i-123",
+		Start:      timestamppb.New(start),
+		End:        timestamppb.New(start.Add(23 * time.Hour)),
+		PageSize:   10,
+	})
+	if err != nil {
+		t.Fatalf("GetActualCost() error = %v", err)
+	}
+	if len(costResp.GetResults()) != 3 {
+		t.Errorf("GetActualCost() returned %d results, want 3", len(costResp.GetResults()))
+	}
+	if costResp.GetTotalCount() != 24 {
+		t.Errorf("TotalCount = %d, want 24 (unaffected by PartialResultCount)", costResp.GetTotalCount())
+	}
+	if costResp.GetNextPageToken() == "" {
+		t.Error("NextPageToken is empty, want a token for the remaining pages")
+	}
+
+	recResp, err := plugin.GetRecommendations(ctx, &pbc.GetRecommendationsRequest{PageSize: 5})
+	if err != nil {
+		t.Fatalf("GetRecommendations() error = %v", err)
+	}
+	if len(recResp.GetRecommendations()) != 3 {
+		t.Errorf("GetRecommendations() returned %d recommendations, want 3", len(recResp.GetRecommendations()))
+	}
+	if recResp.GetNextPageToken() == "" {
+		t.Error("NextPageToken is empty, want a token for the remaining pages")
+	}
+}
+
+func TestMockPluginFailAfterN(t *testing.T) {
+	ctx := context.Background()
+	plugin := pktesting.NewMockPlugin()
+	plugin.FailAfterN = 2
+
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	costReq := &pbc.GetActualCostRequest{
+		ResourceId: "i-123",
+		Start:      timestamppb.New(start),
+		End:        timestamppb.New(start.Add(time.Hour)),
+	}
+	recReq := &pbc.GetRecommendationsRequest{}
+
+	for call := 1; call <= 3; call++ {
+		_, costErr := plugin.GetActualCost(ctx, costReq)
+		_, recErr := plugin.GetRecommendations(ctx, recReq)
+		for rpc, err := range map[string]error{"GetActualCost": costErr, "GetRecommendations": recErr} {
+			if call <= 2 {
+				if err != nil {
+					t.Errorf("%s call %d error = %v, want success", rpc, call, err)
+				}
+				continue
+			}
+			if status.Code(err) != codes.Unavailable {
+				t.Errorf("%s call %d code = %v, want Unavailable", rpc, call, status.Code(err))
+			}
+		}
+	}
+
+	plugin.ResetCallCounts()
+	if _, err := plugin.GetActualCost(ctx, costReq); err != nil {
+		t.Errorf("GetActualCost() after ResetCallCounts error = %v, want success", err)
+	}
+}
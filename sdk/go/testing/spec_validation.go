@@ -44,7 +44,8 @@ var ValidBillingModes = []string{
 	"per_load_balancer_hour", "per_connection_hour",
 
 	// Serverless billing
-	"per_invocation", "per_execution", "per_gb_second", "per_million_requests",
+	"per_invocation", "per_execution", "per_gb_second", "per_vcpu_second", "per_request_gb_second",
+	"per_million_requests",
 
 	// Licensing billing
 	"per_license", "per_seat", "per_user",
@@ -230,13 +231,19 @@ type grpcConn = grpc.ClientConn
 //
 //nolint:staticcheck // grpc.DialContext is deprecated but NewClient doesn't support bufconn dialers
 func (h *TestHarness) createClientConnection() (*grpc.ClientConn, error) {
-	conn, err := grpc.DialContext(context.Background(), "bufnet",
+	opts := []grpc.DialOption{
 		grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) {
 			return h.listener.Dial()
 		}),
 		grpc.WithTransportCredentials(insecure.NewCredentials()),
-	)
-	return conn, err
+	}
+	if h.simulatesNetwork() {
+		opts = append(opts,
+			grpc.WithChainUnaryInterceptor(h.networkUnaryInterceptor()),
+			grpc.WithChainStreamInterceptor(h.networkStreamInterceptor()),
+		)
+	}
+	return grpc.DialContext(context.Background(), "bufnet", opts...)
 }
 
 // SpecValidationTests returns the spec validation conformance tests.
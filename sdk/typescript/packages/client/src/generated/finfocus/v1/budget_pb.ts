@@ -16,7 +16,7 @@ import type { Message } from "@bufbuild/protobuf";
  * Describes the file finfocus/v1/budget.proto.
  */
 export const file_finfocus_v1_budget: GenFile = /*@__PURE__*/
-  fileDesc("ChhmaW5mb2N1cy92MS9idWRnZXQucHJvdG8SC2ZpbmZvY3VzLnYxItYDCgZCdWRnZXQSCgoCaWQYASABKAkSDAoEbmFtZRgCIAEoCRIOCgZzb3VyY2UYAyABKAkSKQoGYW1vdW50GAQgASgLMhkuZmluZm9jdXMudjEuQnVkZ2V0QW1vdW50EikKBnBlcmlvZBgFIAEoDjIZLmZpbmZvY3VzLnYxLkJ1ZGdldFBlcmlvZBIpCgZmaWx0ZXIYBiABKAsyGS5maW5mb2N1cy52MS5CdWRnZXRGaWx0ZXISMAoKdGhyZXNob2xkcxgHIAMoCzIcLmZpbmZvY3VzLnYxLkJ1ZGdldFRocmVzaG9sZBIpCgZzdGF0dXMYCCABKAsyGS5maW5mb2N1cy52MS5CdWRnZXRTdGF0dXMSLgoKY3JlYXRlZF9hdBgJIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASLgoKdXBkYXRlZF9hdBgKIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASMwoIbWV0YWRhdGEYCyADKAsyIS5maW5mb2N1cy52MS5CdWRnZXQuTWV0YWRhdGFFbnRyeRovCg1NZXRhZGF0YUVudHJ5EgsKA2tleRgBIAEoCRINCgV2YWx1ZRgCIAEoCToCOAEiLwoMQnVkZ2V0QW1vdW50Eg0KBWxpbWl0GAEgASgBEhAKCGN1cnJlbmN5GAIgASgJIqoBCgxCdWRnZXRGaWx0ZXISEQoJcHJvdmlkZXJzGAEgAygJEg8KB3JlZ2lvbnMYAiADKAkSFgoOcmVzb3VyY2VfdHlwZXMYAyADKAkSMQoEdGFncxgEIAMoCzIjLmZpbmZvY3VzLnYxLkJ1ZGdldEZpbHRlci5UYWdzRW50cnkaKwoJVGFnc0VudHJ5EgsKA2tleRgBIAEoCRINCgV2YWx1ZRgCIAEoCToCOAEilAEKD0J1ZGdldFRocmVzaG9sZBISCgpwZXJjZW50YWdlGAEgASgBEigKBHR5cGUYAiABKA4yGi5maW5mb2N1cy52MS5UaHJlc2hvbGRUeXBlEhEKCXRyaWdnZXJlZBgDIAEoCBIwCgx0cmlnZ2VyZWRfYXQYBCABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wIroBCgxCdWRnZXRTdGF0dXMSFQoNY3VycmVudF9zcGVuZBgBIAEoARIYChBmb3JlY2FzdGVkX3NwZW5kGAIgASgBEhcKD3BlcmNlbnRhZ2VfdXNlZBgDIAEoARIdChVwZXJjZW50YWdlX2ZvcmVjYXN0ZWQYBCABKAESEAoIY3VycmVuY3kYBSABKAkSLwoGaGVhbHRoGAYgASgOMh8uZmluZm9jdXMudjEuQnVkZ2V0SGVhbHRoU3RhdHVzIlYKEUdldEJ1ZGdldHNSZXF1ZXN0EikKBmZpbHRlchgBIAEoCzIZLmZpbmZvY3VzLnYxLkJ1ZGdldEZpbHRlchIWCg5pbmNsdWRlX3N0YXR1cxgCIAEoCCJnChJHZXRCdWRnZXRzUmVzcG9uc2USJAoHYnVkZ2V0cxgBIAMoCzITLmZpbmZvY3VzLnYxLkJ1ZGdldBIrCgdzdW1tYXJ5GAIgASgLMhouZmluZm9jdXMudjEuQnVkZ2V0U3VtbWFyeSKHAQoNQnVkZ2V0U3VtbWFyeRIVCg10b3RhbF9idWRnZXRzGAEgASgFEhIKCmJ1ZGdldHNfb2sYAiABKAUSFwoPYnVkZ2V0c193YXJuaW5nGAMgASgFEhgKEGJ1ZGdldHNfZXhjZWVkZWQYBCABKAUSGAoQYnVkZ2V0c19jcml0aWNhbBgFIAEoBSq0AQoMQnVkZ2V0UGVyaW9kEh0KGUJVREdFVF9QRVJJT0RfVU5TUEVDSUZJRUQQABIXChNCVURHRVRfUEVSSU9EX0RBSUxZEAESGAoUQlVER0VUX1BFUklPRF9XRUVLTFkQAhIZChVCVURHRVRfUEVSSU9EX01PTlRITFkQAxIbChdCVURHRVRfUEVSSU9EX1FVQVJURVJMWRAEEhoKFkJVREdFVF9QRVJJT0RfQU5OVUFMTFkQBSppCg1UaHJlc2hvbGRUeXBlEh4KGlRIUkVTSE9MRF9UWVBFX1VOU1BFQ0lGSUVEEAASGQoVVEhSRVNIT0xEX1RZUEVfQUNUVUFMEAESHQoZVEhSRVNIT0xEX1RZUEVfRk9SRUNBU1RFRBACKr8BChJCdWRnZXRIZWFsdGhTdGF0dXMSJAogQlVER0VUX0hFQUxUSF9TVEFUVVNfVU5TUEVDSUZJRUQQABIbChdCVURHRVRfSEVBTFRIX1NUQVRVU19PSxABEiAKHEJVREdFVF9IRUFMVEhfU1RBVFVTX1dBUk5JTkcQAhIhCh1CVURHRVRfSEVBTFRIX1NUQVRVU19DUklUSUNBTBADEiEKHUJVREdFVF9IRUFMVEhfU1RBVFVTX0VYQ0VFREVEEARCqQEKD2NvbS5maW5mb2N1cy52MUILQnVkZ2V0UHJvdG9QAVo8Z2l0aHViLmNvbS9yc2hhZGUvZmluZm9jdXMtc3BlYy9zZGsvZ28vcHJvdG8vZmluZm9jdXMvdjE7cGJjogIDRlhYqgILRmluZm9jdXMuVjHKAgtGaW5mb2N1c1xWMeICF0ZpbmZvY3VzXFYxXEdQQk1ldGFkYXRh6gIMRmluZm9jdXM6OlYxYgZwcm90bzM", [file_google_protobuf_timestamp]);
+  fileDesc("ChhmaW5mb2N1cy92MS9idWRnZXQucHJvdG8SC2ZpbmZvY3VzLnYxItYDCgZCdWRnZXQSCgoCaWQYASABKAkSDAoEbmFtZRgCIAEoCRIOCgZzb3VyY2UYAyABKAkSKQoGYW1vdW50GAQgASgLMhkuZmluZm9jdXMudjEuQnVkZ2V0QW1vdW50EikKBnBlcmlvZBgFIAEoDjIZLmZpbmZvY3VzLnYxLkJ1ZGdldFBlcmlvZBIpCgZmaWx0ZXIYBiABKAsyGS5maW5mb2N1cy52MS5CdWRnZXRGaWx0ZXISMAoKdGhyZXNob2xkcxgHIAMoCzIcLmZpbmZvY3VzLnYxLkJ1ZGdldFRocmVzaG9sZBIpCgZzdGF0dXMYCCABKAsyGS5maW5mb2N1cy52MS5CdWRnZXRTdGF0dXMSLgoKY3JlYXRlZF9hdBgJIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASLgoKdXBkYXRlZF9hdBgKIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASMwoIbWV0YWRhdGEYCyADKAsyIS5maW5mb2N1cy52MS5CdWRnZXQuTWV0YWRhdGFFbnRyeRovCg1NZXRhZGF0YUVudHJ5EgsKA2tleRgBIAEoCRINCgV2YWx1ZRgCIAEoCToCOAEiLwoMQnVkZ2V0QW1vdW50Eg0KBWxpbWl0GAEgASgBEhAKCGN1cnJlbmN5GAIgASgJIpACCgxCdWRnZXRGaWx0ZXISEQoJcHJvdmlkZXJzGAEgAygJEg8KB3JlZ2lvbnMYAiADKAkSFgoOcmVzb3VyY2VfdHlwZXMYAyADKAkSMQoEdGFncxgEIAMoCzIjLmZpbmZvY3VzLnYxLkJ1ZGdldEZpbHRlci5UYWdzRW50cnkSKgoHcGVyaW9kcxgFIAMoDjIZLmZpbmZvY3VzLnYxLkJ1ZGdldFBlcmlvZBI4Cg9oZWFsdGhfc3RhdHVzZXMYBiADKA4yHy5maW5mb2N1cy52MS5CdWRnZXRIZWFsdGhTdGF0dXMaKwoJVGFnc0VudHJ5EgsKA2tleRgBIAEoCRINCgV2YWx1ZRgCIAEoCToCOAEilAEKD0J1ZGdldFRocmVzaG9sZBISCgpwZXJjZW50YWdlGAEgASgBEigKBHR5cGUYAiABKA4yGi5maW5mb2N1cy52MS5UaHJlc2hvbGRUeXBlEhEKCXRyaWdnZXJlZBgDIAEoCBIwCgx0cmlnZ2VyZWRfYXQYBCABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wIroBCgxCdWRnZXRTdGF0dXMSFQoNY3VycmVudF9zcGVuZBgBIAEoARIYChBmb3JlY2FzdGVkX3NwZW5kGAIgASgBEhcKD3BlcmNlbnRhZ2VfdXNlZBgDIAEoARIdChVwZXJjZW50YWdlX2ZvcmVjYXN0ZWQYBCABKAESEAoIY3VycmVuY3kYBSABKAkSLwoGaGVhbHRoGAYgASgOMh8uZmluZm9jdXMudjEuQnVkZ2V0SGVhbHRoU3RhdHVzIlYKEUdldEJ1ZGdldHNSZXF1ZXN0EikKBmZpbHRlchgBIAEoCzIZLmZpbmZvY3VzLnYxLkJ1ZGdldEZpbHRlchIWCg5pbmNsdWRlX3N0YXR1cxgCIAEoCCJnChJHZXRCdWRnZXRzUmVzcG9uc2USJAoHYnVkZ2V0cxgBIAMoCzITLmZpbmZvY3VzLnYxLkJ1ZGdldBIrCgdzdW1tYXJ5GAIgASgLMhouZmluZm9jdXMudjEuQnVkZ2V0U3VtbWFyeSKHAQoNQnVkZ2V0U3VtbWFyeRIVCg10b3RhbF9idWRnZXRzGAEgASgFEhIKCmJ1ZGdldHNfb2sYAiABKAUSFwoPYnVkZ2V0c193YXJuaW5nGAMgASgFEhgKEGJ1ZGdldHNfZXhjZWVkZWQYBCABKAUSGAoQYnVkZ2V0c19jcml0aWNhbBgFIAEoBSq0AQoMQnVkZ2V0UGVyaW9kEh0KGUJVREdFVF9QRVJJT0RfVU5TUEVDSUZJRUQQABIXChNCVURHRVRfUEVSSU9EX0RBSUxZEAESGAoUQlVER0VUX1BFUklPRF9XRUVLTFkQAhIZChVCVURHRVRfUEVSSU9EX01PTlRITFkQAxIbChdCVURHRVRfUEVSSU9EX1FVQVJURVJMWRAEEhoKFkJVREdFVF9QRVJJT0RfQU5OVUFMTFkQBSppCg1UaHJlc2hvbGRUeXBlEh4KGlRIUkVTSE9MRF9UWVBFX1VOU1BFQ0lGSUVEEAASGQoVVEhSRVNIT0xEX1RZUEVfQUNUVUFMEAESHQoZVEhSRVNIT0xEX1RZUEVfRk9SRUNBU1RFRBACKr8BChJCdWRnZXRIZWFsdGhTdGF0dXMSJAogQlVER0VUX0hFQUxUSF9TVEFUVVNfVU5TUEVDSUZJRUQQABIbChdCVURHRVRfSEVBTFRIX1NUQVRVU19PSxABEiAKHEJVREdFVF9IRUFMVEhfU1RBVFVTX1dBUk5JTkcQAhIhCh1CVURHRVRfSEVBTFRIX1NUQVRVU19DUklUSUNBTBADEiEKHUJVREdFVF9IRUFMVEhfU1RBVFVTX0VYQ0VFREVEEARCqQEKD2NvbS5maW5mb2N1cy52MUILQnVkZ2V0UHJvdG9QAVo8Z2l0aHViLmNvbS9yc2hhZGUvZmluZm9jdXMtc3BlYy9zZGsvZ28vcHJvdG8vZmluZm9jdXMvdjE7cGJjogIDRlhYqgILRmluZm9jdXMuVjHKAgtGaW5mb2N1c1xWMeICF0ZpbmZvY3VzXFYxXEdQQk1ldGFkYXRh6gIMRmluZm9jdXM6OlYxYgZwcm90bzM", [file_google_protobuf_timestamp]);
 
 /**
  * Budget represents a spending limit with alert thresholds from cloud cost management services.
@@ -139,8 +139,9 @@ export const BudgetAmountSchema: GenMessage<BudgetAmount> = /*@__PURE__*/
   messageDesc(file_finfocus_v1_budget, 1);
 
 /**
- * BudgetFilter allows narrowing down budgets by provider, region, resource type, or tags.
- * All fields are optional - empty filter matches all budgets.
+ * BudgetFilter allows narrowing down budgets by provider, region, resource type, tags,
+ * period, or health status. All fields are optional - empty filter matches all budgets.
+ * When used as a Budget's scope, periods and health_statuses are not meaningful and are ignored.
  *
  * @generated from message finfocus.v1.BudgetFilter
  */
@@ -172,6 +173,20 @@ export type BudgetFilter = Message<"finfocus.v1.BudgetFilter"> & {
    * @generated from field: map<string, string> tags = 4;
    */
   tags: { [key: string]: string };
+
+  /**
+   * Budget period restrictions (optional)
+   *
+   * @generated from field: repeated finfocus.v1.BudgetPeriod periods = 5;
+   */
+  periods: BudgetPeriod[];
+
+  /**
+   * Health status restrictions (optional)
+   *
+   * @generated from field: repeated finfocus.v1.BudgetHealthStatus health_statuses = 6;
+   */
+  healthStatuses: BudgetHealthStatus[];
 };
 
 /**
@@ -18,7 +18,7 @@ import type { JsonObject, Message } from "@bufbuild/protobuf";
  * Describes the file finfocus/v1/costsource.proto.
  */
 export const file_finfocus_v1_costsource: GenFile = /*@__PURE__*/
-  fileDesc("ChxmaW5mb2N1cy92MS9jb3N0c291cmNlLnByb3RvEgtmaW5mb2N1cy52MSINCgtOYW1lUmVxdWVzdCIcCgxOYW1lUmVzcG9uc2USDAoEbmFtZRgBIAEoCSJSCgxJbXBhY3RNZXRyaWMSJQoEa2luZBgBIAEoDjIXLmZpbmZvY3VzLnYxLk1ldHJpY0tpbmQSDQoFdmFsdWUYAiABKAESDAoEdW5pdBgDIAEoCSJECg9TdXBwb3J0c1JlcXVlc3QSMQoIcmVzb3VyY2UYASABKAsyHy5maW5mb2N1cy52MS5SZXNvdXJjZURlc2NyaXB0b3IinwIKEFN1cHBvcnRzUmVzcG9uc2USEQoJc3VwcG9ydGVkGAEgASgIEg4KBnJlYXNvbhgCIAEoCRJFCgxjYXBhYmlsaXRpZXMYAyADKAsyLy5maW5mb2N1cy52MS5TdXBwb3J0c1Jlc3BvbnNlLkNhcGFiaWxpdGllc0VudHJ5EjIKEXN1cHBvcnRlZF9tZXRyaWNzGAQgAygOMhcuZmluZm9jdXMudjEuTWV0cmljS2luZBI4ChFjYXBhYmlsaXRpZXNfZW51bRgFIAMoDjIdLmZpbmZvY3VzLnYxLlBsdWdpbkNhcGFiaWxpdHkaMwoRQ2FwYWJpbGl0aWVzRW50cnkSCwoDa2V5GAEgASgJEg0KBXZhbHVlGAIgASgIOgI4ASKsAgoUR2V0QWN0dWFsQ29zdFJlcXVlc3QSEwoLcmVzb3VyY2VfaWQYASABKAkSKQoFc3RhcnQYAiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEicKA2VuZBgDIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASOQoEdGFncxgEIAMoCzIrLmZpbmZvY3VzLnYxLkdldEFjdHVhbENvc3RSZXF1ZXN0LlRhZ3NFbnRyeRILCgNhcm4YBSABKAkSDwoHZHJ5X3J1bhgGIAEoCBIRCglwYWdlX3NpemUYByABKAUSEgoKcGFnZV90b2tlbhgIIAEoCRorCglUYWdzRW50cnkSCwoDa2V5GAEgASgJEg0KBXZhbHVlGAIgASgJOgI4ASLcAQoVR2V0QWN0dWFsQ29zdFJlc3BvbnNlEi4KB3Jlc3VsdHMYASADKAsyHS5maW5mb2N1cy52MS5BY3R1YWxDb3N0UmVzdWx0EjAKDWZhbGxiYWNrX2hpbnQYAiABKA4yGS5maW5mb2N1cy52MS5GYWxsYmFja0hpbnQSMwoOZHJ5X3J1bl9yZXN1bHQYAyABKAsyGy5maW5mb2N1cy52MS5EcnlSdW5SZXNwb25zZRIXCg9uZXh0X3BhZ2VfdG9rZW4YBCABKAkSEwoLdG90YWxfY291bnQYBSABKAUihwIKF0dldFByb2plY3RlZENvc3RSZXF1ZXN0EjEKCHJlc291cmNlGAEgASgLMh8uZmluZm9jdXMudjEuUmVzb3VyY2VEZXNjcmlwdG9yEh4KFnV0aWxpemF0aW9uX3BlcmNlbnRhZ2UYAiABKAESLAoLZ3Jvd3RoX3R5cGUYAyABKA4yFy5maW5mb2N1cy52MS5Hcm93dGhUeXBlEhgKC2dyb3d0aF9yYXRlGAQgASgBSACIAQESDwoHZHJ5X3J1bhgFIAEoCBIwCg11c2FnZV9wcm9maWxlGAYgASgOMhkuZmluZm9jdXMudjEuVXNhZ2VQcm9maWxlQg4KDF9ncm93dGhfcmF0ZSKpBAoYR2V0UHJvamVjdGVkQ29zdFJlc3BvbnNlEhIKCnVuaXRfcHJpY2UYASABKAESEAoIY3VycmVuY3kYAiABKAkSFgoOY29zdF9wZXJfbW9udGgYAyABKAESFgoOYmlsbGluZ19kZXRhaWwYBCABKAkSMQoOaW1wYWN0X21ldHJpY3MYBSADKAsyGS5maW5mb2N1cy52MS5JbXBhY3RNZXRyaWMSLAoLZ3Jvd3RoX3R5cGUYBiABKA4yFy5maW5mb2N1cy52MS5Hcm93dGhUeXBlEjMKDmRyeV9ydW5fcmVzdWx0GAcgASgLMhsuZmluZm9jdXMudjEuRHJ5UnVuUmVzcG9uc2USOwoQcHJpY2luZ19jYXRlZ29yeRgIIAEoDjIhLmZpbmZvY3VzLnYxLkZvY3VzUHJpY2luZ0NhdGVnb3J5EiQKHHNwb3RfaW50ZXJydXB0aW9uX3Jpc2tfc2NvcmUYCSABKAESJgoZcHJlZGljdGlvbl9pbnRlcnZhbF9sb3dlchgKIAEoAUgAiAEBEiYKGXByZWRpY3Rpb25faW50ZXJ2YWxfdXBwZXIYCyABKAFIAYgBARIdChBjb25maWRlbmNlX2xldmVsGAwgASgBSAKIAQFCHAoaX3ByZWRpY3Rpb25faW50ZXJ2YWxfbG93ZXJCHAoaX3ByZWRpY3Rpb25faW50ZXJ2YWxfdXBwZXJCEwoRX2NvbmZpZGVuY2VfbGV2ZWwiSgoVR2V0UHJpY2luZ1NwZWNSZXF1ZXN0EjEKCHJlc291cmNlGAEgASgLMh8uZmluZm9jdXMudjEuUmVzb3VyY2VEZXNjcmlwdG9yIkAKFkdldFByaWNpbmdTcGVjUmVzcG9uc2USJgoEc3BlYxgBIAEoCzIYLmZpbmZvY3VzLnYxLlByaWNpbmdTcGVjIvECChJSZXNvdXJjZURlc2NyaXB0b3ISEAoIcHJvdmlkZXIYASABKAkSFQoNcmVzb3VyY2VfdHlwZRgCIAEoCRILCgNza3UYAyABKAkSDgoGcmVnaW9uGAQgASgJEjcKBHRhZ3MYBSADKAsyKS5maW5mb2N1cy52MS5SZXNvdXJjZURlc2NyaXB0b3IuVGFnc0VudHJ5EiMKFnV0aWxpemF0aW9uX3BlcmNlbnRhZ2UYBiABKAFIAIgBARIKCgJpZBgHIAEoCRILCgNhcm4YCCABKAkSLAoLZ3Jvd3RoX3R5cGUYCSABKA4yFy5maW5mb2N1cy52MS5Hcm93dGhUeXBlEhgKC2dyb3d0aF9yYXRlGAogASgBSAGIAQEaKwoJVGFnc0VudHJ5EgsKA2tleRgBIAEoCRINCgV2YWx1ZRgCIAEoCToCOAFCGQoXX3V0aWxpemF0aW9uX3BlcmNlbnRhZ2VCDgoMX2dyb3d0aF9yYXRlIvABChBBY3R1YWxDb3N0UmVzdWx0Ei0KCXRpbWVzdGFtcBgBIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASDAoEY29zdBgCIAEoARIUCgx1c2FnZV9hbW91bnQYAyABKAESEgoKdXNhZ2VfdW5pdBgEIAEoCRIOCgZzb3VyY2UYBSABKAkSMgoMZm9jdXNfcmVjb3JkGAYgASgLMhwuZmluZm9jdXMudjEuRm9jdXNDb3N0UmVjb3JkEjEKDmltcGFjdF9tZXRyaWNzGAcgAygLMhkuZmluZm9jdXMudjEuSW1wYWN0TWV0cmljIi8KD1VzYWdlTWV0cmljSGludBIOCgZtZXRyaWMYASABKAkSDAoEdW5pdBgCIAEoCSK9AwoLUHJpY2luZ1NwZWMSEAoIcHJvdmlkZXIYASABKAkSFQoNcmVzb3VyY2VfdHlwZRgCIAEoCRILCgNza3UYAyABKAkSDgoGcmVnaW9uGAQgASgJEhQKDGJpbGxpbmdfbW9kZRgFIAEoCRIVCg1yYXRlX3Blcl91bml0GAYgASgBEhAKCGN1cnJlbmN5GAcgASgJEhMKC2Rlc2NyaXB0aW9uGAggASgJEjIKDG1ldHJpY19oaW50cxgJIAMoCzIcLmZpbmZvY3VzLnYxLlVzYWdlTWV0cmljSGludBJFCg9wbHVnaW5fbWV0YWRhdGEYCiADKAsyLC5maW5mb2N1cy52MS5QcmljaW5nU3BlYy5QbHVnaW5NZXRhZGF0YUVudHJ5Eg4KBnNvdXJjZRgLIAEoCRIMCgR1bml0GAwgASgJEhMKC2Fzc3VtcHRpb25zGA0gAygJEi8KDXByaWNpbmdfdGllcnMYDiADKAsyGC5maW5mb2N1cy52MS5QcmljaW5nVGllcho1ChNQbHVnaW5NZXRhZGF0YUVudHJ5EgsKA2tleRgBIAEoCRINCgV2YWx1ZRgCIAEoCToCOAEiZQoLUHJpY2luZ1RpZXISFAoMbWluX3F1YW50aXR5GAEgASgBEhQKDG1heF9xdWFudGl0eRgCIAEoARIVCg1yYXRlX3Blcl91bml0GAMgASgBEhMKC2Rlc2NyaXB0aW9uGAQgASgJIsMCCgtFcnJvckRldGFpbBIkCgRjb2RlGAEgASgOMhYuZmluZm9jdXMudjEuRXJyb3JDb2RlEiwKCGNhdGVnb3J5GAIgASgOMhouZmluZm9jdXMudjEuRXJyb3JDYXRlZ29yeRIPCgdtZXNzYWdlGAMgASgJEjYKB2RldGFpbHMYBCADKAsyJS5maW5mb2N1cy52MS5FcnJvckRldGFpbC5EZXRhaWxzRW50cnkSIAoTcmV0cnlfYWZ0ZXJfc2Vjb25kcxgFIAEoBUgAiAEBEi0KCXRpbWVzdGFtcBgGIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXAaLgoMRGV0YWlsc0VudHJ5EgsKA2tleRgBIAEoCRINCgV2YWx1ZRgCIAEoCToCOAFCFgoUX3JldHJ5X2FmdGVyX3NlY29uZHMiKgoSSGVhbHRoQ2hlY2tSZXF1ZXN0EhQKDHNlcnZpY2VfbmFtZRgBIAEoCSL+AQoTSGVhbHRoQ2hlY2tSZXNwb25zZRI3CgZzdGF0dXMYASABKA4yJy5maW5mb2N1cy52MS5IZWFsdGhDaGVja1Jlc3BvbnNlLlN0YXR1cxIPCgdtZXNzYWdlGAIgASgJEjMKD2xhc3RfY2hlY2tfdGltZRgDIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXAiaAoGU3RhdHVzEhYKElNUQVRVU19VTlNQRUNJRklFRBAAEhIKDlNUQVRVU19TRVJWSU5HEAESFgoSU1RBVFVTX05PVF9TRVJWSU5HEAISGgoWU1RBVFVTX1NFUlZJQ0VfVU5LTk9XThADIjkKEUdldE1ldHJpY3NSZXF1ZXN0EhQKDG1ldHJpY19uYW1lcxgBIAMoCRIOCgZmb3JtYXQYAiABKAkieQoSR2V0TWV0cmljc1Jlc3BvbnNlEiQKB21ldHJpY3MYASADKAsyEy5maW5mb2N1cy52MS5NZXRyaWMSLQoJdGltZXN0YW1wGAIgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIOCgZmb3JtYXQYAyABKAkidwoGTWV0cmljEgwKBG5hbWUYASABKAkSDAoEaGVscBgCIAEoCRIlCgR0eXBlGAMgASgOMhcuZmluZm9jdXMudjEuTWV0cmljVHlwZRIqCgdzYW1wbGVzGAQgAygLMhkuZmluZm9jdXMudjEuTWV0cmljU2FtcGxlIrIBCgxNZXRyaWNTYW1wbGUSNQoGbGFiZWxzGAEgAygLMiUuZmluZm9jdXMudjEuTWV0cmljU2FtcGxlLkxhYmVsc0VudHJ5Eg0KBXZhbHVlGAIgASgBEi0KCXRpbWVzdGFtcBgDIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXAaLQoLTGFiZWxzRW50cnkSCwoDa2V5GAEgASgJEg0KBXZhbHVlGAIgASgJOgI4ASJhCiBHZXRTZXJ2aWNlTGV2ZWxJbmRpY2F0b3JzUmVxdWVzdBIqCgp0aW1lX3JhbmdlGAEgASgLMhYuZmluZm9jdXMudjEuVGltZVJhbmdlEhEKCXNsaV9uYW1lcxgCIAMoCSKLAQohR2V0U2VydmljZUxldmVsSW5kaWNhdG9yc1Jlc3BvbnNlEjAKBHNsaXMYASADKAsyIi5maW5mb2N1cy52MS5TZXJ2aWNlTGV2ZWxJbmRpY2F0b3ISNAoQbWVhc3VyZW1lbnRfdGltZRgCIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXAilQEKFVNlcnZpY2VMZXZlbEluZGljYXRvchIMCgRuYW1lGAEgASgJEhMKC2Rlc2NyaXB0aW9uGAIgASgJEg0KBXZhbHVlGAMgASgBEgwKBHVuaXQYBCABKAkSFAoMdGFyZ2V0X3ZhbHVlGAUgASgBEiYKBnN0YXR1cxgGIAEoDjIWLmZpbmZvY3VzLnYxLlNMSVN0YXR1cyJfCglUaW1lUmFuZ2USKQoFc3RhcnQYASABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEicKA2VuZBgCIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXAipQEKEVRlbGVtZXRyeU1ldGFkYXRhEhAKCHRyYWNlX2lkGAEgASgJEg8KB3NwYW5faWQYAiABKAkSEgoKcmVxdWVzdF9pZBgDIAEoCRIaChJwcm9jZXNzaW5nX3RpbWVfbXMYBCABKAMSEwoLZGF0YV9zb3VyY2UYBSABKAkSEQoJY2FjaGVfaGl0GAYgASgIEhUKDXF1YWxpdHlfc2NvcmUYByABKAEiowIKCExvZ0VudHJ5Ei0KCXRpbWVzdGFtcBgBIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASDQoFbGV2ZWwYAiABKAkSDwoHbWVzc2FnZRgDIAEoCRIRCgljb21wb25lbnQYBCABKAkSEAoIdHJhY2VfaWQYBSABKAkSDwoHc3Bhbl9pZBgGIAEoCRIxCgZmaWVsZHMYByADKAsyIS5maW5mb2N1cy52MS5Mb2dFbnRyeS5GaWVsZHNFbnRyeRIwCg1lcnJvcl9kZXRhaWxzGAggASgLMhkuZmluZm9jdXMudjEuRXJyb3JEZXRhaWxzGi0KC0ZpZWxkc0VudHJ5EgsKA2tleRgBIAEoCRINCgV2YWx1ZRgCIAEoCToCOAEihAEKDEVycm9yRGV0YWlscxISCgplcnJvcl9jb2RlGAEgASgJEhYKDmVycm9yX2NhdGVnb3J5GAIgASgJEhMKC3N0YWNrX3RyYWNlGAMgASgJEhsKE3JldHJ5X2FmdGVyX3NlY29uZHMYBCABKAUSFgoOY29ycmVsYXRpb25faWQYBSABKAkiWQoTRXN0aW1hdGVDb3N0UmVxdWVzdBIVCg1yZXNvdXJjZV90eXBlGAEgASgJEisKCmF0dHJpYnV0ZXMYAiABKAsyFy5nb29nbGUucHJvdG9idWYuU3RydWN0IqEBChRFc3RpbWF0ZUNvc3RSZXNwb25zZRIQCghjdXJyZW5jeRgBIAEoCRIUCgxjb3N0X21vbnRobHkYAiABKAESOwoQcHJpY2luZ19jYXRlZ29yeRgDIAEoDjIhLmZpbmZvY3VzLnYxLkZvY3VzUHJpY2luZ0NhdGVnb3J5EiQKHHNwb3RfaW50ZXJydXB0aW9uX3Jpc2tfc2NvcmUYBCABKAEiogIKGUdldFJlY29tbWVuZGF0aW9uc1JlcXVlc3QSMQoGZmlsdGVyGAEgASgLMiEuZmluZm9jdXMudjEuUmVjb21tZW5kYXRpb25GaWx0ZXISGQoRcHJvamVjdGlvbl9wZXJpb2QYAiABKAkSEQoJcGFnZV9zaXplGAMgASgFEhIKCnBhZ2VfdG9rZW4YBCABKAkSIwobZXhjbHVkZWRfcmVjb21tZW5kYXRpb25faWRzGAUgAygJEjkKEHRhcmdldF9yZXNvdXJjZXMYBiADKAsyHy5maW5mb2N1cy52MS5SZXNvdXJjZURlc2NyaXB0b3ISMAoNdXNhZ2VfcHJvZmlsZRgHIAEoDjIZLmZpbmZvY3VzLnYxLlVzYWdlUHJvZmlsZSKgAQoaR2V0UmVjb21tZW5kYXRpb25zUmVzcG9uc2USNAoPcmVjb21tZW5kYXRpb25zGAEgAygLMhsuZmluZm9jdXMudjEuUmVjb21tZW5kYXRpb24SMwoHc3VtbWFyeRgCIAEoCzIiLmZpbmZvY3VzLnYxLlJlY29tbWVuZGF0aW9uU3VtbWFyeRIXCg9uZXh0X3BhZ2VfdG9rZW4YAyABKAki2gQKFFJlY29tbWVuZGF0aW9uRmlsdGVyEhAKCHByb3ZpZGVyGAEgASgJEg4KBnJlZ2lvbhgCIAEoCRIVCg1yZXNvdXJjZV90eXBlGAMgASgJEjUKCGNhdGVnb3J5GAQgASgOMiMuZmluZm9jdXMudjEuUmVjb21tZW5kYXRpb25DYXRlZ29yeRI6CgthY3Rpb25fdHlwZRgFIAEoDjIlLmZpbmZvY3VzLnYxLlJlY29tbWVuZGF0aW9uQWN0aW9uVHlwZRILCgNza3UYBiABKAkSOQoEdGFncxgHIAMoCzIrLmZpbmZvY3VzLnYxLlJlY29tbWVuZGF0aW9uRmlsdGVyLlRhZ3NFbnRyeRI1Cghwcmlvcml0eRgIIAEoDjIjLmZpbmZvY3VzLnYxLlJlY29tbWVuZGF0aW9uUHJpb3JpdHkSHQoVbWluX2VzdGltYXRlZF9zYXZpbmdzGAkgASgBEg4KBnNvdXJjZRgKIAEoCRISCgphY2NvdW50X2lkGAsgASgJEjIKB3NvcnRfYnkYDCABKA4yIS5maW5mb2N1cy52MS5SZWNvbW1lbmRhdGlvblNvcnRCeRIqCgpzb3J0X29yZGVyGA0gASgOMhYuZmluZm9jdXMudjEuU29ydE9yZGVyEhwKFG1pbl9jb25maWRlbmNlX3Njb3JlGA4gASgBEhQKDG1heF9hZ2VfZGF5cxgPIAEoBRITCgtyZXNvdXJjZV9pZBgQIAEoCRorCglUYWdzRW50cnkSCwoDa2V5GAEgASgJEg0KBXZhbHVlGAIgASgJOgI4ASLZBwoOUmVjb21tZW5kYXRpb24SCgoCaWQYASABKAkSNQoIY2F0ZWdvcnkYAiABKA4yIy5maW5mb2N1cy52MS5SZWNvbW1lbmRhdGlvbkNhdGVnb3J5EjoKC2FjdGlvbl90eXBlGAMgASgOMiUuZmluZm9jdXMudjEuUmVjb21tZW5kYXRpb25BY3Rpb25UeXBlEjkKCHJlc291cmNlGAQgASgLMicuZmluZm9jdXMudjEuUmVzb3VyY2VSZWNvbW1lbmRhdGlvbkluZm8SMQoJcmlnaHRzaXplGAUgASgLMhwuZmluZm9jdXMudjEuUmlnaHRzaXplQWN0aW9uSAASMQoJdGVybWluYXRlGAYgASgLMhwuZmluZm9jdXMudjEuVGVybWluYXRlQWN0aW9uSAASMwoKY29tbWl0bWVudBgHIAEoCzIdLmZpbmZvY3VzLnYxLkNvbW1pdG1lbnRBY3Rpb25IABIzCgprdWJlcm5ldGVzGAggASgLMh0uZmluZm9jdXMudjEuS3ViZXJuZXRlc0FjdGlvbkgAEisKBm1vZGlmeRgJIAEoCzIZLmZpbmZvY3VzLnYxLk1vZGlmeUFjdGlvbkgAEjEKBmltcGFjdBgKIAEoCzIhLmZpbmZvY3VzLnYxLlJlY29tbWVuZGF0aW9uSW1wYWN0EjUKCHByaW9yaXR5GAsgASgOMiMuZmluZm9jdXMudjEuUmVjb21tZW5kYXRpb25Qcmlvcml0eRIdChBjb25maWRlbmNlX3Njb3JlGAwgASgBSAGIAQESEwoLZGVzY3JpcHRpb24YDSABKAkSEQoJcmVhc29uaW5nGA4gAygJEg4KBnNvdXJjZRgPIAEoCRIzCgpjcmVhdGVkX2F0GBAgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcEgCiAEBEjsKCG1ldGFkYXRhGBEgAygLMikuZmluZm9jdXMudjEuUmVjb21tZW5kYXRpb24uTWV0YWRhdGFFbnRyeRI5Cg5wcmltYXJ5X3JlYXNvbhgSIAEoDjIhLmZpbmZvY3VzLnYxLlJlY29tbWVuZGF0aW9uUmVhc29uEjwKEXNlY29uZGFyeV9yZWFzb25zGBMgAygOMiEuZmluZm9jdXMudjEuUmVjb21tZW5kYXRpb25SZWFzb24aLwoNTWV0YWRhdGFFbnRyeRILCgNrZXkYASABKAkSDQoFdmFsdWUYAiABKAk6AjgBQg8KDWFjdGlvbl9kZXRhaWxCEwoRX2NvbmZpZGVuY2Vfc2NvcmVCDQoLX2NyZWF0ZWRfYXQioQIKGlJlc291cmNlUmVjb21tZW5kYXRpb25JbmZvEgoKAmlkGAEgASgJEgwKBG5hbWUYAiABKAkSEAoIcHJvdmlkZXIYAyABKAkSFQoNcmVzb3VyY2VfdHlwZRgEIAEoCRIOCgZyZWdpb24YBSABKAkSCwoDc2t1GAYgASgJEj8KBHRhZ3MYByADKAsyMS5maW5mb2N1cy52MS5SZXNvdXJjZVJlY29tbWVuZGF0aW9uSW5mby5UYWdzRW50cnkSNQoLdXRpbGl6YXRpb24YCCABKAsyIC5maW5mb2N1cy52MS5SZXNvdXJjZVV0aWxpemF0aW9uGisKCVRhZ3NFbnRyeRILCgNrZXkYASABKAkSDQoFdmFsdWUYAiABKAk6AjgBIpECChNSZXNvdXJjZVV0aWxpemF0aW9uEhMKC2NwdV9wZXJjZW50GAEgASgBEhYKDm1lbW9yeV9wZXJjZW50GAIgASgBEhcKD3N0b3JhZ2VfcGVyY2VudBgDIAEoARIXCg9uZXR3b3JrX2luX21icHMYBCABKAESGAoQbmV0d29ya19vdXRfbWJwcxgFIAEoARJLCg5jdXN0b21fbWV0cmljcxgGIAMoCzIzLmZpbmZvY3VzLnYxLlJlc291cmNlVXRpbGl6YXRpb24uQ3VzdG9tTWV0cmljc0VudHJ5GjQKEkN1c3RvbU1ldHJpY3NFbnRyeRILCgNrZXkYASABKAkSDQoFdmFsdWUYAiABKAE6AjgBIsIBCg9SaWdodHNpemVBY3Rpb24SEwoLY3VycmVudF9za3UYASABKAkSFwoPcmVjb21tZW5kZWRfc2t1GAIgASgJEh0KFWN1cnJlbnRfaW5zdGFuY2VfdHlwZRgDIAEoCRIhChlyZWNvbW1lbmRlZF9pbnN0YW5jZV90eXBlGAQgASgJEj8KFXByb2plY3RlZF91dGlsaXphdGlvbhgFIAEoCzIgLmZpbmZvY3VzLnYxLlJlc291cmNlVXRpbGl6YXRpb24iQAoPVGVybWluYXRlQWN0aW9uEhoKEnRlcm1pbmF0aW9uX3JlYXNvbhgBIAEoCRIRCglpZGxlX2RheXMYAiABKAUifgoQQ29tbWl0bWVudEFjdGlvbhIXCg9jb21taXRtZW50X3R5cGUYASABKAkSDAoEdGVybRgCIAEoCRIWCg5wYXltZW50X29wdGlvbhgDIAEoCRIcChRyZWNvbW1lbmRlZF9xdWFudGl0eRgEIAEoARINCgVzY29wZRgFIAEoCSKKAwoQS3ViZXJuZXRlc0FjdGlvbhISCgpjbHVzdGVyX2lkGAEgASgJEhEKCW5hbWVzcGFjZRgCIAEoCRIXCg9jb250cm9sbGVyX2tpbmQYAyABKAkSFwoPY29udHJvbGxlcl9uYW1lGAQgASgJEhYKDmNvbnRhaW5lcl9uYW1lGAUgASgJEjoKEGN1cnJlbnRfcmVxdWVzdHMYBiABKAsyIC5maW5mb2N1cy52MS5LdWJlcm5ldGVzUmVzb3VyY2VzEj4KFHJlY29tbWVuZGVkX3JlcXVlc3RzGAcgASgLMiAuZmluZm9jdXMudjEuS3ViZXJuZXRlc1Jlc291cmNlcxI4Cg5jdXJyZW50X2xpbWl0cxgIIAEoCzIgLmZpbmZvY3VzLnYxLkt1YmVybmV0ZXNSZXNvdXJjZXMSPAoScmVjb21tZW5kZWRfbGltaXRzGAkgASgLMiAuZmluZm9jdXMudjEuS3ViZXJuZXRlc1Jlc291cmNlcxIRCglhbGdvcml0aG0YCiABKAkiMgoTS3ViZXJuZXRlc1Jlc291cmNlcxILCgNjcHUYASABKAkSDgoGbWVtb3J5GAIgASgJIq0CCgxNb2RpZnlBY3Rpb24SGQoRbW9kaWZpY2F0aW9uX3R5cGUYASABKAkSRAoOY3VycmVudF9jb25maWcYAiADKAsyLC5maW5mb2N1cy52MS5Nb2RpZnlBY3Rpb24uQ3VycmVudENvbmZpZ0VudHJ5EkwKEnJlY29tbWVuZGVkX2NvbmZpZxgDIAMoCzIwLmZpbmZvY3VzLnYxLk1vZGlmeUFjdGlvbi5SZWNvbW1lbmRlZENvbmZpZ0VudHJ5GjQKEkN1cnJlbnRDb25maWdFbnRyeRILCgNrZXkYASABKAkSDQoFdmFsdWUYAiABKAk6AjgBGjgKFlJlY29tbWVuZGVkQ29uZmlnRW50cnkSCwoDa2V5GAEgASgJEg0KBXZhbHVlGAIgASgJOgI4ASKiAgoUUmVjb21tZW5kYXRpb25JbXBhY3QSGQoRZXN0aW1hdGVkX3NhdmluZ3MYASABKAESEAoIY3VycmVuY3kYAiABKAkSGQoRcHJvamVjdGlvbl9wZXJpb2QYAyABKAkSFAoMY3VycmVudF9jb3N0GAQgASgBEhYKDnByb2plY3RlZF9jb3N0GAUgASgBEhoKEnNhdmluZ3NfcGVyY2VudGFnZRgGIAEoARIgChNpbXBsZW1lbnRhdGlvbl9jb3N0GAcgASgBSACIAQESIwoWbWlncmF0aW9uX2VmZm9ydF9ob3VycxgIIAEoAUgBiAEBQhYKFF9pbXBsZW1lbnRhdGlvbl9jb3N0QhkKF19taWdyYXRpb25fZWZmb3J0X2hvdXJzIs4FChVSZWNvbW1lbmRhdGlvblN1bW1hcnkSHQoVdG90YWxfcmVjb21tZW5kYXRpb25zGAEgASgFEh8KF3RvdGFsX2VzdGltYXRlZF9zYXZpbmdzGAIgASgBEhAKCGN1cnJlbmN5GAMgASgJEhkKEXByb2plY3Rpb25fcGVyaW9kGAQgASgJElIKEWNvdW50X2J5X2NhdGVnb3J5GAUgAygLMjcuZmluZm9jdXMudjEuUmVjb21tZW5kYXRpb25TdW1tYXJ5LkNvdW50QnlDYXRlZ29yeUVudHJ5ElYKE3NhdmluZ3NfYnlfY2F0ZWdvcnkYBiADKAsyOS5maW5mb2N1cy52MS5SZWNvbW1lbmRhdGlvblN1bW1hcnkuU2F2aW5nc0J5Q2F0ZWdvcnlFbnRyeRJXChRjb3VudF9ieV9hY3Rpb25fdHlwZRgHIAMoCzI5LmZpbmZvY3VzLnYxLlJlY29tbWVuZGF0aW9uU3VtbWFyeS5Db3VudEJ5QWN0aW9uVHlwZUVudHJ5ElsKFnNhdmluZ3NfYnlfYWN0aW9uX3R5cGUYCCADKAsyOy5maW5mb2N1cy52MS5SZWNvbW1lbmRhdGlvblN1bW1hcnkuU2F2aW5nc0J5QWN0aW9uVHlwZUVudHJ5GjYKFENvdW50QnlDYXRlZ29yeUVudHJ5EgsKA2tleRgBIAEoCRINCgV2YWx1ZRgCIAEoBToCOAEaOAoWU2F2aW5nc0J5Q2F0ZWdvcnlFbnRyeRILCgNrZXkYASABKAkSDQoFdmFsdWUYAiABKAE6AjgBGjgKFkNvdW50QnlBY3Rpb25UeXBlRW50cnkSCwoDa2V5GAEgASgJEg0KBXZhbHVlGAIgASgFOgI4ARo6ChhTYXZpbmdzQnlBY3Rpb25UeXBlRW50cnkSCwoDa2V5GAEgASgJEg0KBXZhbHVlGAIgASgBOgI4ASLYAQocRGlzbWlzc1JlY29tbWVuZGF0aW9uUmVxdWVzdBIZChFyZWNvbW1lbmRhdGlvbl9pZBgBIAEoCRIsCgZyZWFzb24YAiABKA4yHC5maW5mb2N1cy52MS5EaXNtaXNzYWxSZWFzb24SFQoNY3VzdG9tX3JlYXNvbhgDIAEoCRIzCgpleHBpcmVzX2F0GAQgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcEgAiAEBEhQKDGRpc21pc3NlZF9ieRgFIAEoCUINCgtfZXhwaXJlc19hdCLSAQodRGlzbWlzc1JlY29tbWVuZGF0aW9uUmVzcG9uc2USDwoHc3VjY2VzcxgBIAEoCBIPCgdtZXNzYWdlGAIgASgJEjAKDGRpc21pc3NlZF9hdBgDIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASMwoKZXhwaXJlc19hdBgEIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXBIAIgBARIZChFyZWNvbW1lbmRhdGlvbl9pZBgFIAEoCUINCgtfZXhwaXJlc19hdCIWChRHZXRQbHVnaW5JbmZvUmVxdWVzdCKJAgoVR2V0UGx1Z2luSW5mb1Jlc3BvbnNlEgwKBG5hbWUYASABKAkSDwoHdmVyc2lvbhgCIAEoCRIUCgxzcGVjX3ZlcnNpb24YAyABKAkSEQoJcHJvdmlkZXJzGAQgAygJEkIKCG1ldGFkYXRhGAUgAygLMjAuZmluZm9jdXMudjEuR2V0UGx1Z2luSW5mb1Jlc3BvbnNlLk1ldGFkYXRhRW50cnkSMwoMY2FwYWJpbGl0aWVzGAYgAygOMh0uZmluZm9jdXMudjEuUGx1Z2luQ2FwYWJpbGl0eRovCg1NZXRhZGF0YUVudHJ5EgsKA2tleRgBIAEoCRINCgV2YWx1ZRgCIAEoCToCOAEikQEKDEZpZWxkTWFwcGluZxISCgpmaWVsZF9uYW1lGAEgASgJEjcKDnN1cHBvcnRfc3RhdHVzGAIgASgOMh8uZmluZm9jdXMudjEuRmllbGRTdXBwb3J0U3RhdHVzEh0KFWNvbmRpdGlvbl9kZXNjcmlwdGlvbhgDIAEoCRIVCg1leHBlY3RlZF90eXBlGAQgASgJItQBCg1EcnlSdW5SZXF1ZXN0EjEKCHJlc291cmNlGAEgASgLMh8uZmluZm9jdXMudjEuUmVzb3VyY2VEZXNjcmlwdG9yElMKFXNpbXVsYXRpb25fcGFyYW1ldGVycxgCIAMoCzI0LmZpbmZvY3VzLnYxLkRyeVJ1blJlcXVlc3QuU2ltdWxhdGlvblBhcmFtZXRlcnNFbnRyeRo7ChlTaW11bGF0aW9uUGFyYW1ldGVyc0VudHJ5EgsKA2tleRgBIAEoCRINCgV2YWx1ZRgCIAEoCToCOAEinwEKDkRyeVJ1blJlc3BvbnNlEjEKDmZpZWxkX21hcHBpbmdzGAEgAygLMhkuZmluZm9jdXMudjEuRmllbGRNYXBwaW5nEhsKE2NvbmZpZ3VyYXRpb25fdmFsaWQYAiABKAgSHAoUY29uZmlndXJhdGlvbl9lcnJvcnMYAyADKAkSHwoXcmVzb3VyY2VfdHlwZV9zdXBwb3J0ZWQYBCABKAgqjAEKCk1ldHJpY0tpbmQSGwoXTUVUUklDX0tJTkRfVU5TUEVDSUZJRUQQABIgChxNRVRSSUNfS0lORF9DQVJCT05fRk9PVFBSSU5UEAESIgoeTUVUUklDX0tJTkRfRU5FUkdZX0NPTlNVTVBUSU9OEAISGwoXTUVUUklDX0tJTkRfV0FURVJfVVNBR0UQAyqAAQoMRmFsbGJhY2tIaW50Eh0KGUZBTExCQUNLX0hJTlRfVU5TUEVDSUZJRUQQABIWChJGQUxMQkFDS19ISU5UX05PTkUQARIdChlGQUxMQkFDS19ISU5UX1JFQ09NTUVOREVEEAISGgoWRkFMTEJBQ0tfSElOVF9SRVFVSVJFRBADKo0BCg1FcnJvckNhdGVnb3J5Eh4KGkVSUk9SX0NBVEVHT1JZX1VOU1BFQ0lGSUVEEAASHAoYRVJST1JfQ0FURUdPUllfVFJBTlNJRU5UEAESHAoYRVJST1JfQ0FURUdPUllfUEVSTUFORU5UEAISIAocRVJST1JfQ0FURUdPUllfQ09ORklHVVJBVElPThADKr8ECglFcnJvckNvZGUSGgoWRVJST1JfQ09ERV9VTlNQRUNJRklFRBAAEh4KGkVSUk9SX0NPREVfTkVUV09SS19USU1FT1VUEAESIgoeRVJST1JfQ09ERV9TRVJWSUNFX1VOQVZBSUxBQkxFEAISGwoXRVJST1JfQ09ERV9SQVRFX0xJTUlURUQQAxIgChxFUlJPUl9DT0RFX1RFTVBPUkFSWV9GQUlMVVJFEAQSGwoXRVJST1JfQ09ERV9DSVJDVUlUX09QRU4QBRIfChtFUlJPUl9DT0RFX0lOVkFMSURfUkVTT1VSQ0UQBhIhCh1FUlJPUl9DT0RFX1JFU09VUkNFX05PVF9GT1VORBAHEiEKHUVSUk9SX0NPREVfSU5WQUxJRF9USU1FX1JBTkdFEAgSIQodRVJST1JfQ09ERV9VTlNVUFBPUlRFRF9SRUdJT04QCRIgChxFUlJPUl9DT0RFX1BFUk1JU1NJT05fREVOSUVEEAoSHgoaRVJST1JfQ09ERV9EQVRBX0NPUlJVUFRJT04QCxIiCh5FUlJPUl9DT0RFX0lOVkFMSURfQ1JFREVOVElBTFMQDBIeChpFUlJPUl9DT0RFX01JU1NJTkdfQVBJX0tFWRANEh8KG0VSUk9SX0NPREVfSU5WQUxJRF9FTkRQT0lOVBAOEh8KG0VSUk9SX0NPREVfSU5WQUxJRF9QUk9WSURFUhAPEiQKIEVSUk9SX0NPREVfUExVR0lOX05PVF9DT05GSUdVUkVEEBAqjQEKCk1ldHJpY1R5cGUSGwoXTUVUUklDX1RZUEVfVU5TUEVDSUZJRUQQABIXChNNRVRSSUNfVFlQRV9DT1VOVEVSEAESFQoRTUVUUklDX1RZUEVfR0FVR0UQAhIZChVNRVRSSUNfVFlQRV9ISVNUT0dSQU0QAxIXChNNRVRSSUNfVFlQRV9TVU1NQVJZEAQqdwoJU0xJU3RhdHVzEhoKFlNMSV9TVEFUVVNfVU5TUEVDSUZJRUQQABIdChlTTElfU1RBVFVTX01FRVRJTkdfVEFSR0VUEAESFgoSU0xJX1NUQVRVU19XQVJOSU5HEAISFwoTU0xJX1NUQVRVU19DUklUSUNBTBADKoACChZSZWNvbW1lbmRhdGlvbkNhdGVnb3J5EicKI1JFQ09NTUVOREFUSU9OX0NBVEVHT1JZX1VOU1BFQ0lGSUVEEAASIAocUkVDT01NRU5EQVRJT05fQ0FURUdPUllfQ09TVBABEicKI1JFQ09NTUVOREFUSU9OX0NBVEVHT1JZX1BFUkZPUk1BTkNFEAISJAogUkVDT01NRU5EQVRJT05fQ0FURUdPUllfU0VDVVJJVFkQAxInCiNSRUNPTU1FTkRBVElPTl9DQVRFR09SWV9SRUxJQUJJTElUWRAEEiMKH1JFQ09NTUVOREFUSU9OX0NBVEVHT1JZX0FOT01BTFkQBSrLBAoYUmVjb21tZW5kYXRpb25BY3Rpb25UeXBlEioKJlJFQ09NTUVOREFUSU9OX0FDVElPTl9UWVBFX1VOU1BFQ0lGSUVEEAASKAokUkVDT01NRU5EQVRJT05fQUNUSU9OX1RZUEVfUklHSFRTSVpFEAESKAokUkVDT01NRU5EQVRJT05fQUNUSU9OX1RZUEVfVEVSTUlOQVRFEAISMgouUkVDT01NRU5EQVRJT05fQUNUSU9OX1RZUEVfUFVSQ0hBU0VfQ09NTUlUTUVOVBADEi4KKlJFQ09NTUVOREFUSU9OX0FDVElPTl9UWVBFX0FESlVTVF9SRVFVRVNUUxAEEiUKIVJFQ09NTUVOREFUSU9OX0FDVElPTl9UWVBFX01PRElGWRAFEiwKKFJFQ09NTUVOREFUSU9OX0FDVElPTl9UWVBFX0RFTEVURV9VTlVTRUQQBhImCiJSRUNPTU1FTkRBVElPTl9BQ1RJT05fVFlQRV9NSUdSQVRFEAcSKgomUkVDT01NRU5EQVRJT05fQUNUSU9OX1RZUEVfQ09OU09MSURBVEUQCBInCiNSRUNPTU1FTkRBVElPTl9BQ1RJT05fVFlQRV9TQ0hFRFVMRRAJEicKI1JFQ09NTUVOREFUSU9OX0FDVElPTl9UWVBFX1JFRkFDVE9SEAoSJAogUkVDT01NRU5EQVRJT05fQUNUSU9OX1RZUEVfT1RIRVIQCxIqCiZSRUNPTU1FTkRBVElPTl9BQ1RJT05fVFlQRV9JTlZFU1RJR0FURRAMKs4BChZSZWNvbW1lbmRhdGlvblByaW9yaXR5EicKI1JFQ09NTUVOREFUSU9OX1BSSU9SSVRZX1VOU1BFQ0lGSUVEEAASHwobUkVDT01NRU5EQVRJT05fUFJJT1JJVFlfTE9XEAESIgoeUkVDT01NRU5EQVRJT05fUFJJT1JJVFlfTUVESVVNEAISIAocUkVDT01NRU5EQVRJT05fUFJJT1JJVFlfSElHSBADEiQKIFJFQ09NTUVOREFUSU9OX1BSSU9SSVRZX0NSSVRJQ0FMEAQq3wEKFFJlY29tbWVuZGF0aW9uU29ydEJ5EiYKIlJFQ09NTUVOREFUSU9OX1NPUlRfQllfVU5TUEVDSUZJRUQQABIsCihSRUNPTU1FTkRBVElPTl9TT1JUX0JZX0VTVElNQVRFRF9TQVZJTkdTEAESIwofUkVDT01NRU5EQVRJT05fU09SVF9CWV9QUklPUklUWRACEiUKIVJFQ09NTUVOREFUSU9OX1NPUlRfQllfQ1JFQVRFRF9BVBADEiUKIVJFQ09NTUVOREFUSU9OX1NPUlRfQllfQ09ORklERU5DRRAEKlAKCVNvcnRPcmRlchIaChZTT1JUX09SREVSX1VOU1BFQ0lGSUVEEAASEgoOU09SVF9PUkRFUl9BU0MQARITCg9TT1JUX09SREVSX0RFU0MQAiqzAgoPRGlzbWlzc2FsUmVhc29uEiAKHERJU01JU1NBTF9SRUFTT05fVU5TUEVDSUZJRUQQABIjCh9ESVNNSVNTQUxfUkVBU09OX05PVF9BUFBMSUNBQkxFEAESKAokRElTTUlTU0FMX1JFQVNPTl9BTFJFQURZX0lNUExFTUVOVEVEEAISKAokRElTTUlTU0FMX1JFQVNPTl9CVVNJTkVTU19DT05TVFJBSU5UEAMSKQolRElTTUlTU0FMX1JFQVNPTl9URUNITklDQUxfQ09OU1RSQUlOVBAEEh0KGURJU01JU1NBTF9SRUFTT05fREVGRVJSRUQQBRIfChtESVNNSVNTQUxfUkVBU09OX0lOQUNDVVJBVEUQBhIaChZESVNNSVNTQUxfUkVBU09OX09USEVSEAcywwcKEUNvc3RTb3VyY2VTZXJ2aWNlEjsKBE5hbWUSGC5maW5mb2N1cy52MS5OYW1lUmVxdWVzdBoZLmZpbmZvY3VzLnYxLk5hbWVSZXNwb25zZRJHCghTdXBwb3J0cxIcLmZpbmZvY3VzLnYxLlN1cHBvcnRzUmVxdWVzdBodLmZpbmZvY3VzLnYxLlN1cHBvcnRzUmVzcG9uc2USVgoNR2V0QWN0dWFsQ29zdBIhLmZpbmZvY3VzLnYxLkdldEFjdHVhbENvc3RSZXF1ZXN0GiIuZmluZm9jdXMudjEuR2V0QWN0dWFsQ29zdFJlc3BvbnNlEl8KEEdldFByb2plY3RlZENvc3QSJC5maW5mb2N1cy52MS5HZXRQcm9qZWN0ZWRDb3N0UmVxdWVzdBolLmZpbmZvY3VzLnYxLkdldFByb2plY3RlZENvc3RSZXNwb25zZRJZCg5HZXRQcmljaW5nU3BlYxIiLmZpbmZvY3VzLnYxLkdldFByaWNpbmdTcGVjUmVxdWVzdBojLmZpbmZvY3VzLnYxLkdldFByaWNpbmdTcGVjUmVzcG9uc2USUwoMRXN0aW1hdGVDb3N0EiAuZmluZm9jdXMudjEuRXN0aW1hdGVDb3N0UmVxdWVzdBohLmZpbmZvY3VzLnYxLkVzdGltYXRlQ29zdFJlc3BvbnNlEmUKEkdldFJlY29tbWVuZGF0aW9ucxImLmZpbmZvY3VzLnYxLkdldFJlY29tbWVuZGF0aW9uc1JlcXVlc3QaJy5maW5mb2N1cy52MS5HZXRSZWNvbW1lbmRhdGlvbnNSZXNwb25zZRJuChVEaXNtaXNzUmVjb21tZW5kYXRpb24SKS5maW5mb2N1cy52MS5EaXNtaXNzUmVjb21tZW5kYXRpb25SZXF1ZXN0GiouZmluZm9jdXMudjEuRGlzbWlzc1JlY29tbWVuZGF0aW9uUmVzcG9uc2USTQoKR2V0QnVkZ2V0cxIeLmZpbmZvY3VzLnYxLkdldEJ1ZGdldHNSZXF1ZXN0Gh8uZmluZm9jdXMudjEuR2V0QnVkZ2V0c1Jlc3BvbnNlElYKDUdldFBsdWdpbkluZm8SIS5maW5mb2N1cy52MS5HZXRQbHVnaW5JbmZvUmVxdWVzdBoiLmZpbmZvY3VzLnYxLkdldFBsdWdpbkluZm9SZXNwb25zZRJBCgZEcnlSdW4SGi5maW5mb2N1cy52MS5EcnlSdW5SZXF1ZXN0GhsuZmluZm9jdXMudjEuRHJ5UnVuUmVzcG9uc2UyswIKFE9ic2VydmFiaWxpdHlTZXJ2aWNlElAKC0hlYWx0aENoZWNrEh8uZmluZm9jdXMudjEuSGVhbHRoQ2hlY2tSZXF1ZXN0GiAuZmluZm9jdXMudjEuSGVhbHRoQ2hlY2tSZXNwb25zZRJNCgpHZXRNZXRyaWNzEh4uZmluZm9jdXMudjEuR2V0TWV0cmljc1JlcXVlc3QaHy5maW5mb2N1cy52MS5HZXRNZXRyaWNzUmVzcG9uc2USegoZR2V0U2VydmljZUxldmVsSW5kaWNhdG9ycxItLmZpbmZvY3VzLnYxLkdldFNlcnZpY2VMZXZlbEluZGljYXRvcnNSZXF1ZXN0Gi4uZmluZm9jdXMudjEuR2V0U2VydmljZUxldmVsSW5kaWNhdG9yc1Jlc3BvbnNlQq0BCg9jb20uZmluZm9jdXMudjFCD0Nvc3Rzb3VyY2VQcm90b1ABWjxnaXRodWIuY29tL3JzaGFkZS9maW5mb2N1cy1zcGVjL3Nkay9nby9wcm90by9maW5mb2N1cy92MTtwYmOiAgNGWFiqAgtGaW5mb2N1cy5WMcoCC0ZpbmZvY3VzXFYx4gIXRmluZm9jdXNcVjFcR1BCTWV0YWRhdGHqAgxGaW5mb2N1czo6VjFiBnByb3RvMw", [file_google_protobuf_timestamp, file_google_protobuf_struct, file_finfocus_v1_focus, file_finfocus_v1_budget, file_finfocus_v1_enums]);
+  fileDesc("ChxmaW5mb2N1cy92MS9jb3N0c291cmNlLnByb3RvEgtmaW5mb2N1cy52MSINCgtOYW1lUmVxdWVzdCIcCgxOYW1lUmVzcG9uc2USDAoEbmFtZRgBIAEoCSJSCgxJbXBhY3RNZXRyaWMSJQoEa2luZBgBIAEoDjIXLmZpbmZvY3VzLnYxLk1ldHJpY0tpbmQSDQoFdmFsdWUYAiABKAESDAoEdW5pdBgDIAEoCSJECg9TdXBwb3J0c1JlcXVlc3QSMQoIcmVzb3VyY2UYASABKAsyHy5maW5mb2N1cy52MS5SZXNvdXJjZURlc2NyaXB0b3IinwIKEFN1cHBvcnRzUmVzcG9uc2USEQoJc3VwcG9ydGVkGAEgASgIEg4KBnJlYXNvbhgCIAEoCRJFCgxjYXBhYmlsaXRpZXMYAyADKAsyLy5maW5mb2N1cy52MS5TdXBwb3J0c1Jlc3BvbnNlLkNhcGFiaWxpdGllc0VudHJ5EjIKEXN1cHBvcnRlZF9tZXRyaWNzGAQgAygOMhcuZmluZm9jdXMudjEuTWV0cmljS2luZBI4ChFjYXBhYmlsaXRpZXNfZW51bRgFIAMoDjIdLmZpbmZvY3VzLnYxLlBsdWdpbkNhcGFiaWxpdHkaMwoRQ2FwYWJpbGl0aWVzRW50cnkSCwoDa2V5GAEgASgJEg0KBXZhbHVlGAIgASgIOgI4ASKsAgoUR2V0QWN0dWFsQ29zdFJlcXVlc3QSEwoLcmVzb3VyY2VfaWQYASABKAkSKQoFc3RhcnQYAiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEicKA2VuZBgDIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASOQoEdGFncxgEIAMoCzIrLmZpbmZvY3VzLnYxLkdldEFjdHVhbENvc3RSZXF1ZXN0LlRhZ3NFbnRyeRILCgNhcm4YBSABKAkSDwoHZHJ5X3J1bhgGIAEoCBIRCglwYWdlX3NpemUYByABKAUSEgoKcGFnZV90b2tlbhgIIAEoCRorCglUYWdzRW50cnkSCwoDa2V5GAEgASgJEg0KBXZhbHVlGAIgASgJOgI4ASLcAQoVR2V0QWN0dWFsQ29zdFJlc3BvbnNlEi4KB3Jlc3VsdHMYASADKAsyHS5maW5mb2N1cy52MS5BY3R1YWxDb3N0UmVzdWx0EjAKDWZhbGxiYWNrX2hpbnQYAiABKA4yGS5maW5mb2N1cy52MS5GYWxsYmFja0hpbnQSMwoOZHJ5X3J1bl9yZXN1bHQYAyABKAsyGy5maW5mb2N1cy52MS5EcnlSdW5SZXNwb25zZRIXCg9uZXh0X3BhZ2VfdG9rZW4YBCABKAkSEwoLdG90YWxfY291bnQYBSABKAUihwIKF0dldFByb2plY3RlZENvc3RSZXF1ZXN0EjEKCHJlc291cmNlGAEgASgLMh8uZmluZm9jdXMudjEuUmVzb3VyY2VEZXNjcmlwdG9yEh4KFnV0aWxpemF0aW9uX3BlcmNlbnRhZ2UYAiABKAESLAoLZ3Jvd3RoX3R5cGUYAyABKA4yFy5maW5mb2N1cy52MS5Hcm93dGhUeXBlEhgKC2dyb3d0aF9yYXRlGAQgASgBSACIAQESDwoHZHJ5X3J1bhgFIAEoCBIwCg11c2FnZV9wcm9maWxlGAYgASgOMhkuZmluZm9jdXMudjEuVXNhZ2VQcm9maWxlQg4KDF9ncm93dGhfcmF0ZSKqBQoYR2V0UHJvamVjdGVkQ29zdFJlc3BvbnNlEhIKCnVuaXRfcHJpY2UYASABKAESEAoIY3VycmVuY3kYAiABKAkSFgoOY29zdF9wZXJfbW9udGgYAyABKAESFgoOYmlsbGluZ19kZXRhaWwYBCABKAkSMQoOaW1wYWN0X21ldHJpY3MYBSADKAsyGS5maW5mb2N1cy52MS5JbXBhY3RNZXRyaWMSLAoLZ3Jvd3RoX3R5cGUYBiABKA4yFy5maW5mb2N1cy52MS5Hcm93dGhUeXBlEjMKDmRyeV9ydW5fcmVzdWx0GAcgASgLMhsuZmluZm9jdXMudjEuRHJ5UnVuUmVzcG9uc2USOwoQcHJpY2luZ19jYXRlZ29yeRgIIAEoDjIhLmZpbmZvY3VzLnYxLkZvY3VzUHJpY2luZ0NhdGVnb3J5EiQKHHNwb3RfaW50ZXJydXB0aW9uX3Jpc2tfc2NvcmUYCSABKAESJgoZcHJlZGljdGlvbl9pbnRlcnZhbF9sb3dlchgKIAEoAUgAiAEBEiYKGXByZWRpY3Rpb25faW50ZXJ2YWxfdXBwZXIYCyABKAFIAYgBARIdChBjb25maWRlbmNlX2xldmVsGAwgASgBSAKIAQESFAoMYmlsbGluZ19tb2RlGA0gASgJEhUKDXJhdGVfcGVyX3VuaXQYDiABKAESDAoEdW5pdBgPIAEoCRITCgthc3N1bXB0aW9ucxgQIAMoCRIvCg1wcmljaW5nX3RpZXJzGBEgAygLMhguZmluZm9jdXMudjEuUHJpY2luZ1RpZXJCHAoaX3ByZWRpY3Rpb25faW50ZXJ2YWxfbG93ZXJCHAoaX3ByZWRpY3Rpb25faW50ZXJ2YWxfdXBwZXJCEwoRX2NvbmZpZGVuY2VfbGV2ZWwiSgoVR2V0UHJpY2luZ1NwZWNSZXF1ZXN0EjEKCHJlc291cmNlGAEgASgLMh8uZmluZm9jdXMudjEuUmVzb3VyY2VEZXNjcmlwdG9yIkAKFkdldFByaWNpbmdTcGVjUmVzcG9uc2USJgoEc3BlYxgBIAEoCzIYLmZpbmZvY3VzLnYxLlByaWNpbmdTcGVjIvECChJSZXNvdXJjZURlc2NyaXB0b3ISEAoIcHJvdmlkZXIYASABKAkSFQoNcmVzb3VyY2VfdHlwZRgCIAEoCRILCgNza3UYAyABKAkSDgoGcmVnaW9uGAQgASgJEjcKBHRhZ3MYBSADKAsyKS5maW5mb2N1cy52MS5SZXNvdXJjZURlc2NyaXB0b3IuVGFnc0VudHJ5EiMKFnV0aWxpemF0aW9uX3BlcmNlbnRhZ2UYBiABKAFIAIgBARIKCgJpZBgHIAEoCRILCgNhcm4YCCABKAkSLAoLZ3Jvd3RoX3R5cGUYCSABKA4yFy5maW5mb2N1cy52MS5Hcm93dGhUeXBlEhgKC2dyb3d0aF9yYXRlGAogASgBSAGIAQEaKwoJVGFnc0VudHJ5EgsKA2tleRgBIAEoCRINCgV2YWx1ZRgCIAEoCToCOAFCGQoXX3V0aWxpemF0aW9uX3BlcmNlbnRhZ2VCDgoMX2dyb3d0aF9yYXRlIvABChBBY3R1YWxDb3N0UmVzdWx0Ei0KCXRpbWVzdGFtcBgBIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASDAoEY29zdBgCIAEoARIUCgx1c2FnZV9hbW91bnQYAyABKAESEgoKdXNhZ2VfdW5pdBgEIAEoCRIOCgZzb3VyY2UYBSABKAkSMgoMZm9jdXNfcmVjb3JkGAYgASgLMhwuZmluZm9jdXMudjEuRm9jdXNDb3N0UmVjb3JkEjEKDmltcGFjdF9tZXRyaWNzGAcgAygLMhkuZmluZm9jdXMudjEuSW1wYWN0TWV0cmljIi8KD1VzYWdlTWV0cmljSGludBIOCgZtZXRyaWMYASABKAkSDAoEdW5pdBgCIAEoCSK9AwoLUHJpY2luZ1NwZWMSEAoIcHJvdmlkZXIYASABKAkSFQoNcmVzb3VyY2VfdHlwZRgCIAEoCRILCgNza3UYAyABKAkSDgoGcmVnaW9uGAQgASgJEhQKDGJpbGxpbmdfbW9kZRgFIAEoCRIVCg1yYXRlX3Blcl91bml0GAYgASgBEhAKCGN1cnJlbmN5GAcgASgJEhMKC2Rlc2NyaXB0aW9uGAggASgJEjIKDG1ldHJpY19oaW50cxgJIAMoCzIcLmZpbmZvY3VzLnYxLlVzYWdlTWV0cmljSGludBJFCg9wbHVnaW5fbWV0YWRhdGEYCiADKAsyLC5maW5mb2N1cy52MS5QcmljaW5nU3BlYy5QbHVnaW5NZXRhZGF0YUVudHJ5Eg4KBnNvdXJjZRgLIAEoCRIMCgR1bml0GAwgASgJEhMKC2Fzc3VtcHRpb25zGA0gAygJEi8KDXByaWNpbmdfdGllcnMYDiADKAsyGC5maW5mb2N1cy52MS5QcmljaW5nVGllcho1ChNQbHVnaW5NZXRhZGF0YUVudHJ5EgsKA2tleRgBIAEoCRINCgV2YWx1ZRgCIAEoCToCOAEiZQoLUHJpY2luZ1RpZXISFAoMbWluX3F1YW50aXR5GAEgASgBEhQKDG1heF9xdWFudGl0eRgCIAEoARIVCg1yYXRlX3Blcl91bml0GAMgASgBEhMKC2Rlc2NyaXB0aW9uGAQgASgJIsMCCgtFcnJvckRldGFpbBIkCgRjb2RlGAEgASgOMhYuZmluZm9jdXMudjEuRXJyb3JDb2RlEiwKCGNhdGVnb3J5GAIgASgOMhouZmluZm9jdXMudjEuRXJyb3JDYXRlZ29yeRIPCgdtZXNzYWdlGAMgASgJEjYKB2RldGFpbHMYBCADKAsyJS5maW5mb2N1cy52MS5FcnJvckRldGFpbC5EZXRhaWxzRW50cnkSIAoTcmV0cnlfYWZ0ZXJfc2Vjb25kcxgFIAEoBUgAiAEBEi0KCXRpbWVzdGFtcBgGIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXAaLgoMRGV0YWlsc0VudHJ5EgsKA2tleRgBIAEoCRINCgV2YWx1ZRgCIAEoCToCOAFCFgoUX3JldHJ5X2FmdGVyX3NlY29uZHMiKgoSSGVhbHRoQ2hlY2tSZXF1ZXN0EhQKDHNlcnZpY2VfbmFtZRgBIAEoCSL+AQoTSGVhbHRoQ2hlY2tSZXNwb25zZRI3CgZzdGF0dXMYASABKA4yJy5maW5mb2N1cy52MS5IZWFsdGhDaGVja1Jlc3BvbnNlLlN0YXR1cxIPCgdtZXNzYWdlGAIgASgJEjMKD2xhc3RfY2hlY2tfdGltZRgDIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXAiaAoGU3RhdHVzEhYKElNUQVRVU19VTlNQRUNJRklFRBAAEhIKDlNUQVRVU19TRVJWSU5HEAESFgoSU1RBVFVTX05PVF9TRVJWSU5HEAISGgoWU1RBVFVTX1NFUlZJQ0VfVU5LTk9XThADIjkKEUdldE1ldHJpY3NSZXF1ZXN0EhQKDG1ldHJpY19uYW1lcxgBIAMoCRIOCgZmb3JtYXQYAiABKAkieQoSR2V0TWV0cmljc1Jlc3BvbnNlEiQKB21ldHJpY3MYASADKAsyEy5maW5mb2N1cy52MS5NZXRyaWMSLQoJdGltZXN0YW1wGAIgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIOCgZmb3JtYXQYAyABKAkidwoGTWV0cmljEgwKBG5hbWUYASABKAkSDAoEaGVscBgCIAEoCRIlCgR0eXBlGAMgASgOMhcuZmluZm9jdXMudjEuTWV0cmljVHlwZRIqCgdzYW1wbGVzGAQgAygLMhkuZmluZm9jdXMudjEuTWV0cmljU2FtcGxlIrIBCgxNZXRyaWNTYW1wbGUSNQoGbGFiZWxzGAEgAygLMiUuZmluZm9jdXMudjEuTWV0cmljU2FtcGxlLkxhYmVsc0VudHJ5Eg0KBXZhbHVlGAIgASgBEi0KCXRpbWVzdGFtcBgDIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXAaLQoLTGFiZWxzRW50cnkSCwoDa2V5GAEgASgJEg0KBXZhbHVlGAIgASgJOgI4ASJhCiBHZXRTZXJ2aWNlTGV2ZWxJbmRpY2F0b3JzUmVxdWVzdBIqCgp0aW1lX3JhbmdlGAEgASgLMhYuZmluZm9jdXMudjEuVGltZVJhbmdlEhEKCXNsaV9uYW1lcxgCIAMoCSKLAQohR2V0U2VydmljZUxldmVsSW5kaWNhdG9yc1Jlc3BvbnNlEjAKBHNsaXMYASADKAsyIi5maW5mb2N1cy52MS5TZXJ2aWNlTGV2ZWxJbmRpY2F0b3ISNAoQbWVhc3VyZW1lbnRfdGltZRgCIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXAilQEKFVNlcnZpY2VMZXZlbEluZGljYXRvchIMCgRuYW1lGAEgASgJEhMKC2Rlc2NyaXB0aW9uGAIgASgJEg0KBXZhbHVlGAMgASgBEgwKBHVuaXQYBCABKAkSFAoMdGFyZ2V0X3ZhbHVlGAUgASgBEiYKBnN0YXR1cxgGIAEoDjIWLmZpbmZvY3VzLnYxLlNMSVN0YXR1cyJfCglUaW1lUmFuZ2USKQoFc3RhcnQYASABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEicKA2VuZBgCIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXAipQEKEVRlbGVtZXRyeU1ldGFkYXRhEhAKCHRyYWNlX2lkGAEgASgJEg8KB3NwYW5faWQYAiABKAkSEgoKcmVxdWVzdF9pZBgDIAEoCRIaChJwcm9jZXNzaW5nX3RpbWVfbXMYBCABKAMSEwoLZGF0YV9zb3VyY2UYBSABKAkSEQoJY2FjaGVfaGl0GAYgASgIEhUKDXF1YWxpdHlfc2NvcmUYByABKAEiowIKCExvZ0VudHJ5Ei0KCXRpbWVzdGFtcBgBIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASDQoFbGV2ZWwYAiABKAkSDwoHbWVzc2FnZRgDIAEoCRIRCgljb21wb25lbnQYBCABKAkSEAoIdHJhY2VfaWQYBSABKAkSDwoHc3Bhbl9pZBgGIAEoCRIxCgZmaWVsZHMYByADKAsyIS5maW5mb2N1cy52MS5Mb2dFbnRyeS5GaWVsZHNFbnRyeRIwCg1lcnJvcl9kZXRhaWxzGAggASgLMhkuZmluZm9jdXMudjEuRXJyb3JEZXRhaWxzGi0KC0ZpZWxkc0VudHJ5EgsKA2tleRgBIAEoCRINCgV2YWx1ZRgCIAEoCToCOAEihAEKDEVycm9yRGV0YWlscxISCgplcnJvcl9jb2RlGAEgASgJEhYKDmVycm9yX2NhdGVnb3J5GAIgASgJEhMKC3N0YWNrX3RyYWNlGAMgASgJEhsKE3JldHJ5X2FmdGVyX3NlY29uZHMYBCABKAUSFgoOY29ycmVsYXRpb25faWQYBSABKAkiWQoTRXN0aW1hdGVDb3N0UmVxdWVzdBIVCg1yZXNvdXJjZV90eXBlGAEgASgJEisKCmF0dHJpYnV0ZXMYAiABKAsyFy5nb29nbGUucHJvdG9idWYuU3RydWN0IrYBChRFc3RpbWF0ZUNvc3RSZXNwb25zZRIQCghjdXJyZW5jeRgBIAEoCRIUCgxjb3N0X21vbnRobHkYAiABKAESOwoQcHJpY2luZ19jYXRlZ29yeRgDIAEoDjIhLmZpbmZvY3VzLnYxLkZvY3VzUHJpY2luZ0NhdGVnb3J5EiQKHHNwb3RfaW50ZXJydXB0aW9uX3Jpc2tfc2NvcmUYBCABKAESEwoLZXhwbGFuYXRpb24YBSADKAkiogIKGUdldFJlY29tbWVuZGF0aW9uc1JlcXVlc3QSMQoGZmlsdGVyGAEgASgLMiEuZmluZm9jdXMudjEuUmVjb21tZW5kYXRpb25GaWx0ZXISGQoRcHJvamVjdGlvbl9wZXJpb2QYAiABKAkSEQoJcGFnZV9zaXplGAMgASgFEhIKCnBhZ2VfdG9rZW4YBCABKAkSIwobZXhjbHVkZWRfcmVjb21tZW5kYXRpb25faWRzGAUgAygJEjkKEHRhcmdldF9yZXNvdXJjZXMYBiADKAsyHy5maW5mb2N1cy52MS5SZXNvdXJjZURlc2NyaXB0b3ISMAoNdXNhZ2VfcHJvZmlsZRgHIAEoDjIZLmZpbmZvY3VzLnYxLlVzYWdlUHJvZmlsZSKgAQoaR2V0UmVjb21tZW5kYXRpb25zUmVzcG9uc2USNAoPcmVjb21tZW5kYXRpb25zGAEgAygLMhsuZmluZm9jdXMudjEuUmVjb21tZW5kYXRpb24SMwoHc3VtbWFyeRgCIAEoCzIiLmZpbmZvY3VzLnYxLlJlY29tbWVuZGF0aW9uU3VtbWFyeRIXCg9uZXh0X3BhZ2VfdG9rZW4YAyABKAki/gQKFFJlY29tbWVuZGF0aW9uRmlsdGVyEhAKCHByb3ZpZGVyGAEgASgJEg4KBnJlZ2lvbhgCIAEoCRIVCg1yZXNvdXJjZV90eXBlGAMgASgJEjUKCGNhdGVnb3J5GAQgASgOMiMuZmluZm9jdXMudjEuUmVjb21tZW5kYXRpb25DYXRlZ29yeRI6CgthY3Rpb25fdHlwZRgFIAEoDjIlLmZpbmZvY3VzLnYxLlJlY29tbWVuZGF0aW9uQWN0aW9uVHlwZRILCgNza3UYBiABKAkSOQoEdGFncxgHIAMoCzIrLmZpbmZvY3VzLnYxLlJlY29tbWVuZGF0aW9uRmlsdGVyLlRhZ3NFbnRyeRI1Cghwcmlvcml0eRgIIAEoDjIjLmZpbmZvY3VzLnYxLlJlY29tbWVuZGF0aW9uUHJpb3JpdHkSHQoVbWluX2VzdGltYXRlZF9zYXZpbmdzGAkgASgBEg4KBnNvdXJjZRgKIAEoCRISCgphY2NvdW50X2lkGAsgASgJEjIKB3NvcnRfYnkYDCABKA4yIS5maW5mb2N1cy52MS5SZWNvbW1lbmRhdGlvblNvcnRCeRIqCgpzb3J0X29yZGVyGA0gASgOMhYuZmluZm9jdXMudjEuU29ydE9yZGVyEhwKFG1pbl9jb25maWRlbmNlX3Njb3JlGA4gASgBEhQKDG1heF9hZ2VfZGF5cxgPIAEoBRITCgtyZXNvdXJjZV9pZBgQIAEoCRIiChpleGNsdWRlX3Vua25vd25fY29uZmlkZW5jZRgRIAEoCBorCglUYWdzRW50cnkSCwoDa2V5GAEgASgJEg0KBXZhbHVlGAIgASgJOgI4ASLZBwoOUmVjb21tZW5kYXRpb24SCgoCaWQYASABKAkSNQoIY2F0ZWdvcnkYAiABKA4yIy5maW5mb2N1cy52MS5SZWNvbW1lbmRhdGlvbkNhdGVnb3J5EjoKC2FjdGlvbl90eXBlGAMgASgOMiUuZmluZm9jdXMudjEuUmVjb21tZW5kYXRpb25BY3Rpb25UeXBlEjkKCHJlc291cmNlGAQgASgLMicuZmluZm9jdXMudjEuUmVzb3VyY2VSZWNvbW1lbmRhdGlvbkluZm8SMQoJcmlnaHRzaXplGAUgASgLMhwuZmluZm9jdXMudjEuUmlnaHRzaXplQWN0aW9uSAASMQoJdGVybWluYXRlGAYgASgLMhwuZmluZm9jdXMudjEuVGVybWluYXRlQWN0aW9uSAASMwoKY29tbWl0bWVudBgHIAEoCzIdLmZpbmZvY3VzLnYxLkNvbW1pdG1lbnRBY3Rpb25IABIzCgprdWJlcm5ldGVzGAggASgLMh0uZmluZm9jdXMudjEuS3ViZXJuZXRlc0FjdGlvbkgAEisKBm1vZGlmeRgJIAEoCzIZLmZpbmZvY3VzLnYxLk1vZGlmeUFjdGlvbkgAEjEKBmltcGFjdBgKIAEoCzIhLmZpbmZvY3VzLnYxLlJlY29tbWVuZGF0aW9uSW1wYWN0EjUKCHByaW9yaXR5GAsgASgOMiMuZmluZm9jdXMudjEuUmVjb21tZW5kYXRpb25Qcmlvcml0eRIdChBjb25maWRlbmNlX3Njb3JlGAwgASgBSAGIAQESEwoLZGVzY3JpcHRpb24YDSABKAkSEQoJcmVhc29uaW5nGA4gAygJEg4KBnNvdXJjZRgPIAEoCRIzCgpjcmVhdGVkX2F0GBAgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcEgCiAEBEjsKCG1ldGFkYXRhGBEgAygLMikuZmluZm9jdXMudjEuUmVjb21tZW5kYXRpb24uTWV0YWRhdGFFbnRyeRI5Cg5wcmltYXJ5X3JlYXNvbhgSIAEoDjIhLmZpbmZvY3VzLnYxLlJlY29tbWVuZGF0aW9uUmVhc29uEjwKEXNlY29uZGFyeV9yZWFzb25zGBMgAygOMiEuZmluZm9jdXMudjEuUmVjb21tZW5kYXRpb25SZWFzb24aLwoNTWV0YWRhdGFFbnRyeRILCgNrZXkYASABKAkSDQoFdmFsdWUYAiABKAk6AjgBQg8KDWFjdGlvbl9kZXRhaWxCEwoRX2NvbmZpZGVuY2Vfc2NvcmVCDQoLX2NyZWF0ZWRfYXQioQIKGlJlc291cmNlUmVjb21tZW5kYXRpb25JbmZvEgoKAmlkGAEgASgJEgwKBG5hbWUYAiABKAkSEAoIcHJvdmlkZXIYAyABKAkSFQoNcmVzb3VyY2VfdHlwZRgEIAEoCRIOCgZyZWdpb24YBSABKAkSCwoDc2t1GAYgASgJEj8KBHRhZ3MYByADKAsyMS5maW5mb2N1cy52MS5SZXNvdXJjZVJlY29tbWVuZGF0aW9uSW5mby5UYWdzRW50cnkSNQoLdXRpbGl6YXRpb24YCCABKAsyIC5maW5mb2N1cy52MS5SZXNvdXJjZVV0aWxpemF0aW9uGisKCVRhZ3NFbnRyeRILCgNrZXkYASABKAkSDQoFdmFsdWUYAiABKAk6AjgBIpECChNSZXNvdXJjZVV0aWxpemF0aW9uEhMKC2NwdV9wZXJjZW50GAEgASgBEhYKDm1lbW9yeV9wZXJjZW50GAIgASgBEhcKD3N0b3JhZ2VfcGVyY2VudBgDIAEoARIXCg9uZXR3b3JrX2luX21icHMYBCABKAESGAoQbmV0d29ya19vdXRfbWJwcxgFIAEoARJLCg5jdXN0b21fbWV0cmljcxgGIAMoCzIzLmZpbmZvY3VzLnYxLlJlc291cmNlVXRpbGl6YXRpb24uQ3VzdG9tTWV0cmljc0VudHJ5GjQKEkN1c3RvbU1ldHJpY3NFbnRyeRILCgNrZXkYASABKAkSDQoFdmFsdWUYAiABKAE6AjgBIsIBCg9SaWdodHNpemVBY3Rpb24SEwoLY3VycmVudF9za3UYASABKAkSFwoPcmVjb21tZW5kZWRfc2t1GAIgASgJEh0KFWN1cnJlbnRfaW5zdGFuY2VfdHlwZRgDIAEoCRIhChlyZWNvbW1lbmRlZF9pbnN0YW5jZV90eXBlGAQgASgJEj8KFXByb2plY3RlZF91dGlsaXphdGlvbhgFIAEoCzIgLmZpbmZvY3VzLnYxLlJlc291cmNlVXRpbGl6YXRpb24iQAoPVGVybWluYXRlQWN0aW9uEhoKEnRlcm1pbmF0aW9uX3JlYXNvbhgBIAEoCRIRCglpZGxlX2RheXMYAiABKAUifgoQQ29tbWl0bWVudEFjdGlvbhIXCg9jb21taXRtZW50X3R5cGUYASABKAkSDAoEdGVybRgCIAEoCRIWCg5wYXltZW50X29wdGlvbhgDIAEoCRIcChRyZWNvbW1lbmRlZF9xdWFudGl0eRgEIAEoARINCgVzY29wZRgFIAEoCSKKAwoQS3ViZXJuZXRlc0FjdGlvbhISCgpjbHVzdGVyX2lkGAEgASgJEhEKCW5hbWVzcGFjZRgCIAEoCRIXCg9jb250cm9sbGVyX2tpbmQYAyABKAkSFwoPY29udHJvbGxlcl9uYW1lGAQgASgJEhYKDmNvbnRhaW5lcl9uYW1lGAUgASgJEjoKEGN1cnJlbnRfcmVxdWVzdHMYBiABKAsyIC5maW5mb2N1cy52MS5LdWJlcm5ldGVzUmVzb3VyY2VzEj4KFHJlY29tbWVuZGVkX3JlcXVlc3RzGAcgASgLMiAuZmluZm9jdXMudjEuS3ViZXJuZXRlc1Jlc291cmNlcxI4Cg5jdXJyZW50X2xpbWl0cxgIIAEoCzIgLmZpbmZvY3VzLnYxLkt1YmVybmV0ZXNSZXNvdXJjZXMSPAoScmVjb21tZW5kZWRfbGltaXRzGAkgASgLMiAuZmluZm9jdXMudjEuS3ViZXJuZXRlc1Jlc291cmNlcxIRCglhbGdvcml0aG0YCiABKAkiMgoTS3ViZXJuZXRlc1Jlc291cmNlcxILCgNjcHUYASABKAkSDgoGbWVtb3J5GAIgASgJIq0CCgxNb2RpZnlBY3Rpb24SGQoRbW9kaWZpY2F0aW9uX3R5cGUYASABKAkSRAoOY3VycmVudF9jb25maWcYAiADKAsyLC5maW5mb2N1cy52MS5Nb2RpZnlBY3Rpb24uQ3VycmVudENvbmZpZ0VudHJ5EkwKEnJlY29tbWVuZGVkX2NvbmZpZxgDIAMoCzIwLmZpbmZvY3VzLnYxLk1vZGlmeUFjdGlvbi5SZWNvbW1lbmRlZENvbmZpZ0VudHJ5GjQKEkN1cnJlbnRDb25maWdFbnRyeRILCgNrZXkYASABKAkSDQoFdmFsdWUYAiABKAk6AjgBGjgKFlJlY29tbWVuZGVkQ29uZmlnRW50cnkSCwoDa2V5GAEgASgJEg0KBXZhbHVlGAIgASgJOgI4ASKiAgoUUmVjb21tZW5kYXRpb25JbXBhY3QSGQoRZXN0aW1hdGVkX3NhdmluZ3MYASABKAESEAoIY3VycmVuY3kYAiABKAkSGQoRcHJvamVjdGlvbl9wZXJpb2QYAyABKAkSFAoMY3VycmVudF9jb3N0GAQgASgBEhYKDnByb2plY3RlZF9jb3N0GAUgASgBEhoKEnNhdmluZ3NfcGVyY2VudGFnZRgGIAEoARIgChNpbXBsZW1lbnRhdGlvbl9jb3N0GAcgASgBSACIAQESIwoWbWlncmF0aW9uX2VmZm9ydF9ob3VycxgIIAEoAUgBiAEBQhYKFF9pbXBsZW1lbnRhdGlvbl9jb3N0QhkKF19taWdyYXRpb25fZWZmb3J0X2hvdXJzIs4FChVSZWNvbW1lbmRhdGlvblN1bW1hcnkSHQoVdG90YWxfcmVjb21tZW5kYXRpb25zGAEgASgFEh8KF3RvdGFsX2VzdGltYXRlZF9zYXZpbmdzGAIgASgBEhAKCGN1cnJlbmN5GAMgASgJEhkKEXByb2plY3Rpb25fcGVyaW9kGAQgASgJElIKEWNvdW50X2J5X2NhdGVnb3J5GAUgAygLMjcuZmluZm9jdXMudjEuUmVjb21tZW5kYXRpb25TdW1tYXJ5LkNvdW50QnlDYXRlZ29yeUVudHJ5ElYKE3NhdmluZ3NfYnlfY2F0ZWdvcnkYBiADKAsyOS5maW5mb2N1cy52MS5SZWNvbW1lbmRhdGlvblN1bW1hcnkuU2F2aW5nc0J5Q2F0ZWdvcnlFbnRyeRJXChRjb3VudF9ieV9hY3Rpb25fdHlwZRgHIAMoCzI5LmZpbmZvY3VzLnYxLlJlY29tbWVuZGF0aW9uU3VtbWFyeS5Db3VudEJ5QWN0aW9uVHlwZUVudHJ5ElsKFnNhdmluZ3NfYnlfYWN0aW9uX3R5cGUYCCADKAsyOy5maW5mb2N1cy52MS5SZWNvbW1lbmRhdGlvblN1bW1hcnkuU2F2aW5nc0J5QWN0aW9uVHlwZUVudHJ5GjYKFENvdW50QnlDYXRlZ29yeUVudHJ5EgsKA2tleRgBIAEoCRINCgV2YWx1ZRgCIAEoBToCOAEaOAoWU2F2aW5nc0J5Q2F0ZWdvcnlFbnRyeRILCgNrZXkYASABKAkSDQoFdmFsdWUYAiABKAE6AjgBGjgKFkNvdW50QnlBY3Rpb25UeXBlRW50cnkSCwoDa2V5GAEgASgJEg0KBXZhbHVlGAIgASgFOgI4ARo6ChhTYXZpbmdzQnlBY3Rpb25UeXBlRW50cnkSCwoDa2V5GAEgASgJEg0KBXZhbHVlGAIgASgBOgI4ASLYAQocRGlzbWlzc1JlY29tbWVuZGF0aW9uUmVxdWVzdBIZChFyZWNvbW1lbmRhdGlvbl9pZBgBIAEoCRIsCgZyZWFzb24YAiABKA4yHC5maW5mb2N1cy52MS5EaXNtaXNzYWxSZWFzb24SFQoNY3VzdG9tX3JlYXNvbhgDIAEoCRIzCgpleHBpcmVzX2F0GAQgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcEgAiAEBEhQKDGRpc21pc3NlZF9ieRgFIAEoCUINCgtfZXhwaXJlc19hdCLSAQodRGlzbWlzc1JlY29tbWVuZGF0aW9uUmVzcG9uc2USDwoHc3VjY2VzcxgBIAEoCBIPCgdtZXNzYWdlGAIgASgJEjAKDGRpc21pc3NlZF9hdBgDIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASMwoKZXhwaXJlc19hdBgEIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXBIAIgBARIZChFyZWNvbW1lbmRhdGlvbl9pZBgFIAEoCUINCgtfZXhwaXJlc19hdCIWChRHZXRQbHVnaW5JbmZvUmVxdWVzdCKJAgoVR2V0UGx1Z2luSW5mb1Jlc3BvbnNlEgwKBG5hbWUYASABKAkSDwoHdmVyc2lvbhgCIAEoCRIUCgxzcGVjX3ZlcnNpb24YAyABKAkSEQoJcHJvdmlkZXJzGAQgAygJEkIKCG1ldGFkYXRhGAUgAygLMjAuZmluZm9jdXMudjEuR2V0UGx1Z2luSW5mb1Jlc3BvbnNlLk1ldGFkYXRhRW50cnkSMwoMY2FwYWJpbGl0aWVzGAYgAygOMh0uZmluZm9jdXMudjEuUGx1Z2luQ2FwYWJpbGl0eRovCg1NZXRhZGF0YUVudHJ5EgsKA2tleRgBIAEoCRINCgV2YWx1ZRgCIAEoCToCOAEikQEKDEZpZWxkTWFwcGluZxISCgpmaWVsZF9uYW1lGAEgASgJEjcKDnN1cHBvcnRfc3RhdHVzGAIgASgOMh8uZmluZm9jdXMudjEuRmllbGRTdXBwb3J0U3RhdHVzEh0KFWNvbmRpdGlvbl9kZXNjcmlwdGlvbhgDIAEoCRIVCg1leHBlY3RlZF90eXBlGAQgASgJItQBCg1EcnlSdW5SZXF1ZXN0EjEKCHJlc291cmNlGAEgASgLMh8uZmluZm9jdXMudjEuUmVzb3VyY2VEZXNjcmlwdG9yElMKFXNpbXVsYXRpb25fcGFyYW1ldGVycxgCIAMoCzI0LmZpbmZvY3VzLnYxLkRyeVJ1blJlcXVlc3QuU2ltdWxhdGlvblBhcmFtZXRlcnNFbnRyeRo7ChlTaW11bGF0aW9uUGFyYW1ldGVyc0VudHJ5EgsKA2tleRgBIAEoCRINCgV2YWx1ZRgCIAEoCToCOAEinwEKDkRyeVJ1blJlc3BvbnNlEjEKDmZpZWxkX21hcHBpbmdzGAEgAygLMhkuZmluZm9jdXMudjEuRmllbGRNYXBwaW5nEhsKE2NvbmZpZ3VyYXRpb25fdmFsaWQYAiABKAgSHAoUY29uZmlndXJhdGlvbl9lcnJvcnMYAyADKAkSHwoXcmVzb3VyY2VfdHlwZV9zdXBwb3J0ZWQYBCABKAgioQEKGUJhdGNoUHJvamVjdGVkQ29zdFJlcXVlc3QSMgoJcmVzb3VyY2VzGAEgAygLMh8uZmluZm9jdXMudjEuUmVzb3VyY2VEZXNjcmlwdG9yEh4KFnV0aWxpemF0aW9uX3BlcmNlbnRhZ2UYAiABKAESMAoNdXNhZ2VfcHJvZmlsZRgDIAEoDjIZLmZpbmZvY3VzLnYxLlVzYWdlUHJvZmlsZSJUChpCYXRjaFByb2plY3RlZENvc3RSZXNwb25zZRI2CgdyZXN1bHRzGAEgAygLMiUuZmluZm9jdXMudjEuQmF0Y2hQcm9qZWN0ZWRDb3N0UmVzdWx0InwKGEJhdGNoUHJvamVjdGVkQ29zdFJlc3VsdBI3CghyZXNwb25zZRgBIAEoCzIlLmZpbmZvY3VzLnYxLkdldFByb2plY3RlZENvc3RSZXNwb25zZRInCgVlcnJvchgCIAEoCzIYLmZpbmZvY3VzLnYxLkVycm9yRGV0YWlsIh4KHEdldFN1cHBvcnRlZFJlc291cmNlc1JlcXVlc3QiTwoaU3VwcG9ydGVkUmVzb3VyY2VzUmVzcG9uc2USMQoJcmVzb3VyY2VzGAEgAygLMh4uZmluZm9jdXMudjEuU3VwcG9ydGVkUmVzb3VyY2UiUgoRU3VwcG9ydGVkUmVzb3VyY2USEAoIcHJvdmlkZXIYASABKAkSFQoNcmVzb3VyY2VfdHlwZRgCIAEoCRIUCgxleGFtcGxlX3NrdXMYAyADKAkiSgoUU3VwcG9ydHNCYXRjaFJlcXVlc3QSMgoJcmVzb3VyY2VzGAEgAygLMh8uZmluZm9jdXMudjEuUmVzb3VyY2VEZXNjcmlwdG9yIkoKFVN1cHBvcnRzQmF0Y2hSZXNwb25zZRIxCgdyZXN1bHRzGAEgAygLMiAuZmluZm9jdXMudjEuU3VwcG9ydHNCYXRjaFJlc3VsdCI4ChNTdXBwb3J0c0JhdGNoUmVzdWx0EhEKCXN1cHBvcnRlZBgBIAEoCBIOCgZyZWFzb24YAiABKAkqjAEKCk1ldHJpY0tpbmQSGwoXTUVUUklDX0tJTkRfVU5TUEVDSUZJRUQQABIgChxNRVRSSUNfS0lORF9DQVJCT05fRk9PVFBSSU5UEAESIgoeTUVUUklDX0tJTkRfRU5FUkdZX0NPTlNVTVBUSU9OEAISGwoXTUVUUklDX0tJTkRfV0FURVJfVVNBR0UQAyqAAQoMRmFsbGJhY2tIaW50Eh0KGUZBTExCQUNLX0hJTlRfVU5TUEVDSUZJRUQQABIWChJGQUxMQkFDS19ISU5UX05PTkUQARIdChlGQUxMQkFDS19ISU5UX1JFQ09NTUVOREVEEAISGgoWRkFMTEJBQ0tfSElOVF9SRVFVSVJFRBADKo0BCg1FcnJvckNhdGVnb3J5Eh4KGkVSUk9SX0NBVEVHT1JZX1VOU1BFQ0lGSUVEEAASHAoYRVJST1JfQ0FURUdPUllfVFJBTlNJRU5UEAESHAoYRVJST1JfQ0FURUdPUllfUEVSTUFORU5UEAISIAocRVJST1JfQ0FURUdPUllfQ09ORklHVVJBVElPThADKr8ECglFcnJvckNvZGUSGgoWRVJST1JfQ09ERV9VTlNQRUNJRklFRBAAEh4KGkVSUk9SX0NPREVfTkVUV09SS19USU1FT1VUEAESIgoeRVJST1JfQ09ERV9TRVJWSUNFX1VOQVZBSUxBQkxFEAISGwoXRVJST1JfQ09ERV9SQVRFX0xJTUlURUQQAxIgChxFUlJPUl9DT0RFX1RFTVBPUkFSWV9GQUlMVVJFEAQSGwoXRVJST1JfQ09ERV9DSVJDVUlUX09QRU4QBRIfChtFUlJPUl9DT0RFX0lOVkFMSURfUkVTT1VSQ0UQBhIhCh1FUlJPUl9DT0RFX1JFU09VUkNFX05PVF9GT1VORBAHEiEKHUVSUk9SX0NPREVfSU5WQUxJRF9USU1FX1JBTkdFEAgSIQodRVJST1JfQ09ERV9VTlNVUFBPUlRFRF9SRUdJT04QCRIgChxFUlJPUl9DT0RFX1BFUk1JU1NJT05fREVOSUVEEAoSHgoaRVJST1JfQ09ERV9EQVRBX0NPUlJVUFRJT04QCxIiCh5FUlJPUl9DT0RFX0lOVkFMSURfQ1JFREVOVElBTFMQDBIeChpFUlJPUl9DT0RFX01JU1NJTkdfQVBJX0tFWRANEh8KG0VSUk9SX0NPREVfSU5WQUxJRF9FTkRQT0lOVBAOEh8KG0VSUk9SX0NPREVfSU5WQUxJRF9QUk9WSURFUhAPEiQKIEVSUk9SX0NPREVfUExVR0lOX05PVF9DT05GSUdVUkVEEBAqjQEKCk1ldHJpY1R5cGUSGwoXTUVUUklDX1RZUEVfVU5TUEVDSUZJRUQQABIXChNNRVRSSUNfVFlQRV9DT1VOVEVSEAESFQoRTUVUUklDX1RZUEVfR0FVR0UQAhIZChVNRVRSSUNfVFlQRV9ISVNUT0dSQU0QAxIXChNNRVRSSUNfVFlQRV9TVU1NQVJZEAQqdwoJU0xJU3RhdHVzEhoKFlNMSV9TVEFUVVNfVU5TUEVDSUZJRUQQABIdChlTTElfU1RBVFVTX01FRVRJTkdfVEFSR0VUEAESFgoSU0xJX1NUQVRVU19XQVJOSU5HEAISFwoTU0xJX1NUQVRVU19DUklUSUNBTBADKoACChZSZWNvbW1lbmRhdGlvbkNhdGVnb3J5EicKI1JFQ09NTUVOREFUSU9OX0NBVEVHT1JZX1VOU1BFQ0lGSUVEEAASIAocUkVDT01NRU5EQVRJT05fQ0FURUdPUllfQ09TVBABEicKI1JFQ09NTUVOREFUSU9OX0NBVEVHT1JZX1BFUkZPUk1BTkNFEAISJAogUkVDT01NRU5EQVRJT05fQ0FURUdPUllfU0VDVVJJVFkQAxInCiNSRUNPTU1FTkRBVElPTl9DQVRFR09SWV9SRUxJQUJJTElUWRAEEiMKH1JFQ09NTUVOREFUSU9OX0NBVEVHT1JZX0FOT01BTFkQBSrLBAoYUmVjb21tZW5kYXRpb25BY3Rpb25UeXBlEioKJlJFQ09NTUVOREFUSU9OX0FDVElPTl9UWVBFX1VOU1BFQ0lGSUVEEAASKAokUkVDT01NRU5EQVRJT05fQUNUSU9OX1RZUEVfUklHSFRTSVpFEAESKAokUkVDT01NRU5EQVRJT05fQUNUSU9OX1RZUEVfVEVSTUlOQVRFEAISMgouUkVDT01NRU5EQVRJT05fQUNUSU9OX1RZUEVfUFVSQ0hBU0VfQ09NTUlUTUVOVBADEi4KKlJFQ09NTUVOREFUSU9OX0FDVElPTl9UWVBFX0FESlVTVF9SRVFVRVNUUxAEEiUKIVJFQ09NTUVOREFUSU9OX0FDVElPTl9UWVBFX01PRElGWRAFEiwKKFJFQ09NTUVOREFUSU9OX0FDVElPTl9UWVBFX0RFTEVURV9VTlVTRUQQBhImCiJSRUNPTU1FTkRBVElPTl9BQ1RJT05fVFlQRV9NSUdSQVRFEAcSKgomUkVDT01NRU5EQVRJT05fQUNUSU9OX1RZUEVfQ09OU09MSURBVEUQCBInCiNSRUNPTU1FTkRBVElPTl9BQ1RJT05fVFlQRV9TQ0hFRFVMRRAJEicKI1JFQ09NTUVOREFUSU9OX0FDVElPTl9UWVBFX1JFRkFDVE9SEAoSJAogUkVDT01NRU5EQVRJT05fQUNUSU9OX1RZUEVfT1RIRVIQCxIqCiZSRUNPTU1FTkRBVElPTl9BQ1RJT05fVFlQRV9JTlZFU1RJR0FURRAMKs4BChZSZWNvbW1lbmRhdGlvblByaW9yaXR5EicKI1JFQ09NTUVOREFUSU9OX1BSSU9SSVRZX1VOU1BFQ0lGSUVEEAASHwobUkVDT01NRU5EQVRJT05fUFJJT1JJVFlfTE9XEAESIgoeUkVDT01NRU5EQVRJT05fUFJJT1JJVFlfTUVESVVNEAISIAocUkVDT01NRU5EQVRJT05fUFJJT1JJVFlfSElHSBADEiQKIFJFQ09NTUVOREFUSU9OX1BSSU9SSVRZX0NSSVRJQ0FMEAQqgQIKFFJlY29tbWVuZGF0aW9uU29ydEJ5EiYKIlJFQ09NTUVOREFUSU9OX1NPUlRfQllfVU5TUEVDSUZJRUQQABIsCihSRUNPTU1FTkRBVElPTl9TT1JUX0JZX0VTVElNQVRFRF9TQVZJTkdTEAESIwofUkVDT01NRU5EQVRJT05fU09SVF9CWV9QUklPUklUWRACEiUKIVJFQ09NTUVOREFUSU9OX1NPUlRfQllfQ1JFQVRFRF9BVBADEiUKIVJFQ09NTUVOREFUSU9OX1NPUlRfQllfQ09ORklERU5DRRAEEiAKHFJFQ09NTUVOREFUSU9OX1NPUlRfQllfU0NPUkUQBSpQCglTb3J0T3JkZXISGgoWU09SVF9PUkRFUl9VTlNQRUNJRklFRBAAEhIKDlNPUlRfT1JERVJfQVNDEAESEwoPU09SVF9PUkRFUl9ERVNDEAIqswIKD0Rpc21pc3NhbFJlYXNvbhIgChxESVNNSVNTQUxfUkVBU09OX1VOU1BFQ0lGSUVEEAASIwofRElTTUlTU0FMX1JFQVNPTl9OT1RfQVBQTElDQUJMRRABEigKJERJU01JU1NBTF9SRUFTT05fQUxSRUFEWV9JTVBMRU1FTlRFRBACEigKJERJU01JU1NBTF9SRUFTT05fQlVTSU5FU1NfQ09OU1RSQUlOVBADEikKJURJU01JU1NBTF9SRUFTT05fVEVDSE5JQ0FMX0NPTlNUUkFJTlQQBBIdChlESVNNSVNTQUxfUkVBU09OX0RFRkVSUkVEEAUSHwobRElTTUlTU0FMX1JFQVNPTl9JTkFDQ1VSQVRFEAYSGgoWRElTTUlTU0FMX1JFQVNPTl9PVEhFUhAHMsoKChFDb3N0U291cmNlU2VydmljZRI7CgROYW1lEhguZmluZm9jdXMudjEuTmFtZVJlcXVlc3QaGS5maW5mb2N1cy52MS5OYW1lUmVzcG9uc2USRwoIU3VwcG9ydHMSHC5maW5mb2N1cy52MS5TdXBwb3J0c1JlcXVlc3QaHS5maW5mb2N1cy52MS5TdXBwb3J0c1Jlc3BvbnNlElYKDUdldEFjdHVhbENvc3QSIS5maW5mb2N1cy52MS5HZXRBY3R1YWxDb3N0UmVxdWVzdBoiLmZpbmZvY3VzLnYxLkdldEFjdHVhbENvc3RSZXNwb25zZRJfChBHZXRQcm9qZWN0ZWRDb3N0EiQuZmluZm9jdXMudjEuR2V0UHJvamVjdGVkQ29zdFJlcXVlc3QaJS5maW5mb2N1cy52MS5HZXRQcm9qZWN0ZWRDb3N0UmVzcG9uc2USWQoOR2V0UHJpY2luZ1NwZWMSIi5maW5mb2N1cy52MS5HZXRQcmljaW5nU3BlY1JlcXVlc3QaIy5maW5mb2N1cy52MS5HZXRQcmljaW5nU3BlY1Jlc3BvbnNlElMKDEVzdGltYXRlQ29zdBIgLmZpbmZvY3VzLnYxLkVzdGltYXRlQ29zdFJlcXVlc3QaIS5maW5mb2N1cy52MS5Fc3RpbWF0ZUNvc3RSZXNwb25zZRJlChJHZXRSZWNvbW1lbmRhdGlvbnMSJi5maW5mb2N1cy52MS5HZXRSZWNvbW1lbmRhdGlvbnNSZXF1ZXN0GicuZmluZm9jdXMudjEuR2V0UmVjb21tZW5kYXRpb25zUmVzcG9uc2USbgoVRGlzbWlzc1JlY29tbWVuZGF0aW9uEikuZmluZm9jdXMudjEuRGlzbWlzc1JlY29tbWVuZGF0aW9uUmVxdWVzdBoqLmZpbmZvY3VzLnYxLkRpc21pc3NSZWNvbW1lbmRhdGlvblJlc3BvbnNlEk0KCkdldEJ1ZGdldHMSHi5maW5mb2N1cy52MS5HZXRCdWRnZXRzUmVxdWVzdBofLmZpbmZvY3VzLnYxLkdldEJ1ZGdldHNSZXNwb25zZRJWCg1HZXRQbHVnaW5JbmZvEiEuZmluZm9jdXMudjEuR2V0UGx1Z2luSW5mb1JlcXVlc3QaIi5maW5mb2N1cy52MS5HZXRQbHVnaW5JbmZvUmVzcG9uc2USQQoGRHJ5UnVuEhouZmluZm9jdXMudjEuRHJ5UnVuUmVxdWVzdBobLmZpbmZvY3VzLnYxLkRyeVJ1blJlc3BvbnNlElYKEFN0cmVhbUFjdHVhbENvc3QSIS5maW5mb2N1cy52MS5HZXRBY3R1YWxDb3N0UmVxdWVzdBodLmZpbmZvY3VzLnYxLkFjdHVhbENvc3RSZXN1bHQwARJoChVHZXRQcm9qZWN0ZWRDb3N0QmF0Y2gSJi5maW5mb2N1cy52MS5CYXRjaFByb2plY3RlZENvc3RSZXF1ZXN0GicuZmluZm9jdXMudjEuQmF0Y2hQcm9qZWN0ZWRDb3N0UmVzcG9uc2USawoVR2V0U3VwcG9ydGVkUmVzb3VyY2VzEikuZmluZm9jdXMudjEuR2V0U3VwcG9ydGVkUmVzb3VyY2VzUmVxdWVzdBonLmZpbmZvY3VzLnYxLlN1cHBvcnRlZFJlc291cmNlc1Jlc3BvbnNlElYKDVN1cHBvcnRzQmF0Y2gSIS5maW5mb2N1cy52MS5TdXBwb3J0c0JhdGNoUmVxdWVzdBoiLmZpbmZvY3VzLnYxLlN1cHBvcnRzQmF0Y2hSZXNwb25zZTKzAgoUT2JzZXJ2YWJpbGl0eVNlcnZpY2USUAoLSGVhbHRoQ2hlY2sSHy5maW5mb2N1cy52MS5IZWFsdGhDaGVja1JlcXVlc3QaIC5maW5mb2N1cy52MS5IZWFsdGhDaGVja1Jlc3BvbnNlEk0KCkdldE1ldHJpY3MSHi5maW5mb2N1cy52MS5HZXRNZXRyaWNzUmVxdWVzdBofLmZpbmZvY3VzLnYxLkdldE1ldHJpY3NSZXNwb25zZRJ6ChlHZXRTZXJ2aWNlTGV2ZWxJbmRpY2F0b3JzEi0uZmluZm9jdXMudjEuR2V0U2VydmljZUxldmVsSW5kaWNhdG9yc1JlcXVlc3QaLi5maW5mb2N1cy52MS5HZXRTZXJ2aWNlTGV2ZWxJbmRpY2F0b3JzUmVzcG9uc2VCrQEKD2NvbS5maW5mb2N1cy52MUIPQ29zdHNvdXJjZVByb3RvUAFaPGdpdGh1Yi5jb20vcnNoYWRlL2ZpbmZvY3VzLXNwZWMvc2RrL2dvL3Byb3RvL2ZpbmZvY3VzL3YxO3BiY6ICA0ZYWKoCC0ZpbmZvY3VzLlYxygILRmluZm9jdXNcVjHiAhdGaW5mb2N1c1xWMVxHUEJNZXRhZGF0YeoCDEZpbmZvY3VzOjpWMWIGcHJvdG8z", [file_google_protobuf_timestamp, file_google_protobuf_struct, file_finfocus_v1_focus, file_finfocus_v1_budget, file_finfocus_v1_enums]);
 
 /**
  * NameRequest is used for the Name RPC call (empty request).
@@ -576,6 +576,46 @@ export type GetProjectedCostResponse = Message<"finfocus.v1.GetProjectedCostResp
    * @generated from field: optional double confidence_level = 12;
    */
   confidenceLevel?: number;
+
+  /**
+   * billing_mode is the billing mode unit_price is quoted in (e.g., "per_hour",
+   * "per_gb_month", "tiered"), matching PricingSpec.billing_mode.
+   * Empty when the plugin does not report it.
+   *
+   * @generated from field: string billing_mode = 13;
+   */
+  billingMode: string;
+
+  /**
+   * rate_per_unit is the base rate per unit of billing_mode, matching
+   * PricingSpec.rate_per_unit. For tiered pricing, see pricing_tiers.
+   *
+   * @generated from field: double rate_per_unit = 14;
+   */
+  ratePerUnit: number;
+
+  /**
+   * unit is the unit the rate applies to (e.g., "hour", "GB-month", "request"),
+   * matching PricingSpec.unit.
+   *
+   * @generated from field: string unit = 15;
+   */
+  unit: string;
+
+  /**
+   * assumptions contains human-readable strings explaining how cost_per_month
+   * was derived (e.g., "730 hours per month", "100 GB provisioned").
+   *
+   * @generated from field: repeated string assumptions = 16;
+   */
+  assumptions: string[];
+
+  /**
+   * pricing_tiers contains the tiered pricing breakdown when billing_mode is "tiered".
+   *
+   * @generated from field: repeated finfocus.v1.PricingTier pricing_tiers = 17;
+   */
+  pricingTiers: PricingTier[];
 };
 
 /**
@@ -1806,6 +1846,17 @@ export type EstimateCostResponse = Message<"finfocus.v1.EstimateCostResponse"> &
    * @generated from field: double spot_interruption_risk_score = 4;
    */
   spotInterruptionRiskScore: number;
+
+  /**
+   * explanation contains the ordered, human-readable calculation steps used to
+   * derive cost_monthly (e.g., "0.0104 USD/hour x 730 hours x 2 instances = 15.18 USD")
+   * so users can audit the estimate. Empty when the plugin does not provide it.
+   *
+   * New in FinFocus 1.3+. Set via pluginsdk.WithExplanation().
+   *
+   * @generated from field: repeated string explanation = 5;
+   */
+  explanation: string[];
 };
 
 /**
@@ -2085,6 +2136,16 @@ export type RecommendationFilter = Message<"finfocus.v1.RecommendationFilter"> &
    * @generated from field: string resource_id = 16;
    */
   resourceId: string;
+
+  /**
+   * exclude_unknown_confidence controls how min_confidence_score treats
+   * recommendations without a confidence score. By default (false) unknown
+   * confidence passes the filter; when true such recommendations are dropped.
+   * Has no effect when min_confidence_score is 0.
+   *
+   * @generated from field: bool exclude_unknown_confidence = 17;
+   */
+  excludeUnknownConfidence: boolean;
 };
 
 /**
@@ -3116,6 +3177,240 @@ export type DryRunResponse = Message<"finfocus.v1.DryRunResponse"> & {
 export const DryRunResponseSchema: GenMessage<DryRunResponse> = /*@__PURE__*/
   messageDesc(file_finfocus_v1_costsource, 52);
 
+/**
+ * BatchProjectedCostRequest requests projected costs for many resources in one call.
+ *
+ * @generated from message finfocus.v1.BatchProjectedCostRequest
+ */
+export type BatchProjectedCostRequest = Message<"finfocus.v1.BatchProjectedCostRequest"> & {
+  /**
+   * resources to project, in order. Maximum 1000 resources per request.
+   *
+   * @generated from field: repeated finfocus.v1.ResourceDescriptor resources = 1;
+   */
+  resources: ResourceDescriptor[];
+
+  /**
+   * utilization_percentage is the default utilization applied to every resource,
+   * with the same semantics as GetProjectedCostRequest.utilization_percentage.
+   *
+   * @generated from field: double utilization_percentage = 2;
+   */
+  utilizationPercentage: number;
+
+  /**
+   * usage_profile is applied to every resource, with the same semantics as
+   * GetProjectedCostRequest.usage_profile.
+   *
+   * @generated from field: finfocus.v1.UsageProfile usage_profile = 3;
+   */
+  usageProfile: UsageProfile;
+};
+
+/**
+ * Describes the message finfocus.v1.BatchProjectedCostRequest.
+ * Use `create(BatchProjectedCostRequestSchema)` to create a new message.
+ */
+export const BatchProjectedCostRequestSchema: GenMessage<BatchProjectedCostRequest> = /*@__PURE__*/
+  messageDesc(file_finfocus_v1_costsource, 53);
+
+/**
+ * BatchProjectedCostResponse contains one result per requested resource.
+ *
+ * @generated from message finfocus.v1.BatchProjectedCostResponse
+ */
+export type BatchProjectedCostResponse = Message<"finfocus.v1.BatchProjectedCostResponse"> & {
+  /**
+   * results are aligned with BatchProjectedCostRequest.resources:
+   * results[i] is the outcome for resources[i].
+   *
+   * @generated from field: repeated finfocus.v1.BatchProjectedCostResult results = 1;
+   */
+  results: BatchProjectedCostResult[];
+};
+
+/**
+ * Describes the message finfocus.v1.BatchProjectedCostResponse.
+ * Use `create(BatchProjectedCostResponseSchema)` to create a new message.
+ */
+export const BatchProjectedCostResponseSchema: GenMessage<BatchProjectedCostResponse> = /*@__PURE__*/
+  messageDesc(file_finfocus_v1_costsource, 54);
+
+/**
+ * BatchProjectedCostResult is the outcome of projecting a single resource in a batch.
+ * Exactly one of response or error is set.
+ *
+ * @generated from message finfocus.v1.BatchProjectedCostResult
+ */
+export type BatchProjectedCostResult = Message<"finfocus.v1.BatchProjectedCostResult"> & {
+  /**
+   * response is the projected cost when the resource was projected successfully.
+   *
+   * @generated from field: finfocus.v1.GetProjectedCostResponse response = 1;
+   */
+  response?: GetProjectedCostResponse;
+
+  /**
+   * error describes why the resource could not be projected. A failed resource
+   * does not fail the rest of the batch.
+   *
+   * @generated from field: finfocus.v1.ErrorDetail error = 2;
+   */
+  error?: ErrorDetail;
+};
+
+/**
+ * Describes the message finfocus.v1.BatchProjectedCostResult.
+ * Use `create(BatchProjectedCostResultSchema)` to create a new message.
+ */
+export const BatchProjectedCostResultSchema: GenMessage<BatchProjectedCostResult> = /*@__PURE__*/
+  messageDesc(file_finfocus_v1_costsource, 55);
+
+/**
+ * GetSupportedResourcesRequest is used for the GetSupportedResources RPC call (empty request).
+ *
+ * @generated from message finfocus.v1.GetSupportedResourcesRequest
+ */
+export type GetSupportedResourcesRequest = Message<"finfocus.v1.GetSupportedResourcesRequest"> & {
+};
+
+/**
+ * Describes the message finfocus.v1.GetSupportedResourcesRequest.
+ * Use `create(GetSupportedResourcesRequestSchema)` to create a new message.
+ */
+export const GetSupportedResourcesRequestSchema: GenMessage<GetSupportedResourcesRequest> = /*@__PURE__*/
+  messageDesc(file_finfocus_v1_costsource, 56);
+
+/**
+ * SupportedResourcesResponse lists the resources a plugin can price.
+ *
+ * @generated from message finfocus.v1.SupportedResourcesResponse
+ */
+export type SupportedResourcesResponse = Message<"finfocus.v1.SupportedResourcesResponse"> & {
+  /**
+   * resources supported by the plugin, sorted by provider, then resource_type.
+   *
+   * @generated from field: repeated finfocus.v1.SupportedResource resources = 1;
+   */
+  resources: SupportedResource[];
+};
+
+/**
+ * Describes the message finfocus.v1.SupportedResourcesResponse.
+ * Use `create(SupportedResourcesResponseSchema)` to create a new message.
+ */
+export const SupportedResourcesResponseSchema: GenMessage<SupportedResourcesResponse> = /*@__PURE__*/
+  messageDesc(file_finfocus_v1_costsource, 57);
+
+/**
+ * SupportedResource is a provider and resource type pair a plugin can price.
+ *
+ * @generated from message finfocus.v1.SupportedResource
+ */
+export type SupportedResource = Message<"finfocus.v1.SupportedResource"> & {
+  /**
+   * provider is the cloud provider (e.g., "aws", "azure", "gcp").
+   *
+   * @generated from field: string provider = 1;
+   */
+  provider: string;
+
+  /**
+   * resource_type is the resource type (e.g., "aws:ec2/instance:Instance").
+   * Empty when every resource type of the provider is supported.
+   *
+   * @generated from field: string resource_type = 2;
+   */
+  resourceType: string;
+
+  /**
+   * example_skus are optional representative SKUs (e.g., "t3.micro") to
+   * pre-fill pickers. Not an exhaustive list of supported SKUs.
+   *
+   * @generated from field: repeated string example_skus = 3;
+   */
+  exampleSkus: string[];
+};
+
+/**
+ * Describes the message finfocus.v1.SupportedResource.
+ * Use `create(SupportedResourceSchema)` to create a new message.
+ */
+export const SupportedResourceSchema: GenMessage<SupportedResource> = /*@__PURE__*/
+  messageDesc(file_finfocus_v1_costsource, 58);
+
+/**
+ * SupportsBatchRequest checks many resources in one call.
+ *
+ * @generated from message finfocus.v1.SupportsBatchRequest
+ */
+export type SupportsBatchRequest = Message<"finfocus.v1.SupportsBatchRequest"> & {
+  /**
+   * resources to check, in order. Maximum 500 resources per request.
+   *
+   * @generated from field: repeated finfocus.v1.ResourceDescriptor resources = 1;
+   */
+  resources: ResourceDescriptor[];
+};
+
+/**
+ * Describes the message finfocus.v1.SupportsBatchRequest.
+ * Use `create(SupportsBatchRequestSchema)` to create a new message.
+ */
+export const SupportsBatchRequestSchema: GenMessage<SupportsBatchRequest> = /*@__PURE__*/
+  messageDesc(file_finfocus_v1_costsource, 59);
+
+/**
+ * SupportsBatchResponse contains one result per requested resource.
+ *
+ * @generated from message finfocus.v1.SupportsBatchResponse
+ */
+export type SupportsBatchResponse = Message<"finfocus.v1.SupportsBatchResponse"> & {
+  /**
+   * results are aligned with SupportsBatchRequest.resources:
+   * results[i] is the answer for resources[i].
+   *
+   * @generated from field: repeated finfocus.v1.SupportsBatchResult results = 1;
+   */
+  results: SupportsBatchResult[];
+};
+
+/**
+ * Describes the message finfocus.v1.SupportsBatchResponse.
+ * Use `create(SupportsBatchResponseSchema)` to create a new message.
+ */
+export const SupportsBatchResponseSchema: GenMessage<SupportsBatchResponse> = /*@__PURE__*/
+  messageDesc(file_finfocus_v1_costsource, 60);
+
+/**
+ * SupportsBatchResult is the answer for a single resource in a batch.
+ *
+ * @generated from message finfocus.v1.SupportsBatchResult
+ */
+export type SupportsBatchResult = Message<"finfocus.v1.SupportsBatchResult"> & {
+  /**
+   * supported indicates whether the plugin can price the resource.
+   *
+   * @generated from field: bool supported = 1;
+   */
+  supported: boolean;
+
+  /**
+   * reason explains why the resource is not supported, or why it could not
+   * be checked. Empty when supported is true.
+   *
+   * @generated from field: string reason = 2;
+   */
+  reason: string;
+};
+
+/**
+ * Describes the message finfocus.v1.SupportsBatchResult.
+ * Use `create(SupportsBatchResultSchema)` to create a new message.
+ */
+export const SupportsBatchResultSchema: GenMessage<SupportsBatchResult> = /*@__PURE__*/
+  messageDesc(file_finfocus_v1_costsource, 61);
+
 /**
  * MetricKind represents the type of sustainability/impact metric supported by a plugin.
  *
@@ -3667,6 +3962,13 @@ export enum RecommendationSortBy {
    * @generated from enum value: RECOMMENDATION_SORT_BY_CONFIDENCE = 4;
    */
   CONFIDENCE = 4,
+
+  /**
+   * Combined score of estimated savings, priority, and confidence (see pluginsdk.ScoreRecommendation)
+   *
+   * @generated from enum value: RECOMMENDATION_SORT_BY_SCORE = 5;
+   */
+  SCORE = 5,
 }
 
 /**
@@ -3998,6 +4300,116 @@ export const CostSourceService: GenService<{
     input: typeof DryRunRequestSchema;
     output: typeof DryRunResponseSchema;
   },
+  /**
+   * StreamActualCost retrieves actual cost data as a stream of results
+   * instead of a single response. Use it for large time ranges where
+   * buffering every result in one GetActualCostResponse is impractical.
+   *
+   * The request is identical to GetActualCost. Each ActualCostResult is sent
+   * as soon as it is available; the stream ends when all results for the
+   * requested range have been sent. Plugins that page their GetActualCost
+   * responses should follow next_page_token internally and stream every page.
+   *
+   * Plugins that do not stream natively may adapt their unary GetActualCost
+   * handler; the Go SDK does this automatically.
+   *
+   * Error cases:
+   *   - InvalidArgument: Invalid request (same rules as GetActualCost)
+   *   - Unimplemented: Plugin does not support streaming
+   *   - Internal: Failure while retrieving cost data (results already sent
+   *     remain valid)
+   *
+   * Client-side example (Go):
+   *
+   *   stream, err := client.StreamActualCost(ctx, &GetActualCostRequest{...})
+   *   if err != nil {
+   *       return err
+   *   }
+   *   for stream.Receive() {
+   *       process(stream.Msg())
+   *   }
+   *   return stream.Err()
+   *
+   *
+   * @generated from rpc finfocus.v1.CostSourceService.StreamActualCost
+   */
+  streamActualCost: {
+    methodKind: "server_streaming";
+    input: typeof GetActualCostRequestSchema;
+    output: typeof ActualCostResultSchema;
+  },
+  /**
+   * GetProjectedCostBatch calculates projected costs for many resources in a
+   * single call, avoiding one GetProjectedCost round-trip per resource when
+   * estimating a whole stack.
+   *
+   * Results are aligned with the request: results[i] is the outcome for
+   * resources[i]. A resource that cannot be projected gets an ErrorDetail in
+   * its result instead of failing the whole batch.
+   *
+   * Plugins that do not implement batching natively may project each resource
+   * with their GetProjectedCost handler; the Go SDK does this automatically.
+   *
+   * Error cases (whole batch):
+   *   - InvalidArgument: More than 1000 resources in the request
+   *   - Unimplemented: Plugin does not support batch projection
+   *   - Canceled/DeadlineExceeded: The call was cancelled before all
+   *     resources were projected
+   *
+   *
+   * @generated from rpc finfocus.v1.CostSourceService.GetProjectedCostBatch
+   */
+  getProjectedCostBatch: {
+    methodKind: "unary";
+    input: typeof BatchProjectedCostRequestSchema;
+    output: typeof BatchProjectedCostResponseSchema;
+  },
+  /**
+   * GetSupportedResources lists the provider and resource type pairs the
+   * plugin can price, so tools can show coverage (e.g., in a resource picker)
+   * before a user runs an estimate. Use Supports to check a specific resource.
+   *
+   * Resources are sorted by provider, then resource_type. An empty
+   * resource_type means the plugin supports every resource type of that
+   * provider.
+   *
+   * Error cases:
+   *   - Unimplemented: Plugin cannot enumerate its supported resources
+   *
+   *
+   * @generated from rpc finfocus.v1.CostSourceService.GetSupportedResources
+   */
+  getSupportedResources: {
+    methodKind: "unary";
+    input: typeof GetSupportedResourcesRequestSchema;
+    output: typeof SupportedResourcesResponseSchema;
+  },
+  /**
+   * SupportsBatch checks many resources in a single call, avoiding one
+   * Supports round-trip per resource when planning a stack.
+   *
+   * Results are aligned with the request: results[i] is the answer for
+   * resources[i]. A resource that cannot be checked is reported as not
+   * supported, with the failure as its reason, instead of failing the whole
+   * batch.
+   *
+   * Plugins that do not implement batching natively may check each resource
+   * with their Supports handler; the Go SDK does this automatically.
+   *
+   * Error cases (whole batch):
+   *   - InvalidArgument: More than 500 resources in the request
+   *   - Unimplemented: Plugin does not support batch checks
+   *   - Canceled/DeadlineExceeded: The call was cancelled before all
+   *     resources were checked
+   *
+   *
+   * @generated from rpc finfocus.v1.CostSourceService.SupportsBatch
+   */
+  supportsBatch: {
+    methodKind: "unary";
+    input: typeof SupportsBatchRequestSchema;
+    output: typeof SupportsBatchResponseSchema;
+  },
 }> = /*@__PURE__*/
   serviceDesc(file_finfocus_v1_costsource, 0);
 